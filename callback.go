@@ -1,6 +1,7 @@
 package glfw
 
 type SizeCallback func(w *Window, width int, height int)
+type PosCallback func(w *Window, xpos int, ypos int)
 type CursorPosCallback func(w *Window, xpos float64, ypos float64)
 type KeyCallback func(w *Window, key Key, scancode int, action Action, mods ModifierKey)
 type DropCallback func(w *Window, names []string)
@@ -14,7 +15,18 @@ type MaximizeCallback func(w *Window, maximized bool)
 type ScrollCallback func(w *Window, xoff float64, yoff float64)
 type MouseButtonCallback func(w *Window, button MouseButton, action Action, mods ModifierKey)
 type CloseCallback func(w *Window)
+type ContextLostCallback func(w *Window, status ResetStatus)
 type ErrorCallbackFunc func(e int, description string)
+type MonitorCallback func(monitor *Monitor, event PeripheralEvent)
+
+// PeripheralEvent tells whether a monitor was connected or disconnected.
+type PeripheralEvent int
+
+// Monitor events.
+const (
+	Connected    PeripheralEvent = glfw_CONNECTED
+	Disconnected PeripheralEvent = glfw_DISCONNECTED
+)
 
 // SetCursorPosCallback sets the cursor position callback which is called
 // when the cursor is moved. The callback is provided with the position relative
@@ -83,11 +95,33 @@ func (w *Window) SetFramebufferSizeCallback(cbfun SizeCallback) (previous SizeCa
 	return previous
 }
 
+// SetPosCallback sets the position callback of the Window, which is called
+// when the Window is moved. The callback is provided with the position, in
+// screen coordinates, of the upper-left corner of the client area.
+func (w *Window) SetPosCallback(cbfun PosCallback) (previous PosCallback) {
+	w.posCallback, previous = cbfun, w.posCallback
+	return previous
+}
+
 func (w *Window) SetIconifyCallback(cbfun IconifyCallback) (previous IconifyCallback) {
 	w.iconifyCallback, previous = cbfun, w.iconifyCallback
 	return previous
 }
 
+// SetMaximizeCallback sets the maximize callback of the Window, which is
+// called when the Window is maximized or restored.
+func (w *Window) SetMaximizeCallback(cbfun MaximizeCallback) (previous MaximizeCallback) {
+	w.maximizeCallback, previous = cbfun, w.maximizeCallback
+	return previous
+}
+
+// SetCursorEnterCallback sets the cursor boundary crossing callback of the
+// Window, which is called when the cursor enters or leaves the client area.
+func (w *Window) SetCursorEnterCallback(cbfun CursorEnterCallback) (previous CursorEnterCallback) {
+	w.cursorEnterCallback, previous = cbfun, w.cursorEnterCallback
+	return previous
+}
+
 // SetMouseButtonCallback sets the mouse button callback which is called when a
 // mouse button is pressed or released.
 //
@@ -116,8 +150,27 @@ func (w *Window) SetCloseCallback(cbfun CloseCallback) (previous CloseCallback)
 	return previous
 }
 
-// SetErrorCallback is not used
+// SetContextLostCallback sets the context lost callback of the window, which is
+// called from SwapBuffers the first time a graphics reset is detected. Resets
+// are only reported for contexts created with the ContextRobustness hint set
+// to LoseContextOnReset. See RecreateContext.
+func (w *Window) SetContextLostCallback(cbfun ContextLostCallback) (previous ContextLostCallback) {
+	w.contextLostCallback, previous = cbfun, w.contextLostCallback
+	return previous
+}
+
+// SetMonitorCallback sets the monitor configuration callback, which is called
+// when a monitor is connected to or disconnected from the system.
+func SetMonitorCallback(cbfun MonitorCallback) (previous MonitorCallback) {
+	_glfw.monitorCallback, previous = cbfun, _glfw.monitorCallback
+	return previous
+}
+
+// SetErrorCallback sets the error callback, which is called with an error code
+// and a human-readable description each time a non-fatal error occurs.
 func SetErrorCallback(cbfun ErrorCallbackFunc) (previous ErrorCallbackFunc) {
+	_glfw.errorLock.Lock()
+	defer _glfw.errorLock.Unlock()
 	_glfw.errorCallback, previous = cbfun, _glfw.errorCallback
 	return previous
 }
@@ -0,0 +1,455 @@
+// Glfwinfo prints the library version, the monitors and the framebuffer and
+// context obtained with the given hints, as text or JSON. It is a port of
+// tests/glfwinfo.c of GLFW, with the same flags:
+//
+//	go run ./cmd/glfwinfo [-a es] [-m 3] [-n 3] [-p core] [-l] [-json]
+//
+// Framebuffer bit counts accept "-" for DontCare. Run with -h for the list
+// of flags.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+	"strconv"
+
+	glfw "github.com/jkvatne/purego-glfw"
+)
+
+// hintFlag is a window hint set from the command line. Counts accept "-"
+// for DontCare.
+type hintFlag struct {
+	hint  glfw.Hint
+	value int
+	set   bool
+}
+
+func (h *hintFlag) String() string {
+	if h == nil || !h.set {
+		return ""
+	}
+	if h.value == glfw.DontCare {
+		return "-"
+	}
+	return strconv.Itoa(h.value)
+}
+
+func (h *hintFlag) Set(s string) error {
+	if s == "-" {
+		h.value, h.set = glfw.DontCare, true
+		return nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil || v < 0 {
+		return fmt.Errorf("invalid count %q", s)
+	}
+	h.value, h.set = v, true
+	return nil
+}
+
+// enumFlag is a window hint set by name from the command line
+type enumFlag struct {
+	hint   glfw.Hint
+	values map[string]int
+	name   string
+}
+
+func (e *enumFlag) String() string {
+	if e == nil {
+		return ""
+	}
+	return e.name
+}
+
+func (e *enumFlag) Set(s string) error {
+	if _, ok := e.values[s]; !ok {
+		return fmt.Errorf("invalid value %q", s)
+	}
+	e.name = s
+	return nil
+}
+
+type monitorInfo struct {
+	Name        string             `json:"name"`
+	Primary     bool               `json:"primary"`
+	X           int                `json:"x"`
+	Y           int                `json:"y"`
+	WidthMM     int                `json:"widthMM"`
+	HeightMM    int                `json:"heightMM"`
+	ScaleX      float32            `json:"scaleX"`
+	ScaleY      float32            `json:"scaleY"`
+	Workarea    [4]int             `json:"workarea"` // x, y, width, height
+	CurrentMode glfw.GLFWvidmode   `json:"currentMode"`
+	Modes       []glfw.GLFWvidmode `json:"modes"`
+}
+
+type report struct {
+	HeaderVersion  string                   `json:"headerVersion"`
+	LibraryVersion string                   `json:"libraryVersion"`
+	VersionString  string                   `json:"versionString"`
+	Platform       string                   `json:"platform"`
+	Monitors       []monitorInfo            `json:"monitors"`
+	Framebuffer    glfw.FramebufferConfig   `json:"framebuffer"`
+	Unsatisfied    []string                 `json:"unsatisfied,omitempty"`
+	Context        glfw.ContextInfo         `json:"context"`
+	Formats        []glfw.FramebufferConfig `json:"formats,omitempty"`
+}
+
+// The window, its context and the OpenGL calls must stay on the main thread
+func init() {
+	runtime.LockOSThread()
+}
+
+func main() {
+	os.Exit(run())
+}
+
+// run returns the exit code, so the deferred Terminate restores the video
+// modes before the program exits
+func run() int {
+	clientAPI := &enumFlag{hint: glfw.ClientAPI, values: map[string]int{"gl": glfw.OpenGLAPI, "es": glfw.OpenGLESAPI}}
+	contextAPI := &enumFlag{hint: glfw.ContextCreationAPI, values: map[string]int{"native": glfw.NativeContextAPI, "egl": glfw.EGLContextAPI, "osmesa": glfw.OSMesaContextAPI}}
+	behavior := &enumFlag{hint: glfw.ContextReleaseBehavior, values: map[string]int{"none": glfw.ReleaseBehaviorNone, "flush": glfw.ReleaseBehaviorFlush}}
+	profile := &enumFlag{hint: glfw.OpenGLProfile, values: map[string]int{"core": glfw.OpenGLCoreProfile, "compat": glfw.OpenGLCompatProfile}}
+	robustness := &enumFlag{hint: glfw.ContextRobustness, values: map[string]int{"none": glfw.NoResetNotification, "lose": glfw.LoseContextOnReset}}
+	major := &hintFlag{hint: glfw.ContextVersionMajor}
+	minor := &hintFlag{hint: glfw.ContextVersionMinor}
+	enums := []*enumFlag{clientAPI, contextAPI, behavior, profile, robustness}
+	alias := func(value flag.Value, long, short, usage string) {
+		flag.Var(value, long, usage)
+		flag.Var(value, short, "short for -"+long)
+	}
+	alias(clientAPI, "client-api", "a", "client API: gl or es")
+	alias(behavior, "behavior", "b", "context release behavior: none or flush")
+	alias(contextAPI, "context-api", "c", "context creation API: native, egl or osmesa")
+	alias(major, "major", "m", "major client API version")
+	alias(minor, "minor", "n", "minor client API version")
+	alias(profile, "profile", "p", "OpenGL profile: core or compat")
+	alias(robustness, "robustness", "s", "context robustness strategy: none or lose")
+	var debug, forward, noError, listExtensions, versionOnly, listFormats, asJSON bool
+	flag.BoolVar(&debug, "debug", false, "request a debug context")
+	flag.BoolVar(&debug, "d", false, "short for -debug")
+	flag.BoolVar(&forward, "forward", false, "request a forward-compatible context")
+	flag.BoolVar(&forward, "f", false, "short for -forward")
+	flag.BoolVar(&noError, "no-error", false, "request a context that does not emit errors")
+	flag.BoolVar(&listExtensions, "list-extensions", false, "list the context extensions")
+	flag.BoolVar(&listExtensions, "l", false, "short for -list-extensions")
+	flag.BoolVar(&versionOnly, "version", false, "print the library version only")
+	flag.BoolVar(&versionOnly, "v", false, "short for -version")
+	flag.BoolVar(&listFormats, "list-formats", false, "list all the framebuffer configurations (pixel formats)")
+	flag.BoolVar(&asJSON, "json", false, "print the report as JSON")
+
+	bits := []*hintFlag{
+		{hint: glfw.RedBits}, {hint: glfw.GreenBits}, {hint: glfw.BlueBits}, {hint: glfw.AlphaBits},
+		{hint: glfw.DepthBits}, {hint: glfw.StencilBits},
+		{hint: glfw.AccumRedBits}, {hint: glfw.AccumGreenBits}, {hint: glfw.AccumBlueBits}, {hint: glfw.AccumAlphaBits},
+		{hint: glfw.AuxBuffers}, {hint: glfw.Samples},
+	}
+	names := []string{"red-bits", "green-bits", "blue-bits", "alpha-bits", "depth-bits", "stencil-bits",
+		"accum-red-bits", "accum-green-bits", "accum-blue-bits", "accum-alpha-bits", "aux-buffers", "samples"}
+	for i, b := range bits {
+		flag.Var(b, names[i], "number of "+names[i]+", or - for don't care")
+	}
+	var stereo, srgb, singlebuffer bool
+	flag.BoolVar(&stereo, "stereo", false, "request stereoscopic rendering")
+	flag.BoolVar(&srgb, "srgb", false, "request an sRGB capable framebuffer")
+	flag.BoolVar(&singlebuffer, "singlebuffer", false, "request single buffering")
+	flag.Parse()
+	if flag.NArg() != 0 {
+		flag.Usage()
+		return 2
+	}
+
+	glfw.SetErrorCallback(func(code int, description string) {
+		fmt.Fprintf(os.Stderr, "Error 0x%08X: %s\n", code, description)
+	})
+	var r report
+	r.HeaderVersion = fmt.Sprintf("%d.%d.%d", glfw.VersionMajor, glfw.VersionMinor, glfw.VersionRevision)
+	vMajor, vMinor, vRevision := glfw.GetVersion()
+	r.LibraryVersion = fmt.Sprintf("%d.%d.%d", vMajor, vMinor, vRevision)
+	r.VersionString = glfw.GetVersionString()
+	if versionOnly {
+		if asJSON {
+			return printJSON(r)
+		}
+		printVersion(&r)
+		return 0
+	}
+	if err := glfw.Init(); err != nil {
+		fmt.Fprintln(os.Stderr, "glfwinfo:", err)
+		return 1
+	}
+	defer glfw.Terminate()
+	r.Platform = platformName(glfw.GetPlatform())
+	r.Monitors = monitors()
+
+	glfw.WindowHint(glfw.Visible, glfw.False)
+	for _, e := range enums {
+		if e.name != "" {
+			glfw.WindowHint(e.hint, e.values[e.name])
+		}
+	}
+	for _, h := range append([]*hintFlag{major, minor}, bits...) {
+		if h.set {
+			glfw.WindowHint(h.hint, h.value)
+		}
+	}
+	flags := []struct {
+		hint glfw.Hint
+		set  bool
+	}{
+		{glfw.OpenGLDebugContext, debug},
+		{glfw.OpenGLForwardCompatible, forward},
+		{glfw.ContextNoError, noError},
+		{glfw.Stereo, stereo},
+		{glfw.SRGBCapable, srgb},
+	}
+	for _, f := range flags {
+		if f.set {
+			glfw.WindowHint(f.hint, glfw.True)
+		}
+	}
+	if singlebuffer {
+		glfw.WindowHint(glfw.DoubleBuffer, glfw.False)
+	}
+	if listFormats {
+		formats, err := glfw.GetFramebufferConfigs()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "glfwinfo:", err)
+			return 1
+		}
+		r.Formats = formats
+	}
+	desired := glfw.FramebufferHints()
+
+	w, err := glfw.CreateWindow(200, 200, "Version", nil, nil)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "glfwinfo:", err)
+		return 1
+	}
+	defer w.Destroy()
+	r.Framebuffer = w.GetFramebufferConfig()
+	r.Unsatisfied = glfw.UnsatisfiedHints(desired, r.Framebuffer)
+	if r.Context, err = w.GetContextInfo(); err != nil {
+		fmt.Fprintln(os.Stderr, "glfwinfo:", err)
+		return 1
+	}
+
+	if asJSON {
+		return printJSON(r)
+	}
+	printVersion(&r)
+	printMonitors(r.Monitors)
+	printContext(&r.Context, listExtensions)
+	printFramebuffer(&r)
+	return 0
+}
+
+func platformName(platform int) string {
+	switch platform {
+	case glfw.PlatformWin32:
+		return "Win32"
+	}
+	return fmt.Sprintf("unknown (0x%08X)", platform)
+}
+
+func monitors() []monitorInfo {
+	var list []monitorInfo
+	primary := glfw.GetPrimaryMonitor()
+	for _, m := range glfw.GetMonitors() {
+		var info monitorInfo
+		info.Name = m.GetMonitorName()
+		info.Primary = m == primary
+		info.X, info.Y = m.GetPos()
+		info.WidthMM, info.HeightMM = m.GetPhysicalSize()
+		info.ScaleX, info.ScaleY = m.GetContentScale()
+		x, y, width, height := m.GetWorkarea()
+		info.Workarea = [4]int{x, y, width, height}
+		info.CurrentMode = m.GetVideoMode()
+		info.Modes = m.GetVideoModes()
+		list = append(list, info)
+	}
+	return list
+}
+
+// printJSON prints the report and returns the exit code
+func printJSON(r report) int {
+	e := json.NewEncoder(os.Stdout)
+	e.SetIndent("", "  ")
+	if err := e.Encode(r); err != nil {
+		fmt.Fprintln(os.Stderr, "glfwinfo:", err)
+		return 1
+	}
+	return 0
+}
+
+func printVersion(r *report) {
+	fmt.Printf("GLFW header version: %s\n", r.HeaderVersion)
+	fmt.Printf("GLFW library version: %s\n", r.LibraryVersion)
+	fmt.Printf("GLFW library version string: \"%s\"\n", r.VersionString)
+	if r.Platform != "" {
+		fmt.Printf("GLFW platform: %s\n", r.Platform)
+	}
+}
+
+func formatMode(mode glfw.GLFWvidmode) string {
+	return fmt.Sprintf("%d x %d x %d (%d %d %d) %d Hz",
+		mode.Width, mode.Height, mode.RedBits+mode.GreenBits+mode.BlueBits,
+		mode.RedBits, mode.GreenBits, mode.BlueBits, mode.RefreshRate)
+}
+
+func printMonitors(monitors []monitorInfo) {
+	for i, m := range monitors {
+		primary := ""
+		if m.Primary {
+			primary = " (primary)"
+		}
+		fmt.Printf("Monitor %d: \"%s\"%s\n", i, m.Name, primary)
+		fmt.Printf("  position: %d, %d\n", m.X, m.Y)
+		fmt.Printf("  physical size: %d x %d mm\n", m.WidthMM, m.HeightMM)
+		fmt.Printf("  content scale: %g x %g\n", m.ScaleX, m.ScaleY)
+		fmt.Printf("  workarea: %d x %d at %d, %d\n", m.Workarea[2], m.Workarea[3], m.Workarea[0], m.Workarea[1])
+		fmt.Printf("  current mode: %s\n", formatMode(m.CurrentMode))
+		fmt.Printf("  modes:\n")
+		for j, mode := range m.Modes {
+			fmt.Printf("  %3d: %s\n", j, formatMode(mode))
+		}
+	}
+}
+
+func printContext(c *glfw.ContextInfo, listExtensions bool) {
+	api := c.Client
+	fmt.Printf("%s context version string: \"%s\"\n", api, c.Version)
+	fmt.Printf("%s context version parsed by GLFW: %d.%d.%d\n", api, c.Major, c.Minor, c.Revision)
+	var flags []string
+	if c.ForwardCompatible {
+		flags = append(flags, "forward-compatible")
+	}
+	if c.Debug {
+		flags = append(flags, "debug")
+	}
+	if c.NoError {
+		flags = append(flags, "no-error")
+	}
+	fmt.Printf("%s context flags:", api)
+	for _, f := range flags {
+		fmt.Printf(" %s", f)
+	}
+	fmt.Println()
+	if c.Profile != "" {
+		fmt.Printf("%s profile: %s\n", api, c.Profile)
+	}
+	if c.Robustness != "" {
+		fmt.Printf("%s robustness strategy: %s\n", api, c.Robustness)
+	}
+	fmt.Printf("%s context renderer string: \"%s\"\n", api, c.Renderer)
+	fmt.Printf("%s context vendor string: \"%s\"\n", api, c.Vendor)
+	if c.ShadingLanguageVersion != "" {
+		fmt.Printf("%s context shading language version: \"%s\"\n", api, c.ShadingLanguageVersion)
+	}
+	l := &c.Limits
+	limits := []struct {
+		name  string
+		value int
+	}{
+		{"max texture size", l.MaxTextureSize},
+		{"max 3D texture size", l.Max3DTextureSize},
+		{"max cube map texture size", l.MaxCubeMapTextureSize},
+		{"max array texture layers", l.MaxArrayTextureLayers},
+		{"max renderbuffer size", l.MaxRenderbufferSize},
+		{"max samples", l.MaxSamples},
+		{"max color attachments", l.MaxColorAttachments},
+		{"max draw buffers", l.MaxDrawBuffers},
+		{"max vertex attribs", l.MaxVertexAttribs},
+		{"max texture image units", l.MaxTextureImageUnits},
+		{"max combined texture image units", l.MaxCombinedTextureImageUnits},
+		{"max uniform block size", l.MaxUniformBlockSize},
+		{"max vertex uniform blocks", l.MaxVertexUniformBlocks},
+		{"max fragment uniform blocks", l.MaxFragmentUniformBlocks},
+		{"max combined uniform blocks", l.MaxCombinedUniformBlocks},
+		{"max uniform buffer bindings", l.MaxUniformBufferBindings},
+		{"max shader storage buffer bindings", l.MaxShaderStorageBufferBindings},
+		{"max compute work group invocations", l.MaxComputeWorkGroupInvocations},
+	}
+	fmt.Printf("%s limits:\n", api)
+	fmt.Printf("  max viewport dims: %d x %d\n", l.MaxViewportDims[0], l.MaxViewportDims[1])
+	for _, limit := range limits {
+		if limit.value != 0 {
+			fmt.Printf("  %s: %d\n", limit.name, limit.value)
+		}
+	}
+	if listExtensions {
+		fmt.Printf("%s context extensions:\n", api)
+		for _, e := range c.Extensions {
+			fmt.Printf("  %s\n", e)
+		}
+		fmt.Printf("WGL extensions:\n")
+		for _, e := range c.PlatformExtensions {
+			fmt.Printf("  %s\n", e)
+		}
+	}
+}
+
+func colorSpaceName(colorSpace int) string {
+	switch colorSpace {
+	case glfw.SRGBColorSpace:
+		return "sRGB"
+	case glfw.LinearColorSpace:
+		return "linear"
+	case glfw.ScRGBColorSpace:
+		return "scRGB"
+	case glfw.HDR10ColorSpace:
+		return "HDR10"
+	}
+	return "unknown"
+}
+
+func formatConfig(f glfw.FramebufferConfig) string {
+	s := fmt.Sprintf("red %d green %d blue %d alpha %d depth %d stencil %d",
+		f.RedBits, f.GreenBits, f.BlueBits, f.AlphaBits, f.DepthBits, f.StencilBits)
+	if f.AccumRedBits+f.AccumGreenBits+f.AccumBlueBits+f.AccumAlphaBits > 0 {
+		s += fmt.Sprintf(" accum %d %d %d %d", f.AccumRedBits, f.AccumGreenBits, f.AccumBlueBits, f.AccumAlphaBits)
+	}
+	if f.AuxBuffers > 0 {
+		s += fmt.Sprintf(" aux %d", f.AuxBuffers)
+	}
+	if f.Samples > 0 {
+		s += fmt.Sprintf(" samples %d", f.Samples)
+	}
+	flags := []struct {
+		name string
+		set  bool
+	}{
+		{"sRGB", f.SRGB},
+		{"stereo", f.Stereo},
+		{"double", f.Doublebuffer},
+		{"transparent", f.Transparent},
+		{"float", f.Float},
+	}
+	for _, flag := range flags {
+		if flag.set {
+			s += " " + flag.name
+		}
+	}
+	if f.ColorSpace != 0 && f.ColorSpace != glfw.DontCare {
+		s += " " + colorSpaceName(f.ColorSpace)
+	}
+	return s + fmt.Sprintf(" (%s acceleration)", f.Acceleration)
+}
+
+func printFramebuffer(r *report) {
+	fmt.Printf("Framebuffer: pixel format %d\n", r.Framebuffer.PixelFormat)
+	fmt.Printf("  %s\n", formatConfig(r.Framebuffer))
+	for _, s := range r.Unsatisfied {
+		fmt.Printf("  unsatisfied hint: %s\n", s)
+	}
+	if len(r.Formats) > 0 {
+		fmt.Printf("Pixel formats:\n")
+		for _, f := range r.Formats {
+			fmt.Printf("  %3d: %s\n", f.PixelFormat, formatConfig(f))
+		}
+	}
+}
@@ -0,0 +1,79 @@
+// Gltrace prints and replays the traces written by gl.StartCapture, in
+// programs built with the gltrace tag.
+//
+//	go run ./cmd/gltrace dump trace.bin
+//	go run ./cmd/gltrace replay [-width 640] [-height 480] [-version 3.3] [-core] [-png out.png] trace.bin
+//
+// Replay issues the calls on the context of a hidden window, and can save the
+// content of its framebuffer as a png image, to compare the rendering of two
+// drivers, for example with llvmpipe in CI. Replay is only supported on Windows.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+
+	"github.com/jkvatne/purego-glfw/gl"
+)
+
+type replayOptions struct {
+	width, height int
+	version       string
+	core          bool
+	png           string
+}
+
+// The window, its context and the replayed calls must stay on the main thread
+func init() {
+	runtime.LockOSThread()
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: gltrace dump trace")
+	fmt.Fprintln(os.Stderr, "       gltrace replay [flags] trace")
+	os.Exit(2)
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+	var err error
+	switch os.Args[1] {
+	case "dump":
+		if len(os.Args) != 3 {
+			usage()
+		}
+		err = dump(os.Args[2])
+	case "replay":
+		var opt replayOptions
+		fs := flag.NewFlagSet("replay", flag.ExitOnError)
+		fs.IntVar(&opt.width, "width", 640, "width of the framebuffer")
+		fs.IntVar(&opt.height, "height", 480, "height of the framebuffer")
+		fs.StringVar(&opt.version, "version", "", "OpenGL version of the context, as major.minor")
+		fs.BoolVar(&opt.core, "core", false, "create a core profile context")
+		fs.StringVar(&opt.png, "png", "", "save the framebuffer to a png file after the replay")
+		_ = fs.Parse(os.Args[2:])
+		if fs.NArg() != 1 {
+			usage()
+		}
+		err = replay(fs.Arg(0), opt)
+	default:
+		usage()
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "gltrace:", err)
+		os.Exit(1)
+	}
+}
+
+func dump(name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return gl.DumpTrace(os.Stdout, f)
+}
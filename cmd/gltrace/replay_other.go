@@ -0,0 +1,9 @@
+//go:build !windows
+
+package main
+
+import "errors"
+
+func replay(name string, opt replayOptions) error {
+	return errors.New("replay is only supported on Windows")
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"image/png"
+	"os"
+
+	glfw "github.com/jkvatne/purego-glfw"
+	"github.com/jkvatne/purego-glfw/gl"
+)
+
+func replay(name string, opt replayOptions) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := glfw.Init(); err != nil {
+		return err
+	}
+	defer glfw.Terminate()
+	glfw.WindowHint(glfw.Visible, glfw.False)
+	if opt.version != "" {
+		var major, minor int
+		if _, err := fmt.Sscanf(opt.version, "%d.%d", &major, &minor); err != nil {
+			return fmt.Errorf("invalid version %q", opt.version)
+		}
+		glfw.WindowHint(glfw.ContextVersionMajor, major)
+		glfw.WindowHint(glfw.ContextVersionMinor, minor)
+	}
+	if opt.core {
+		glfw.WindowHint(glfw.OpenGLProfile, glfw.OpenGLCoreProfile)
+	}
+	w, err := glfw.CreateWindow(opt.width, opt.height, "gltrace", nil, nil)
+	if err != nil {
+		return err
+	}
+	defer w.Destroy()
+	w.MakeContextCurrent()
+	ctx, err := gl.NewContext(glfw.GetProcAddress)
+	if err != nil {
+		return err
+	}
+	n, err := gl.Replay(f, ctx)
+	fmt.Printf("%d calls replayed\n", n)
+	if err != nil {
+		return err
+	}
+	if opt.png != "" {
+		return savePNG(ctx, w, opt.png)
+	}
+	return nil
+}
+
+// savePNG saves the back buffer of the window, where the replay rendered
+func savePNG(ctx *gl.Context, w *glfw.Window, name string) error {
+	width, height := w.GetFramebufferSize()
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	ctx.BindFramebuffer(gl.READ_FRAMEBUFFER, 0)
+	ctx.ReadBuffer(gl.BACK)
+	ctx.PixelStorei(gl.PACK_ALIGNMENT, 1)
+	ctx.ReadPixels(0, 0, int32(width), int32(height), gl.RGBA, gl.UNSIGNED_BYTE, gl.Ptr(img.Pix))
+	// OpenGL rows start at the bottom
+	row := make([]byte, img.Stride)
+	for y := 0; y < height/2; y++ {
+		top := img.Pix[y*img.Stride : (y+1)*img.Stride]
+		bottom := img.Pix[(height-1-y)*img.Stride : (height-y)*img.Stride]
+		copy(row, top)
+		copy(top, bottom)
+		copy(bottom, row)
+	}
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
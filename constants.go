@@ -62,6 +62,21 @@ const (
 	ICON_SMALL     = 0
 )
 
+const (
+	BI_RGB       = 0
+	SRCCOPY      = 0x00CC0020
+	COLORONCOLOR = 3
+)
+
+type PAINTSTRUCT struct {
+	hdc         HDC
+	fErase      int32
+	rcPaint     RECT
+	fRestore    int32
+	fIncUpdate  int32
+	rgbReserved [32]byte
+}
+
 type BITMAPINFO struct {
 	biSize          uint32
 	biWidth         uint32
@@ -217,6 +232,7 @@ const (
 	VER_MINORVERSION     = 0x0000001
 	VER_BUILDNUMBER      = 0x0000004
 	VER_SERVICEPACKMAJOR = 0x00000020
+	WIN32_WINNT_WIN8     = 0x0602
 	WIN32_WINNT_WINBLUE  = 0x0603
 )
 
@@ -252,52 +268,55 @@ const (
 )
 
 const (
-	_WM_CANCELMODE           = 0x001F
-	_WM_CHAR                 = 0x0102
-	_WM_SYSCHAR              = 0x0106
-	_WM_CLOSE                = 0x0010
-	_WM_CREATE               = 0x0001
-	_WM_DPICHANGED           = 0x02E0
-	_WM_DESTROY              = 0x0002
-	_WM_ERASEBKGND           = 0x0014
-	_WM_GETMINMAXINFO        = 0x0024
-	_WM_IME_COMPOSITION      = 0x010F
-	_WM_IME_ENDCOMPOSITION   = 0x010E
-	_WM_IME_STARTCOMPOSITION = 0x010D
-	_WM_KEYDOWN              = 0x0100
-	_WM_KEYUP                = 0x0101
-	_WM_KILLFOCUS            = 0x0008
-	_WM_LBUTTONDOWN          = 0x0201
-	_WM_LBUTTONUP            = 0x0202
-	_WM_MBUTTONDOWN          = 0x0207
-	_WM_MBUTTONUP            = 0x0208
-	_WM_MOUSEMOVE            = 0x0200
-	_WM_MOUSEWHEEL           = 0x020A
-	_WM_MOUSEHWHEEL          = 0x020E
-	_WM_MOUSELEAVE           = 0x02A3
-	_WM_MOUSEHOVER           = 0x02A1
-	_WM_NCACTIVATE           = 0x0086
-	_WM_NCHITTEST            = 0x0084
-	_WM_NCCALCSIZE           = 0x0083
-	_WM_PAINT                = 0x000F
-	_WM_QUIT                 = 0x0012
-	_WM_SETCURSOR            = 0x0020
-	_WM_SETFOCUS             = 0x0007
-	_WM_SHOWWINDOW           = 0x0018
-	_WM_SIZE                 = 0x0005
-	_WM_STYLECHANGED         = 0x007D
-	_WM_SYSKEYDOWN           = 0x0104
-	_WM_SYSKEYUP             = 0x0105
-	_WM_RBUTTONDOWN          = 0x0204
-	_WM_RBUTTONUP            = 0x0205
-	_WM_TIMER                = 0x0113
-	_WM_UNICHAR              = 0x0109
-	_WM_USER                 = 0x0400
-	_WM_WINDOWPOSCHANGED     = 0x0047
-	_WM_DROPFILES            = 0x0233
-	_WM_COPYDATA             = 0x004A
-	_WM_COPYGLOBALDATA       = 0x0049
-	_MSGFLT_ALLOW            = 1
+	_WM_CANCELMODE                  = 0x001F
+	_WM_CHAR                        = 0x0102
+	_WM_SYSCHAR                     = 0x0106
+	_WM_CLOSE                       = 0x0010
+	_WM_CREATE                      = 0x0001
+	_WM_DPICHANGED                  = 0x02E0
+	_WM_DWMCOMPOSITIONCHANGED       = 0x031E
+	_WM_DWMCOLORIZATIONCOLORCHANGED = 0x0320
+	_WM_DESTROY                     = 0x0002
+	_WM_ERASEBKGND                  = 0x0014
+	_WM_GETMINMAXINFO               = 0x0024
+	_WM_IME_COMPOSITION             = 0x010F
+	_WM_IME_ENDCOMPOSITION          = 0x010E
+	_WM_IME_STARTCOMPOSITION        = 0x010D
+	_WM_KEYDOWN                     = 0x0100
+	_WM_KEYUP                       = 0x0101
+	_WM_KILLFOCUS                   = 0x0008
+	_WM_LBUTTONDOWN                 = 0x0201
+	_WM_LBUTTONUP                   = 0x0202
+	_WM_MBUTTONDOWN                 = 0x0207
+	_WM_MBUTTONUP                   = 0x0208
+	_WM_MOUSEMOVE                   = 0x0200
+	_WM_MOUSEWHEEL                  = 0x020A
+	_WM_MOUSEHWHEEL                 = 0x020E
+	_WM_MOUSELEAVE                  = 0x02A3
+	_WM_MOUSEHOVER                  = 0x02A1
+	_WM_NCACTIVATE                  = 0x0086
+	_WM_NCHITTEST                   = 0x0084
+	_WM_NCCALCSIZE                  = 0x0083
+	_WM_PAINT                       = 0x000F
+	_WM_QUIT                        = 0x0012
+	_WM_SETCURSOR                   = 0x0020
+	_WM_SETFOCUS                    = 0x0007
+	_WM_SHOWWINDOW                  = 0x0018
+	_WM_SIZE                        = 0x0005
+	_WM_MOVE                        = 0x0003
+	_WM_STYLECHANGED                = 0x007D
+	_WM_SYSKEYDOWN                  = 0x0104
+	_WM_SYSKEYUP                    = 0x0105
+	_WM_RBUTTONDOWN                 = 0x0204
+	_WM_RBUTTONUP                   = 0x0205
+	_WM_TIMER                       = 0x0113
+	_WM_UNICHAR                     = 0x0109
+	_WM_USER                        = 0x0400
+	_WM_WINDOWPOSCHANGED            = 0x0047
+	_WM_DROPFILES                   = 0x0233
+	_WM_COPYDATA                    = 0x004A
+	_WM_COPYGLOBALDATA              = 0x0049
+	_MSGFLT_ALLOW                   = 1
 )
 
 // Windows constants
@@ -412,6 +431,18 @@ const (
 	_GL_COLOR_BUFFER_BIT                    = 0x00004000
 )
 
+// dwmTimingInfo holds the fields of DWM_TIMING_INFO used for frame statistics.
+// All qpc values are in QueryPerformanceCounter units.
+type dwmTimingInfo struct {
+	qpcRefreshPeriod  uint64
+	qpcVBlank         uint64
+	cRefresh          uint64
+	cFrameDisplayed   uint64
+	qpcFrameDisplayed uint64
+	cFramesDropped    uint64
+	cFramesMissed     uint64
+}
+
 type TRACKMOUSEEVENT struct {
 	cbSize      uint32
 	dwFlags     uint32
@@ -508,6 +539,18 @@ const (
 	IDC_HELP        = 32651 // Arrow and question mark
 )
 
+const (
+	_DWM_BB_ENABLE     = 0x00000001
+	_DWM_BB_BLURREGION = 0x00000002
+)
+
+type DWM_BLURBEHIND struct {
+	dwFlags                uint32
+	fEnable                int32
+	hRgnBlur               syscall.Handle
+	fTransitionOnMaximized int32
+}
+
 type GUID struct {
 	Data1 uint32
 	Data2 uint16
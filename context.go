@@ -3,6 +3,7 @@ package glfw
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"syscall"
 	"unsafe"
@@ -16,7 +17,7 @@ func glfwIsValidContextConfig(ctxconfig *_GLFWctxconfig) error {
 		return errors.New("invalid cclient API")
 	}
 	if ctxconfig.share != nil {
-		if ctxconfig.client == NoAPI || ctxconfig.share.context.client == OpenGLAPI {
+		if ctxconfig.client == NoAPI || ctxconfig.share.context.client == NoAPI {
 			return errors.New("no context API")
 		}
 		if ctxconfig.client != ctxconfig.share.context.client {
@@ -90,6 +91,12 @@ func glfwChooseFBConfig(desired *_GLFWfbconfig, alternatives []_GLFWfbconfig, co
 		if desired.transparent != current.transparent {
 			missing++
 		}
+		if desired.floatBuffer != current.floatBuffer {
+			missing++
+		}
+		if desired.colorSpace != DontCare && desired.colorSpace != 0 && desired.colorSpace != current.colorSpace {
+			missing++
+		}
 		colorDiff = 0
 		if desired.redBits != DontCare {
 			colorDiff += (desired.redBits - current.redBits) * (desired.redBits - current.redBits)
@@ -167,7 +174,7 @@ func glfwRefreshContextAttribs(window *_GLFWwindow, ctxconfig *_GLFWctxconfig) e
 	}
 	r, _, err := syscall.SyscallN(window.context.GetString, uintptr(_GL_VERSION))
 	if !errors.Is(err, syscall.Errno(0)) {
-		return fmt.Errorf("String retrieval is broken, " + err.Error())
+		return fmt.Errorf("String retrieval is broken, %v", err)
 	}
 	version := GoStr((*uint8)(unsafe.Pointer(r)))
 	prefixes := []string{"OpenGL ES-CM ", "OpenGL ES-CL ", "OpenGL ES ", ""}
@@ -277,6 +284,10 @@ func glfwRefreshContextAttribs(window *_GLFWwindow, ctxconfig *_GLFWctxconfig) e
 		}
 	}
 
+	if window.context.robustness == LoseContextOnReset {
+		window.context.GetGraphicsResetStatus = getGraphicsResetStatusProc(window)
+	}
+
 	if ExtensionSupported("GL_KHR_context_flush_control") {
 		var behavior int
 		getIntegerv(window, _GL_CONTEXT_RELEASE_BEHAVIOR, &behavior)
@@ -318,6 +329,30 @@ func glfwMakeContextCurrent(window *_GLFWwindow) error {
 	return err
 }
 
+// call calls the named OpenGL function, loading it on first use.
+// The context must be current.
+func (c *_GLFWcontext) call(name string, args ...uintptr) uintptr {
+	proc := c.lookup(name)
+	if proc == 0 {
+		panic("OpenGL function " + name + " is not available")
+	}
+	r, _, _ := syscall.SyscallN(proc, args...)
+	return r
+}
+
+// lookup returns the address of an OpenGL function, or 0 if it is not available
+func (c *_GLFWcontext) lookup(name string) uintptr {
+	proc, ok := c.procs[name]
+	if !ok {
+		proc = c.getProcAddress(name)
+		if c.procs == nil {
+			c.procs = make(map[string]uintptr)
+		}
+		c.procs[name] = proc
+	}
+	return proc
+}
+
 func glfwGetCurrentContext() *Window {
 	return getCurrentWindow()
 }
@@ -326,7 +361,15 @@ func glfwSwapBuffers(window *_GLFWwindow) {
 	if window == nil {
 		panic("glfwSwapBuffers: window == nil")
 	}
-	window.context.swapBuffers(window)
+	if window.offscreen.fbo != 0 {
+		// There is nothing to present, just make sure the rendering is submitted
+		if getCurrentWindow() == window {
+			window.context.call("glFlush")
+		}
+	} else {
+		window.context.swapBuffers(window)
+	}
+	checkGraphicsReset(window)
 }
 
 func ExtensionSupported(extension string) bool {
@@ -354,12 +397,30 @@ func ExtensionSupported(extension string) bool {
 		}
 	} else {
 		// Check if extension is in the old style OpenGL extensions string
-		r, _, _ := syscall.SyscallN(window.context.GetStringi, uintptr(_GL_EXTENSIONS))
+		r, _, _ := syscall.SyscallN(window.context.GetString, uintptr(_GL_EXTENSIONS))
 		extensions := GoStr((*uint8)(unsafe.Pointer(r)))
-		if strings.Contains(extensions, extension) {
+		if slices.Contains(strings.Fields(extensions), extension) {
 			return true
 		}
 	}
 	// Check if extension is in the platform-specific string
 	return window.context.extensionSupported(extension)
 }
+
+// GetProcAddress returns the address of the specified OpenGL or OpenGL ES
+// core or extension function, if it is supported by the current context,
+// and nil otherwise. It has the signature of gl.InitWithProcAddrFunc, so the
+// gl package can be loaded from the same driver and context as the window:
+//
+//	gl.InitWithProcAddrFunc(glfw.GetProcAddress)
+//
+// The addresses may be specific to the context, so load them again after
+// making a context from another window current.
+func GetProcAddress(procname string) unsafe.Pointer {
+	window := getCurrentWindow()
+	if window == nil {
+		glfwInputError(NoCurrentContext, "Cannot query entry point without a current OpenGL or OpenGL ES context")
+		return nil
+	}
+	return unsafe.Pointer(window.context.getProcAddress(procname))
+}
@@ -0,0 +1,199 @@
+package glfw
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unsafe"
+)
+
+const (
+	_GL_VENDOR                             = 0x1F00
+	_GL_RENDERER                           = 0x1F01
+	_GL_SHADING_LANGUAGE_VERSION           = 0x8B8C
+	_GL_MAX_TEXTURE_SIZE                   = 0x0D33
+	_GL_MAX_VIEWPORT_DIMS                  = 0x0D3A
+	_GL_MAX_3D_TEXTURE_SIZE                = 0x8073
+	_GL_MAX_CUBE_MAP_TEXTURE_SIZE          = 0x851C
+	_GL_MAX_ARRAY_TEXTURE_LAYERS           = 0x88FF
+	_GL_MAX_RENDERBUFFER_SIZE              = 0x84E8
+	_GL_MAX_SAMPLES                        = 0x8D57
+	_GL_MAX_COLOR_ATTACHMENTS              = 0x8CDF
+	_GL_MAX_DRAW_BUFFERS                   = 0x8824
+	_GL_MAX_VERTEX_ATTRIBS                 = 0x8869
+	_GL_MAX_TEXTURE_IMAGE_UNITS            = 0x8872
+	_GL_MAX_COMBINED_TEXTURE_IMAGE_UNITS   = 0x8B4D
+	_GL_MAX_UNIFORM_BLOCK_SIZE             = 0x8A30
+	_GL_MAX_VERTEX_UNIFORM_BLOCKS          = 0x8A2B
+	_GL_MAX_FRAGMENT_UNIFORM_BLOCKS        = 0x8A2D
+	_GL_MAX_COMBINED_UNIFORM_BLOCKS        = 0x8A2E
+	_GL_MAX_UNIFORM_BUFFER_BINDINGS        = 0x8A2F
+	_GL_MAX_SHADER_STORAGE_BUFFER_BINDINGS = 0x90DD
+	_GL_MAX_COMPUTE_WORK_GROUP_INVOCATIONS = 0x90EB
+)
+
+// ContextInfo describes the OpenGL or OpenGL ES context of a window, for
+// logs and crash reports. It can be marshalled to JSON.
+type ContextInfo struct {
+	Vendor                 string        `json:"vendor"`
+	Renderer               string        `json:"renderer"`
+	Version                string        `json:"version"`                // GL_VERSION as reported by the driver
+	ShadingLanguageVersion string        `json:"shadingLanguageVersion"` // Empty before OpenGL 2.0
+	Client                 string        `json:"client"`                 // "OpenGL" or "OpenGL ES"
+	Major                  int           `json:"major"`
+	Minor                  int           `json:"minor"`
+	Revision               int           `json:"revision"`
+	Profile                string        `json:"profile"`    // "core", "compat" or empty before OpenGL 3.2
+	Robustness             string        `json:"robustness"` // "lose context on reset", "no reset notification" or empty
+	ForwardCompatible      bool          `json:"forwardCompatible"`
+	Debug                  bool          `json:"debug"`
+	NoError                bool          `json:"noError"`
+	Extensions             []string      `json:"extensions"`         // Sorted
+	PlatformExtensions     []string      `json:"platformExtensions"` // WGL extensions, sorted
+	Limits                 ContextLimits `json:"limits"`
+}
+
+// ContextLimits holds implementation limits of a context. The limits that
+// do not exist in the version of the context are zero.
+type ContextLimits struct {
+	MaxTextureSize                 int    `json:"maxTextureSize"`
+	Max3DTextureSize               int    `json:"max3DTextureSize"`
+	MaxCubeMapTextureSize          int    `json:"maxCubeMapTextureSize"`
+	MaxArrayTextureLayers          int    `json:"maxArrayTextureLayers"`
+	MaxRenderbufferSize            int    `json:"maxRenderbufferSize"`
+	MaxViewportDims                [2]int `json:"maxViewportDims"`
+	MaxSamples                     int    `json:"maxSamples"`
+	MaxColorAttachments            int    `json:"maxColorAttachments"`
+	MaxDrawBuffers                 int    `json:"maxDrawBuffers"`
+	MaxVertexAttribs               int    `json:"maxVertexAttribs"`
+	MaxTextureImageUnits           int    `json:"maxTextureImageUnits"`
+	MaxCombinedTextureImageUnits   int    `json:"maxCombinedTextureImageUnits"`
+	MaxUniformBlockSize            int    `json:"maxUniformBlockSize"`
+	MaxVertexUniformBlocks         int    `json:"maxVertexUniformBlocks"`
+	MaxFragmentUniformBlocks       int    `json:"maxFragmentUniformBlocks"`
+	MaxCombinedUniformBlocks       int    `json:"maxCombinedUniformBlocks"`
+	MaxUniformBufferBindings       int    `json:"maxUniformBufferBindings"`
+	MaxShaderStorageBufferBindings int    `json:"maxShaderStorageBufferBindings"`
+	MaxComputeWorkGroupInvocations int    `json:"maxComputeWorkGroupInvocations"`
+}
+
+// GetContextInfo returns the strings, version, flags, extensions and limits
+// of the context of the window. The context is made current during the call,
+// and the previous one is restored.
+func (w *Window) GetContextInfo() (ContextInfo, error) {
+	if w.context.client == NoAPI {
+		return ContextInfo{}, fmt.Errorf("window has no OpenGL or OpenGL ES context")
+	}
+	previous := getCurrentWindow()
+	if previous != w {
+		if err := glfwMakeContextCurrent(w); err != nil {
+			return ContextInfo{}, err
+		}
+		defer func() { _ = glfwMakeContextCurrent(previous) }()
+	}
+	c := w.context
+	info := ContextInfo{
+		Vendor:            glString(w, _GL_VENDOR),
+		Renderer:          glString(w, _GL_RENDERER),
+		Version:           glString(w, _GL_VERSION),
+		Client:            "OpenGL",
+		Major:             int(c.major),
+		Minor:             int(c.minor),
+		Revision:          int(c.revision),
+		ForwardCompatible: c.forward,
+		Debug:             c.debug,
+		NoError:           c.noerror,
+	}
+	es := c.client == OpenGLESAPI
+	if es {
+		info.Client = "OpenGL ES"
+	}
+	// The version a value appeared in, for OpenGL and OpenGL ES. Getting
+	// unknown values would leave GL_INVALID_ENUM for the application.
+	since := func(glMajor, glMinor, esMajor, esMinor int32) bool {
+		major, minor := glMajor, glMinor
+		if es {
+			major, minor = esMajor, esMinor
+		}
+		return c.major > major || c.major == major && c.minor >= minor
+	}
+	if since(2, 0, 2, 0) {
+		info.ShadingLanguageVersion = glString(w, _GL_SHADING_LANGUAGE_VERSION)
+	}
+	switch c.profile {
+	case OpenGLCoreProfile:
+		info.Profile = "core"
+	case OpenGLCompatProfile:
+		info.Profile = "compat"
+	}
+	switch c.robustness {
+	case LoseContextOnReset:
+		info.Robustness = "lose context on reset"
+	case NoResetNotification:
+		info.Robustness = "no reset notification"
+	}
+	info.Extensions = glExtensions(w)
+	if c.source == NativeContextAPI {
+		info.PlatformExtensions = strings.Fields(extensionsStringWGL())
+		slices.Sort(info.PlatformExtensions)
+	}
+
+	l := &info.Limits
+	limits := []struct {
+		name                               int
+		glMajor, glMinor, esMajor, esMinor int32
+		value                              *int
+	}{
+		{_GL_MAX_TEXTURE_SIZE, 1, 0, 1, 0, &l.MaxTextureSize},
+		{_GL_MAX_3D_TEXTURE_SIZE, 1, 2, 3, 0, &l.Max3DTextureSize},
+		{_GL_MAX_CUBE_MAP_TEXTURE_SIZE, 1, 3, 2, 0, &l.MaxCubeMapTextureSize},
+		{_GL_MAX_ARRAY_TEXTURE_LAYERS, 3, 0, 3, 0, &l.MaxArrayTextureLayers},
+		{_GL_MAX_RENDERBUFFER_SIZE, 3, 0, 2, 0, &l.MaxRenderbufferSize},
+		{_GL_MAX_SAMPLES, 3, 0, 3, 0, &l.MaxSamples},
+		{_GL_MAX_COLOR_ATTACHMENTS, 3, 0, 3, 0, &l.MaxColorAttachments},
+		{_GL_MAX_DRAW_BUFFERS, 2, 0, 3, 0, &l.MaxDrawBuffers},
+		{_GL_MAX_VERTEX_ATTRIBS, 2, 0, 2, 0, &l.MaxVertexAttribs},
+		{_GL_MAX_TEXTURE_IMAGE_UNITS, 2, 0, 2, 0, &l.MaxTextureImageUnits},
+		{_GL_MAX_COMBINED_TEXTURE_IMAGE_UNITS, 2, 0, 2, 0, &l.MaxCombinedTextureImageUnits},
+		{_GL_MAX_UNIFORM_BLOCK_SIZE, 3, 1, 3, 0, &l.MaxUniformBlockSize},
+		{_GL_MAX_VERTEX_UNIFORM_BLOCKS, 3, 1, 3, 0, &l.MaxVertexUniformBlocks},
+		{_GL_MAX_FRAGMENT_UNIFORM_BLOCKS, 3, 1, 3, 0, &l.MaxFragmentUniformBlocks},
+		{_GL_MAX_COMBINED_UNIFORM_BLOCKS, 3, 1, 3, 0, &l.MaxCombinedUniformBlocks},
+		{_GL_MAX_UNIFORM_BUFFER_BINDINGS, 3, 1, 3, 0, &l.MaxUniformBufferBindings},
+		{_GL_MAX_SHADER_STORAGE_BUFFER_BINDINGS, 4, 3, 3, 1, &l.MaxShaderStorageBufferBindings},
+		{_GL_MAX_COMPUTE_WORK_GROUP_INVOCATIONS, 4, 3, 3, 1, &l.MaxComputeWorkGroupInvocations},
+	}
+	for _, limit := range limits {
+		if since(limit.glMajor, limit.glMinor, limit.esMajor, limit.esMinor) {
+			var v int32
+			c.call("glGetIntegerv", uintptr(limit.name), uintptr(unsafe.Pointer(&v)))
+			*limit.value = int(v)
+		}
+	}
+	var dims [2]int32
+	c.call("glGetIntegerv", _GL_MAX_VIEWPORT_DIMS, uintptr(unsafe.Pointer(&dims[0])))
+	l.MaxViewportDims = [2]int{int(dims[0]), int(dims[1])}
+	return info, nil
+}
+
+// glString returns a string of the current context, or "" if it is not available
+func glString(w *Window, name int) string {
+	return GoStr((*uint8)(unsafe.Pointer(w.context.call("glGetString", uintptr(name)))))
+}
+
+// glExtensions returns the sorted extensions of the current context
+func glExtensions(w *Window) []string {
+	var extensions []string
+	if w.context.major >= 3 {
+		var count int
+		getIntegerv(w, _GL_NUM_EXTENSIONS, &count)
+		for i := 0; i < count; i++ {
+			r := w.context.call("glGetStringi", _GL_EXTENSIONS, uintptr(i))
+			extensions = append(extensions, GoStr((*uint8)(unsafe.Pointer(r))))
+		}
+	} else {
+		extensions = strings.Fields(glString(w, _GL_EXTENSIONS))
+	}
+	slices.Sort(extensions)
+	return extensions
+}
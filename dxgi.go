@@ -0,0 +1,157 @@
+package glfw
+
+import (
+	"fmt"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// This file contains the DXGI calls used to query the display adapters and outputs.
+// DXGI is a COM API, so the methods are called through the vtable of each object.
+
+var (
+	dxgi                 = windows.NewLazySystemDLL("dxgi.dll")
+	_CreateDXGIFactory1  = dxgi.NewProc("CreateDXGIFactory1")
+	iidIDXGIFactory1     = GUID{0x770aae78, 0xf26f, 0x4dba, [8]uint8{0xa8, 0x29, 0x25, 0x3c, 0x83, 0xd1, 0xb3, 0x87}}
+	iidIDXGIOutput6      = GUID{0x068346e8, 0xaaec, 0x4b84, [8]uint8{0xad, 0xd7, 0x13, 0x7f, 0x51, 0x3f, 0x77, 0xa1}}
+	errDXGIUnavailable   = fmt.Errorf("DXGI: dxgi.dll is not available")
+	errDXGIOutput6Needed = fmt.Errorf("DXGI: IDXGIOutput6 is not supported, Windows 10 version 1703 or later is needed")
+)
+
+const (
+	_DXGI_ERROR_NOT_FOUND = 0x887A0002
+
+	// vtable indices, counted from IUnknown
+	_IUnknown_QueryInterface     = 0
+	_IUnknown_Release            = 2
+	_IDXGIFactory1_EnumAdapters1 = 12
+	_IDXGIAdapter_EnumOutputs    = 7
+	_IDXGIOutput_GetDesc         = 7
+	_IDXGIOutput6_GetDesc1       = 27
+
+	_DXGI_COLOR_SPACE_RGB_FULL_G2084_NONE_P2020 = 12
+)
+
+// comObject is a COM interface pointer. The first field of every COM object is its vtable.
+type comObject struct {
+	vtbl *[64]uintptr
+}
+
+func (o *comObject) call(method int, args ...uintptr) uintptr {
+	r, _, _ := syscall.SyscallN(o.vtbl[method], append([]uintptr{uintptr(unsafe.Pointer(o))}, args...)...)
+	return r
+}
+
+func (o *comObject) release() {
+	o.call(_IUnknown_Release)
+}
+
+type dxgiOutputDesc struct {
+	DeviceName         [32]uint16
+	DesktopCoordinates RECT
+	AttachedToDesktop  int32
+	Rotation           uint32
+	Monitor            HMONITOR
+}
+
+type dxgiOutputDesc1 struct {
+	dxgiOutputDesc
+	BitsPerColor          uint32
+	ColorSpace            uint32
+	RedPrimary            [2]float32
+	GreenPrimary          [2]float32
+	BluePrimary           [2]float32
+	WhitePoint            [2]float32
+	MinLuminance          float32
+	MaxLuminance          float32
+	MaxFullFrameLuminance float32
+}
+
+// dxgiEnumAdapters calls fn for each adapter until it returns false.
+// The adapter is released when fn returns.
+func dxgiEnumAdapters(fn func(adapter *comObject) bool) error {
+	if dxgi.Load() != nil || _CreateDXGIFactory1.Find() != nil {
+		return errDXGIUnavailable
+	}
+	var factory *comObject
+	r, _, _ := _CreateDXGIFactory1.Call(uintptr(unsafe.Pointer(&iidIDXGIFactory1)), uintptr(unsafe.Pointer(&factory)))
+	if r != 0 || factory == nil {
+		return fmt.Errorf("DXGI: CreateDXGIFactory1 failed, 0x%08X", r)
+	}
+	defer factory.release()
+	for i := uintptr(0); ; i++ {
+		var adapter *comObject
+		if r := factory.call(_IDXGIFactory1_EnumAdapters1, i, uintptr(unsafe.Pointer(&adapter))); uint32(r) == _DXGI_ERROR_NOT_FOUND {
+			return nil
+		} else if r != 0 {
+			return fmt.Errorf("DXGI: EnumAdapters1 failed, 0x%08X", r)
+		}
+		more := fn(adapter)
+		adapter.release()
+		if !more {
+			return nil
+		}
+	}
+}
+
+// dxgiEnumOutputs calls fn for each output of each adapter until it returns false.
+// The output is released when fn returns.
+func dxgiEnumOutputs(fn func(adapter, output *comObject) bool) error {
+	more := true
+	return dxgiEnumAdapters(func(adapter *comObject) bool {
+		for i := uintptr(0); more; i++ {
+			var output *comObject
+			if adapter.call(_IDXGIAdapter_EnumOutputs, i, uintptr(unsafe.Pointer(&output))) != 0 {
+				break
+			}
+			more = fn(adapter, output)
+			output.release()
+		}
+		return more
+	})
+}
+
+func glfwPlatformGetHDRInfo(monitor *Monitor) (HDRInfo, error) {
+	var info HDRInfo
+	var err error
+	found := false
+	e := dxgiEnumOutputs(func(adapter, output *comObject) bool {
+		var desc dxgiOutputDesc
+		if output.call(_IDXGIOutput_GetDesc, uintptr(unsafe.Pointer(&desc))) != 0 || desc.Monitor != monitor.Win32.hMonitor {
+			return true
+		}
+		found = true
+		var output6 *comObject
+		if output.call(_IUnknown_QueryInterface, uintptr(unsafe.Pointer(&iidIDXGIOutput6)), uintptr(unsafe.Pointer(&output6))) != 0 {
+			err = errDXGIOutput6Needed
+			return false
+		}
+		defer output6.release()
+		var desc1 dxgiOutputDesc1
+		if r := output6.call(_IDXGIOutput6_GetDesc1, uintptr(unsafe.Pointer(&desc1))); r != 0 {
+			err = fmt.Errorf("DXGI: GetDesc1 failed, 0x%08X", r)
+			return false
+		}
+		info = HDRInfo{
+			Enabled:               desc1.ColorSpace == _DXGI_COLOR_SPACE_RGB_FULL_G2084_NONE_P2020,
+			BitsPerColor:          int(desc1.BitsPerColor),
+			RedPrimary:            desc1.RedPrimary,
+			GreenPrimary:          desc1.GreenPrimary,
+			BluePrimary:           desc1.BluePrimary,
+			WhitePoint:            desc1.WhitePoint,
+			MinLuminance:          desc1.MinLuminance,
+			MaxLuminance:          desc1.MaxLuminance,
+			MaxFullFrameLuminance: desc1.MaxFullFrameLuminance,
+		}
+		return false
+	})
+	if e != nil {
+		return info, e
+	}
+	if err == nil && !found {
+		err = fmt.Errorf("DXGI: no output found for monitor %s", monitor.GetMonitorName())
+	}
+	return info, err
+}
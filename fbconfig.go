@@ -0,0 +1,292 @@
+package glfw
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Acceleration tells how a pixel format is rendered.
+type Acceleration int
+
+const (
+	NoAcceleration      Acceleration = iota // Software rendering, not used for windows
+	GenericAcceleration                     // Generic implementation with partial hardware support
+	FullAcceleration                        // Rendered by the graphics driver
+)
+
+func (a Acceleration) String() string {
+	switch a {
+	case NoAcceleration:
+		return "none"
+	case GenericAcceleration:
+		return "generic"
+	case FullAcceleration:
+		return "full"
+	}
+	return fmt.Sprintf("Acceleration(%d)", int(a))
+}
+
+// FramebufferConfig describes a framebuffer configuration (pixel format).
+// In a requested configuration, a bit count of DontCare means that any
+// value is accepted.
+type FramebufferConfig struct {
+	PixelFormat    int // Platform pixel format number, 0 for requested configurations
+	RedBits        int
+	GreenBits      int
+	BlueBits       int
+	AlphaBits      int
+	DepthBits      int
+	StencilBits    int
+	AccumRedBits   int
+	AccumGreenBits int
+	AccumBlueBits  int
+	AccumAlphaBits int
+	AuxBuffers     int
+	Samples        int
+	SRGB           bool
+	Stereo         bool
+	Doublebuffer   bool
+	Transparent    bool
+	Float          bool // Floating point colour components
+	ColorSpace     int  // One of the ColorSpace hint values, 0 or DontCare if unknown or not requested
+	Acceleration   Acceleration
+}
+
+// FramebufferChooser selects the framebuffer configuration for a new window.
+// It is given the configuration requested by the window hints and the usable
+// configurations, and returns the index of the chosen one, or -1 if none
+// is acceptable.
+type FramebufferChooser func(desired FramebufferConfig, candidates []FramebufferConfig) int
+
+var framebufferChooser FramebufferChooser = ChooseFramebufferConfig
+
+// SetFramebufferChooser sets the function used to select the framebuffer
+// configuration when a window is created. Nil restores the default,
+// ChooseFramebufferConfig.
+func SetFramebufferChooser(chooser FramebufferChooser) {
+	if chooser == nil {
+		chooser = ChooseFramebufferConfig
+	}
+	framebufferChooser = chooser
+}
+
+// ChooseFramebufferConfig is the default framebuffer chooser. It prefers
+// the candidate with the least number of missing buffers, then the closest
+// colour depth and lastly the closest size of the other buffers.
+// It returns -1 if there are no candidates.
+func ChooseFramebufferConfig(desired FramebufferConfig, candidates []FramebufferConfig) int {
+	if len(candidates) == 0 {
+		return -1
+	}
+	alternatives := make([]_GLFWfbconfig, len(candidates))
+	for i, c := range candidates {
+		alternatives[i] = fbconfigFromPublic(c)
+		alternatives[i].handle = uintptr(i)
+	}
+	want := fbconfigFromPublic(desired)
+	closest := glfwChooseFBConfig(&want, alternatives, int32(len(alternatives)))
+	if closest == nil {
+		return -1
+	}
+	return int(closest.handle)
+}
+
+// UnsatisfiedHints returns a description of each part of the desired
+// configuration that the obtained configuration does not satisfy,
+// or nil if all of them are satisfied.
+func UnsatisfiedHints(desired, obtained FramebufferConfig) []string {
+	var s []string
+	bits := []struct {
+		name       string
+		want, have int
+	}{
+		{"red bits", desired.RedBits, obtained.RedBits},
+		{"green bits", desired.GreenBits, obtained.GreenBits},
+		{"blue bits", desired.BlueBits, obtained.BlueBits},
+		{"alpha bits", desired.AlphaBits, obtained.AlphaBits},
+		{"depth bits", desired.DepthBits, obtained.DepthBits},
+		{"stencil bits", desired.StencilBits, obtained.StencilBits},
+		{"accumulation red bits", desired.AccumRedBits, obtained.AccumRedBits},
+		{"accumulation green bits", desired.AccumGreenBits, obtained.AccumGreenBits},
+		{"accumulation blue bits", desired.AccumBlueBits, obtained.AccumBlueBits},
+		{"accumulation alpha bits", desired.AccumAlphaBits, obtained.AccumAlphaBits},
+		{"aux buffers", desired.AuxBuffers, obtained.AuxBuffers},
+		{"samples", desired.Samples, obtained.Samples},
+	}
+	for _, b := range bits {
+		if b.want != DontCare && b.have < b.want {
+			s = append(s, fmt.Sprintf("%s: requested %d, got %d", b.name, b.want, b.have))
+		}
+	}
+	flags := []struct {
+		name       string
+		want, have bool
+	}{
+		{"sRGB", desired.SRGB, obtained.SRGB},
+		{"stereo", desired.Stereo, obtained.Stereo},
+		{"transparency", desired.Transparent, obtained.Transparent},
+	}
+	for _, f := range flags {
+		if f.want && !f.have {
+			s = append(s, f.name+": requested but not available")
+		}
+	}
+	if desired.Float && !obtained.Float {
+		s = append(s, "floating point components: requested but not available")
+	}
+	if desired.ColorSpace != DontCare && desired.ColorSpace != 0 && desired.ColorSpace != obtained.ColorSpace {
+		s = append(s, fmt.Sprintf("colour space: requested %s, got %s", colorSpaceName(desired.ColorSpace), colorSpaceName(obtained.ColorSpace)))
+	}
+	if desired.Doublebuffer != obtained.Doublebuffer {
+		s = append(s, fmt.Sprintf("double buffering: requested %v, got %v", desired.Doublebuffer, obtained.Doublebuffer))
+	}
+	return s
+}
+
+// FramebufferHints returns the framebuffer configuration requested by the
+// current window hints.
+func FramebufferHints() FramebufferConfig {
+	return fbconfigToPublic(&_glfw.hints.framebuffer)
+}
+
+// GetFramebufferConfigs returns all framebuffer configurations supported
+// for OpenGL rendering to a window, including the ones that are not
+// hardware accelerated. The sRGB capability depends on the client API hint.
+func GetFramebufferConfigs() ([]FramebufferConfig, error) {
+	if !_glfw.initialized {
+		return nil, fmt.Errorf("glfw is not initialized")
+	}
+	return glfwPlatformGetFramebufferConfigs(_glfw.hints.context.client)
+}
+
+// GetFramebufferConfig returns the framebuffer configuration chosen for the
+// window. It is zero for windows without an OpenGL context.
+func (w *Window) GetFramebufferConfig() FramebufferConfig {
+	return w.framebuffer
+}
+
+// FormatUnavailableError is returned by CreateWindow when the framebuffer
+// chooser finds no acceptable configuration. Unsatisfied describes the hints
+// that the closest configuration does not satisfy, as UnsatisfiedHints.
+type FormatUnavailableError struct {
+	Unsatisfied []string
+}
+
+func (e *FormatUnavailableError) Error() string {
+	if len(e.Unsatisfied) == 0 {
+		return "no suitable framebuffer configuration"
+	}
+	return "no suitable framebuffer configuration: " + strings.Join(e.Unsatisfied, ", ")
+}
+
+// chooseFramebuffer selects the configuration for the window among the
+// accelerated candidates with the requested buffering
+func chooseFramebuffer(w *_GLFWwindow, fbconfig *_GLFWfbconfig, configs []FramebufferConfig) (FramebufferConfig, error) {
+	var usable []FramebufferConfig
+	for _, c := range configs {
+		if c.Acceleration != NoAcceleration && c.Doublebuffer == fbconfig.doublebuffer {
+			usable = append(usable, c)
+		}
+	}
+	desired := fbconfigToPublic(fbconfig)
+	switch desired.ColorSpace {
+	case ScRGBColorSpace:
+		// scRGB is linear half float, with the full range shown by the compositor in HDR mode
+		desired.Float = true
+		desired.ColorSpace = LinearColorSpace
+		desired.RedBits, desired.GreenBits, desired.BlueBits, desired.AlphaBits = 16, 16, 16, 16
+	case HDR10ColorSpace:
+		// The PQ encoding is done by the application, so only the bit depth can be selected
+		desired.Float = false
+		desired.ColorSpace = DontCare
+		desired.RedBits, desired.GreenBits, desired.BlueBits, desired.AlphaBits = 10, 10, 10, 2
+	}
+	i := framebufferChooser(desired, usable)
+	if i < 0 || i >= len(usable) {
+		// Describe the differences to the closest of all the configurations
+		err := &FormatUnavailableError{}
+		if j := ChooseFramebufferConfig(desired, configs); j >= 0 {
+			err.Unsatisfied = UnsatisfiedHints(desired, configs[j])
+		}
+		return FramebufferConfig{}, err
+	}
+	w.framebuffer = usable[i]
+	// Report the HDR colour space when the chosen format can carry it
+	switch fbconfig.colorSpace {
+	case ScRGBColorSpace:
+		if w.framebuffer.Float && w.framebuffer.ColorSpace != SRGBColorSpace {
+			w.framebuffer.ColorSpace = ScRGBColorSpace
+		}
+	case HDR10ColorSpace:
+		if !w.framebuffer.Float && w.framebuffer.RedBits == 10 && w.framebuffer.GreenBits == 10 && w.framebuffer.BlueBits == 10 {
+			w.framebuffer.ColorSpace = HDR10ColorSpace
+		}
+	}
+	return usable[i], nil
+}
+
+func colorSpaceName(colorSpace int) string {
+	switch colorSpace {
+	case DontCare:
+		return "any"
+	case 0:
+		return "unknown"
+	case SRGBColorSpace:
+		return "sRGB"
+	case LinearColorSpace:
+		return "linear"
+	case ScRGBColorSpace:
+		return "scRGB"
+	case HDR10ColorSpace:
+		return "HDR10"
+	}
+	return fmt.Sprintf("0x%X", colorSpace)
+}
+
+func fbconfigToPublic(f *_GLFWfbconfig) FramebufferConfig {
+	return FramebufferConfig{
+		RedBits:        int(f.redBits),
+		GreenBits:      int(f.greenBits),
+		BlueBits:       int(f.blueBits),
+		AlphaBits:      int(f.alphaBits),
+		DepthBits:      int(f.depthBits),
+		StencilBits:    int(f.stencilBits),
+		AccumRedBits:   int(f.accumRedBits),
+		AccumGreenBits: int(f.accumGreenBits),
+		AccumBlueBits:  int(f.accumBlueBits),
+		AccumAlphaBits: int(f.accumAlphaBits),
+		AuxBuffers:     int(f.auxBuffers),
+		Samples:        int(f.samples),
+		SRGB:           f.sRGB,
+		Stereo:         f.stereo,
+		Doublebuffer:   f.doublebuffer,
+		Transparent:    f.transparent,
+		Float:          f.floatBuffer,
+		ColorSpace:     int(f.colorSpace),
+		Acceleration:   FullAcceleration,
+	}
+}
+
+func fbconfigFromPublic(c FramebufferConfig) _GLFWfbconfig {
+	return _GLFWfbconfig{
+		redBits:        int32(c.RedBits),
+		greenBits:      int32(c.GreenBits),
+		blueBits:       int32(c.BlueBits),
+		alphaBits:      int32(c.AlphaBits),
+		depthBits:      int32(c.DepthBits),
+		stencilBits:    int32(c.StencilBits),
+		accumRedBits:   int32(c.AccumRedBits),
+		accumGreenBits: int32(c.AccumGreenBits),
+		accumBlueBits:  int32(c.AccumBlueBits),
+		accumAlphaBits: int32(c.AccumAlphaBits),
+		auxBuffers:     int32(c.AuxBuffers),
+		samples:        int32(c.Samples),
+		sRGB:           c.SRGB,
+		stereo:         c.Stereo,
+		doublebuffer:   c.Doublebuffer,
+		transparent:    c.Transparent,
+		floatBuffer:    c.Float,
+		colorSpace:     int32(c.ColorSpace),
+		handle:         uintptr(c.PixelFormat),
+	}
+}
@@ -0,0 +1,170 @@
+package glfw
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// Synthetic pixel formats, numbered from 1
+var (
+	rgba8     = FramebufferConfig{PixelFormat: 1, RedBits: 8, GreenBits: 8, BlueBits: 8, AlphaBits: 8, DepthBits: 24, StencilBits: 8, Doublebuffer: true, Acceleration: FullAcceleration}
+	rgb565    = FramebufferConfig{PixelFormat: 2, RedBits: 5, GreenBits: 6, BlueBits: 5, DepthBits: 16, Doublebuffer: true, Acceleration: FullAcceleration}
+	rgba8MS4  = FramebufferConfig{PixelFormat: 3, RedBits: 8, GreenBits: 8, BlueBits: 8, AlphaBits: 8, DepthBits: 24, StencilBits: 8, Samples: 4, Doublebuffer: true, Acceleration: FullAcceleration}
+	rgba16F   = FramebufferConfig{PixelFormat: 4, RedBits: 16, GreenBits: 16, BlueBits: 16, AlphaBits: 16, DepthBits: 24, StencilBits: 8, Float: true, Doublebuffer: true, Acceleration: FullAcceleration}
+	rgb10A2   = FramebufferConfig{PixelFormat: 5, RedBits: 10, GreenBits: 10, BlueBits: 10, AlphaBits: 2, DepthBits: 24, StencilBits: 8, Doublebuffer: true, Acceleration: FullAcceleration}
+	rgba8SRGB = FramebufferConfig{PixelFormat: 6, RedBits: 8, GreenBits: 8, BlueBits: 8, AlphaBits: 8, DepthBits: 24, StencilBits: 8, SRGB: true, Doublebuffer: true, Acceleration: FullAcceleration}
+)
+
+// defaultHints is the configuration requested by the default window hints
+var defaultHints = FramebufferConfig{RedBits: 8, GreenBits: 8, BlueBits: 8, AlphaBits: 8, DepthBits: 24, StencilBits: 8, Doublebuffer: true}
+
+func TestChooseFramebufferConfig(t *testing.T) {
+	all := []FramebufferConfig{rgba8, rgb565, rgba8MS4, rgba16F, rgb10A2, rgba8SRGB}
+	dontCare := FramebufferConfig{
+		RedBits: DontCare, GreenBits: DontCare, BlueBits: DontCare, AlphaBits: DontCare,
+		DepthBits: DontCare, StencilBits: DontCare, AccumRedBits: DontCare, AccumGreenBits: DontCare,
+		AccumBlueBits: DontCare, AccumAlphaBits: DontCare, Samples: DontCare,
+	}
+	tests := []struct {
+		name       string
+		desired    func(*FramebufferConfig)
+		candidates []FramebufferConfig
+		want       int // Pixel format, or -1
+	}{
+		{"default hints", func(*FramebufferConfig) {}, all, 1},
+		{"samples", func(d *FramebufferConfig) { d.Samples = 4 }, all, 3},
+		{"no samples requested", func(d *FramebufferConfig) {}, []FramebufferConfig{rgba8MS4, rgba8}, 1},
+		{"float", func(d *FramebufferConfig) {
+			d.Float = true
+			d.RedBits, d.GreenBits, d.BlueBits, d.AlphaBits = 16, 16, 16, 16
+		}, all, 4},
+		{"sRGB", func(d *FramebufferConfig) { d.SRGB = true }, all, 6},
+		{"deep colour", func(d *FramebufferConfig) {
+			d.RedBits, d.GreenBits, d.BlueBits, d.AlphaBits = 10, 10, 10, 2
+		}, all, 5},
+		// A missing buffer is worse than any difference in size
+		{"missing alpha", func(d *FramebufferConfig) {
+			d.RedBits, d.GreenBits, d.BlueBits = 5, 6, 5
+		}, []FramebufferConfig{rgb565, rgb10A2}, 5},
+		// The colour depth matters more than the other buffers
+		{"colour depth first", func(d *FramebufferConfig) {
+			d.RedBits, d.GreenBits, d.BlueBits = 5, 6, 5
+			d.AlphaBits, d.StencilBits = DontCare, DontCare
+		}, []FramebufferConfig{rgba8, rgb565}, 2},
+		{"all don't care", func(d *FramebufferConfig) { *d = dontCare }, []FramebufferConfig{rgb565, rgba8}, 2},
+		{"no candidates", func(*FramebufferConfig) {}, nil, -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			desired := defaultHints
+			tt.desired(&desired)
+			got := ChooseFramebufferConfig(desired, tt.candidates)
+			if got >= 0 {
+				got = tt.candidates[got].PixelFormat
+			}
+			if got != tt.want {
+				t.Errorf("chose pixel format %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnsatisfiedHints(t *testing.T) {
+	tests := []struct {
+		name     string
+		desired  func(*FramebufferConfig)
+		obtained FramebufferConfig
+		want     []string
+	}{
+		{"satisfied", func(*FramebufferConfig) {}, rgba8, nil},
+		{"more than requested", func(d *FramebufferConfig) { d.DepthBits = 16 }, rgba8, nil},
+		{"don't care", func(d *FramebufferConfig) { d.AlphaBits, d.DepthBits, d.StencilBits = DontCare, DontCare, DontCare }, rgb565, []string{
+			"red bits: requested 8, got 5",
+			"green bits: requested 8, got 6",
+			"blue bits: requested 8, got 5",
+		}},
+		{"buffers", func(d *FramebufferConfig) { d.Samples = 4 }, rgb565, []string{
+			"red bits: requested 8, got 5",
+			"green bits: requested 8, got 6",
+			"blue bits: requested 8, got 5",
+			"alpha bits: requested 8, got 0",
+			"depth bits: requested 24, got 16",
+			"stencil bits: requested 8, got 0",
+			"samples: requested 4, got 0",
+		}},
+		{"flags", func(d *FramebufferConfig) { d.SRGB, d.Stereo, d.Transparent, d.Float = true, true, true, true }, rgba8, []string{
+			"sRGB: requested but not available",
+			"stereo: requested but not available",
+			"transparency: requested but not available",
+			"floating point components: requested but not available",
+		}},
+		{"colour space", func(d *FramebufferConfig) { d.ColorSpace = HDR10ColorSpace }, FramebufferConfig{
+			RedBits: 8, GreenBits: 8, BlueBits: 8, AlphaBits: 8, DepthBits: 24, StencilBits: 8, Doublebuffer: true, ColorSpace: SRGBColorSpace,
+		}, []string{"colour space: requested HDR10, got sRGB"}},
+		{"any colour space", func(d *FramebufferConfig) { d.ColorSpace = DontCare }, rgba8, nil},
+		{"single buffered", func(d *FramebufferConfig) {}, FramebufferConfig{
+			RedBits: 8, GreenBits: 8, BlueBits: 8, AlphaBits: 8, DepthBits: 24, StencilBits: 8,
+		}, []string{"double buffering: requested true, got false"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			desired := defaultHints
+			tt.desired(&desired)
+			if got := UnsatisfiedHints(desired, tt.obtained); !slices.Equal(got, tt.want) {
+				t.Errorf("UnsatisfiedHints = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChooseFramebuffer(t *testing.T) {
+	software := rgba8
+	software.PixelFormat, software.Acceleration = 7, NoAcceleration
+	single := rgba8
+	single.PixelFormat, single.Doublebuffer = 8, false
+	configs := []FramebufferConfig{software, single, rgb565}
+
+	// Only the accelerated configurations with the requested buffering are candidates
+	fbconfig := fbconfigFromPublic(defaultHints)
+	w := &_GLFWwindow{}
+	got, err := chooseFramebuffer(w, &fbconfig, configs)
+	if err != nil || got.PixelFormat != 2 || w.framebuffer.PixelFormat != 2 {
+		t.Errorf("chose pixel format %d (window %d), %v, want 2", got.PixelFormat, w.framebuffer.PixelFormat, err)
+	}
+	fbconfig.doublebuffer = false
+	if got, err := chooseFramebuffer(w, &fbconfig, configs); err != nil || got.PixelFormat != 8 {
+		t.Errorf("chose single buffered pixel format %d, %v, want 8", got.PixelFormat, err)
+	}
+
+	// A chooser refusing every candidate gives the unsatisfied hints of the closest configuration
+	defer SetFramebufferChooser(nil)
+	var candidates []FramebufferConfig
+	SetFramebufferChooser(func(desired FramebufferConfig, c []FramebufferConfig) int {
+		candidates = c
+		return -1
+	})
+	desired := defaultHints
+	desired.Samples = 8
+	fbconfig = fbconfigFromPublic(desired)
+	_, err = chooseFramebuffer(w, &fbconfig, configs)
+	if len(candidates) != 1 || candidates[0].PixelFormat != 2 {
+		t.Errorf("chooser was given %v, want pixel format 2 only", candidates)
+	}
+	var formatErr *FormatUnavailableError
+	if !errors.As(err, &formatErr) {
+		t.Fatalf("error %v is not a *FormatUnavailableError", err)
+	}
+	if want := []string{"samples: requested 8, got 0"}; !slices.Equal(formatErr.Unsatisfied, want) {
+		t.Errorf("Unsatisfied = %q, want %q", formatErr.Unsatisfied, want)
+	}
+	if want := "no suitable framebuffer configuration: samples: requested 8, got 0"; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+
+	// An index out of range is refused too
+	SetFramebufferChooser(func(FramebufferConfig, []FramebufferConfig) int { return 5 })
+	if _, err := chooseFramebuffer(w, &fbconfig, configs); !errors.As(err, &formatErr) {
+		t.Errorf("index out of range gave %v, want a *FormatUnavailableError", err)
+	}
+}
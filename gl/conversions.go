@@ -59,6 +59,5 @@ func Str(str string) *uint8 {
 	if !strings.HasSuffix(str, "\x00") {
 		panic("str argument missing null terminator: " + str)
 	}
-	header := (*reflect.StringHeader)(unsafe.Pointer(&str))
-	return (*uint8)(unsafe.Pointer(header.Data))
+	return unsafe.StringData(str)
 }
@@ -1,4 +1,4 @@
-// +build !windows
+//go:build !windows && !(linux && (amd64 || arm64))
 
 // Code generated by glow (https://github.com/neclepsio/glow). DO NOT EDIT.
 
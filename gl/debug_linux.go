@@ -0,0 +1,41 @@
+//go:build amd64 || arm64
+
+package gl
+
+import (
+	"sync"
+	"unsafe"
+
+	"github.com/ebitengine/purego"
+)
+
+type DebugProc func(
+	source uint32,
+	gltype uint32,
+	id uint32,
+	severity uint32,
+	length int32,
+	message string,
+	userParam unsafe.Pointer)
+
+var (
+	userDebugCallback DebugProc
+	debugCallbackOnce sync.Once
+	debugCallback     uintptr
+)
+
+// newDebugProcCallback returns a C function calling the callback. Callbacks
+// made with purego are never released, so a single trampoline is shared,
+// calling the callback set last, as the cgo bindings do.
+func newDebugProcCallback(callback DebugProc) uintptr {
+	userDebugCallback = callback
+	debugCallbackOnce.Do(func() {
+		debugCallback = purego.NewCallback(func(source, gltype, id, severity, length uintptr, message *uint8, userParam unsafe.Pointer) uintptr {
+			if userDebugCallback != nil {
+				userDebugCallback(uint32(source), uint32(gltype), uint32(id), uint32(severity), int32(length), GoStr(message), userParam)
+			}
+			return 0
+		})
+	})
+	return debugCallback
+}
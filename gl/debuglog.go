@@ -0,0 +1,38 @@
+//go:build windows || (linux && (amd64 || arm64))
+
+package gl
+
+import (
+	"context"
+	"log/slog"
+	"unsafe"
+)
+
+// logDebugOutput routes the KHR_debug messages of a debug context to the
+// logger, in builds with the gldebug tag. Debug output is enabled by default
+// only in debug contexts, made with the OpenGLDebugContext hint.
+func (ctx *Context) logDebugOutput() {
+	if ctx.gpDebugMessageCallback == 0 || ctx.gpIsEnabled == 0 || !ctx.IsEnabled(DEBUG_OUTPUT) {
+		return
+	}
+	// Synchronous output logs the message in the stack of the call
+	ctx.Enable(DEBUG_OUTPUT_SYNCHRONOUS)
+	ctx.DebugMessageCallback(func(source, gltype, id, severity uint32, length int32, message string, userParam unsafe.Pointer) {
+		logger.Log(context.Background(), debugLevel(severity), message,
+			"source", source, "type", gltype, "id", id)
+	}, nil)
+}
+
+// debugLevel returns the log level of a KHR_debug message severity
+func debugLevel(severity uint32) slog.Level {
+	switch severity {
+	case DEBUG_SEVERITY_HIGH:
+		return slog.LevelError
+	case DEBUG_SEVERITY_MEDIUM:
+		return slog.LevelWarn
+	case DEBUG_SEVERITY_LOW:
+		return slog.LevelInfo
+	default:
+		return slog.LevelDebug
+	}
+}
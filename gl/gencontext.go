@@ -0,0 +1,314 @@
+//go:build ignore
+
+// gencontext converts the bindings generated by glow in package_windows.go,
+// where the entry points are package variables, into methods of Context:
+//
+//   - the entry points become the fields of Context, loaded by Context.load
+//     instead of InitWithProcAddrFunc
+//   - each function becomes a method calling ctx.gpName, followed by
+//     ctx.called when hooked, or ctx.returned for the functions returning an
+//     integer, and a package-level function calling the method of the
+//     default context
+//
+// It also writes objectparams.go, listing the parameters naming objects or
+// locations, which Replay translates.
+//
+// The input may also be the output of a previous run, so the file can be
+// generated again after this program is changed. Run it with "go generate"
+// in this directory, after glow and before genlinux.go.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+)
+
+const header = "// Code generated by glow (https://github.com/neclepsio/glow) and gencontext.go. DO NOT EDIT."
+
+var (
+	// Both the package-level functions of glow and the methods of a previous run
+	funcRe    = regexp.MustCompile(`^func (\(ctx \*Context\) )?([A-Z]\w*)\((.*)\) ?(.*) \{$`)
+	entryRe   = regexp.MustCompile(`^\tgp(\w+) +uintptr$`)
+	syscallRe = regexp.MustCompile(`^\t(ret, _, _ := )?syscall\.Syscall\d*\((ctx\.)?gp\w+, `)
+)
+
+// unhooked lists the functions not followed by ctx.called, as the checks
+// of the gldebug tag call them
+var unhooked = map[string]bool{
+	"GetError": true,
+}
+
+// integerResults are the results passed to ctx.returned, which include the
+// names and locations returned by the functions
+var integerResults = map[string]bool{
+	"int32":   true,
+	"uint16":  true,
+	"uint32":  true,
+	"uint64":  true,
+	"uintptr": true,
+}
+
+// clearRe matches the functions whose buffer parameter is GL_COLOR,
+// GL_DEPTH or GL_STENCIL instead of a buffer object
+var clearRe = regexp.MustCompile(`^Clear(Buffer|NamedFramebuffer)(fi|fv|iv|uiv)$`)
+
+// objectKind returns the kind of object, defined in replay.go, named by a
+// parameter of a function, or "". The parameters are recognized by their
+// names in the Khronos registry.
+func objectKind(fn, name, typ string) string {
+	if typ != "uint32" && typ != "*uint32" && typ != "int32" && typ != "uintptr" {
+		return ""
+	}
+	switch name {
+	case "buffer", "buffers", "readBuffer", "writeBuffer":
+		if !clearRe.MatchString(fn) {
+			return "objBuffer"
+		}
+	case "texture", "textures", "origtexture":
+		if fn != "ActiveTexture" && fn != "ClientActiveTexture" {
+			return "objTexture"
+		}
+	case "framebuffer", "framebuffers", "readFramebuffer", "drawFramebuffer":
+		return "objFramebuffer"
+	case "renderbuffer", "renderbuffers":
+		return "objRenderbuffer"
+	case "vaobj", "arrays":
+		return "objVertexArray"
+	case "array":
+		// The array of EnableClientState and the like is an enum
+		if strings.HasSuffix(fn, "VertexArray") {
+			return "objVertexArray"
+		}
+	case "program":
+		return "objProgram"
+	case "shader", "shaders":
+		return "objShader"
+	case "sampler", "samplers":
+		return "objSampler"
+	case "pipeline", "pipelines":
+		return "objPipeline"
+	case "id", "ids":
+		switch {
+		case strings.Contains(fn, "Quer") || strings.Contains(fn, "ConditionalRender"):
+			return "objQuery"
+		case strings.Contains(fn, "TransformFeedback"):
+			return "objTransformFeedback"
+		}
+	case "xfb":
+		return "objTransformFeedback"
+	case "sync":
+		return "objSync"
+	case "location":
+		if strings.Contains(fn, "Uniform") {
+			return "objUniform"
+		}
+	case "index", "attribindex":
+		if strings.Contains(fn, "VertexAttrib") || strings.Contains(fn, "VertexArrayAttrib") {
+			return "objAttrib"
+		}
+	case "uniformBlockIndex":
+		return "objUniformBlock"
+	}
+	return ""
+}
+
+type function struct {
+	doc    []string // Comment lines
+	name   string
+	params string
+	result string
+	body   []string // With the entry point in a package variable
+}
+
+// argNames returns the names of the comma separated parameters
+func argNames(params string) []string {
+	var names []string
+	for _, p := range strings.Split(params, ",") {
+		if name, _, ok := strings.Cut(strings.TrimSpace(p), " "); ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// argTypes returns the types of the comma separated parameters
+func argTypes(params string) []string {
+	var types []string
+	for _, p := range strings.Split(params, ",") {
+		if _, typ, ok := strings.Cut(strings.TrimSpace(p), " "); ok {
+			types = append(types, typ)
+		}
+	}
+	return types
+}
+
+func parse(lines []string) (entries []string, funcs []function) {
+	var doc []string
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		if m := entryRe.FindStringSubmatch(line); m != nil {
+			entries = append(entries, m[1])
+			continue
+		}
+		if strings.HasPrefix(line, "//") {
+			doc = append(doc, line)
+			continue
+		}
+		m := funcRe.FindStringSubmatch(line)
+		if m == nil {
+			doc = nil
+			continue
+		}
+		f := function{doc: doc, name: m[2], params: m[3], result: m[4]}
+		doc = nil
+		for i++; lines[i] != "}"; i++ {
+			f.body = append(f.body, lines[i])
+		}
+		if !syscallRe.MatchString(f.body[0]) {
+			// The package-level functions calling the default context
+			continue
+		}
+		var body []string
+		for j := 0; j < len(f.body); j++ {
+			if f.body[j] == "\tif hooked {" {
+				j += 2
+				continue
+			}
+			body = append(body, strings.Replace(f.body[j], "(ctx.gp", "(gp", 1))
+		}
+		f.body = body
+		funcs = append(funcs, f)
+	}
+	return entries, funcs
+}
+
+func main() {
+	src, err := os.ReadFile("package_windows.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	var lines []string
+	sc := bufio.NewScanner(bytes.NewReader(src))
+	sc.Buffer(nil, 1<<20)
+	for sc.Scan() {
+		lines = append(lines, sc.Text())
+	}
+	entries, funcs := parse(lines)
+	if len(entries) == 0 || len(funcs) == 0 {
+		log.Fatal("package_windows.go: no entry points found")
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintln(&out, header)
+	fmt.Fprintln(&out)
+	fmt.Fprintln(&out, "package gl")
+	fmt.Fprintln(&out)
+	fmt.Fprintln(&out, "import (\n\t\"math\"\n\t\"syscall\"\n\t\"unsafe\"\n)")
+	fmt.Fprintln(&out)
+	fmt.Fprintln(&out, "// Context holds the OpenGL entry points of one context, and has a method")
+	fmt.Fprintln(&out, "// for each function of the package. The package-level functions call the")
+	fmt.Fprintln(&out, "// default context, loaded by Init and InitWithProcAddrFunc.")
+	fmt.Fprintln(&out, "type Context struct {")
+	fmt.Fprintln(&out, "\tinBegin bool // Between Begin and End, where glGetError must not be called")
+	fmt.Fprintln(&out)
+	for _, e := range entries {
+		fmt.Fprintf(&out, "\tgp%s uintptr\n", e)
+	}
+	fmt.Fprintln(&out, "}")
+	fmt.Fprintln(&out)
+	fmt.Fprintln(&out, "func boolToUintptr(b bool) uintptr {\n\tif b {\n\t\treturn 1\n\t}\n\treturn 0\n}")
+	fmt.Fprintln(&out)
+
+	for _, f := range funcs {
+		signature := fmt.Sprintf("%s(%s) %s {", f.name, f.params, f.result)
+		args := strings.Join(argNames(f.params), ", ")
+
+		// The method
+		for _, d := range f.doc {
+			fmt.Fprintln(&out, d)
+		}
+		fmt.Fprintln(&out, "func (ctx *Context) "+signature)
+		fmt.Fprintln(&out, strings.Replace(f.body[0], "(gp", "(ctx.gp", 1))
+		if !unhooked[f.name] {
+			hook := fmt.Sprintf("called(%q", f.name)
+			if integerResults[f.result] {
+				// The converted result of the return statement
+				hook = fmt.Sprintf("returned(%q, %s", f.name, strings.TrimPrefix(f.body[len(f.body)-1], "\treturn "))
+			}
+			if args != "" {
+				hook += ", " + args
+			}
+			fmt.Fprintf(&out, "\tif hooked {\n\t\tctx.%s)\n\t}\n", hook)
+		}
+		for _, b := range f.body[1:] {
+			fmt.Fprintln(&out, b)
+		}
+		fmt.Fprintln(&out, "}")
+		fmt.Fprintln(&out)
+
+		// The package-level function
+		for _, d := range f.doc {
+			fmt.Fprintln(&out, d)
+		}
+		fmt.Fprintln(&out, "func "+signature)
+		call := fmt.Sprintf("defaultContext.%s(%s)", f.name, args)
+		if f.result != "" {
+			call = "return " + call
+		}
+		fmt.Fprintf(&out, "\t%s\n}\n\n", call)
+	}
+
+	fmt.Fprintln(&out, "// load loads the entry points of the current context")
+	fmt.Fprintln(&out, "func (ctx *Context) load(getProcAddr func(name string) unsafe.Pointer) error {")
+	for _, e := range entries {
+		fmt.Fprintf(&out, "\tctx.gp%s = uintptr(getProcAddr(\"gl%s\"))\n", e, e)
+	}
+	fmt.Fprintln(&out, "\treturn nil\n}")
+
+	writeGo("package_windows.go", out.Bytes())
+	writeObjectParams(funcs)
+}
+
+// writeObjectParams writes the table of the parameters naming objects or
+// locations used by Replay
+func writeObjectParams(funcs []function) {
+	var out bytes.Buffer
+	fmt.Fprintln(&out, "// Code generated by gencontext.go. DO NOT EDIT.")
+	fmt.Fprintln(&out)
+	fmt.Fprintln(&out, "//go:build windows || (linux && (amd64 || arm64))")
+	fmt.Fprintln(&out)
+	fmt.Fprintln(&out, "package gl")
+	fmt.Fprintln(&out)
+	fmt.Fprintln(&out, "// objectParams lists the parameters of the functions naming objects, or")
+	fmt.Fprintln(&out, "// locations, which Replay translates from the capture to the replay")
+	fmt.Fprintln(&out, "var objectParams = map[string][]objectParam{")
+	for _, f := range funcs {
+		var params []string
+		for i, typ := range argTypes(f.params) {
+			if kind := objectKind(f.name, argNames(f.params)[i], typ); kind != "" {
+				params = append(params, fmt.Sprintf("{%d, %s}", i, kind))
+			}
+		}
+		if len(params) > 0 {
+			fmt.Fprintf(&out, "\t%q: {%s},\n", f.name, strings.Join(params, ", "))
+		}
+	}
+	fmt.Fprintln(&out, "}")
+	writeGo("objectparams.go", out.Bytes())
+}
+
+func writeGo(path string, src []byte) {
+	formatted, err := format.Source(src)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(path, formatted, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,9 @@
+package gl
+
+// package_windows.go is generated by glow and converted to methods of Context
+// by gencontext.go. The Linux bindings are generated from the Windows
+// bindings, and the version specific packages from both.
+
+//go:generate go run gencontext.go
+//go:generate go run genlinux.go
+//go:generate go run genversions.go
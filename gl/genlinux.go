@@ -0,0 +1,181 @@
+//go:build ignore
+
+// genlinux generates package_linux.go from package_windows.go, as converted
+// by gencontext.go, keeping the hooks of the methods.
+//
+// The Windows bindings call the entry points with syscall.SyscallN, passing
+// floating point values as their bits in integer registers, which works with
+// the Windows x64 calling convention. The System V and AArch64 conventions
+// pass floating point values in separate registers, so the functions with
+// floating point parameters or results are bound with purego.RegisterFunc,
+// while the others are called with purego.SyscallN.
+//
+// Run it with "go generate" in this directory.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+)
+
+var (
+	methodRe  = regexp.MustCompile(`^func \(ctx \*Context\) (\w+)\((.*)\) ?(.*) \{$`)
+	syscallRe = regexp.MustCompile(`^\t(ret, _, _ := )?syscall\.Syscall\d*\((ctx\.gp\w+), (\d+)(.*)\)$`)
+	initRe    = regexp.MustCompile(`^\tctx\.(gp\w+) = uintptr\(getProcAddr\("(\w+)"\)\)$`)
+)
+
+type param struct{ name, typ string }
+
+func isFloat(typ string) bool {
+	return typ == "float32" || typ == "float64"
+}
+
+// splitArgs splits a comma separated argument list at the top level
+func splitArgs(s string) []string {
+	var args []string
+	depth, start := 0, 0
+	for i, c := range s {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				args = append(args, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if rest := strings.TrimSpace(s[start:]); rest != "" {
+		args = append(args, rest)
+	}
+	return args
+}
+
+func parseParams(s string) []param {
+	var params []param
+	for _, p := range splitArgs(s) {
+		name, typ, _ := strings.Cut(p, " ")
+		params = append(params, param{name, typ})
+	}
+	return params
+}
+
+func main() {
+	src, err := os.ReadFile("package_windows.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	var lines []string
+	sc := bufio.NewScanner(bytes.NewReader(src))
+	sc.Buffer(nil, 1<<20)
+	for sc.Scan() {
+		lines = append(lines, sc.Text())
+	}
+	// The methods and their hooks come from gencontext.go, never from glow directly
+	if len(lines) == 0 || !strings.Contains(lines[0], "gencontext.go") {
+		log.Fatal("package_windows.go is not converted, run gencontext.go first")
+	}
+
+	var out, funcVars bytes.Buffer
+	registered := map[string]bool{}
+	fmt.Fprintln(&out, "// Code generated by genlinux.go from package_windows.go. DO NOT EDIT.")
+	fmt.Fprintln(&out)
+	fmt.Fprintln(&out, "//go:build amd64 || arm64")
+	fmt.Fprintln(&out)
+	fmt.Fprintln(&out, "package gl")
+	fmt.Fprintln(&out)
+	fmt.Fprintln(&out, "import (\n\t\"errors\"\n\t\"unsafe\"\n\n\t\"github.com/ebitengine/purego\"\n)")
+	fmt.Fprintln(&out)
+
+	i := 0
+	// Skip the header up to the context
+	for !strings.HasPrefix(lines[i], "// Context holds") {
+		i++
+	}
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		m := methodRe.FindStringSubmatch(line)
+		if m == nil || m[1] == "load" {
+			if im := initRe.FindStringSubmatch(line); im != nil && registered[im[1]] {
+				name := strings.TrimPrefix(im[1], "gp")
+				fmt.Fprintln(&out, line)
+				fmt.Fprintf(&out, "\tif ctx.gp%s != 0 {\n\t\tpurego.RegisterFunc(&ctx.fp%s, ctx.gp%s)\n\t} else {\n\t\tctx.fp%s = nil\n\t}\n", name, name, name, name)
+				continue
+			}
+			if line == "\treturn nil" && i == len(lines)-2 {
+				fmt.Fprintln(&out, "\tif ctx.gpGetString == 0 {")
+				fmt.Fprintln(&out, "\t\treturn errors.New(\"gl: glGetString is missing, no OpenGL library or current context\")")
+				fmt.Fprintln(&out, "\t}")
+			}
+			fmt.Fprintln(&out, line)
+			continue
+		}
+		name, params, result := m[1], parseParams(m[2]), m[3]
+		body := []string{}
+		for i++; lines[i] != "}"; i++ {
+			body = append(body, lines[i])
+		}
+		sm := syscallRe.FindStringSubmatch(body[0])
+		if sm == nil {
+			log.Fatalf("%s: unexpected body %q", name, body[0])
+		}
+		float := isFloat(result)
+		for _, p := range params {
+			float = float || isFloat(p.typ)
+		}
+		fmt.Fprintln(&out, line)
+		if float {
+			var types, names []string
+			for _, p := range params {
+				types = append(types, p.typ)
+				names = append(names, p.name)
+			}
+			fmt.Fprintf(&funcVars, "\tfp%s func(%s) %s\n", name, strings.Join(types, ", "), result)
+			registered["gp"+name] = true
+			call := fmt.Sprintf("ctx.fp%s(%s)", name, strings.Join(names, ", "))
+			if result != "" {
+				call = "ret := " + call
+			}
+			fmt.Fprintf(&out, "\t%s\n", call)
+			for _, b := range body[1:] {
+				// The error check, the return is replaced
+				if !strings.HasPrefix(b, "\treturn ") {
+					fmt.Fprintln(&out, b)
+				}
+			}
+			if result != "" {
+				fmt.Fprintln(&out, "\treturn ret")
+			}
+			fmt.Fprintln(&out, "}")
+			continue
+		}
+		var n int
+		fmt.Sscan(sm[3], &n)
+		args := append([]string{sm[2]}, splitArgs(strings.TrimPrefix(sm[4], ", "))[:n]...)
+		fmt.Fprintf(&out, "\t%spurego.SyscallN(%s)\n", sm[1], strings.Join(args, ", "))
+		for _, b := range body[1:] {
+			// GLboolean is a byte, and the rest of the register is undefined
+			fmt.Fprintln(&out, strings.Replace(b, "return ret != 0", "return uint8(ret) != 0", 1))
+		}
+		fmt.Fprintln(&out, "}")
+	}
+
+	// The function variables go after the entry points
+	result := bytes.Replace(out.Bytes(), []byte("\n}\n\nfunc boolToUintptr"),
+		[]byte("\n\n\t// Functions with floating point parameters or results, bound by load\n"+funcVars.String()+"}\n\nfunc boolToUintptr"), 1)
+	formatted, err := format.Source(result)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("package_linux.go", formatted, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
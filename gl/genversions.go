@@ -0,0 +1,336 @@
+//go:build ignore
+
+// genversions generates the version specific packages from the all-core
+// bindings in this directory. Each package contains exactly the functions and
+// enums of one OpenGL or OpenGL ES version, as listed in the Khronos headers:
+//
+//	gl/v3.3-core, gl/v4.1-core, gl/v4.6-core  from GL/glcorearb.h
+//	gles/v3.0                                 from GLES3/gl3.h
+//
+// Only the pure Go bindings, for Windows and for Linux on amd64 and arm64,
+// are generated. Run it with "go generate" in this directory, after
+// package_linux.go is up to date.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+type profile struct {
+	dir      string   // Output directory, relative to this one
+	pkg      string   // Package name
+	title    string   // Used in comments and errors
+	header   string   // Khronos header
+	sections []string // Header sections included
+}
+
+var (
+	corearb = flag.String("corearb", "/usr/include/GL/glcorearb.h", "path of the OpenGL core profile header")
+	gles3   = flag.String("gles3", "/usr/include/GLES3/gl3.h", "path of the OpenGL ES 3.0 header")
+)
+
+func profiles() []profile {
+	gl := func(major, minor int) []string {
+		var s []string
+		for _, v := range []string{"1_0", "1_1", "1_2", "1_3", "1_4", "1_5", "2_0", "2_1", "3_0", "3_1", "3_2", "3_3",
+			"4_0", "4_1", "4_2", "4_3", "4_4", "4_5", "4_6"} {
+			s = append(s, "GL_VERSION_"+v)
+			if v == fmt.Sprintf("%d_%d", major, minor) {
+				break
+			}
+		}
+		return s
+	}
+	return []profile{
+		{"v3.3-core", "gl", "OpenGL 3.3 core profile", *corearb, gl(3, 3)},
+		{"v4.1-core", "gl", "OpenGL 4.1 core profile", *corearb, gl(4, 1)},
+		{"v4.6-core", "gl", "OpenGL 4.6 core profile", *corearb, gl(4, 6)},
+		{"../gles/v3.0", "gles", "OpenGL ES 3.0", *gles3, []string{"GL_ES_VERSION_2_0", "GL_ES_VERSION_3_0"}},
+	}
+}
+
+type enum struct{ name, value string }
+
+var (
+	sectionStartRe = regexp.MustCompile(`^#ifndef (GL_(ES_)?VERSION_\d_\d)$`)
+	defineRe       = regexp.MustCompile(`^#define GL_(\w+)\s+(0x[0-9A-Fa-f]+|\d+)(u|ull)?$`)
+	prototypeRe    = regexp.MustCompile(`^(GLAPI|GL_APICALL) .*\bgl(\w+) \(`)
+	versionRe      = regexp.MustCompile(`^(ES_)?VERSION_\d_\d$`)
+	funcRe         = regexp.MustCompile(`^func (\w+)\(`)
+	methodRe       = regexp.MustCompile(`^func \(ctx \*Context\) (\w+)\(`)
+	entryRe        = regexp.MustCompile(`\bctx\.[gf]p([A-Z]\w*)\b`)
+	varRe          = regexp.MustCompile(`^\t[gf]p(\w+)\s`)
+	loadRe         = regexp.MustCompile(`^\tctx\.gp(\w+) = uintptr\(getProcAddr\("(\w+)"\)\)$`)
+	registerRe     = regexp.MustCompile(`^\tif ctx\.gp(\w+) != 0 \{$`)
+)
+
+// readHeader returns the functions and enums of the sections of a header
+func readHeader(path string, sections []string) (map[string]bool, []enum) {
+	f, err := os.Open(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+	wanted := map[string]bool{}
+	for _, s := range sections {
+		wanted[s] = true
+	}
+	funcs := map[string]bool{}
+	var enums []enum
+	seen := map[string]bool{}
+	section := ""
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := sc.Text()
+		if m := sectionStartRe.FindStringSubmatch(line); m != nil {
+			section = m[1]
+			continue
+		}
+		if line == "#endif /* "+section+" */" {
+			section = ""
+			continue
+		}
+		if !wanted[section] {
+			continue
+		}
+		if m := defineRe.FindStringSubmatch(line); m != nil && !versionRe.MatchString(m[1]) && !seen[m[1]] {
+			seen[m[1]] = true
+			name := m[1]
+			if name[0] >= '0' && name[0] <= '9' {
+				name = "GL_" + name
+			}
+			enums = append(enums, enum{name, m[2]})
+		}
+		if m := prototypeRe.FindStringSubmatch(line); m != nil {
+			funcs[m[2]] = true
+		}
+	}
+	return funcs, enums
+}
+
+func readLines(path string) []string {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var lines []string
+	sc := bufio.NewScanner(bytes.NewReader(src))
+	sc.Buffer(nil, 1<<20)
+	for sc.Scan() {
+		lines = append(lines, sc.Text())
+	}
+	return lines
+}
+
+// filterBindings returns the declarations of the functions of the profile
+// from an all-core binding file, and the entry points that were found.
+// Context.load is replaced by one that reports missing entry points.
+func filterBindings(p profile, src string, funcs map[string]bool) (string, map[string]bool) {
+	lines := readLines(src)
+	if len(lines) == 0 || !strings.Contains(lines[0], "gencontext.go") && !strings.Contains(lines[0], "genlinux.go") {
+		log.Fatalf("%s is not generated by gencontext.go or genlinux.go", src)
+	}
+	var out bytes.Buffer
+	found := map[string]bool{}
+	methods := map[string]bool{}
+	var loads []string
+	i := 0
+	for !strings.HasPrefix(lines[i], "// Context holds") {
+		i++
+	}
+	var comment []string
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		switch {
+		case strings.HasPrefix(line, "type Context struct {"):
+			out.WriteString(strings.Join(comment, "\n") + "\n" + line + "\n")
+			comment = nil
+			for i++; lines[i] != "}"; i++ {
+				if m := varRe.FindStringSubmatch(lines[i]); m == nil || funcs[m[1]] {
+					out.WriteString(lines[i] + "\n")
+				}
+			}
+			out.WriteString("}\n")
+		case strings.HasPrefix(line, "//"):
+			comment = append(comment, line)
+		case line == "":
+			if comment == nil {
+				out.WriteString("\n")
+			}
+		case strings.HasPrefix(line, "func (ctx *Context) load("):
+			for i++; lines[i] != "}"; i++ {
+				if m := loadRe.FindStringSubmatch(lines[i]); m != nil && funcs[m[1]] {
+					loads = append(loads, fmt.Sprintf("\tctx.gp%s = load(%q)", m[1], m[2]))
+				}
+				if m := registerRe.FindStringSubmatch(lines[i]); m != nil {
+					block := lines[i : i+5]
+					i += 4
+					if funcs[m[1]] {
+						loads = append(loads, block...)
+					}
+				}
+			}
+			comment = nil
+		case funcRe.MatchString(line) || methodRe.MatchString(line):
+			start := i
+			for lines[i] != "}" {
+				i++
+			}
+			body := strings.Join(lines[start:i+1], "\n")
+			var keep bool
+			if m := methodRe.FindStringSubmatch(line); m != nil {
+				// The package-level wrapper follows the method
+				if e := entryRe.FindStringSubmatch(strings.Join(lines[start+1:i], "\n")); e != nil && funcs[e[1]] {
+					keep = true
+					found[e[1]] = true
+					methods[m[1]] = true
+				}
+			} else {
+				name := funcRe.FindStringSubmatch(line)[1]
+				keep = name == "boolToUintptr" || methods[name]
+			}
+			if keep {
+				if len(comment) > 0 {
+					out.WriteString(strings.Join(comment, "\n") + "\n")
+				}
+				out.WriteString(body + "\n")
+			}
+			comment = nil
+		default:
+			log.Fatalf("%s:%d: unexpected line %q", src, i+1, line)
+		}
+	}
+	fmt.Fprintf(&out, `
+// load loads the entry points of the %s. It returns an error listing the
+// entry points that could not be found, which means that the current context
+// does not support the %s.
+func (ctx *Context) load(getProcAddr func(name string) unsafe.Pointer) error {
+	var missing []string
+	load := func(name string) uintptr {
+		p := uintptr(getProcAddr(name))
+		if p == 0 {
+			missing = append(missing, name)
+		}
+		return p
+	}
+%s
+	if len(missing) > 0 {
+		return fmt.Errorf("%s: %%d entry points are missing: %%s", len(missing), strings.Join(missing, ", "))
+	}
+	return nil
+}
+`, p.title, p.title, strings.Join(loads, "\n"), p.pkg)
+	return out.String(), found
+}
+
+// imports returns the import declaration for the packages used by the source
+func imports(src string) string {
+	var std, ext []string
+	for _, pkg := range []string{"fmt", "math", "strings", "syscall", "unsafe"} {
+		if strings.Contains(src, pkg+".") {
+			std = append(std, fmt.Sprintf("\t%q", pkg))
+		}
+	}
+	if strings.Contains(src, "purego.") {
+		ext = append(ext, "\t\"github.com/ebitengine/purego\"")
+	}
+	s := "import (\n" + strings.Join(std, "\n") + "\n"
+	if len(ext) > 0 {
+		s += "\n" + strings.Join(ext, "\n") + "\n"
+	}
+	return s + ")\n"
+}
+
+func writeGo(path string, src string) {
+	formatted, err := format.Source([]byte(src))
+	if err != nil {
+		log.Fatalf("%s: %v", path, err)
+	}
+	if err := os.WriteFile(path, formatted, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// copyFile copies a file of this package, changing the package name
+func copyFile(p profile, name string) {
+	src, err := os.ReadFile(name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	s := strings.Replace("\n"+string(src), "\npackage gl\n", "\npackage "+p.pkg+"\n", 1)[1:]
+	writeGo(filepath.Join(p.dir, name), s)
+}
+
+func generate(p profile) {
+	funcs, enums := readHeader(p.header, p.sections)
+	if err := os.MkdirAll(p.dir, 0o755); err != nil {
+		log.Fatal(err)
+	}
+	header := "// Code generated by genversions.go from the all-core bindings and " + filepath.Base(p.header) + ". DO NOT EDIT.\n\n"
+
+	var pkg bytes.Buffer
+	pkg.WriteString(header)
+	fmt.Fprintf(&pkg, "// Package %s implements Go bindings to the %s.\n// It contains exactly the functions and enums of this version.\n", p.pkg, p.title)
+	fmt.Fprintf(&pkg, "//\n// Only Windows and Linux on amd64 and arm64 are supported, without cgo.\npackage %s\n\nconst (\n", p.pkg)
+	for _, e := range enums {
+		fmt.Fprintf(&pkg, "\t%s = %s\n", e.name, e.value)
+	}
+	pkg.WriteString(`)
+
+// Init initializes the package with the entry points of the current context.
+// It returns an error listing the entry points that are missing. A context
+// must be current, and Init must be called again after switching to a context
+// of another driver. Use InitWithProcAddrFunc(glfw.GetProcAddress) to get the
+// entry points from the context of a glfw window.
+func Init() error {
+	return InitWithProcAddrFunc(getProcAddress)
+}
+`)
+	writeGo(filepath.Join(p.dir, "package.go"), pkg.String())
+
+	var found map[string]bool
+	for _, f := range []struct{ src, constraint string }{
+		{"package_windows.go", ""},
+		{"package_linux.go", "//go:build amd64 || arm64\n\n"},
+	} {
+		body, ok := filterBindings(p, f.src, funcs)
+		found = ok
+		writeGo(filepath.Join(p.dir, f.src), header+f.constraint+"package "+p.pkg+"\n\n"+imports(body)+"\n"+body)
+	}
+	for name := range funcs {
+		if !found[name] {
+			log.Fatalf("%s: gl%s is not in the all-core bindings", p.title, name)
+		}
+	}
+	for _, name := range []string{"check.go", "gldebug.go", "nogldebug.go", "gltrace.go", "nogltrace.go", "trace.go", "replay.go", "objectparams.go", "context.go", "conversions.go", "helpers.go", "conversions_purego.go", "procaddr_windows.go", "procaddr_linux.go", "egl_linux.go"} {
+		copyFile(p, name)
+	}
+	if funcs["DebugMessageCallback"] {
+		copyFile(p, "debug_windows.go")
+		copyFile(p, "debug_linux.go")
+		copyFile(p, "debuglog.go")
+	} else {
+		writeGo(filepath.Join(p.dir, "debuglog.go"), header+"//go:build windows || (linux && (amd64 || arm64))\n\npackage "+p.pkg+`
+
+// logDebugOutput does nothing, the `+p.title+` has no KHR_debug
+func (ctx *Context) logDebugOutput() {}
+`)
+	}
+	log.Printf("%s: %d functions, %d enums", p.title, len(funcs), len(enums))
+}
+
+func main() {
+	flag.Parse()
+	for _, p := range profiles() {
+		generate(p)
+	}
+}
@@ -0,0 +1,17 @@
+//go:build amd64 || arm64
+
+package glmock
+
+import "github.com/ebitengine/purego"
+
+// glfloat is a float parameter of an entry point, passed in a floating point
+// register with the System V and AArch64 calling conventions
+type glfloat = float32
+
+func f32(x glfloat) float32 {
+	return x
+}
+
+func newCallback(fn any) uintptr {
+	return purego.NewCallback(fn)
+}
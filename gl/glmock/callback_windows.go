@@ -0,0 +1,19 @@
+package glmock
+
+import (
+	"math"
+	"syscall"
+)
+
+// glfloat is a float parameter of an entry point. Callbacks can not have
+// float parameters on Windows, but the bindings pass the bits of floats in
+// the integer registers as well.
+type glfloat = uintptr
+
+func f32(x glfloat) float32 {
+	return math.Float32frombits(uint32(x))
+}
+
+func newCallback(fn any) uintptr {
+	return syscall.NewCallbackCDecl(fn)
+}
@@ -0,0 +1,757 @@
+//go:build windows || (linux && (amd64 || arm64))
+
+// Package glmock is an in-memory implementation of OpenGL, to test rendering
+// code without a GPU or a display. Install it in the gl package with
+//
+//	m := glmock.New()
+//	gl.InitWithProcAddrFunc(m.GetProcAddress)
+//
+// or, in tests, with m := glmock.Install(t).
+//
+// It implements the lifetime of buffers, textures, shaders, programs, vertex
+// arrays, framebuffers and renderbuffers, and keeps the bindings, viewport,
+// scissor box, clear color and enabled capabilities. The errors are reported
+// by glGetError as by a core profile context. Every call is recorded, and
+// the functions that are not implemented do nothing, return zero and are
+// recorded as "unimplemented".
+//
+// The entry points are shared by all mocks, and call the mock whose
+// GetProcAddress was called last, so tests using mocks must not run in
+// parallel.
+package glmock
+
+import (
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"unsafe"
+
+	"github.com/jkvatne/purego-glfw/gl"
+)
+
+// Call is a recorded call
+type Call struct {
+	Name string // The function, without the gl prefix
+	Args []any  // The arguments, with the data of pointers as slices or strings
+}
+
+func (c Call) String() string {
+	args := make([]string, len(c.Args))
+	for i, a := range c.Args {
+		args[i] = fmt.Sprint(a)
+	}
+	return c.Name + "(" + strings.Join(args, ", ") + ")"
+}
+
+// Buffer is a buffer object
+type Buffer struct {
+	Data  []byte
+	Usage uint32
+}
+
+// Texture is a texture object. Only level 0 of 2D textures is kept.
+type Texture struct {
+	Target         uint32 // Set by the first BindTexture
+	InternalFormat int32
+	Width, Height  int32
+	Params         map[uint32]int32 // Set by TexParameteri
+}
+
+// Shader is a shader object
+type Shader struct {
+	Type          uint32
+	Source        string
+	Compiled      bool
+	InfoLog       string
+	DeletePending bool // Deleted while attached to a program
+}
+
+// Program is a program object
+type Program struct {
+	Shaders       []uint32
+	Linked        bool
+	InfoLog       string
+	DeletePending bool             // Deleted while current
+	Locations     map[string]int32 // Uniform locations, given on the first query
+	Attributes    map[string]int32 // Attribute locations, given on the first query or bound
+}
+
+// VertexAttrib is the state of a vertex attribute of a vertex array
+type VertexAttrib struct {
+	Enabled    bool
+	Buffer     uint32 // The buffer bound to GL_ARRAY_BUFFER by VertexAttribPointer
+	Size       int32
+	Type       uint32
+	Normalized bool
+	Stride     int32
+	Offset     uintptr
+}
+
+// VertexArray is a vertex array object
+type VertexArray struct {
+	ElementArrayBuffer uint32
+	Attribs            map[uint32]*VertexAttrib
+}
+
+// Attachment is a framebuffer attachment
+type Attachment struct {
+	Texture      uint32
+	Renderbuffer uint32
+}
+
+// Framebuffer is a framebuffer object
+type Framebuffer struct {
+	Attachments map[uint32]Attachment
+}
+
+// Renderbuffer is a renderbuffer object
+type Renderbuffer struct {
+	InternalFormat uint32
+	Width, Height  int32
+}
+
+// TextureUnit is the key of the texture bindings
+type TextureUnit struct {
+	Unit   uint32 // From 0, not GL_TEXTURE0
+	Target uint32
+}
+
+// State is the context state kept by a mock
+type State struct {
+	Buffers         map[uint32]uint32 // Buffer bound to each target, except GL_ELEMENT_ARRAY_BUFFER which is in the vertex array
+	Textures        map[TextureUnit]uint32
+	ActiveTexture   uint32 // From 0, not GL_TEXTURE0
+	Program         uint32
+	VertexArray     uint32
+	DrawFramebuffer uint32
+	ReadFramebuffer uint32
+	Renderbuffer    uint32
+	Viewport        [4]int32
+	Scissor         [4]int32
+	ClearColor      [4]float32
+	Enabled         map[uint32]bool
+}
+
+// objects is a name space of objects
+type objects[T any] struct {
+	next *uint32
+	m    map[uint32]*T
+}
+
+func newObjects[T any](next *uint32) objects[T] {
+	if next == nil {
+		next = new(uint32)
+	}
+	return objects[T]{next: next, m: map[uint32]*T{}}
+}
+
+func (o objects[T]) gen(v *T) uint32 {
+	*o.next++
+	o.m[*o.next] = v
+	return *o.next
+}
+
+func (o objects[T]) live() []uint32 {
+	return slices.Sorted(maps.Keys(o.m))
+}
+
+// Mock is an OpenGL implementation in memory
+type Mock struct {
+	// CompileError returns the info log of a shader that fails to compile,
+	// or an empty string. By default all shaders compile.
+	CompileError func(source string) string
+
+	mu            sync.Mutex
+	calls         []Call
+	err           uint32
+	checked       uint32 // The first error read by the checks of the gldebug tag
+	state         State
+	defaultVAO    VertexArray
+	shaderNames   uint32 // Shaders and programs share their names
+	buffers       objects[Buffer]
+	textures      objects[Texture]
+	shaders       objects[Shader]
+	programs      objects[Program]
+	vertexArrays  objects[VertexArray]
+	framebuffers  objects[Framebuffer]
+	renderbuffers objects[Renderbuffer]
+}
+
+// New returns a mock with the state of a new context
+func New() *Mock {
+	m := &Mock{}
+	m.state = State{
+		Buffers:  map[uint32]uint32{},
+		Textures: map[TextureUnit]uint32{},
+		Enabled:  map[uint32]bool{gl.DITHER: true, gl.MULTISAMPLE: true},
+	}
+	m.defaultVAO.Attribs = map[uint32]*VertexAttrib{}
+	m.buffers = newObjects[Buffer](nil)
+	m.textures = newObjects[Texture](nil)
+	m.shaders = newObjects[Shader](&m.shaderNames)
+	m.programs = newObjects[Program](&m.shaderNames)
+	m.vertexArrays = newObjects[VertexArray](nil)
+	m.framebuffers = newObjects[Framebuffer](nil)
+	m.renderbuffers = newObjects[Renderbuffer](nil)
+	return m
+}
+
+// Install returns a new mock installed in the gl package. The errors read
+// by the checks of the gldebug tag are kept for GetError instead of
+// panicking, and the calls are not logged.
+func Install(t testing.TB) *Mock {
+	t.Helper()
+	m := New()
+	if err := gl.InitWithProcAddrFunc(m.GetProcAddress); err != nil {
+		t.Fatal(err)
+	}
+	gl.SetLogger(slog.New(slog.DiscardHandler))
+	gl.SetErrorHandler(func(err *gl.Error) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if m.checked == gl.NO_ERROR {
+			m.checked = err.Code
+		}
+	})
+	return m
+}
+
+// GetError returns the error of glGetError, or else the first error read by
+// the checks of the gldebug tag, and clears both. It gives the same result
+// in builds with and without the tag.
+func (m *Mock) GetError() uint32 {
+	code := gl.GetError()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if code == gl.NO_ERROR {
+		code = m.checked
+	}
+	m.checked = gl.NO_ERROR
+	return code
+}
+
+var current atomic.Pointer[Mock]
+
+// GetProcAddress returns the entry points of the mock, to be passed to
+// gl.InitWithProcAddrFunc. It makes the mock the one called by the entry
+// points.
+func (m *Mock) GetProcAddress(name string) unsafe.Pointer {
+	current.Store(m)
+	p, ok := entryPoints()[strings.TrimPrefix(name, "gl")]
+	if !ok {
+		p = unimplemented()
+	}
+	return *(*unsafe.Pointer)(unsafe.Pointer(&p))
+}
+
+// enter locks the current mock and records a call. The caller unlocks it.
+func enter(name string, args ...any) *Mock {
+	m := current.Load()
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{name, args})
+	return m
+}
+
+// setError records an error, if none is recorded
+func (m *Mock) setError(code uint32) {
+	if m.err == gl.NO_ERROR {
+		m.err = code
+	}
+}
+
+// Calls returns the calls recorded since New or ResetCalls
+func (m *Mock) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.calls)
+}
+
+// Count returns the number of recorded calls of a function
+func (m *Mock) Count(name string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n := 0
+	for _, c := range m.calls {
+		if c.Name == name {
+			n++
+		}
+	}
+	return n
+}
+
+// ResetCalls discards the recorded calls
+func (m *Mock) ResetCalls() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = nil
+}
+
+// State returns the context state. The maps are shared with the mock.
+func (m *Mock) State() State {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.state
+}
+
+// lookup returns an object of a mock, or nil if it does not exist
+func lookup[T any](m *Mock, o objects[T], name uint32) *T {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return o.m[name]
+}
+
+// live returns the names of the objects of a mock that are not deleted
+func live[T any](m *Mock, o objects[T]) []uint32 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return o.live()
+}
+
+// Buffer returns a buffer object, or nil if it does not exist
+func (m *Mock) Buffer(name uint32) *Buffer { return lookup(m, m.buffers, name) }
+
+// Texture returns a texture object, or nil if it does not exist
+func (m *Mock) Texture(name uint32) *Texture { return lookup(m, m.textures, name) }
+
+// Shader returns a shader object, or nil if it does not exist
+func (m *Mock) Shader(name uint32) *Shader { return lookup(m, m.shaders, name) }
+
+// Program returns a program object, or nil if it does not exist
+func (m *Mock) Program(name uint32) *Program { return lookup(m, m.programs, name) }
+
+// VertexArray returns a vertex array object, or nil if it does not exist.
+// Vertex array 0 is the default vertex array.
+func (m *Mock) VertexArray(name uint32) *VertexArray {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.vertexArray(name)
+}
+
+func (m *Mock) vertexArray(name uint32) *VertexArray {
+	if name == 0 {
+		return &m.defaultVAO
+	}
+	return m.vertexArrays.m[name]
+}
+
+// Framebuffer returns a framebuffer object, or nil if it does not exist
+func (m *Mock) Framebuffer(name uint32) *Framebuffer { return lookup(m, m.framebuffers, name) }
+
+// Renderbuffer returns a renderbuffer object, or nil if it does not exist
+func (m *Mock) Renderbuffer(name uint32) *Renderbuffer { return lookup(m, m.renderbuffers, name) }
+
+// Buffers returns the names of the buffer objects that are not deleted, to
+// check for leaks
+func (m *Mock) Buffers() []uint32 { return live(m, m.buffers) }
+
+// Textures returns the names of the texture objects that are not deleted
+func (m *Mock) Textures() []uint32 { return live(m, m.textures) }
+
+// Shaders returns the names of the shader objects that are not deleted
+func (m *Mock) Shaders() []uint32 { return live(m, m.shaders) }
+
+// Programs returns the names of the program objects that are not deleted
+func (m *Mock) Programs() []uint32 { return live(m, m.programs) }
+
+// VertexArrays returns the names of the vertex array objects that are not deleted
+func (m *Mock) VertexArrays() []uint32 { return live(m, m.vertexArrays) }
+
+// Framebuffers returns the names of the framebuffer objects that are not deleted
+func (m *Mock) Framebuffers() []uint32 { return live(m, m.framebuffers) }
+
+// Renderbuffers returns the names of the renderbuffer objects that are not deleted
+func (m *Mock) Renderbuffers() []uint32 { return live(m, m.renderbuffers) }
+
+// boundBuffer returns the buffer bound to a target, or nil with an error
+func (m *Mock) boundBuffer(target uint32) *Buffer {
+	name := m.state.Buffers[target]
+	if target == gl.ELEMENT_ARRAY_BUFFER {
+		name = m.vertexArray(m.state.VertexArray).ElementArrayBuffer
+	}
+	b := m.buffers.m[name]
+	if b == nil {
+		m.setError(gl.INVALID_OPERATION)
+	}
+	return b
+}
+
+func (m *Mock) bindBuffer(target, name uint32) {
+	if name != 0 && m.buffers.m[name] == nil {
+		m.setError(gl.INVALID_OPERATION)
+		return
+	}
+	if target == gl.ELEMENT_ARRAY_BUFFER {
+		m.vertexArray(m.state.VertexArray).ElementArrayBuffer = name
+		return
+	}
+	m.state.Buffers[target] = name
+}
+
+func (m *Mock) deleteBuffer(name uint32) {
+	if m.buffers.m[name] == nil {
+		return
+	}
+	for target, b := range m.state.Buffers {
+		if b == name {
+			m.state.Buffers[target] = 0
+		}
+	}
+	if vao := m.vertexArray(m.state.VertexArray); vao.ElementArrayBuffer == name {
+		vao.ElementArrayBuffer = 0
+	}
+	delete(m.buffers.m, name)
+}
+
+func (m *Mock) bindTexture(target, name uint32) {
+	if name != 0 {
+		t := m.textures.m[name]
+		if t == nil || t.Target != 0 && t.Target != target {
+			m.setError(gl.INVALID_OPERATION)
+			return
+		}
+		t.Target = target
+	}
+	m.state.Textures[TextureUnit{m.state.ActiveTexture, target}] = name
+}
+
+// boundTexture returns the texture bound to a target of the active unit,
+// or nil with an error
+func (m *Mock) boundTexture(target uint32) *Texture {
+	t := m.textures.m[m.state.Textures[TextureUnit{m.state.ActiveTexture, target}]]
+	if t == nil {
+		m.setError(gl.INVALID_OPERATION)
+	}
+	return t
+}
+
+func (m *Mock) deleteTexture(name uint32) {
+	if m.textures.m[name] == nil {
+		return
+	}
+	for unit, t := range m.state.Textures {
+		if t == name {
+			m.state.Textures[unit] = 0
+		}
+	}
+	delete(m.textures.m, name)
+}
+
+// freeShader deletes a shader flagged for deletion once it is detached from
+// all programs
+func (m *Mock) freeShader(name uint32) {
+	s := m.shaders.m[name]
+	if s == nil || !s.DeletePending {
+		return
+	}
+	for _, p := range m.programs.m {
+		if slices.Contains(p.Shaders, name) {
+			return
+		}
+	}
+	delete(m.shaders.m, name)
+}
+
+func (m *Mock) deleteShader(name uint32) {
+	if s := m.shaders.m[name]; s != nil {
+		s.DeletePending = true
+		m.freeShader(name)
+	} else if name != 0 {
+		m.setError(gl.INVALID_VALUE)
+	}
+}
+
+// freeProgram deletes a program flagged for deletion once it is not current
+func (m *Mock) freeProgram(name uint32) {
+	p := m.programs.m[name]
+	if p == nil || !p.DeletePending || m.state.Program == name {
+		return
+	}
+	delete(m.programs.m, name)
+	for _, s := range p.Shaders {
+		m.freeShader(s)
+	}
+}
+
+func (m *Mock) deleteProgram(name uint32) {
+	if p := m.programs.m[name]; p != nil {
+		p.DeletePending = true
+		m.freeProgram(name)
+	} else if name != 0 {
+		m.setError(gl.INVALID_VALUE)
+	}
+}
+
+func (m *Mock) attachShader(program, shader uint32) {
+	p, s := m.programs.m[program], m.shaders.m[shader]
+	switch {
+	case p == nil || s == nil:
+		m.setError(gl.INVALID_VALUE)
+	case slices.Contains(p.Shaders, shader):
+		m.setError(gl.INVALID_OPERATION)
+	default:
+		p.Shaders = append(p.Shaders, shader)
+	}
+}
+
+func (m *Mock) detachShader(program, shader uint32) {
+	p := m.programs.m[program]
+	if p == nil || m.shaders.m[shader] == nil {
+		m.setError(gl.INVALID_VALUE)
+		return
+	}
+	i := slices.Index(p.Shaders, shader)
+	if i < 0 {
+		m.setError(gl.INVALID_OPERATION)
+		return
+	}
+	p.Shaders = slices.Delete(p.Shaders, i, i+1)
+	m.freeShader(shader)
+}
+
+func (m *Mock) compileShader(name uint32) {
+	s := m.shaders.m[name]
+	if s == nil {
+		m.setError(gl.INVALID_VALUE)
+		return
+	}
+	s.Compiled, s.InfoLog = true, ""
+	if m.CompileError != nil {
+		if log := m.CompileError(s.Source); log != "" {
+			s.Compiled, s.InfoLog = false, log
+		}
+	}
+}
+
+// linkProgram links a program with compiled vertex and fragment shaders
+func (m *Mock) linkProgram(name uint32) {
+	p := m.programs.m[name]
+	if p == nil {
+		m.setError(gl.INVALID_VALUE)
+		return
+	}
+	p.Linked, p.InfoLog = false, ""
+	var vertex, fragment bool
+	for _, s := range p.Shaders {
+		sh := m.shaders.m[s]
+		if !sh.Compiled {
+			p.InfoLog = fmt.Sprintf("shader %d is not compiled", s)
+			return
+		}
+		vertex = vertex || sh.Type == gl.VERTEX_SHADER
+		fragment = fragment || sh.Type == gl.FRAGMENT_SHADER
+	}
+	if !vertex || !fragment {
+		p.InfoLog = "a vertex and a fragment shader must be attached"
+		return
+	}
+	p.Linked = true
+	p.Locations = map[string]int32{}
+	if p.Attributes == nil {
+		p.Attributes = map[string]int32{}
+	}
+}
+
+func (m *Mock) useProgram(name uint32) {
+	if name != 0 {
+		if p := m.programs.m[name]; p == nil || !p.Linked {
+			m.setError(gl.INVALID_OPERATION)
+			return
+		}
+	}
+	old := m.state.Program
+	m.state.Program = name
+	m.freeProgram(old)
+}
+
+// location returns the location of a name in a linked program, given in the
+// order of the queries
+func (m *Mock) location(program uint32, name string, locations func(p *Program) map[string]int32) int32 {
+	p := m.programs.m[program]
+	if p == nil || !p.Linked {
+		m.setError(gl.INVALID_OPERATION)
+		return -1
+	}
+	l := locations(p)
+	loc, ok := l[name]
+	if !ok {
+		loc = int32(len(l))
+		l[name] = loc
+	}
+	return loc
+}
+
+// uniform checks that a program is current for a Uniform call
+func (m *Mock) uniform() {
+	if m.state.Program == 0 {
+		m.setError(gl.INVALID_OPERATION)
+	}
+}
+
+func (m *Mock) bindVertexArray(name uint32) {
+	if name != 0 && m.vertexArrays.m[name] == nil {
+		m.setError(gl.INVALID_OPERATION)
+		return
+	}
+	m.state.VertexArray = name
+}
+
+func (m *Mock) deleteVertexArray(name uint32) {
+	if m.vertexArrays.m[name] == nil {
+		return
+	}
+	if m.state.VertexArray == name {
+		m.state.VertexArray = 0
+	}
+	delete(m.vertexArrays.m, name)
+}
+
+func (m *Mock) attrib(index uint32) *VertexAttrib {
+	vao := m.vertexArray(m.state.VertexArray)
+	a := vao.Attribs[index]
+	if a == nil {
+		a = &VertexAttrib{Size: 4, Type: gl.FLOAT}
+		vao.Attribs[index] = a
+	}
+	return a
+}
+
+func (m *Mock) bindFramebuffer(target, name uint32) {
+	if name != 0 && m.framebuffers.m[name] == nil {
+		m.setError(gl.INVALID_OPERATION)
+		return
+	}
+	switch target {
+	case gl.FRAMEBUFFER:
+		m.state.DrawFramebuffer, m.state.ReadFramebuffer = name, name
+	case gl.DRAW_FRAMEBUFFER:
+		m.state.DrawFramebuffer = name
+	case gl.READ_FRAMEBUFFER:
+		m.state.ReadFramebuffer = name
+	default:
+		m.setError(gl.INVALID_ENUM)
+	}
+}
+
+// boundFramebuffer returns the framebuffer bound to a target, or nil with an
+// error for the default framebuffer
+func (m *Mock) boundFramebuffer(target uint32) *Framebuffer {
+	name := m.state.DrawFramebuffer
+	if target == gl.READ_FRAMEBUFFER {
+		name = m.state.ReadFramebuffer
+	}
+	f := m.framebuffers.m[name]
+	if f == nil {
+		m.setError(gl.INVALID_OPERATION)
+	}
+	return f
+}
+
+func (m *Mock) deleteFramebuffer(name uint32) {
+	if m.framebuffers.m[name] == nil {
+		return
+	}
+	if m.state.DrawFramebuffer == name {
+		m.state.DrawFramebuffer = 0
+	}
+	if m.state.ReadFramebuffer == name {
+		m.state.ReadFramebuffer = 0
+	}
+	delete(m.framebuffers.m, name)
+}
+
+func (m *Mock) checkFramebufferStatus(target uint32) uint32 {
+	name := m.state.DrawFramebuffer
+	if target == gl.READ_FRAMEBUFFER {
+		name = m.state.ReadFramebuffer
+	}
+	if f := m.framebuffers.m[name]; f != nil && len(f.Attachments) == 0 {
+		return gl.FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT
+	}
+	return gl.FRAMEBUFFER_COMPLETE
+}
+
+func (m *Mock) bindRenderbuffer(name uint32) {
+	if name != 0 && m.renderbuffers.m[name] == nil {
+		m.setError(gl.INVALID_OPERATION)
+		return
+	}
+	m.state.Renderbuffer = name
+}
+
+func (m *Mock) deleteRenderbuffer(name uint32) {
+	if m.renderbuffers.m[name] == nil {
+		return
+	}
+	if m.state.Renderbuffer == name {
+		m.state.Renderbuffer = 0
+	}
+	delete(m.renderbuffers.m, name)
+}
+
+// draw checks that a linked program is current for a draw call
+func (m *Mock) draw() {
+	if p := m.programs.m[m.state.Program]; p == nil || !p.Linked {
+		m.setError(gl.INVALID_OPERATION)
+	}
+}
+
+// getIntegerv returns the values of a state variable
+func (m *Mock) getIntegerv(pname uint32) []int32 {
+	b := func(v bool) []int32 {
+		if v {
+			return []int32{1}
+		}
+		return []int32{0}
+	}
+	switch pname {
+	case gl.MAJOR_VERSION:
+		return []int32{3}
+	case gl.MINOR_VERSION:
+		return []int32{3}
+	case gl.NUM_EXTENSIONS, gl.CONTEXT_FLAGS:
+		return []int32{0}
+	case gl.CONTEXT_PROFILE_MASK:
+		return []int32{gl.CONTEXT_CORE_PROFILE_BIT}
+	case gl.MAX_TEXTURE_SIZE, gl.MAX_RENDERBUFFER_SIZE:
+		return []int32{16384}
+	case gl.MAX_VERTEX_ATTRIBS, gl.MAX_TEXTURE_IMAGE_UNITS:
+		return []int32{16}
+	case gl.MAX_COMBINED_TEXTURE_IMAGE_UNITS:
+		return []int32{80}
+	case gl.MAX_COLOR_ATTACHMENTS, gl.MAX_DRAW_BUFFERS:
+		return []int32{8}
+	case gl.VIEWPORT:
+		return m.state.Viewport[:]
+	case gl.SCISSOR_BOX:
+		return m.state.Scissor[:]
+	case gl.ARRAY_BUFFER_BINDING:
+		return []int32{int32(m.state.Buffers[gl.ARRAY_BUFFER])}
+	case gl.ELEMENT_ARRAY_BUFFER_BINDING:
+		return []int32{int32(m.vertexArray(m.state.VertexArray).ElementArrayBuffer)}
+	case gl.UNIFORM_BUFFER_BINDING:
+		return []int32{int32(m.state.Buffers[gl.UNIFORM_BUFFER])}
+	case gl.CURRENT_PROGRAM:
+		return []int32{int32(m.state.Program)}
+	case gl.VERTEX_ARRAY_BINDING:
+		return []int32{int32(m.state.VertexArray)}
+	case gl.DRAW_FRAMEBUFFER_BINDING:
+		return []int32{int32(m.state.DrawFramebuffer)}
+	case gl.READ_FRAMEBUFFER_BINDING:
+		return []int32{int32(m.state.ReadFramebuffer)}
+	case gl.RENDERBUFFER_BINDING:
+		return []int32{int32(m.state.Renderbuffer)}
+	case gl.ACTIVE_TEXTURE:
+		return []int32{int32(gl.TEXTURE0 + m.state.ActiveTexture)}
+	case gl.TEXTURE_BINDING_2D:
+		return []int32{int32(m.state.Textures[TextureUnit{m.state.ActiveTexture, gl.TEXTURE_2D}])}
+	case gl.BLEND, gl.DEPTH_TEST, gl.CULL_FACE, gl.SCISSOR_TEST, gl.STENCIL_TEST, gl.DITHER, gl.MULTISAMPLE:
+		return b(m.state.Enabled[pname])
+	}
+	m.setError(gl.INVALID_ENUM)
+	return nil
+}
@@ -0,0 +1,311 @@
+//go:build windows || (linux && (amd64 || arm64))
+
+package glmock_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/jkvatne/purego-glfw/gl"
+	"github.com/jkvatne/purego-glfw/gl/glmock"
+)
+
+// expectError checks the error returned by glGetError, or read by the checks
+func expectError(t *testing.T, m *glmock.Mock, want uint32) {
+	t.Helper()
+	if got := m.GetError(); got != want {
+		t.Errorf("glGetError = 0x%04X, want 0x%04X", got, want)
+	}
+}
+
+func TestBuffers(t *testing.T) {
+	m := glmock.Install(t)
+	var buffers [3]uint32
+	gl.GenBuffers(3, &buffers[0])
+	if buffers != [3]uint32{1, 2, 3} || !slices.Equal(m.Buffers(), buffers[:]) {
+		t.Fatalf("GenBuffers gave %v, live %v", buffers, m.Buffers())
+	}
+	gl.BindBuffer(gl.ARRAY_BUFFER, buffers[0])
+	gl.BufferData(gl.ARRAY_BUFFER, 4, gl.Ptr([]byte{1, 2, 3, 4}), gl.STATIC_DRAW)
+	gl.BufferSubData(gl.ARRAY_BUFFER, 2, 2, gl.Ptr([]byte{5, 6}))
+	expectError(t, m, gl.NO_ERROR)
+	if b := m.Buffer(buffers[0]); !slices.Equal(b.Data, []byte{1, 2, 5, 6}) || b.Usage != gl.STATIC_DRAW {
+		t.Errorf("buffer %+v, want [1 2 5 6] with GL_STATIC_DRAW", b)
+	}
+	gl.BufferSubData(gl.ARRAY_BUFFER, 3, 2, gl.Ptr([]byte{7, 8}))
+	expectError(t, m, gl.INVALID_VALUE)
+
+	// The element array buffer belongs to the vertex array
+	gl.BindBuffer(gl.ELEMENT_ARRAY_BUFFER, buffers[1])
+	if got := m.VertexArray(0).ElementArrayBuffer; got != buffers[1] {
+		t.Errorf("element array buffer %d, want %d", got, buffers[1])
+	}
+
+	// Deleted buffers are unbound, and cannot be bound again
+	gl.DeleteBuffers(2, &buffers[0])
+	if m.Buffer(buffers[0]) != nil || !slices.Equal(m.Buffers(), buffers[2:]) {
+		t.Errorf("live buffers %v after delete, want %v", m.Buffers(), buffers[2:])
+	}
+	if s := m.State(); s.Buffers[gl.ARRAY_BUFFER] != 0 || m.VertexArray(0).ElementArrayBuffer != 0 {
+		t.Errorf("deleted buffers are still bound: %v, element array %d", s.Buffers, m.VertexArray(0).ElementArrayBuffer)
+	}
+	gl.BindBuffer(gl.ARRAY_BUFFER, buffers[0])
+	expectError(t, m, gl.INVALID_OPERATION)
+	gl.BufferData(gl.ARRAY_BUFFER, 4, nil, gl.STATIC_DRAW)
+	expectError(t, m, gl.INVALID_OPERATION)
+}
+
+func TestTextures(t *testing.T) {
+	m := glmock.Install(t)
+	var textures [2]uint32
+	gl.GenTextures(2, &textures[0])
+	gl.ActiveTexture(gl.TEXTURE3)
+	gl.BindTexture(gl.TEXTURE_2D, textures[0])
+	gl.TexImage2D(gl.TEXTURE_2D, 0, gl.RGBA8, 64, 32, 0, gl.RGBA, gl.UNSIGNED_BYTE, nil)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, gl.LINEAR)
+	expectError(t, m, gl.NO_ERROR)
+	tex := m.Texture(textures[0])
+	if tex.Target != gl.TEXTURE_2D || tex.InternalFormat != gl.RGBA8 || tex.Width != 64 || tex.Height != 32 || tex.Params[gl.TEXTURE_MIN_FILTER] != gl.LINEAR {
+		t.Errorf("texture %+v", tex)
+	}
+	if s := m.State(); s.ActiveTexture != 3 || s.Textures[glmock.TextureUnit{Unit: 3, Target: gl.TEXTURE_2D}] != textures[0] {
+		t.Errorf("active texture %d, bindings %v", s.ActiveTexture, s.Textures)
+	}
+
+	// A texture keeps the target it was first bound to
+	gl.BindTexture(gl.TEXTURE_3D, textures[0])
+	expectError(t, m, gl.INVALID_OPERATION)
+	gl.ActiveTexture(gl.TEXTURE0 + 80)
+	expectError(t, m, gl.INVALID_ENUM)
+
+	gl.DeleteTextures(1, &textures[0])
+	if m.Texture(textures[0]) != nil || m.State().Textures[glmock.TextureUnit{Unit: 3, Target: gl.TEXTURE_2D}] != 0 {
+		t.Error("deleted texture is still bound")
+	}
+	if !slices.Equal(m.Textures(), textures[1:]) {
+		t.Errorf("live textures %v, want %v", m.Textures(), textures[1:])
+	}
+}
+
+func TestShadersAndPrograms(t *testing.T) {
+	m := glmock.Install(t)
+	m.CompileError = func(source string) string {
+		if strings.Contains(source, "error") {
+			return "0:1: syntax error"
+		}
+		return ""
+	}
+	vertex := gl.CreateShader(gl.VERTEX_SHADER)
+	gl.ShaderSourceString(vertex, "void main() {}")
+	gl.CompileShader(vertex)
+	fragment := gl.CreateShader(gl.FRAGMENT_SHADER)
+	gl.ShaderSourceString(fragment, "error")
+	gl.CompileShader(fragment)
+	if s := m.Shader(fragment); s.Compiled || s.InfoLog != "0:1: syntax error" {
+		t.Errorf("shader %+v, want a compile error", s)
+	}
+	// Shaders and programs share their names
+	program := gl.CreateProgram()
+	if vertex != 1 || fragment != 2 || program != 3 {
+		t.Errorf("names %d, %d, %d, want 1, 2, 3", vertex, fragment, program)
+	}
+	gl.AttachShader(program, vertex)
+	gl.LinkProgram(program)
+	if p := m.Program(program); p.Linked || p.InfoLog == "" {
+		t.Errorf("program %+v linked without a fragment shader", p)
+	}
+	gl.AttachShader(program, fragment)
+	gl.AttachShader(program, fragment)
+	expectError(t, m, gl.INVALID_OPERATION)
+	gl.LinkProgram(program)
+	if p := m.Program(program); p.Linked || !strings.Contains(p.InfoLog, "not compiled") {
+		t.Errorf("program %+v linked with a shader that is not compiled", p)
+	}
+	gl.ShaderSourceString(fragment, "void main() {}")
+	gl.CompileShader(fragment)
+	gl.LinkProgram(program)
+	if p := m.Program(program); !p.Linked || !slices.Equal(p.Shaders, []uint32{vertex, fragment}) {
+		t.Fatalf("program %+v, want linked", p)
+	}
+	gl.UseProgram(program)
+	expectError(t, m, gl.NO_ERROR)
+
+	// The locations are given in the order of the queries
+	if a, b := gl.GetUniformLocation(program, gl.Str("a\x00")), gl.GetUniformLocation(program, gl.Str("b\x00")); a != 0 || b != 1 {
+		t.Errorf("uniform locations %d, %d, want 0, 1", a, b)
+	}
+	gl.BindAttribLocation(program, 5, gl.Str("position\x00"))
+	if got := gl.GetAttribLocation(program, gl.Str("position\x00")); got != 5 {
+		t.Errorf("bound attribute location %d, want 5", got)
+	}
+
+	// The shaders are deleted once detached, and the program once not current
+	gl.DeleteShader(vertex)
+	gl.DeleteShader(fragment)
+	gl.DeleteProgram(program)
+	if len(m.Shaders()) != 2 || len(m.Programs()) != 1 || !m.Program(program).DeletePending {
+		t.Errorf("shaders %v and programs %v deleted while in use", m.Shaders(), m.Programs())
+	}
+	gl.UseProgram(0)
+	if len(m.Shaders()) != 0 || len(m.Programs()) != 0 {
+		t.Errorf("shaders %v and programs %v not deleted", m.Shaders(), m.Programs())
+	}
+	gl.DeleteShader(vertex)
+	expectError(t, m, gl.INVALID_VALUE)
+}
+
+func TestVertexArrays(t *testing.T) {
+	m := glmock.Install(t)
+	var vao, buffers [2]uint32
+	gl.GenVertexArrays(2, &vao[0])
+	gl.GenBuffers(2, &buffers[0])
+	gl.BindVertexArray(vao[0])
+	gl.BindBuffer(gl.ARRAY_BUFFER, buffers[0])
+	gl.BindBuffer(gl.ELEMENT_ARRAY_BUFFER, buffers[1])
+	gl.VertexAttribPointer(1, 3, gl.FLOAT, false, 24, gl.PtrOffset(12))
+	gl.EnableVertexAttribArray(1)
+	expectError(t, m, gl.NO_ERROR)
+	want := glmock.VertexAttrib{Enabled: true, Buffer: buffers[0], Size: 3, Type: gl.FLOAT, Stride: 24, Offset: 12}
+	if a := m.VertexArray(vao[0]).Attribs[1]; a == nil || *a != want {
+		t.Errorf("vertex attribute %+v, want %+v", a, want)
+	}
+	if got := m.VertexArray(vao[0]).ElementArrayBuffer; got != buffers[1] {
+		t.Errorf("element array buffer %d, want %d", got, buffers[1])
+	}
+
+	// Client side arrays are refused with a vertex array object
+	gl.BindVertexArray(vao[1])
+	gl.BindBuffer(gl.ARRAY_BUFFER, 0)
+	gl.VertexAttribPointer(0, 3, gl.FLOAT, false, 0, gl.Ptr([]float32{0, 0, 0}))
+	expectError(t, m, gl.INVALID_OPERATION)
+	if m.VertexArray(vao[1]).ElementArrayBuffer != 0 || len(m.VertexArray(vao[1]).Attribs) != 0 {
+		t.Error("the state of a vertex array is shared")
+	}
+
+	gl.DeleteVertexArrays(1, &vao[1])
+	if m.State().VertexArray != 0 || m.VertexArray(vao[1]) != nil || !slices.Equal(m.VertexArrays(), vao[:1]) {
+		t.Errorf("live vertex arrays %v, bound %d", m.VertexArrays(), m.State().VertexArray)
+	}
+}
+
+func TestFramebuffers(t *testing.T) {
+	m := glmock.Install(t)
+	var fbo, rbo, tex uint32
+	gl.GenFramebuffers(1, &fbo)
+	gl.GenRenderbuffers(1, &rbo)
+	gl.GenTextures(1, &tex)
+	gl.BindFramebuffer(gl.FRAMEBUFFER, fbo)
+	if s := m.State(); s.DrawFramebuffer != fbo || s.ReadFramebuffer != fbo {
+		t.Errorf("bound framebuffers %d, %d, want %d", s.DrawFramebuffer, s.ReadFramebuffer, fbo)
+	}
+	if got := gl.CheckFramebufferStatus(gl.FRAMEBUFFER); got != gl.FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT {
+		t.Errorf("status of an empty framebuffer 0x%X", got)
+	}
+	gl.BindRenderbuffer(gl.RENDERBUFFER, rbo)
+	gl.RenderbufferStorage(gl.RENDERBUFFER, gl.DEPTH24_STENCIL8, 640, 480)
+	gl.FramebufferRenderbuffer(gl.FRAMEBUFFER, gl.DEPTH_STENCIL_ATTACHMENT, gl.RENDERBUFFER, rbo)
+	gl.BindTexture(gl.TEXTURE_2D, tex)
+	gl.FramebufferTexture2D(gl.FRAMEBUFFER, gl.COLOR_ATTACHMENT0, gl.TEXTURE_2D, tex, 0)
+	expectError(t, m, gl.NO_ERROR)
+	if got := gl.CheckFramebufferStatus(gl.FRAMEBUFFER); got != gl.FRAMEBUFFER_COMPLETE {
+		t.Errorf("status 0x%X, want complete", got)
+	}
+	want := map[uint32]glmock.Attachment{gl.COLOR_ATTACHMENT0: {Texture: tex}, gl.DEPTH_STENCIL_ATTACHMENT: {Renderbuffer: rbo}}
+	if f := m.Framebuffer(fbo); len(f.Attachments) != 2 || f.Attachments[gl.COLOR_ATTACHMENT0] != want[gl.COLOR_ATTACHMENT0] ||
+		f.Attachments[gl.DEPTH_STENCIL_ATTACHMENT] != want[gl.DEPTH_STENCIL_ATTACHMENT] {
+		t.Errorf("attachments %v, want %v", f.Attachments, want)
+	}
+	if r := m.Renderbuffer(rbo); r.InternalFormat != gl.DEPTH24_STENCIL8 || r.Width != 640 || r.Height != 480 {
+		t.Errorf("renderbuffer %+v", r)
+	}
+
+	gl.DeleteFramebuffers(1, &fbo)
+	gl.DeleteRenderbuffers(1, &rbo)
+	if s := m.State(); s.DrawFramebuffer != 0 || s.ReadFramebuffer != 0 || s.Renderbuffer != 0 {
+		t.Errorf("deleted objects are still bound: %+v", s)
+	}
+	if len(m.Framebuffers()) != 0 || len(m.Renderbuffers()) != 0 {
+		t.Errorf("live framebuffers %v, renderbuffers %v", m.Framebuffers(), m.Renderbuffers())
+	}
+	// Attaching to the default framebuffer is an error
+	gl.FramebufferTexture2D(gl.FRAMEBUFFER, gl.COLOR_ATTACHMENT0, gl.TEXTURE_2D, tex, 0)
+	expectError(t, m, gl.INVALID_OPERATION)
+}
+
+func TestCalls(t *testing.T) {
+	m := glmock.Install(t)
+	m.ResetCalls()
+	gl.ClearColor(0, 0.5, 1, 1)
+	gl.Clear(gl.COLOR_BUFFER_BIT)
+	var buffer uint32
+	gl.GenBuffers(1, &buffer)
+	gl.DeleteBuffers(1, &buffer)
+	gl.DrawArrays(gl.TRIANGLES, 0, 3)
+	gl.PointSize(2)
+	want := []string{
+		"ClearColor(0, 0.5, 1, 1)",
+		"Clear(16384)",
+		"GenBuffers(1)",
+		"DeleteBuffers(1, [1])",
+		"DrawArrays(4, 0, 3)",
+		"unimplemented()",
+	}
+	var got []string
+	for _, c := range m.Calls() {
+		// The checks of the gldebug tag call glGetError after each call
+		if c.Name != "GetError" {
+			got = append(got, c.String())
+		}
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("calls %q, want %q", got, want)
+	}
+	if n := m.Count("DrawArrays"); n != 1 {
+		t.Errorf("Count(DrawArrays) = %d, want 1", n)
+	}
+	// Drawing without a program is an error
+	expectError(t, m, gl.INVALID_OPERATION)
+	expectError(t, m, gl.NO_ERROR)
+
+	m.ResetCalls()
+	if calls := m.Calls(); len(calls) != 0 || m.Count("Clear") != 0 {
+		t.Errorf("calls %v after ResetCalls", calls)
+	}
+}
+
+func TestState(t *testing.T) {
+	m := glmock.Install(t)
+	gl.Viewport(0, 0, 800, 600)
+	gl.Scissor(10, 20, 30, 40)
+	gl.ClearColor(0.25, 0.5, 0.75, 1)
+	gl.Enable(gl.DEPTH_TEST)
+	gl.Disable(gl.DITHER)
+	s := m.State()
+	if s.Viewport != [4]int32{0, 0, 800, 600} || s.Scissor != [4]int32{10, 20, 30, 40} || s.ClearColor != [4]float32{0.25, 0.5, 0.75, 1} {
+		t.Errorf("state %+v", s)
+	}
+	if !s.Enabled[gl.DEPTH_TEST] || s.Enabled[gl.DITHER] || !s.Enabled[gl.MULTISAMPLE] {
+		t.Errorf("enabled %v", s.Enabled)
+	}
+	if !gl.IsEnabled(gl.DEPTH_TEST) || gl.IsEnabled(gl.BLEND) {
+		t.Error("IsEnabled does not match Enable")
+	}
+
+	var viewport [4]int32
+	gl.GetIntegerv(gl.VIEWPORT, &viewport[0])
+	var color [4]float32
+	gl.GetFloatv(gl.COLOR_CLEAR_VALUE, &color[0])
+	if viewport != s.Viewport || color != s.ClearColor {
+		t.Errorf("queried viewport %v, clear color %v", viewport, color)
+	}
+	var major, profile int32
+	gl.GetIntegerv(gl.MAJOR_VERSION, &major)
+	gl.GetIntegerv(gl.CONTEXT_PROFILE_MASK, &profile)
+	if version := gl.GoStr(gl.GetString(gl.VERSION)); major != 3 || profile != gl.CONTEXT_CORE_PROFILE_BIT || version != "3.3.0 glmock" {
+		t.Errorf("version %d %q, profile %d", major, version, profile)
+	}
+	expectError(t, m, gl.NO_ERROR)
+	gl.GetIntegerv(gl.TEXTURE_BINDING_3D, &major)
+	expectError(t, m, gl.INVALID_ENUM)
+}
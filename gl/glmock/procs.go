@@ -0,0 +1,716 @@
+//go:build windows || (linux && (amd64 || arm64))
+
+package glmock
+
+import (
+	"slices"
+	"sync"
+	"unsafe"
+
+	"github.com/jkvatne/purego-glfw/gl"
+)
+
+var (
+	procsOnce         sync.Once
+	procs             map[string]uintptr
+	unimplementedProc uintptr
+	// The strings returned by GetString
+	glStrings = map[uint32][]byte{
+		gl.VENDOR:                   []byte("glmock\x00"),
+		gl.RENDERER:                 []byte("glmock\x00"),
+		gl.VERSION:                  []byte("3.3.0 glmock\x00"),
+		gl.SHADING_LANGUAGE_VERSION: []byte("3.30\x00"),
+	}
+)
+
+// entryPoints returns the callbacks of the implemented functions. Callbacks
+// are never released, so they are made once and shared by all mocks.
+func entryPoints() map[string]uintptr {
+	procsOnce.Do(func() {
+		procs = map[string]uintptr{}
+		for name, fn := range functions {
+			procs[name] = newCallback(fn)
+		}
+		unimplementedProc = newCallback(func() uintptr {
+			enter("unimplemented").mu.Unlock()
+			return 0
+		})
+	})
+	return procs
+}
+
+// unimplemented returns the callback of the functions that are not
+// implemented. It is called without arguments, and returns zero.
+func unimplemented() uintptr {
+	entryPoints()
+	return unimplementedProc
+}
+
+func boolArg(b uintptr) bool {
+	return uint8(b) != 0
+}
+
+func boolResult(b bool) uintptr {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// genNames fills names with the names of n new objects
+func genNames[T any](m *Mock, o objects[T], n int32, names *uint32, newObject func() *T) {
+	if n < 0 {
+		m.setError(gl.INVALID_VALUE)
+		return
+	}
+	out := unsafe.Slice(names, n)
+	for i := range out {
+		out[i] = o.gen(newObject())
+	}
+}
+
+// nameArgs returns the names passed to a Delete function
+func nameArgs(n uintptr, names *uint32) []uint32 {
+	if int32(n) <= 0 || names == nil {
+		return nil
+	}
+	return slices.Clone(unsafe.Slice(names, int32(n)))
+}
+
+// functions holds the implementation of each function, called with the
+// arguments as passed in the registers. The arguments are recorded with the
+// types of the gl package.
+var functions = map[string]any{
+	"GetError": func() uintptr {
+		m := enter("GetError")
+		defer m.mu.Unlock()
+		code := m.err
+		m.err = gl.NO_ERROR
+		return uintptr(code)
+	},
+	"GetString": func(name uintptr) uintptr {
+		m := enter("GetString", uint32(name))
+		defer m.mu.Unlock()
+		s, ok := glStrings[uint32(name)]
+		if !ok {
+			m.setError(gl.INVALID_ENUM)
+			return 0
+		}
+		return uintptr(unsafe.Pointer(&s[0]))
+	},
+	"GetStringi": func(name, index uintptr) uintptr {
+		m := enter("GetStringi", uint32(name), uint32(index))
+		defer m.mu.Unlock()
+		// There are no extensions
+		m.setError(gl.INVALID_VALUE)
+		return 0
+	},
+	"GetIntegerv": func(pname uintptr, data *int32) uintptr {
+		m := enter("GetIntegerv", uint32(pname))
+		defer m.mu.Unlock()
+		v := m.getIntegerv(uint32(pname))
+		copy(unsafe.Slice(data, len(v)), v)
+		return 0
+	},
+	"GetFloatv": func(pname uintptr, data *float32) uintptr {
+		m := enter("GetFloatv", uint32(pname))
+		defer m.mu.Unlock()
+		if uint32(pname) == gl.COLOR_CLEAR_VALUE {
+			copy(unsafe.Slice(data, 4), m.state.ClearColor[:])
+			return 0
+		}
+		v := m.getIntegerv(uint32(pname))
+		out := unsafe.Slice(data, len(v))
+		for i, x := range v {
+			out[i] = float32(x)
+		}
+		return 0
+	},
+	"Enable": func(cap uintptr) uintptr {
+		m := enter("Enable", uint32(cap))
+		defer m.mu.Unlock()
+		m.state.Enabled[uint32(cap)] = true
+		return 0
+	},
+	"Disable": func(cap uintptr) uintptr {
+		m := enter("Disable", uint32(cap))
+		defer m.mu.Unlock()
+		m.state.Enabled[uint32(cap)] = false
+		return 0
+	},
+	"IsEnabled": func(cap uintptr) uintptr {
+		m := enter("IsEnabled", uint32(cap))
+		defer m.mu.Unlock()
+		return boolResult(m.state.Enabled[uint32(cap)])
+	},
+	"Viewport": func(x, y, width, height uintptr) uintptr {
+		m := enter("Viewport", int32(x), int32(y), int32(width), int32(height))
+		defer m.mu.Unlock()
+		m.state.Viewport = [4]int32{int32(x), int32(y), int32(width), int32(height)}
+		return 0
+	},
+	"Scissor": func(x, y, width, height uintptr) uintptr {
+		m := enter("Scissor", int32(x), int32(y), int32(width), int32(height))
+		defer m.mu.Unlock()
+		m.state.Scissor = [4]int32{int32(x), int32(y), int32(width), int32(height)}
+		return 0
+	},
+	"ClearColor": func(red, green, blue, alpha glfloat) uintptr {
+		m := enter("ClearColor", f32(red), f32(green), f32(blue), f32(alpha))
+		defer m.mu.Unlock()
+		m.state.ClearColor = [4]float32{f32(red), f32(green), f32(blue), f32(alpha)}
+		return 0
+	},
+	"Clear": func(mask uintptr) uintptr {
+		enter("Clear", uint32(mask)).mu.Unlock()
+		return 0
+	},
+	"Flush": func() uintptr {
+		enter("Flush").mu.Unlock()
+		return 0
+	},
+	"Finish": func() uintptr {
+		enter("Finish").mu.Unlock()
+		return 0
+	},
+
+	// Buffers
+	"GenBuffers": func(n uintptr, buffers *uint32) uintptr {
+		m := enter("GenBuffers", int32(n))
+		defer m.mu.Unlock()
+		genNames(m, m.buffers, int32(n), buffers, func() *Buffer { return &Buffer{} })
+		return 0
+	},
+	"DeleteBuffers": func(n uintptr, buffers *uint32) uintptr {
+		names := nameArgs(n, buffers)
+		m := enter("DeleteBuffers", int32(n), names)
+		defer m.mu.Unlock()
+		for _, b := range names {
+			m.deleteBuffer(b)
+		}
+		return 0
+	},
+	"IsBuffer": func(buffer uintptr) uintptr {
+		m := enter("IsBuffer", uint32(buffer))
+		defer m.mu.Unlock()
+		return boolResult(m.buffers.m[uint32(buffer)] != nil)
+	},
+	"BindBuffer": func(target, buffer uintptr) uintptr {
+		m := enter("BindBuffer", uint32(target), uint32(buffer))
+		defer m.mu.Unlock()
+		m.bindBuffer(uint32(target), uint32(buffer))
+		return 0
+	},
+	"BindBufferBase": func(target, index, buffer uintptr) uintptr {
+		m := enter("BindBufferBase", uint32(target), uint32(index), uint32(buffer))
+		defer m.mu.Unlock()
+		m.bindBuffer(uint32(target), uint32(buffer))
+		return 0
+	},
+	"BufferData": func(target, size uintptr, data *byte, usage uintptr) uintptr {
+		var content []byte
+		if data != nil {
+			content = slices.Clone(unsafe.Slice(data, int(size)))
+		}
+		m := enter("BufferData", uint32(target), int(size), content, uint32(usage))
+		defer m.mu.Unlock()
+		if b := m.boundBuffer(uint32(target)); b != nil {
+			if content == nil {
+				content = make([]byte, int(size))
+			}
+			b.Data, b.Usage = content, uint32(usage)
+		}
+		return 0
+	},
+	"BufferSubData": func(target, offset, size uintptr, data *byte) uintptr {
+		content := slices.Clone(unsafe.Slice(data, int(size)))
+		m := enter("BufferSubData", uint32(target), int(offset), int(size), content)
+		defer m.mu.Unlock()
+		if b := m.boundBuffer(uint32(target)); b != nil {
+			if int(offset)+int(size) > len(b.Data) {
+				m.setError(gl.INVALID_VALUE)
+				return 0
+			}
+			copy(b.Data[offset:], content)
+		}
+		return 0
+	},
+
+	// Textures
+	"GenTextures": func(n uintptr, textures *uint32) uintptr {
+		m := enter("GenTextures", int32(n))
+		defer m.mu.Unlock()
+		genNames(m, m.textures, int32(n), textures, func() *Texture { return &Texture{Params: map[uint32]int32{}} })
+		return 0
+	},
+	"DeleteTextures": func(n uintptr, textures *uint32) uintptr {
+		names := nameArgs(n, textures)
+		m := enter("DeleteTextures", int32(n), names)
+		defer m.mu.Unlock()
+		for _, t := range names {
+			m.deleteTexture(t)
+		}
+		return 0
+	},
+	"IsTexture": func(texture uintptr) uintptr {
+		m := enter("IsTexture", uint32(texture))
+		defer m.mu.Unlock()
+		return boolResult(m.textures.m[uint32(texture)] != nil)
+	},
+	"ActiveTexture": func(texture uintptr) uintptr {
+		m := enter("ActiveTexture", uint32(texture))
+		defer m.mu.Unlock()
+		if uint32(texture) < gl.TEXTURE0 || uint32(texture) >= gl.TEXTURE0+80 {
+			m.setError(gl.INVALID_ENUM)
+			return 0
+		}
+		m.state.ActiveTexture = uint32(texture) - gl.TEXTURE0
+		return 0
+	},
+	"BindTexture": func(target, texture uintptr) uintptr {
+		m := enter("BindTexture", uint32(target), uint32(texture))
+		defer m.mu.Unlock()
+		m.bindTexture(uint32(target), uint32(texture))
+		return 0
+	},
+	"TexImage2D": func(target, level, internalformat, width, height, border, format, xtype uintptr, pixels unsafe.Pointer) uintptr {
+		m := enter("TexImage2D", uint32(target), int32(level), int32(internalformat), int32(width), int32(height), int32(border), uint32(format), uint32(xtype))
+		defer m.mu.Unlock()
+		if t := m.boundTexture(uint32(target)); t != nil && int32(level) == 0 {
+			t.InternalFormat, t.Width, t.Height = int32(internalformat), int32(width), int32(height)
+		}
+		return 0
+	},
+	"TexStorage2D": func(target, levels, internalformat, width, height uintptr) uintptr {
+		m := enter("TexStorage2D", uint32(target), int32(levels), uint32(internalformat), int32(width), int32(height))
+		defer m.mu.Unlock()
+		if t := m.boundTexture(uint32(target)); t != nil {
+			t.InternalFormat, t.Width, t.Height = int32(internalformat), int32(width), int32(height)
+		}
+		return 0
+	},
+	"TexParameteri": func(target, pname, param uintptr) uintptr {
+		m := enter("TexParameteri", uint32(target), uint32(pname), int32(param))
+		defer m.mu.Unlock()
+		if t := m.boundTexture(uint32(target)); t != nil {
+			t.Params[uint32(pname)] = int32(param)
+		}
+		return 0
+	},
+	"GenerateMipmap": func(target uintptr) uintptr {
+		m := enter("GenerateMipmap", uint32(target))
+		defer m.mu.Unlock()
+		m.boundTexture(uint32(target))
+		return 0
+	},
+
+	// Shaders and programs
+	"CreateShader": func(xtype uintptr) uintptr {
+		m := enter("CreateShader", uint32(xtype))
+		defer m.mu.Unlock()
+		switch uint32(xtype) {
+		case gl.VERTEX_SHADER, gl.FRAGMENT_SHADER, gl.GEOMETRY_SHADER, gl.TESS_CONTROL_SHADER, gl.TESS_EVALUATION_SHADER, gl.COMPUTE_SHADER:
+			return uintptr(m.shaders.gen(&Shader{Type: uint32(xtype)}))
+		}
+		m.setError(gl.INVALID_ENUM)
+		return 0
+	},
+	"DeleteShader": func(shader uintptr) uintptr {
+		m := enter("DeleteShader", uint32(shader))
+		defer m.mu.Unlock()
+		m.deleteShader(uint32(shader))
+		return 0
+	},
+	"IsShader": func(shader uintptr) uintptr {
+		m := enter("IsShader", uint32(shader))
+		defer m.mu.Unlock()
+		return boolResult(m.shaders.m[uint32(shader)] != nil)
+	},
+	"ShaderSource": func(shader, count uintptr, xstring **uint8, length *int32) uintptr {
+		var src []byte
+		if int32(count) > 0 {
+			var lengths []int32
+			if length != nil {
+				lengths = unsafe.Slice(length, int32(count))
+			}
+			for i, s := range unsafe.Slice(xstring, int32(count)) {
+				if lengths != nil && lengths[i] >= 0 {
+					src = append(src, unsafe.Slice(s, lengths[i])...)
+				} else {
+					src = append(src, gl.GoStr(s)...)
+				}
+			}
+		}
+		m := enter("ShaderSource", uint32(shader), int32(count), string(src))
+		defer m.mu.Unlock()
+		if s := m.shaders.m[uint32(shader)]; s != nil {
+			s.Source = string(src)
+		} else {
+			m.setError(gl.INVALID_VALUE)
+		}
+		return 0
+	},
+	"CompileShader": func(shader uintptr) uintptr {
+		m := enter("CompileShader", uint32(shader))
+		defer m.mu.Unlock()
+		m.compileShader(uint32(shader))
+		return 0
+	},
+	"GetShaderiv": func(shader, pname uintptr, params *int32) uintptr {
+		m := enter("GetShaderiv", uint32(shader), uint32(pname))
+		defer m.mu.Unlock()
+		s := m.shaders.m[uint32(shader)]
+		if s == nil {
+			m.setError(gl.INVALID_VALUE)
+			return 0
+		}
+		switch uint32(pname) {
+		case gl.SHADER_TYPE:
+			*params = int32(s.Type)
+		case gl.COMPILE_STATUS:
+			*params = int32(boolResult(s.Compiled))
+		case gl.DELETE_STATUS:
+			*params = int32(boolResult(s.DeletePending))
+		case gl.INFO_LOG_LENGTH:
+			*params = int32(len(s.InfoLog) + 1)
+		case gl.SHADER_SOURCE_LENGTH:
+			*params = int32(len(s.Source) + 1)
+		default:
+			m.setError(gl.INVALID_ENUM)
+		}
+		return 0
+	},
+	"GetShaderInfoLog": func(shader, bufSize uintptr, length *int32, infoLog *uint8) uintptr {
+		m := enter("GetShaderInfoLog", uint32(shader), int32(bufSize))
+		defer m.mu.Unlock()
+		if s := m.shaders.m[uint32(shader)]; s != nil {
+			copyString(s.InfoLog, int32(bufSize), length, infoLog)
+		} else {
+			m.setError(gl.INVALID_VALUE)
+		}
+		return 0
+	},
+	"CreateProgram": func() uintptr {
+		m := enter("CreateProgram")
+		defer m.mu.Unlock()
+		return uintptr(m.programs.gen(&Program{}))
+	},
+	"DeleteProgram": func(program uintptr) uintptr {
+		m := enter("DeleteProgram", uint32(program))
+		defer m.mu.Unlock()
+		m.deleteProgram(uint32(program))
+		return 0
+	},
+	"IsProgram": func(program uintptr) uintptr {
+		m := enter("IsProgram", uint32(program))
+		defer m.mu.Unlock()
+		return boolResult(m.programs.m[uint32(program)] != nil)
+	},
+	"AttachShader": func(program, shader uintptr) uintptr {
+		m := enter("AttachShader", uint32(program), uint32(shader))
+		defer m.mu.Unlock()
+		m.attachShader(uint32(program), uint32(shader))
+		return 0
+	},
+	"DetachShader": func(program, shader uintptr) uintptr {
+		m := enter("DetachShader", uint32(program), uint32(shader))
+		defer m.mu.Unlock()
+		m.detachShader(uint32(program), uint32(shader))
+		return 0
+	},
+	"LinkProgram": func(program uintptr) uintptr {
+		m := enter("LinkProgram", uint32(program))
+		defer m.mu.Unlock()
+		m.linkProgram(uint32(program))
+		return 0
+	},
+	"UseProgram": func(program uintptr) uintptr {
+		m := enter("UseProgram", uint32(program))
+		defer m.mu.Unlock()
+		m.useProgram(uint32(program))
+		return 0
+	},
+	"GetProgramiv": func(program, pname uintptr, params *int32) uintptr {
+		m := enter("GetProgramiv", uint32(program), uint32(pname))
+		defer m.mu.Unlock()
+		p := m.programs.m[uint32(program)]
+		if p == nil {
+			m.setError(gl.INVALID_VALUE)
+			return 0
+		}
+		switch uint32(pname) {
+		case gl.LINK_STATUS:
+			*params = int32(boolResult(p.Linked))
+		case gl.DELETE_STATUS:
+			*params = int32(boolResult(p.DeletePending))
+		case gl.ATTACHED_SHADERS:
+			*params = int32(len(p.Shaders))
+		case gl.INFO_LOG_LENGTH:
+			*params = int32(len(p.InfoLog) + 1)
+		default:
+			m.setError(gl.INVALID_ENUM)
+		}
+		return 0
+	},
+	"GetProgramInfoLog": func(program, bufSize uintptr, length *int32, infoLog *uint8) uintptr {
+		m := enter("GetProgramInfoLog", uint32(program), int32(bufSize))
+		defer m.mu.Unlock()
+		if p := m.programs.m[uint32(program)]; p != nil {
+			copyString(p.InfoLog, int32(bufSize), length, infoLog)
+		} else {
+			m.setError(gl.INVALID_VALUE)
+		}
+		return 0
+	},
+	"GetUniformLocation": func(program uintptr, name *uint8) uintptr {
+		s := gl.GoStr(name)
+		m := enter("GetUniformLocation", uint32(program), s)
+		defer m.mu.Unlock()
+		return uintptr(m.location(uint32(program), s, func(p *Program) map[string]int32 { return p.Locations }))
+	},
+	"GetAttribLocation": func(program uintptr, name *uint8) uintptr {
+		s := gl.GoStr(name)
+		m := enter("GetAttribLocation", uint32(program), s)
+		defer m.mu.Unlock()
+		return uintptr(m.location(uint32(program), s, func(p *Program) map[string]int32 { return p.Attributes }))
+	},
+	"BindAttribLocation": func(program, index uintptr, name *uint8) uintptr {
+		s := gl.GoStr(name)
+		m := enter("BindAttribLocation", uint32(program), uint32(index), s)
+		defer m.mu.Unlock()
+		if p := m.programs.m[uint32(program)]; p != nil {
+			if p.Attributes == nil {
+				p.Attributes = map[string]int32{}
+			}
+			p.Attributes[s] = int32(index)
+		} else {
+			m.setError(gl.INVALID_VALUE)
+		}
+		return 0
+	},
+	"Uniform1i": func(location, v0 uintptr) uintptr {
+		m := enter("Uniform1i", int32(location), int32(v0))
+		defer m.mu.Unlock()
+		m.uniform()
+		return 0
+	},
+	"Uniform1f": func(location uintptr, v0 glfloat) uintptr {
+		m := enter("Uniform1f", int32(location), f32(v0))
+		defer m.mu.Unlock()
+		m.uniform()
+		return 0
+	},
+	"Uniform2f": func(location uintptr, v0, v1 glfloat) uintptr {
+		m := enter("Uniform2f", int32(location), f32(v0), f32(v1))
+		defer m.mu.Unlock()
+		m.uniform()
+		return 0
+	},
+	"Uniform3f": func(location uintptr, v0, v1, v2 glfloat) uintptr {
+		m := enter("Uniform3f", int32(location), f32(v0), f32(v1), f32(v2))
+		defer m.mu.Unlock()
+		m.uniform()
+		return 0
+	},
+	"Uniform4f": func(location uintptr, v0, v1, v2, v3 glfloat) uintptr {
+		m := enter("Uniform4f", int32(location), f32(v0), f32(v1), f32(v2), f32(v3))
+		defer m.mu.Unlock()
+		m.uniform()
+		return 0
+	},
+	"UniformMatrix4fv": func(location, count, transpose uintptr, value *float32) uintptr {
+		m := enter("UniformMatrix4fv", int32(location), int32(count), boolArg(transpose), slices.Clone(unsafe.Slice(value, 16*int32(count))))
+		defer m.mu.Unlock()
+		m.uniform()
+		return 0
+	},
+
+	// Vertex arrays
+	"GenVertexArrays": func(n uintptr, arrays *uint32) uintptr {
+		m := enter("GenVertexArrays", int32(n))
+		defer m.mu.Unlock()
+		genNames(m, m.vertexArrays, int32(n), arrays, func() *VertexArray { return &VertexArray{Attribs: map[uint32]*VertexAttrib{}} })
+		return 0
+	},
+	"DeleteVertexArrays": func(n uintptr, arrays *uint32) uintptr {
+		names := nameArgs(n, arrays)
+		m := enter("DeleteVertexArrays", int32(n), names)
+		defer m.mu.Unlock()
+		for _, a := range names {
+			m.deleteVertexArray(a)
+		}
+		return 0
+	},
+	"IsVertexArray": func(array uintptr) uintptr {
+		m := enter("IsVertexArray", uint32(array))
+		defer m.mu.Unlock()
+		return boolResult(m.vertexArrays.m[uint32(array)] != nil)
+	},
+	"BindVertexArray": func(array uintptr) uintptr {
+		m := enter("BindVertexArray", uint32(array))
+		defer m.mu.Unlock()
+		m.bindVertexArray(uint32(array))
+		return 0
+	},
+	"EnableVertexAttribArray": func(index uintptr) uintptr {
+		m := enter("EnableVertexAttribArray", uint32(index))
+		defer m.mu.Unlock()
+		m.attrib(uint32(index)).Enabled = true
+		return 0
+	},
+	"DisableVertexAttribArray": func(index uintptr) uintptr {
+		m := enter("DisableVertexAttribArray", uint32(index))
+		defer m.mu.Unlock()
+		m.attrib(uint32(index)).Enabled = false
+		return 0
+	},
+	"VertexAttribPointer": func(index, size, xtype, normalized, stride, pointer uintptr) uintptr {
+		m := enter("VertexAttribPointer", uint32(index), int32(size), uint32(xtype), boolArg(normalized), int32(stride), pointer)
+		defer m.mu.Unlock()
+		if m.state.Buffers[gl.ARRAY_BUFFER] == 0 && m.state.VertexArray != 0 {
+			// Client side arrays are only allowed with the default vertex array
+			m.setError(gl.INVALID_OPERATION)
+			return 0
+		}
+		a := m.attrib(uint32(index))
+		a.Buffer, a.Size, a.Type, a.Normalized, a.Stride, a.Offset = m.state.Buffers[gl.ARRAY_BUFFER], int32(size), uint32(xtype), boolArg(normalized), int32(stride), pointer
+		return 0
+	},
+
+	// Framebuffers and renderbuffers
+	"GenFramebuffers": func(n uintptr, framebuffers *uint32) uintptr {
+		m := enter("GenFramebuffers", int32(n))
+		defer m.mu.Unlock()
+		genNames(m, m.framebuffers, int32(n), framebuffers, func() *Framebuffer { return &Framebuffer{Attachments: map[uint32]Attachment{}} })
+		return 0
+	},
+	"DeleteFramebuffers": func(n uintptr, framebuffers *uint32) uintptr {
+		names := nameArgs(n, framebuffers)
+		m := enter("DeleteFramebuffers", int32(n), names)
+		defer m.mu.Unlock()
+		for _, f := range names {
+			m.deleteFramebuffer(f)
+		}
+		return 0
+	},
+	"IsFramebuffer": func(framebuffer uintptr) uintptr {
+		m := enter("IsFramebuffer", uint32(framebuffer))
+		defer m.mu.Unlock()
+		return boolResult(m.framebuffers.m[uint32(framebuffer)] != nil)
+	},
+	"BindFramebuffer": func(target, framebuffer uintptr) uintptr {
+		m := enter("BindFramebuffer", uint32(target), uint32(framebuffer))
+		defer m.mu.Unlock()
+		m.bindFramebuffer(uint32(target), uint32(framebuffer))
+		return 0
+	},
+	"FramebufferTexture2D": func(target, attachment, textarget, texture, level uintptr) uintptr {
+		m := enter("FramebufferTexture2D", uint32(target), uint32(attachment), uint32(textarget), uint32(texture), int32(level))
+		defer m.mu.Unlock()
+		if f := m.boundFramebuffer(uint32(target)); f != nil {
+			if texture == 0 {
+				delete(f.Attachments, uint32(attachment))
+			} else if m.textures.m[uint32(texture)] == nil {
+				m.setError(gl.INVALID_OPERATION)
+			} else {
+				f.Attachments[uint32(attachment)] = Attachment{Texture: uint32(texture)}
+			}
+		}
+		return 0
+	},
+	"FramebufferRenderbuffer": func(target, attachment, renderbuffertarget, renderbuffer uintptr) uintptr {
+		m := enter("FramebufferRenderbuffer", uint32(target), uint32(attachment), uint32(renderbuffertarget), uint32(renderbuffer))
+		defer m.mu.Unlock()
+		if f := m.boundFramebuffer(uint32(target)); f != nil {
+			if renderbuffer == 0 {
+				delete(f.Attachments, uint32(attachment))
+			} else if m.renderbuffers.m[uint32(renderbuffer)] == nil {
+				m.setError(gl.INVALID_OPERATION)
+			} else {
+				f.Attachments[uint32(attachment)] = Attachment{Renderbuffer: uint32(renderbuffer)}
+			}
+		}
+		return 0
+	},
+	"CheckFramebufferStatus": func(target uintptr) uintptr {
+		m := enter("CheckFramebufferStatus", uint32(target))
+		defer m.mu.Unlock()
+		return uintptr(m.checkFramebufferStatus(uint32(target)))
+	},
+	"GenRenderbuffers": func(n uintptr, renderbuffers *uint32) uintptr {
+		m := enter("GenRenderbuffers", int32(n))
+		defer m.mu.Unlock()
+		genNames(m, m.renderbuffers, int32(n), renderbuffers, func() *Renderbuffer { return &Renderbuffer{} })
+		return 0
+	},
+	"DeleteRenderbuffers": func(n uintptr, renderbuffers *uint32) uintptr {
+		names := nameArgs(n, renderbuffers)
+		m := enter("DeleteRenderbuffers", int32(n), names)
+		defer m.mu.Unlock()
+		for _, r := range names {
+			m.deleteRenderbuffer(r)
+		}
+		return 0
+	},
+	"IsRenderbuffer": func(renderbuffer uintptr) uintptr {
+		m := enter("IsRenderbuffer", uint32(renderbuffer))
+		defer m.mu.Unlock()
+		return boolResult(m.renderbuffers.m[uint32(renderbuffer)] != nil)
+	},
+	"BindRenderbuffer": func(target, renderbuffer uintptr) uintptr {
+		m := enter("BindRenderbuffer", uint32(target), uint32(renderbuffer))
+		defer m.mu.Unlock()
+		m.bindRenderbuffer(uint32(renderbuffer))
+		return 0
+	},
+	"RenderbufferStorage": func(target, internalformat, width, height uintptr) uintptr {
+		m := enter("RenderbufferStorage", uint32(target), uint32(internalformat), int32(width), int32(height))
+		defer m.mu.Unlock()
+		if r := m.renderbuffers.m[m.state.Renderbuffer]; r != nil {
+			r.InternalFormat, r.Width, r.Height = uint32(internalformat), int32(width), int32(height)
+		} else {
+			m.setError(gl.INVALID_OPERATION)
+		}
+		return 0
+	},
+
+	// Drawing
+	"DrawArrays": func(mode, first, count uintptr) uintptr {
+		m := enter("DrawArrays", uint32(mode), int32(first), int32(count))
+		defer m.mu.Unlock()
+		m.draw()
+		return 0
+	},
+	"DrawArraysInstanced": func(mode, first, count, instancecount uintptr) uintptr {
+		m := enter("DrawArraysInstanced", uint32(mode), int32(first), int32(count), int32(instancecount))
+		defer m.mu.Unlock()
+		m.draw()
+		return 0
+	},
+	"DrawElements": func(mode, count, xtype, indices uintptr) uintptr {
+		m := enter("DrawElements", uint32(mode), int32(count), uint32(xtype), indices)
+		defer m.mu.Unlock()
+		m.draw()
+		return 0
+	},
+	"DrawElementsInstanced": func(mode, count, xtype, indices, instancecount uintptr) uintptr {
+		m := enter("DrawElementsInstanced", uint32(mode), int32(count), uint32(xtype), indices, int32(instancecount))
+		defer m.mu.Unlock()
+		m.draw()
+		return 0
+	},
+}
+
+// copyString copies an info log as glGetShaderInfoLog does
+func copyString(s string, bufSize int32, length *int32, dst *uint8) {
+	if bufSize <= 0 || dst == nil {
+		return
+	}
+	n := min(int32(len(s)), bufSize-1)
+	buf := unsafe.Slice(dst, bufSize)
+	copy(buf, s[:n])
+	buf[n] = 0
+	if length != nil {
+		*length = n
+	}
+}
@@ -0,0 +1,146 @@
+//go:build windows || (linux && (amd64 || arm64))
+
+package gl_test
+
+import (
+	"encoding/binary"
+	"math"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/jkvatne/purego-glfw/gl"
+	"github.com/jkvatne/purego-glfw/gl/glmock"
+)
+
+func TestBufferDataSlice(t *testing.T) {
+	m := glmock.Install(t)
+	var buffer uint32
+	gl.GenBuffers(1, &buffer)
+	gl.BindBuffer(gl.ARRAY_BUFFER, buffer)
+	gl.BufferDataSlice(gl.ARRAY_BUFFER, []float32{1, 2, 3}, gl.DYNAMIC_DRAW)
+	gl.BufferSubDataSlice(gl.ARRAY_BUFFER, 4, []float32{5})
+	var want []byte
+	for _, f := range []float32{1, 5, 3} {
+		want = binary.NativeEndian.AppendUint32(want, math.Float32bits(f))
+	}
+	if b := m.Buffer(buffer); !slices.Equal(b.Data, want) || b.Usage != gl.DYNAMIC_DRAW {
+		t.Errorf("buffer %+v, want %v", b, want)
+	}
+
+	// An empty slice gives an empty data store, and replaces nothing
+	gl.BufferDataSlice[uint16](gl.ARRAY_BUFFER, nil, gl.STATIC_DRAW)
+	gl.BufferSubDataSlice(gl.ARRAY_BUFFER, 0, []uint16{})
+	if b := m.Buffer(buffer); len(b.Data) != 0 || b.Usage != gl.STATIC_DRAW {
+		t.Errorf("buffer %+v, want empty", b)
+	}
+	if n := m.Count("BufferSubData"); n != 1 {
+		t.Errorf("BufferSubData called %d times, want 1", n)
+	}
+}
+
+func TestShaderSourceString(t *testing.T) {
+	m := glmock.Install(t)
+	shader := gl.CreateShader(gl.VERTEX_SHADER)
+	for _, source := range []string{"#version 330\nvoid main() {}", ""} {
+		gl.ShaderSourceString(shader, source)
+		if got := m.Shader(shader).Source; got != source {
+			t.Errorf("source %q, want %q", got, source)
+		}
+	}
+}
+
+func TestInfoLogs(t *testing.T) {
+	m := glmock.Install(t)
+	m.CompileError = func(source string) string {
+		if strings.Contains(source, "error") {
+			return "0:1: error: syntax error\n"
+		}
+		return ""
+	}
+	shader := gl.CreateShader(gl.FRAGMENT_SHADER)
+	gl.ShaderSourceString(shader, "void main() {}")
+	gl.CompileShader(shader)
+	if got := gl.GetShaderInfoLogString(shader); got != "" {
+		t.Errorf("info log %q of a compiled shader, want none", got)
+	}
+	// The trailing newline is removed
+	gl.ShaderSourceString(shader, "error")
+	gl.CompileShader(shader)
+	if got := gl.GetShaderInfoLogString(shader); got != "0:1: error: syntax error" {
+		t.Errorf("shader info log %q", got)
+	}
+
+	program := gl.CreateProgram()
+	if got := gl.GetProgramInfoLogString(program); got != "" {
+		t.Errorf("info log %q of a new program, want none", got)
+	}
+	gl.LinkProgram(program)
+	if got := gl.GetProgramInfoLogString(program); got != "a vertex and a fragment shader must be attached" {
+		t.Errorf("program info log %q", got)
+	}
+}
+
+func TestNewProgram(t *testing.T) {
+	m := glmock.Install(t)
+	program, err := gl.NewProgram("void main() {}", "void main() {}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The shaders are detached and deleted
+	if p := m.Program(program); p == nil || !p.Linked || len(p.Shaders) != 0 {
+		t.Errorf("program %+v, want linked without shaders", p)
+	}
+	if shaders := m.Shaders(); len(shaders) != 0 {
+		t.Errorf("shaders %v are not deleted", shaders)
+	}
+
+	gl.UseProgram(program)
+	gl.UniformMatrix4(0, [16]float32{0: 1, 5: 1, 10: 1, 15: 1})
+	i := slices.IndexFunc(m.Calls(), func(c glmock.Call) bool { return c.Name == "UniformMatrix4fv" })
+	if i < 0 {
+		t.Fatal("UniformMatrix4 did not call UniformMatrix4fv")
+	}
+	if c := m.Calls()[i]; c.Args[1] != int32(1) || c.Args[3].([]float32)[15] != 1 {
+		t.Errorf("UniformMatrix4 called %v", c)
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	m := glmock.Install(t)
+	m.CompileError = func(source string) string {
+		if strings.Contains(source, "error") {
+			return "0:1: error: syntax error"
+		}
+		return ""
+	}
+	_, err := gl.CompileShaderSource(gl.FRAGMENT_SHADER, "error")
+	if err == nil || err.Error() != "gl: compiling fragment shader: 0:1: error: syntax error" {
+		t.Errorf("CompileShaderSource returned %v", err)
+	}
+	if shaders := m.Shaders(); len(shaders) != 0 {
+		t.Errorf("shader %v is not deleted after the error", shaders)
+	}
+	if _, err := gl.NewProgram("void main() {}", "error"); err == nil || !strings.Contains(err.Error(), "fragment shader") {
+		t.Errorf("NewProgram returned %v", err)
+	}
+	if len(m.Shaders()) != 0 || len(m.Programs()) != 0 {
+		t.Errorf("shaders %v and programs %v are not deleted after the error", m.Shaders(), m.Programs())
+	}
+
+	// Linking without a fragment shader fails
+	vertex, err := gl.CompileShaderSource(gl.VERTEX_SHADER, "void main() {}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = gl.LinkShaders(vertex)
+	if err == nil || err.Error() != "gl: linking program: a vertex and a fragment shader must be attached" {
+		t.Errorf("LinkShaders returned %v", err)
+	}
+	if programs := m.Programs(); len(programs) != 0 {
+		t.Errorf("program %v is not deleted after the error", programs)
+	}
+	if shaders := m.Shaders(); !slices.Equal(shaders, []uint32{vertex}) {
+		t.Errorf("shaders %v, want the vertex shader %d kept", shaders, vertex)
+	}
+}
@@ -0,0 +1,161 @@
+//go:build windows || (linux && (amd64 || arm64))
+
+package gl_test
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/jkvatne/purego-glfw/gl"
+	"github.com/jkvatne/purego-glfw/gl/glmock"
+)
+
+// captureCalls returns the trace of the calls made by calls on a mock. The
+// test is skipped without the gltrace tag.
+func captureCalls(t *testing.T, calls func(m *glmock.Mock)) []byte {
+	t.Helper()
+	// The calls are not all valid on the mock, such as drawing without a
+	// program, which the checks of the gldebug tag report to the mock
+	m := glmock.Install(t)
+	var trace bytes.Buffer
+	if err := gl.StartCapture(&trace); err != nil {
+		t.Skip(err)
+	}
+	calls(m)
+	if err := gl.StopCapture(); err != nil {
+		t.Fatal(err)
+	}
+	return trace.Bytes()
+}
+
+// dumpTrace returns the lines printed by DumpTrace
+func dumpTrace(t *testing.T, trace []byte) []string {
+	t.Helper()
+	var out strings.Builder
+	if err := gl.DumpTrace(&out, bytes.NewReader(trace)); err != nil {
+		t.Fatal(err)
+	}
+	return strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+}
+
+func TestCapturePointers(t *testing.T) {
+	color := [4]float32{0.25, 0.5, 0.75, 1}
+	depth := float32(1)
+	border := [4]float32{1, 0, 0, 1}
+	filter := int32(gl.LINEAR)
+	indices := []uint16{0, 1, 2, 2, 1, 3}
+	trace := captureCalls(t, func(*glmock.Mock) {
+		gl.ClearBufferfv(gl.COLOR, 0, &color[0])
+		gl.ClearBufferfv(gl.DEPTH, 0, &depth)
+		gl.TexParameterfv(gl.TEXTURE_2D, gl.TEXTURE_BORDER_COLOR, &border[0])
+		gl.TexParameteriv(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, &filter)
+		// Client side indices, then an offset in an element array buffer
+		gl.DrawElements(gl.TRIANGLES, 6, gl.UNSIGNED_SHORT, gl.Ptr(indices))
+		var buffers [2]uint32
+		gl.GenBuffers(2, &buffers[0])
+		gl.BindBuffer(gl.ELEMENT_ARRAY_BUFFER, buffers[0])
+		gl.DrawElements(gl.TRIANGLES, 3, gl.UNSIGNED_SHORT, gl.PtrOffset(6))
+		gl.BindBuffer(gl.ARRAY_BUFFER, buffers[1])
+		gl.VertexAttribPointer(0, 3, gl.FLOAT, false, 12, gl.PtrOffset(12))
+	})
+	want := []string{
+		"1 glClearBufferfv(6144, 0, <16 bytes>)",
+		"2 glClearBufferfv(6145, 0, <4 bytes>)",
+		"3 glTexParameterfv(3553, 4100, <16 bytes>)",
+		"4 glTexParameteriv(3553, 10241, <4 bytes>)",
+		"5 glDrawElements(4, 6, 5123, <12 bytes>)",
+		"6 glGenBuffers(2, <8 bytes>)",
+		"7 glBindBuffer(34963, 1)",
+		"8 glDrawElements(4, 3, 5123, offset 6)",
+		"9 glBindBuffer(34962, 2)",
+		"10 glVertexAttribPointer(0, 3, 5126, false, 12, offset 12)",
+	}
+	if got := dumpTrace(t, trace); strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("trace:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestReplayClientArray(t *testing.T) {
+	vertices := []float32{0, 0, 0, 1, 0, 0, 0, 1, 0}
+	trace := captureCalls(t, func(*glmock.Mock) {
+		gl.VertexAttribPointer(0, 3, gl.FLOAT, false, 12, gl.Ptr(vertices))
+	})
+	m := glmock.New()
+	ctx, err := gl.NewContext(m.GetProcAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n, err := gl.Replay(bytes.NewReader(trace), ctx)
+	if err == nil || !strings.Contains(err.Error(), "client memory") {
+		t.Errorf("Replay of a client side vertex array returned %v", err)
+	}
+	if n != 0 || m.Count("VertexAttribPointer") != 0 {
+		t.Errorf("Replay issued %d calls, want none", n)
+	}
+}
+
+func TestReplayNames(t *testing.T) {
+	var program uint32
+	trace := captureCalls(t, func(m *glmock.Mock) {
+		var buffer uint32
+		gl.GenBuffers(1, &buffer)
+		gl.BindBuffer(gl.ARRAY_BUFFER, buffer)
+		program = gl.CreateProgram()
+		for _, xtype := range []uint32{gl.VERTEX_SHADER, gl.FRAGMENT_SHADER} {
+			shader := gl.CreateShader(xtype)
+			gl.ShaderSourceString(shader, "void main() {}")
+			gl.CompileShader(shader)
+			gl.AttachShader(program, shader)
+		}
+		gl.LinkProgram(program)
+		// The locations of the capture differ from those of the replay
+		m.Program(program).Locations["pad"] = 0
+		m.Program(program).Attributes["pad"] = 0
+		gl.UseProgram(program)
+		scale := gl.GetUniformLocation(program, gl.Str("scale\x00"))
+		gl.Uniform1f(scale, 2)
+		position := uint32(gl.GetAttribLocation(program, gl.Str("position\x00")))
+		gl.VertexAttribPointer(position, 3, gl.FLOAT, false, 12, nil)
+		gl.EnableVertexAttribArray(position)
+	})
+	if got, want := dumpTrace(t, trace)[3], "4 glCreateShader(35633) = 2"; got != want {
+		t.Errorf("trace line %q, want %q", got, want)
+	}
+
+	// The objects of the replay are given other names
+	m := glmock.New()
+	ctx, err := gl.NewContext(m.GetProcAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var unused [3]uint32
+	ctx.GenBuffers(3, &unused[0])
+	ctx.CreateShader(gl.FRAGMENT_SHADER)
+	if _, err := gl.Replay(bytes.NewReader(trace), ctx); err != nil {
+		t.Fatal(err)
+	}
+	s := m.State()
+	if s.Buffers[gl.ARRAY_BUFFER] != 4 {
+		t.Errorf("bound buffer %d, want 4", s.Buffers[gl.ARRAY_BUFFER])
+	}
+	if s.Program != program+1 || m.Program(s.Program) == nil || !m.Program(s.Program).Linked {
+		t.Errorf("current program %d, want the linked program %d", s.Program, program+1)
+	}
+	if shaders := m.Program(s.Program).Shaders; !slices.Equal(shaders, []uint32{3, 4}) {
+		t.Errorf("attached shaders %v, want [3 4]", shaders)
+	}
+	var uniform []any
+	for _, c := range m.Calls() {
+		if c.Name == "Uniform1f" {
+			uniform = c.Args
+		}
+	}
+	if len(uniform) != 2 || uniform[0] != int32(0) || uniform[1] != float32(2) {
+		t.Errorf("Uniform1f%v, want location 0", uniform)
+	}
+	if a := m.VertexArray(0).Attribs[0]; a == nil || !a.Enabled || a.Buffer != 4 {
+		t.Errorf("vertex attribute 0 is %+v, want enabled with buffer 4", a)
+	}
+}
@@ -0,0 +1,7 @@
+//go:build egl && (amd64 || arm64)
+
+package gl
+
+func init() {
+	useEGL = true
+}
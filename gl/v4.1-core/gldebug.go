@@ -0,0 +1,7 @@
+//go:build gldebug && (windows || (linux && (amd64 || arm64)))
+
+package gl
+
+// checkErrors is true in builds with the gldebug tag, where every call is
+// traced and followed by glGetError.
+const checkErrors = true
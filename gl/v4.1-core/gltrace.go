@@ -0,0 +1,7 @@
+//go:build gltrace && (windows || (linux && (amd64 || arm64)))
+
+package gl
+
+// capture is true in builds with the gltrace tag, where the calls can be
+// recorded with StartCapture.
+const capture = true
@@ -0,0 +1,7202 @@
+// Code generated by genversions.go from the all-core bindings and glcorearb.h. DO NOT EDIT.
+
+//go:build amd64 || arm64
+
+package gl
+
+import (
+	"fmt"
+	"strings"
+	"unsafe"
+
+	"github.com/ebitengine/purego"
+)
+
+// Context holds the OpenGL entry points of one context, and has a method
+// for each function of the package. The package-level functions call the
+// default context, loaded by Init and InitWithProcAddrFunc.
+type Context struct {
+	inBegin bool // Between Begin and End, where glGetError must not be called
+
+	gpActiveShaderProgram                 uintptr
+	gpActiveTexture                       uintptr
+	gpAttachShader                        uintptr
+	gpBeginConditionalRender              uintptr
+	gpBeginQuery                          uintptr
+	gpBeginQueryIndexed                   uintptr
+	gpBeginTransformFeedback              uintptr
+	gpBindAttribLocation                  uintptr
+	gpBindBuffer                          uintptr
+	gpBindBufferBase                      uintptr
+	gpBindBufferRange                     uintptr
+	gpBindFragDataLocation                uintptr
+	gpBindFragDataLocationIndexed         uintptr
+	gpBindFramebuffer                     uintptr
+	gpBindProgramPipeline                 uintptr
+	gpBindRenderbuffer                    uintptr
+	gpBindSampler                         uintptr
+	gpBindTexture                         uintptr
+	gpBindTransformFeedback               uintptr
+	gpBindVertexArray                     uintptr
+	gpBlendColor                          uintptr
+	gpBlendEquation                       uintptr
+	gpBlendEquationSeparate               uintptr
+	gpBlendEquationSeparatei              uintptr
+	gpBlendEquationi                      uintptr
+	gpBlendFunc                           uintptr
+	gpBlendFuncSeparate                   uintptr
+	gpBlendFuncSeparatei                  uintptr
+	gpBlendFunci                          uintptr
+	gpBlitFramebuffer                     uintptr
+	gpBufferData                          uintptr
+	gpBufferSubData                       uintptr
+	gpCheckFramebufferStatus              uintptr
+	gpClampColor                          uintptr
+	gpClear                               uintptr
+	gpClearBufferfi                       uintptr
+	gpClearBufferfv                       uintptr
+	gpClearBufferiv                       uintptr
+	gpClearBufferuiv                      uintptr
+	gpClearColor                          uintptr
+	gpClearDepth                          uintptr
+	gpClearDepthf                         uintptr
+	gpClearStencil                        uintptr
+	gpClientWaitSync                      uintptr
+	gpColorMask                           uintptr
+	gpColorMaski                          uintptr
+	gpCompileShader                       uintptr
+	gpCompressedTexImage1D                uintptr
+	gpCompressedTexImage2D                uintptr
+	gpCompressedTexImage3D                uintptr
+	gpCompressedTexSubImage1D             uintptr
+	gpCompressedTexSubImage2D             uintptr
+	gpCompressedTexSubImage3D             uintptr
+	gpCopyBufferSubData                   uintptr
+	gpCopyTexImage1D                      uintptr
+	gpCopyTexImage2D                      uintptr
+	gpCopyTexSubImage1D                   uintptr
+	gpCopyTexSubImage2D                   uintptr
+	gpCopyTexSubImage3D                   uintptr
+	gpCreateProgram                       uintptr
+	gpCreateShader                        uintptr
+	gpCreateShaderProgramv                uintptr
+	gpCullFace                            uintptr
+	gpDeleteBuffers                       uintptr
+	gpDeleteFramebuffers                  uintptr
+	gpDeleteProgram                       uintptr
+	gpDeleteProgramPipelines              uintptr
+	gpDeleteQueries                       uintptr
+	gpDeleteRenderbuffers                 uintptr
+	gpDeleteSamplers                      uintptr
+	gpDeleteShader                        uintptr
+	gpDeleteSync                          uintptr
+	gpDeleteTextures                      uintptr
+	gpDeleteTransformFeedbacks            uintptr
+	gpDeleteVertexArrays                  uintptr
+	gpDepthFunc                           uintptr
+	gpDepthMask                           uintptr
+	gpDepthRange                          uintptr
+	gpDepthRangeArrayv                    uintptr
+	gpDepthRangeIndexed                   uintptr
+	gpDepthRangef                         uintptr
+	gpDetachShader                        uintptr
+	gpDisable                             uintptr
+	gpDisableVertexAttribArray            uintptr
+	gpDisablei                            uintptr
+	gpDrawArrays                          uintptr
+	gpDrawArraysIndirect                  uintptr
+	gpDrawArraysInstanced                 uintptr
+	gpDrawBuffer                          uintptr
+	gpDrawBuffers                         uintptr
+	gpDrawElements                        uintptr
+	gpDrawElementsBaseVertex              uintptr
+	gpDrawElementsIndirect                uintptr
+	gpDrawElementsInstanced               uintptr
+	gpDrawElementsInstancedBaseVertex     uintptr
+	gpDrawRangeElements                   uintptr
+	gpDrawRangeElementsBaseVertex         uintptr
+	gpDrawTransformFeedback               uintptr
+	gpDrawTransformFeedbackStream         uintptr
+	gpEnable                              uintptr
+	gpEnableVertexAttribArray             uintptr
+	gpEnablei                             uintptr
+	gpEndConditionalRender                uintptr
+	gpEndQuery                            uintptr
+	gpEndQueryIndexed                     uintptr
+	gpEndTransformFeedback                uintptr
+	gpFenceSync                           uintptr
+	gpFinish                              uintptr
+	gpFlush                               uintptr
+	gpFlushMappedBufferRange              uintptr
+	gpFramebufferRenderbuffer             uintptr
+	gpFramebufferTexture                  uintptr
+	gpFramebufferTexture1D                uintptr
+	gpFramebufferTexture2D                uintptr
+	gpFramebufferTexture3D                uintptr
+	gpFramebufferTextureLayer             uintptr
+	gpFrontFace                           uintptr
+	gpGenBuffers                          uintptr
+	gpGenFramebuffers                     uintptr
+	gpGenProgramPipelines                 uintptr
+	gpGenQueries                          uintptr
+	gpGenRenderbuffers                    uintptr
+	gpGenSamplers                         uintptr
+	gpGenTextures                         uintptr
+	gpGenTransformFeedbacks               uintptr
+	gpGenVertexArrays                     uintptr
+	gpGenerateMipmap                      uintptr
+	gpGetActiveAttrib                     uintptr
+	gpGetActiveSubroutineName             uintptr
+	gpGetActiveSubroutineUniformName      uintptr
+	gpGetActiveSubroutineUniformiv        uintptr
+	gpGetActiveUniform                    uintptr
+	gpGetActiveUniformBlockName           uintptr
+	gpGetActiveUniformBlockiv             uintptr
+	gpGetActiveUniformName                uintptr
+	gpGetActiveUniformsiv                 uintptr
+	gpGetAttachedShaders                  uintptr
+	gpGetAttribLocation                   uintptr
+	gpGetBooleani_v                       uintptr
+	gpGetBooleanv                         uintptr
+	gpGetBufferParameteri64v              uintptr
+	gpGetBufferParameteriv                uintptr
+	gpGetBufferPointerv                   uintptr
+	gpGetBufferSubData                    uintptr
+	gpGetCompressedTexImage               uintptr
+	gpGetDoublei_v                        uintptr
+	gpGetDoublev                          uintptr
+	gpGetError                            uintptr
+	gpGetFloati_v                         uintptr
+	gpGetFloatv                           uintptr
+	gpGetFragDataIndex                    uintptr
+	gpGetFragDataLocation                 uintptr
+	gpGetFramebufferAttachmentParameteriv uintptr
+	gpGetInteger64i_v                     uintptr
+	gpGetInteger64v                       uintptr
+	gpGetIntegeri_v                       uintptr
+	gpGetIntegerv                         uintptr
+	gpGetMultisamplefv                    uintptr
+	gpGetPointerv                         uintptr
+	gpGetProgramBinary                    uintptr
+	gpGetProgramInfoLog                   uintptr
+	gpGetProgramPipelineInfoLog           uintptr
+	gpGetProgramPipelineiv                uintptr
+	gpGetProgramStageiv                   uintptr
+	gpGetProgramiv                        uintptr
+	gpGetQueryIndexediv                   uintptr
+	gpGetQueryObjecti64v                  uintptr
+	gpGetQueryObjectiv                    uintptr
+	gpGetQueryObjectui64v                 uintptr
+	gpGetQueryObjectuiv                   uintptr
+	gpGetQueryiv                          uintptr
+	gpGetRenderbufferParameteriv          uintptr
+	gpGetSamplerParameterIiv              uintptr
+	gpGetSamplerParameterIuiv             uintptr
+	gpGetSamplerParameterfv               uintptr
+	gpGetSamplerParameteriv               uintptr
+	gpGetShaderInfoLog                    uintptr
+	gpGetShaderPrecisionFormat            uintptr
+	gpGetShaderSource                     uintptr
+	gpGetShaderiv                         uintptr
+	gpGetString                           uintptr
+	gpGetStringi                          uintptr
+	gpGetSubroutineIndex                  uintptr
+	gpGetSubroutineUniformLocation        uintptr
+	gpGetSynciv                           uintptr
+	gpGetTexImage                         uintptr
+	gpGetTexLevelParameterfv              uintptr
+	gpGetTexLevelParameteriv              uintptr
+	gpGetTexParameterIiv                  uintptr
+	gpGetTexParameterIuiv                 uintptr
+	gpGetTexParameterfv                   uintptr
+	gpGetTexParameteriv                   uintptr
+	gpGetTransformFeedbackVarying         uintptr
+	gpGetUniformBlockIndex                uintptr
+	gpGetUniformIndices                   uintptr
+	gpGetUniformLocation                  uintptr
+	gpGetUniformSubroutineuiv             uintptr
+	gpGetUniformdv                        uintptr
+	gpGetUniformfv                        uintptr
+	gpGetUniformiv                        uintptr
+	gpGetUniformuiv                       uintptr
+	gpGetVertexAttribIiv                  uintptr
+	gpGetVertexAttribIuiv                 uintptr
+	gpGetVertexAttribLdv                  uintptr
+	gpGetVertexAttribPointerv             uintptr
+	gpGetVertexAttribdv                   uintptr
+	gpGetVertexAttribfv                   uintptr
+	gpGetVertexAttribiv                   uintptr
+	gpHint                                uintptr
+	gpIsBuffer                            uintptr
+	gpIsEnabled                           uintptr
+	gpIsEnabledi                          uintptr
+	gpIsFramebuffer                       uintptr
+	gpIsProgram                           uintptr
+	gpIsProgramPipeline                   uintptr
+	gpIsQuery                             uintptr
+	gpIsRenderbuffer                      uintptr
+	gpIsSampler                           uintptr
+	gpIsShader                            uintptr
+	gpIsSync                              uintptr
+	gpIsTexture                           uintptr
+	gpIsTransformFeedback                 uintptr
+	gpIsVertexArray                       uintptr
+	gpLineWidth                           uintptr
+	gpLinkProgram                         uintptr
+	gpLogicOp                             uintptr
+	gpMapBuffer                           uintptr
+	gpMapBufferRange                      uintptr
+	gpMinSampleShading                    uintptr
+	gpMultiDrawArrays                     uintptr
+	gpMultiDrawElements                   uintptr
+	gpMultiDrawElementsBaseVertex         uintptr
+	gpPatchParameterfv                    uintptr
+	gpPatchParameteri                     uintptr
+	gpPauseTransformFeedback              uintptr
+	gpPixelStoref                         uintptr
+	gpPixelStorei                         uintptr
+	gpPointParameterf                     uintptr
+	gpPointParameterfv                    uintptr
+	gpPointParameteri                     uintptr
+	gpPointParameteriv                    uintptr
+	gpPointSize                           uintptr
+	gpPolygonMode                         uintptr
+	gpPolygonOffset                       uintptr
+	gpPrimitiveRestartIndex               uintptr
+	gpProgramBinary                       uintptr
+	gpProgramParameteri                   uintptr
+	gpProgramUniform1d                    uintptr
+	gpProgramUniform1dv                   uintptr
+	gpProgramUniform1f                    uintptr
+	gpProgramUniform1fv                   uintptr
+	gpProgramUniform1i                    uintptr
+	gpProgramUniform1iv                   uintptr
+	gpProgramUniform1ui                   uintptr
+	gpProgramUniform1uiv                  uintptr
+	gpProgramUniform2d                    uintptr
+	gpProgramUniform2dv                   uintptr
+	gpProgramUniform2f                    uintptr
+	gpProgramUniform2fv                   uintptr
+	gpProgramUniform2i                    uintptr
+	gpProgramUniform2iv                   uintptr
+	gpProgramUniform2ui                   uintptr
+	gpProgramUniform2uiv                  uintptr
+	gpProgramUniform3d                    uintptr
+	gpProgramUniform3dv                   uintptr
+	gpProgramUniform3f                    uintptr
+	gpProgramUniform3fv                   uintptr
+	gpProgramUniform3i                    uintptr
+	gpProgramUniform3iv                   uintptr
+	gpProgramUniform3ui                   uintptr
+	gpProgramUniform3uiv                  uintptr
+	gpProgramUniform4d                    uintptr
+	gpProgramUniform4dv                   uintptr
+	gpProgramUniform4f                    uintptr
+	gpProgramUniform4fv                   uintptr
+	gpProgramUniform4i                    uintptr
+	gpProgramUniform4iv                   uintptr
+	gpProgramUniform4ui                   uintptr
+	gpProgramUniform4uiv                  uintptr
+	gpProgramUniformMatrix2dv             uintptr
+	gpProgramUniformMatrix2fv             uintptr
+	gpProgramUniformMatrix2x3dv           uintptr
+	gpProgramUniformMatrix2x3fv           uintptr
+	gpProgramUniformMatrix2x4dv           uintptr
+	gpProgramUniformMatrix2x4fv           uintptr
+	gpProgramUniformMatrix3dv             uintptr
+	gpProgramUniformMatrix3fv             uintptr
+	gpProgramUniformMatrix3x2dv           uintptr
+	gpProgramUniformMatrix3x2fv           uintptr
+	gpProgramUniformMatrix3x4dv           uintptr
+	gpProgramUniformMatrix3x4fv           uintptr
+	gpProgramUniformMatrix4dv             uintptr
+	gpProgramUniformMatrix4fv             uintptr
+	gpProgramUniformMatrix4x2dv           uintptr
+	gpProgramUniformMatrix4x2fv           uintptr
+	gpProgramUniformMatrix4x3dv           uintptr
+	gpProgramUniformMatrix4x3fv           uintptr
+	gpProvokingVertex                     uintptr
+	gpQueryCounter                        uintptr
+	gpReadBuffer                          uintptr
+	gpReadPixels                          uintptr
+	gpReleaseShaderCompiler               uintptr
+	gpRenderbufferStorage                 uintptr
+	gpRenderbufferStorageMultisample      uintptr
+	gpResumeTransformFeedback             uintptr
+	gpSampleCoverage                      uintptr
+	gpSampleMaski                         uintptr
+	gpSamplerParameterIiv                 uintptr
+	gpSamplerParameterIuiv                uintptr
+	gpSamplerParameterf                   uintptr
+	gpSamplerParameterfv                  uintptr
+	gpSamplerParameteri                   uintptr
+	gpSamplerParameteriv                  uintptr
+	gpScissor                             uintptr
+	gpScissorArrayv                       uintptr
+	gpScissorIndexed                      uintptr
+	gpScissorIndexedv                     uintptr
+	gpShaderBinary                        uintptr
+	gpShaderSource                        uintptr
+	gpStencilFunc                         uintptr
+	gpStencilFuncSeparate                 uintptr
+	gpStencilMask                         uintptr
+	gpStencilMaskSeparate                 uintptr
+	gpStencilOp                           uintptr
+	gpStencilOpSeparate                   uintptr
+	gpTexBuffer                           uintptr
+	gpTexImage1D                          uintptr
+	gpTexImage2D                          uintptr
+	gpTexImage2DMultisample               uintptr
+	gpTexImage3D                          uintptr
+	gpTexImage3DMultisample               uintptr
+	gpTexParameterIiv                     uintptr
+	gpTexParameterIuiv                    uintptr
+	gpTexParameterf                       uintptr
+	gpTexParameterfv                      uintptr
+	gpTexParameteri                       uintptr
+	gpTexParameteriv                      uintptr
+	gpTexSubImage1D                       uintptr
+	gpTexSubImage2D                       uintptr
+	gpTexSubImage3D                       uintptr
+	gpTransformFeedbackVaryings           uintptr
+	gpUniform1d                           uintptr
+	gpUniform1dv                          uintptr
+	gpUniform1f                           uintptr
+	gpUniform1fv                          uintptr
+	gpUniform1i                           uintptr
+	gpUniform1iv                          uintptr
+	gpUniform1ui                          uintptr
+	gpUniform1uiv                         uintptr
+	gpUniform2d                           uintptr
+	gpUniform2dv                          uintptr
+	gpUniform2f                           uintptr
+	gpUniform2fv                          uintptr
+	gpUniform2i                           uintptr
+	gpUniform2iv                          uintptr
+	gpUniform2ui                          uintptr
+	gpUniform2uiv                         uintptr
+	gpUniform3d                           uintptr
+	gpUniform3dv                          uintptr
+	gpUniform3f                           uintptr
+	gpUniform3fv                          uintptr
+	gpUniform3i                           uintptr
+	gpUniform3iv                          uintptr
+	gpUniform3ui                          uintptr
+	gpUniform3uiv                         uintptr
+	gpUniform4d                           uintptr
+	gpUniform4dv                          uintptr
+	gpUniform4f                           uintptr
+	gpUniform4fv                          uintptr
+	gpUniform4i                           uintptr
+	gpUniform4iv                          uintptr
+	gpUniform4ui                          uintptr
+	gpUniform4uiv                         uintptr
+	gpUniformBlockBinding                 uintptr
+	gpUniformMatrix2dv                    uintptr
+	gpUniformMatrix2fv                    uintptr
+	gpUniformMatrix2x3dv                  uintptr
+	gpUniformMatrix2x3fv                  uintptr
+	gpUniformMatrix2x4dv                  uintptr
+	gpUniformMatrix2x4fv                  uintptr
+	gpUniformMatrix3dv                    uintptr
+	gpUniformMatrix3fv                    uintptr
+	gpUniformMatrix3x2dv                  uintptr
+	gpUniformMatrix3x2fv                  uintptr
+	gpUniformMatrix3x4dv                  uintptr
+	gpUniformMatrix3x4fv                  uintptr
+	gpUniformMatrix4dv                    uintptr
+	gpUniformMatrix4fv                    uintptr
+	gpUniformMatrix4x2dv                  uintptr
+	gpUniformMatrix4x2fv                  uintptr
+	gpUniformMatrix4x3dv                  uintptr
+	gpUniformMatrix4x3fv                  uintptr
+	gpUniformSubroutinesuiv               uintptr
+	gpUnmapBuffer                         uintptr
+	gpUseProgram                          uintptr
+	gpUseProgramStages                    uintptr
+	gpValidateProgram                     uintptr
+	gpValidateProgramPipeline             uintptr
+	gpVertexAttrib1d                      uintptr
+	gpVertexAttrib1dv                     uintptr
+	gpVertexAttrib1f                      uintptr
+	gpVertexAttrib1fv                     uintptr
+	gpVertexAttrib1s                      uintptr
+	gpVertexAttrib1sv                     uintptr
+	gpVertexAttrib2d                      uintptr
+	gpVertexAttrib2dv                     uintptr
+	gpVertexAttrib2f                      uintptr
+	gpVertexAttrib2fv                     uintptr
+	gpVertexAttrib2s                      uintptr
+	gpVertexAttrib2sv                     uintptr
+	gpVertexAttrib3d                      uintptr
+	gpVertexAttrib3dv                     uintptr
+	gpVertexAttrib3f                      uintptr
+	gpVertexAttrib3fv                     uintptr
+	gpVertexAttrib3s                      uintptr
+	gpVertexAttrib3sv                     uintptr
+	gpVertexAttrib4Nbv                    uintptr
+	gpVertexAttrib4Niv                    uintptr
+	gpVertexAttrib4Nsv                    uintptr
+	gpVertexAttrib4Nub                    uintptr
+	gpVertexAttrib4Nubv                   uintptr
+	gpVertexAttrib4Nuiv                   uintptr
+	gpVertexAttrib4Nusv                   uintptr
+	gpVertexAttrib4bv                     uintptr
+	gpVertexAttrib4d                      uintptr
+	gpVertexAttrib4dv                     uintptr
+	gpVertexAttrib4f                      uintptr
+	gpVertexAttrib4fv                     uintptr
+	gpVertexAttrib4iv                     uintptr
+	gpVertexAttrib4s                      uintptr
+	gpVertexAttrib4sv                     uintptr
+	gpVertexAttrib4ubv                    uintptr
+	gpVertexAttrib4uiv                    uintptr
+	gpVertexAttrib4usv                    uintptr
+	gpVertexAttribDivisor                 uintptr
+	gpVertexAttribI1i                     uintptr
+	gpVertexAttribI1iv                    uintptr
+	gpVertexAttribI1ui                    uintptr
+	gpVertexAttribI1uiv                   uintptr
+	gpVertexAttribI2i                     uintptr
+	gpVertexAttribI2iv                    uintptr
+	gpVertexAttribI2ui                    uintptr
+	gpVertexAttribI2uiv                   uintptr
+	gpVertexAttribI3i                     uintptr
+	gpVertexAttribI3iv                    uintptr
+	gpVertexAttribI3ui                    uintptr
+	gpVertexAttribI3uiv                   uintptr
+	gpVertexAttribI4bv                    uintptr
+	gpVertexAttribI4i                     uintptr
+	gpVertexAttribI4iv                    uintptr
+	gpVertexAttribI4sv                    uintptr
+	gpVertexAttribI4ubv                   uintptr
+	gpVertexAttribI4ui                    uintptr
+	gpVertexAttribI4uiv                   uintptr
+	gpVertexAttribI4usv                   uintptr
+	gpVertexAttribIPointer                uintptr
+	gpVertexAttribL1d                     uintptr
+	gpVertexAttribL1dv                    uintptr
+	gpVertexAttribL2d                     uintptr
+	gpVertexAttribL2dv                    uintptr
+	gpVertexAttribL3d                     uintptr
+	gpVertexAttribL3dv                    uintptr
+	gpVertexAttribL4d                     uintptr
+	gpVertexAttribL4dv                    uintptr
+	gpVertexAttribLPointer                uintptr
+	gpVertexAttribP1ui                    uintptr
+	gpVertexAttribP1uiv                   uintptr
+	gpVertexAttribP2ui                    uintptr
+	gpVertexAttribP2uiv                   uintptr
+	gpVertexAttribP3ui                    uintptr
+	gpVertexAttribP3uiv                   uintptr
+	gpVertexAttribP4ui                    uintptr
+	gpVertexAttribP4uiv                   uintptr
+	gpVertexAttribPointer                 uintptr
+	gpViewport                            uintptr
+	gpViewportArrayv                      uintptr
+	gpViewportIndexedf                    uintptr
+	gpViewportIndexedfv                   uintptr
+	gpWaitSync                            uintptr
+
+	// Functions with floating point parameters or results, bound by load
+	fpBlendColor        func(float32, float32, float32, float32)
+	fpClearBufferfi     func(uint32, int32, float32, int32)
+	fpClearColor        func(float32, float32, float32, float32)
+	fpClearDepth        func(float64)
+	fpClearDepthf       func(float32)
+	fpDepthRange        func(float64, float64)
+	fpDepthRangeIndexed func(uint32, float64, float64)
+	fpDepthRangef       func(float32, float32)
+	fpLineWidth         func(float32)
+	fpMinSampleShading  func(float32)
+	fpPixelStoref       func(uint32, float32)
+	fpPointParameterf   func(uint32, float32)
+	fpPointSize         func(float32)
+	fpPolygonOffset     func(float32, float32)
+	fpProgramUniform1d  func(uint32, int32, float64)
+	fpProgramUniform1f  func(uint32, int32, float32)
+	fpProgramUniform2d  func(uint32, int32, float64, float64)
+	fpProgramUniform2f  func(uint32, int32, float32, float32)
+	fpProgramUniform3d  func(uint32, int32, float64, float64, float64)
+	fpProgramUniform3f  func(uint32, int32, float32, float32, float32)
+	fpProgramUniform4d  func(uint32, int32, float64, float64, float64, float64)
+	fpProgramUniform4f  func(uint32, int32, float32, float32, float32, float32)
+	fpSampleCoverage    func(float32, bool)
+	fpSamplerParameterf func(uint32, uint32, float32)
+	fpTexParameterf     func(uint32, uint32, float32)
+	fpUniform1d         func(int32, float64)
+	fpUniform1f         func(int32, float32)
+	fpUniform2d         func(int32, float64, float64)
+	fpUniform2f         func(int32, float32, float32)
+	fpUniform3d         func(int32, float64, float64, float64)
+	fpUniform3f         func(int32, float32, float32, float32)
+	fpUniform4d         func(int32, float64, float64, float64, float64)
+	fpUniform4f         func(int32, float32, float32, float32, float32)
+	fpVertexAttrib1d    func(uint32, float64)
+	fpVertexAttrib1f    func(uint32, float32)
+	fpVertexAttrib2d    func(uint32, float64, float64)
+	fpVertexAttrib2f    func(uint32, float32, float32)
+	fpVertexAttrib3d    func(uint32, float64, float64, float64)
+	fpVertexAttrib3f    func(uint32, float32, float32, float32)
+	fpVertexAttrib4d    func(uint32, float64, float64, float64, float64)
+	fpVertexAttrib4f    func(uint32, float32, float32, float32, float32)
+	fpVertexAttribL1d   func(uint32, float64)
+	fpVertexAttribL2d   func(uint32, float64, float64)
+	fpVertexAttribL3d   func(uint32, float64, float64, float64)
+	fpVertexAttribL4d   func(uint32, float64, float64, float64, float64)
+	fpViewportIndexedf  func(uint32, float32, float32, float32, float32)
+}
+
+func boolToUintptr(b bool) uintptr {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// set the active program object for a program pipeline object
+func (ctx *Context) ActiveShaderProgram(pipeline uint32, program uint32) {
+	purego.SyscallN(ctx.gpActiveShaderProgram, uintptr(pipeline), uintptr(program))
+	if hooked {
+		ctx.called("ActiveShaderProgram", pipeline, program)
+	}
+}
+
+// set the active program object for a program pipeline object
+func ActiveShaderProgram(pipeline uint32, program uint32) {
+	defaultContext.ActiveShaderProgram(pipeline, program)
+}
+
+// select active texture unit
+func (ctx *Context) ActiveTexture(texture uint32) {
+	purego.SyscallN(ctx.gpActiveTexture, uintptr(texture))
+	if hooked {
+		ctx.called("ActiveTexture", texture)
+	}
+}
+
+// select active texture unit
+func ActiveTexture(texture uint32) {
+	defaultContext.ActiveTexture(texture)
+}
+
+// Attaches a shader object to a program object
+func (ctx *Context) AttachShader(program uint32, shader uint32) {
+	purego.SyscallN(ctx.gpAttachShader, uintptr(program), uintptr(shader))
+	if hooked {
+		ctx.called("AttachShader", program, shader)
+	}
+}
+
+// Attaches a shader object to a program object
+func AttachShader(program uint32, shader uint32) {
+	defaultContext.AttachShader(program, shader)
+}
+
+// start conditional rendering
+func (ctx *Context) BeginConditionalRender(id uint32, mode uint32) {
+	purego.SyscallN(ctx.gpBeginConditionalRender, uintptr(id), uintptr(mode))
+	if hooked {
+		ctx.called("BeginConditionalRender", id, mode)
+	}
+}
+
+// start conditional rendering
+func BeginConditionalRender(id uint32, mode uint32) {
+	defaultContext.BeginConditionalRender(id, mode)
+}
+
+// delimit the boundaries of a query object
+func (ctx *Context) BeginQuery(target uint32, id uint32) {
+	purego.SyscallN(ctx.gpBeginQuery, uintptr(target), uintptr(id))
+	if hooked {
+		ctx.called("BeginQuery", target, id)
+	}
+}
+
+// delimit the boundaries of a query object
+func BeginQuery(target uint32, id uint32) {
+	defaultContext.BeginQuery(target, id)
+}
+
+func (ctx *Context) BeginQueryIndexed(target uint32, index uint32, id uint32) {
+	purego.SyscallN(ctx.gpBeginQueryIndexed, uintptr(target), uintptr(index), uintptr(id))
+	if hooked {
+		ctx.called("BeginQueryIndexed", target, index, id)
+	}
+}
+
+func BeginQueryIndexed(target uint32, index uint32, id uint32) {
+	defaultContext.BeginQueryIndexed(target, index, id)
+}
+
+// start transform feedback operation
+func (ctx *Context) BeginTransformFeedback(primitiveMode uint32) {
+	purego.SyscallN(ctx.gpBeginTransformFeedback, uintptr(primitiveMode))
+	if hooked {
+		ctx.called("BeginTransformFeedback", primitiveMode)
+	}
+}
+
+// start transform feedback operation
+func BeginTransformFeedback(primitiveMode uint32) {
+	defaultContext.BeginTransformFeedback(primitiveMode)
+}
+
+// Associates a generic vertex attribute index with a named attribute variable
+func (ctx *Context) BindAttribLocation(program uint32, index uint32, name *uint8) {
+	purego.SyscallN(ctx.gpBindAttribLocation, uintptr(program), uintptr(index), uintptr(unsafe.Pointer(name)))
+	if hooked {
+		ctx.called("BindAttribLocation", program, index, name)
+	}
+}
+
+// Associates a generic vertex attribute index with a named attribute variable
+func BindAttribLocation(program uint32, index uint32, name *uint8) {
+	defaultContext.BindAttribLocation(program, index, name)
+}
+
+// bind a named buffer object
+func (ctx *Context) BindBuffer(target uint32, buffer uint32) {
+	purego.SyscallN(ctx.gpBindBuffer, uintptr(target), uintptr(buffer))
+	if hooked {
+		ctx.called("BindBuffer", target, buffer)
+	}
+}
+
+// bind a named buffer object
+func BindBuffer(target uint32, buffer uint32) {
+	defaultContext.BindBuffer(target, buffer)
+}
+
+// bind a buffer object to an indexed buffer target
+func (ctx *Context) BindBufferBase(target uint32, index uint32, buffer uint32) {
+	purego.SyscallN(ctx.gpBindBufferBase, uintptr(target), uintptr(index), uintptr(buffer))
+	if hooked {
+		ctx.called("BindBufferBase", target, index, buffer)
+	}
+}
+
+// bind a buffer object to an indexed buffer target
+func BindBufferBase(target uint32, index uint32, buffer uint32) {
+	defaultContext.BindBufferBase(target, index, buffer)
+}
+
+// bind a range within a buffer object to an indexed buffer target
+func (ctx *Context) BindBufferRange(target uint32, index uint32, buffer uint32, offset int, size int) {
+	purego.SyscallN(ctx.gpBindBufferRange, uintptr(target), uintptr(index), uintptr(buffer), uintptr(offset), uintptr(size))
+	if hooked {
+		ctx.called("BindBufferRange", target, index, buffer, offset, size)
+	}
+}
+
+// bind a range within a buffer object to an indexed buffer target
+func BindBufferRange(target uint32, index uint32, buffer uint32, offset int, size int) {
+	defaultContext.BindBufferRange(target, index, buffer, offset, size)
+}
+
+// bind a user-defined varying out variable to a fragment shader color number
+func (ctx *Context) BindFragDataLocation(program uint32, color uint32, name *uint8) {
+	purego.SyscallN(ctx.gpBindFragDataLocation, uintptr(program), uintptr(color), uintptr(unsafe.Pointer(name)))
+	if hooked {
+		ctx.called("BindFragDataLocation", program, color, name)
+	}
+}
+
+// bind a user-defined varying out variable to a fragment shader color number
+func BindFragDataLocation(program uint32, color uint32, name *uint8) {
+	defaultContext.BindFragDataLocation(program, color, name)
+}
+
+// bind a user-defined varying out variable to a fragment shader color number and index
+func (ctx *Context) BindFragDataLocationIndexed(program uint32, colorNumber uint32, index uint32, name *uint8) {
+	purego.SyscallN(ctx.gpBindFragDataLocationIndexed, uintptr(program), uintptr(colorNumber), uintptr(index), uintptr(unsafe.Pointer(name)))
+	if hooked {
+		ctx.called("BindFragDataLocationIndexed", program, colorNumber, index, name)
+	}
+}
+
+// bind a user-defined varying out variable to a fragment shader color number and index
+func BindFragDataLocationIndexed(program uint32, colorNumber uint32, index uint32, name *uint8) {
+	defaultContext.BindFragDataLocationIndexed(program, colorNumber, index, name)
+}
+
+// bind a framebuffer to a framebuffer target
+func (ctx *Context) BindFramebuffer(target uint32, framebuffer uint32) {
+	purego.SyscallN(ctx.gpBindFramebuffer, uintptr(target), uintptr(framebuffer))
+	if hooked {
+		ctx.called("BindFramebuffer", target, framebuffer)
+	}
+}
+
+// bind a framebuffer to a framebuffer target
+func BindFramebuffer(target uint32, framebuffer uint32) {
+	defaultContext.BindFramebuffer(target, framebuffer)
+}
+
+// bind a program pipeline to the current context
+func (ctx *Context) BindProgramPipeline(pipeline uint32) {
+	purego.SyscallN(ctx.gpBindProgramPipeline, uintptr(pipeline))
+	if hooked {
+		ctx.called("BindProgramPipeline", pipeline)
+	}
+}
+
+// bind a program pipeline to the current context
+func BindProgramPipeline(pipeline uint32) {
+	defaultContext.BindProgramPipeline(pipeline)
+}
+
+// bind a renderbuffer to a renderbuffer target
+func (ctx *Context) BindRenderbuffer(target uint32, renderbuffer uint32) {
+	purego.SyscallN(ctx.gpBindRenderbuffer, uintptr(target), uintptr(renderbuffer))
+	if hooked {
+		ctx.called("BindRenderbuffer", target, renderbuffer)
+	}
+}
+
+// bind a renderbuffer to a renderbuffer target
+func BindRenderbuffer(target uint32, renderbuffer uint32) {
+	defaultContext.BindRenderbuffer(target, renderbuffer)
+}
+
+// bind a named sampler to a texturing target
+func (ctx *Context) BindSampler(unit uint32, sampler uint32) {
+	purego.SyscallN(ctx.gpBindSampler, uintptr(unit), uintptr(sampler))
+	if hooked {
+		ctx.called("BindSampler", unit, sampler)
+	}
+}
+
+// bind a named sampler to a texturing target
+func BindSampler(unit uint32, sampler uint32) {
+	defaultContext.BindSampler(unit, sampler)
+}
+
+// bind a named texture to a texturing target
+func (ctx *Context) BindTexture(target uint32, texture uint32) {
+	purego.SyscallN(ctx.gpBindTexture, uintptr(target), uintptr(texture))
+	if hooked {
+		ctx.called("BindTexture", target, texture)
+	}
+}
+
+// bind a named texture to a texturing target
+func BindTexture(target uint32, texture uint32) {
+	defaultContext.BindTexture(target, texture)
+}
+
+// bind a transform feedback object
+func (ctx *Context) BindTransformFeedback(target uint32, id uint32) {
+	purego.SyscallN(ctx.gpBindTransformFeedback, uintptr(target), uintptr(id))
+	if hooked {
+		ctx.called("BindTransformFeedback", target, id)
+	}
+}
+
+// bind a transform feedback object
+func BindTransformFeedback(target uint32, id uint32) {
+	defaultContext.BindTransformFeedback(target, id)
+}
+
+// bind a vertex array object
+func (ctx *Context) BindVertexArray(array uint32) {
+	purego.SyscallN(ctx.gpBindVertexArray, uintptr(array))
+	if hooked {
+		ctx.called("BindVertexArray", array)
+	}
+}
+
+// bind a vertex array object
+func BindVertexArray(array uint32) {
+	defaultContext.BindVertexArray(array)
+}
+
+// set the blend color
+func (ctx *Context) BlendColor(red float32, green float32, blue float32, alpha float32) {
+	ctx.fpBlendColor(red, green, blue, alpha)
+	if hooked {
+		ctx.called("BlendColor", red, green, blue, alpha)
+	}
+}
+
+// set the blend color
+func BlendColor(red float32, green float32, blue float32, alpha float32) {
+	defaultContext.BlendColor(red, green, blue, alpha)
+}
+
+// specify the equation used for both the RGB blend equation and the Alpha blend equation
+func (ctx *Context) BlendEquation(mode uint32) {
+	purego.SyscallN(ctx.gpBlendEquation, uintptr(mode))
+	if hooked {
+		ctx.called("BlendEquation", mode)
+	}
+}
+
+// specify the equation used for both the RGB blend equation and the Alpha blend equation
+func BlendEquation(mode uint32) {
+	defaultContext.BlendEquation(mode)
+}
+
+// set the RGB blend equation and the alpha blend equation separately
+func (ctx *Context) BlendEquationSeparate(modeRGB uint32, modeAlpha uint32) {
+	purego.SyscallN(ctx.gpBlendEquationSeparate, uintptr(modeRGB), uintptr(modeAlpha))
+	if hooked {
+		ctx.called("BlendEquationSeparate", modeRGB, modeAlpha)
+	}
+}
+
+// set the RGB blend equation and the alpha blend equation separately
+func BlendEquationSeparate(modeRGB uint32, modeAlpha uint32) {
+	defaultContext.BlendEquationSeparate(modeRGB, modeAlpha)
+}
+
+func (ctx *Context) BlendEquationSeparatei(buf uint32, modeRGB uint32, modeAlpha uint32) {
+	purego.SyscallN(ctx.gpBlendEquationSeparatei, uintptr(buf), uintptr(modeRGB), uintptr(modeAlpha))
+	if hooked {
+		ctx.called("BlendEquationSeparatei", buf, modeRGB, modeAlpha)
+	}
+}
+
+func BlendEquationSeparatei(buf uint32, modeRGB uint32, modeAlpha uint32) {
+	defaultContext.BlendEquationSeparatei(buf, modeRGB, modeAlpha)
+}
+
+func (ctx *Context) BlendEquationi(buf uint32, mode uint32) {
+	purego.SyscallN(ctx.gpBlendEquationi, uintptr(buf), uintptr(mode))
+	if hooked {
+		ctx.called("BlendEquationi", buf, mode)
+	}
+}
+
+func BlendEquationi(buf uint32, mode uint32) {
+	defaultContext.BlendEquationi(buf, mode)
+}
+
+// specify pixel arithmetic
+func (ctx *Context) BlendFunc(sfactor uint32, dfactor uint32) {
+	purego.SyscallN(ctx.gpBlendFunc, uintptr(sfactor), uintptr(dfactor))
+	if hooked {
+		ctx.called("BlendFunc", sfactor, dfactor)
+	}
+}
+
+// specify pixel arithmetic
+func BlendFunc(sfactor uint32, dfactor uint32) {
+	defaultContext.BlendFunc(sfactor, dfactor)
+}
+
+// specify pixel arithmetic for RGB and alpha components separately
+func (ctx *Context) BlendFuncSeparate(sfactorRGB uint32, dfactorRGB uint32, sfactorAlpha uint32, dfactorAlpha uint32) {
+	purego.SyscallN(ctx.gpBlendFuncSeparate, uintptr(sfactorRGB), uintptr(dfactorRGB), uintptr(sfactorAlpha), uintptr(dfactorAlpha))
+	if hooked {
+		ctx.called("BlendFuncSeparate", sfactorRGB, dfactorRGB, sfactorAlpha, dfactorAlpha)
+	}
+}
+
+// specify pixel arithmetic for RGB and alpha components separately
+func BlendFuncSeparate(sfactorRGB uint32, dfactorRGB uint32, sfactorAlpha uint32, dfactorAlpha uint32) {
+	defaultContext.BlendFuncSeparate(sfactorRGB, dfactorRGB, sfactorAlpha, dfactorAlpha)
+}
+
+func (ctx *Context) BlendFuncSeparatei(buf uint32, srcRGB uint32, dstRGB uint32, srcAlpha uint32, dstAlpha uint32) {
+	purego.SyscallN(ctx.gpBlendFuncSeparatei, uintptr(buf), uintptr(srcRGB), uintptr(dstRGB), uintptr(srcAlpha), uintptr(dstAlpha))
+	if hooked {
+		ctx.called("BlendFuncSeparatei", buf, srcRGB, dstRGB, srcAlpha, dstAlpha)
+	}
+}
+
+func BlendFuncSeparatei(buf uint32, srcRGB uint32, dstRGB uint32, srcAlpha uint32, dstAlpha uint32) {
+	defaultContext.BlendFuncSeparatei(buf, srcRGB, dstRGB, srcAlpha, dstAlpha)
+}
+
+func (ctx *Context) BlendFunci(buf uint32, src uint32, dst uint32) {
+	purego.SyscallN(ctx.gpBlendFunci, uintptr(buf), uintptr(src), uintptr(dst))
+	if hooked {
+		ctx.called("BlendFunci", buf, src, dst)
+	}
+}
+
+func BlendFunci(buf uint32, src uint32, dst uint32) {
+	defaultContext.BlendFunci(buf, src, dst)
+}
+
+// copy a block of pixels from one framebuffer object to another
+func (ctx *Context) BlitFramebuffer(srcX0 int32, srcY0 int32, srcX1 int32, srcY1 int32, dstX0 int32, dstY0 int32, dstX1 int32, dstY1 int32, mask uint32, filter uint32) {
+	purego.SyscallN(ctx.gpBlitFramebuffer, uintptr(srcX0), uintptr(srcY0), uintptr(srcX1), uintptr(srcY1), uintptr(dstX0), uintptr(dstY0), uintptr(dstX1), uintptr(dstY1), uintptr(mask), uintptr(filter))
+	if hooked {
+		ctx.called("BlitFramebuffer", srcX0, srcY0, srcX1, srcY1, dstX0, dstY0, dstX1, dstY1, mask, filter)
+	}
+}
+
+// copy a block of pixels from one framebuffer object to another
+func BlitFramebuffer(srcX0 int32, srcY0 int32, srcX1 int32, srcY1 int32, dstX0 int32, dstY0 int32, dstX1 int32, dstY1 int32, mask uint32, filter uint32) {
+	defaultContext.BlitFramebuffer(srcX0, srcY0, srcX1, srcY1, dstX0, dstY0, dstX1, dstY1, mask, filter)
+}
+
+// creates and initializes a buffer object's data     store
+func (ctx *Context) BufferData(target uint32, size int, data unsafe.Pointer, usage uint32) {
+	purego.SyscallN(ctx.gpBufferData, uintptr(target), uintptr(size), uintptr(data), uintptr(usage))
+	if hooked {
+		ctx.called("BufferData", target, size, data, usage)
+	}
+}
+
+// creates and initializes a buffer object's data     store
+func BufferData(target uint32, size int, data unsafe.Pointer, usage uint32) {
+	defaultContext.BufferData(target, size, data, usage)
+}
+
+// updates a subset of a buffer object's data store
+func (ctx *Context) BufferSubData(target uint32, offset int, size int, data unsafe.Pointer) {
+	purego.SyscallN(ctx.gpBufferSubData, uintptr(target), uintptr(offset), uintptr(size), uintptr(data))
+	if hooked {
+		ctx.called("BufferSubData", target, offset, size, data)
+	}
+}
+
+// updates a subset of a buffer object's data store
+func BufferSubData(target uint32, offset int, size int, data unsafe.Pointer) {
+	defaultContext.BufferSubData(target, offset, size, data)
+}
+
+// check the completeness status of a framebuffer
+func (ctx *Context) CheckFramebufferStatus(target uint32) uint32 {
+	ret, _, _ := purego.SyscallN(ctx.gpCheckFramebufferStatus, uintptr(target))
+	if hooked {
+		ctx.returned("CheckFramebufferStatus", (uint32)(ret), target)
+	}
+	return (uint32)(ret)
+}
+
+// check the completeness status of a framebuffer
+func CheckFramebufferStatus(target uint32) uint32 {
+	return defaultContext.CheckFramebufferStatus(target)
+}
+
+// specify whether data read via  should be clamped
+func (ctx *Context) ClampColor(target uint32, clamp uint32) {
+	purego.SyscallN(ctx.gpClampColor, uintptr(target), uintptr(clamp))
+	if hooked {
+		ctx.called("ClampColor", target, clamp)
+	}
+}
+
+// specify whether data read via  should be clamped
+func ClampColor(target uint32, clamp uint32) {
+	defaultContext.ClampColor(target, clamp)
+}
+
+// clear buffers to preset values
+func (ctx *Context) Clear(mask uint32) {
+	purego.SyscallN(ctx.gpClear, uintptr(mask))
+	if hooked {
+		ctx.called("Clear", mask)
+	}
+}
+
+// clear buffers to preset values
+func Clear(mask uint32) {
+	defaultContext.Clear(mask)
+}
+
+func (ctx *Context) ClearBufferfi(buffer uint32, drawbuffer int32, depth float32, stencil int32) {
+	ctx.fpClearBufferfi(buffer, drawbuffer, depth, stencil)
+	if hooked {
+		ctx.called("ClearBufferfi", buffer, drawbuffer, depth, stencil)
+	}
+}
+
+func ClearBufferfi(buffer uint32, drawbuffer int32, depth float32, stencil int32) {
+	defaultContext.ClearBufferfi(buffer, drawbuffer, depth, stencil)
+}
+
+func (ctx *Context) ClearBufferfv(buffer uint32, drawbuffer int32, value *float32) {
+	purego.SyscallN(ctx.gpClearBufferfv, uintptr(buffer), uintptr(drawbuffer), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ClearBufferfv", buffer, drawbuffer, value)
+	}
+}
+
+func ClearBufferfv(buffer uint32, drawbuffer int32, value *float32) {
+	defaultContext.ClearBufferfv(buffer, drawbuffer, value)
+}
+
+func (ctx *Context) ClearBufferiv(buffer uint32, drawbuffer int32, value *int32) {
+	purego.SyscallN(ctx.gpClearBufferiv, uintptr(buffer), uintptr(drawbuffer), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ClearBufferiv", buffer, drawbuffer, value)
+	}
+}
+
+func ClearBufferiv(buffer uint32, drawbuffer int32, value *int32) {
+	defaultContext.ClearBufferiv(buffer, drawbuffer, value)
+}
+
+func (ctx *Context) ClearBufferuiv(buffer uint32, drawbuffer int32, value *uint32) {
+	purego.SyscallN(ctx.gpClearBufferuiv, uintptr(buffer), uintptr(drawbuffer), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ClearBufferuiv", buffer, drawbuffer, value)
+	}
+}
+
+func ClearBufferuiv(buffer uint32, drawbuffer int32, value *uint32) {
+	defaultContext.ClearBufferuiv(buffer, drawbuffer, value)
+}
+
+// specify clear values for the color buffers
+func (ctx *Context) ClearColor(red float32, green float32, blue float32, alpha float32) {
+	ctx.fpClearColor(red, green, blue, alpha)
+	if hooked {
+		ctx.called("ClearColor", red, green, blue, alpha)
+	}
+}
+
+// specify clear values for the color buffers
+func ClearColor(red float32, green float32, blue float32, alpha float32) {
+	defaultContext.ClearColor(red, green, blue, alpha)
+}
+
+// specify the clear value for the depth buffer
+func (ctx *Context) ClearDepth(depth float64) {
+	ctx.fpClearDepth(depth)
+	if hooked {
+		ctx.called("ClearDepth", depth)
+	}
+}
+
+// specify the clear value for the depth buffer
+func ClearDepth(depth float64) {
+	defaultContext.ClearDepth(depth)
+}
+
+// specify the clear value for the depth buffer
+func (ctx *Context) ClearDepthf(d float32) {
+	ctx.fpClearDepthf(d)
+	if hooked {
+		ctx.called("ClearDepthf", d)
+	}
+}
+
+// specify the clear value for the depth buffer
+func ClearDepthf(d float32) {
+	defaultContext.ClearDepthf(d)
+}
+
+// specify the clear value for the stencil buffer
+func (ctx *Context) ClearStencil(s int32) {
+	purego.SyscallN(ctx.gpClearStencil, uintptr(s))
+	if hooked {
+		ctx.called("ClearStencil", s)
+	}
+}
+
+// specify the clear value for the stencil buffer
+func ClearStencil(s int32) {
+	defaultContext.ClearStencil(s)
+}
+
+// block and wait for a sync object to become signaled
+func (ctx *Context) ClientWaitSync(sync uintptr, flags uint32, timeout uint64) uint32 {
+	ret, _, _ := purego.SyscallN(ctx.gpClientWaitSync, uintptr(sync), uintptr(flags), uintptr(timeout))
+	if hooked {
+		ctx.returned("ClientWaitSync", (uint32)(ret), sync, flags, timeout)
+	}
+	return (uint32)(ret)
+}
+
+// block and wait for a sync object to become signaled
+func ClientWaitSync(sync uintptr, flags uint32, timeout uint64) uint32 {
+	return defaultContext.ClientWaitSync(sync, flags, timeout)
+}
+
+func (ctx *Context) ColorMask(red bool, green bool, blue bool, alpha bool) {
+	purego.SyscallN(ctx.gpColorMask, boolToUintptr(red), boolToUintptr(green), boolToUintptr(blue), boolToUintptr(alpha))
+	if hooked {
+		ctx.called("ColorMask", red, green, blue, alpha)
+	}
+}
+
+func ColorMask(red bool, green bool, blue bool, alpha bool) {
+	defaultContext.ColorMask(red, green, blue, alpha)
+}
+
+func (ctx *Context) ColorMaski(index uint32, r bool, g bool, b bool, a bool) {
+	purego.SyscallN(ctx.gpColorMaski, uintptr(index), boolToUintptr(r), boolToUintptr(g), boolToUintptr(b), boolToUintptr(a))
+	if hooked {
+		ctx.called("ColorMaski", index, r, g, b, a)
+	}
+}
+
+func ColorMaski(index uint32, r bool, g bool, b bool, a bool) {
+	defaultContext.ColorMaski(index, r, g, b, a)
+}
+
+// Compiles a shader object
+func (ctx *Context) CompileShader(shader uint32) {
+	purego.SyscallN(ctx.gpCompileShader, uintptr(shader))
+	if hooked {
+		ctx.called("CompileShader", shader)
+	}
+}
+
+// Compiles a shader object
+func CompileShader(shader uint32) {
+	defaultContext.CompileShader(shader)
+}
+
+// specify a one-dimensional texture image in a compressed format
+func (ctx *Context) CompressedTexImage1D(target uint32, level int32, internalformat uint32, width int32, border int32, imageSize int32, data unsafe.Pointer) {
+	purego.SyscallN(ctx.gpCompressedTexImage1D, uintptr(target), uintptr(level), uintptr(internalformat), uintptr(width), uintptr(border), uintptr(imageSize), uintptr(data))
+	if hooked {
+		ctx.called("CompressedTexImage1D", target, level, internalformat, width, border, imageSize, data)
+	}
+}
+
+// specify a one-dimensional texture image in a compressed format
+func CompressedTexImage1D(target uint32, level int32, internalformat uint32, width int32, border int32, imageSize int32, data unsafe.Pointer) {
+	defaultContext.CompressedTexImage1D(target, level, internalformat, width, border, imageSize, data)
+}
+
+// specify a two-dimensional texture image in a compressed format
+func (ctx *Context) CompressedTexImage2D(target uint32, level int32, internalformat uint32, width int32, height int32, border int32, imageSize int32, data unsafe.Pointer) {
+	purego.SyscallN(ctx.gpCompressedTexImage2D, uintptr(target), uintptr(level), uintptr(internalformat), uintptr(width), uintptr(height), uintptr(border), uintptr(imageSize), uintptr(data))
+	if hooked {
+		ctx.called("CompressedTexImage2D", target, level, internalformat, width, height, border, imageSize, data)
+	}
+}
+
+// specify a two-dimensional texture image in a compressed format
+func CompressedTexImage2D(target uint32, level int32, internalformat uint32, width int32, height int32, border int32, imageSize int32, data unsafe.Pointer) {
+	defaultContext.CompressedTexImage2D(target, level, internalformat, width, height, border, imageSize, data)
+}
+
+// specify a three-dimensional texture image in a compressed format
+func (ctx *Context) CompressedTexImage3D(target uint32, level int32, internalformat uint32, width int32, height int32, depth int32, border int32, imageSize int32, data unsafe.Pointer) {
+	purego.SyscallN(ctx.gpCompressedTexImage3D, uintptr(target), uintptr(level), uintptr(internalformat), uintptr(width), uintptr(height), uintptr(depth), uintptr(border), uintptr(imageSize), uintptr(data))
+	if hooked {
+		ctx.called("CompressedTexImage3D", target, level, internalformat, width, height, depth, border, imageSize, data)
+	}
+}
+
+// specify a three-dimensional texture image in a compressed format
+func CompressedTexImage3D(target uint32, level int32, internalformat uint32, width int32, height int32, depth int32, border int32, imageSize int32, data unsafe.Pointer) {
+	defaultContext.CompressedTexImage3D(target, level, internalformat, width, height, depth, border, imageSize, data)
+}
+
+// specify a one-dimensional texture subimage in a compressed     format
+func (ctx *Context) CompressedTexSubImage1D(target uint32, level int32, xoffset int32, width int32, format uint32, imageSize int32, data unsafe.Pointer) {
+	purego.SyscallN(ctx.gpCompressedTexSubImage1D, uintptr(target), uintptr(level), uintptr(xoffset), uintptr(width), uintptr(format), uintptr(imageSize), uintptr(data))
+	if hooked {
+		ctx.called("CompressedTexSubImage1D", target, level, xoffset, width, format, imageSize, data)
+	}
+}
+
+// specify a one-dimensional texture subimage in a compressed     format
+func CompressedTexSubImage1D(target uint32, level int32, xoffset int32, width int32, format uint32, imageSize int32, data unsafe.Pointer) {
+	defaultContext.CompressedTexSubImage1D(target, level, xoffset, width, format, imageSize, data)
+}
+
+// specify a two-dimensional texture subimage in a compressed format
+func (ctx *Context) CompressedTexSubImage2D(target uint32, level int32, xoffset int32, yoffset int32, width int32, height int32, format uint32, imageSize int32, data unsafe.Pointer) {
+	purego.SyscallN(ctx.gpCompressedTexSubImage2D, uintptr(target), uintptr(level), uintptr(xoffset), uintptr(yoffset), uintptr(width), uintptr(height), uintptr(format), uintptr(imageSize), uintptr(data))
+	if hooked {
+		ctx.called("CompressedTexSubImage2D", target, level, xoffset, yoffset, width, height, format, imageSize, data)
+	}
+}
+
+// specify a two-dimensional texture subimage in a compressed format
+func CompressedTexSubImage2D(target uint32, level int32, xoffset int32, yoffset int32, width int32, height int32, format uint32, imageSize int32, data unsafe.Pointer) {
+	defaultContext.CompressedTexSubImage2D(target, level, xoffset, yoffset, width, height, format, imageSize, data)
+}
+
+// specify a three-dimensional texture subimage in a compressed format
+func (ctx *Context) CompressedTexSubImage3D(target uint32, level int32, xoffset int32, yoffset int32, zoffset int32, width int32, height int32, depth int32, format uint32, imageSize int32, data unsafe.Pointer) {
+	purego.SyscallN(ctx.gpCompressedTexSubImage3D, uintptr(target), uintptr(level), uintptr(xoffset), uintptr(yoffset), uintptr(zoffset), uintptr(width), uintptr(height), uintptr(depth), uintptr(format), uintptr(imageSize), uintptr(data))
+	if hooked {
+		ctx.called("CompressedTexSubImage3D", target, level, xoffset, yoffset, zoffset, width, height, depth, format, imageSize, data)
+	}
+}
+
+// specify a three-dimensional texture subimage in a compressed format
+func CompressedTexSubImage3D(target uint32, level int32, xoffset int32, yoffset int32, zoffset int32, width int32, height int32, depth int32, format uint32, imageSize int32, data unsafe.Pointer) {
+	defaultContext.CompressedTexSubImage3D(target, level, xoffset, yoffset, zoffset, width, height, depth, format, imageSize, data)
+}
+
+// copy all or part of the data store of a buffer object to the data store of another buffer object
+func (ctx *Context) CopyBufferSubData(readTarget uint32, writeTarget uint32, readOffset int, writeOffset int, size int) {
+	purego.SyscallN(ctx.gpCopyBufferSubData, uintptr(readTarget), uintptr(writeTarget), uintptr(readOffset), uintptr(writeOffset), uintptr(size))
+	if hooked {
+		ctx.called("CopyBufferSubData", readTarget, writeTarget, readOffset, writeOffset, size)
+	}
+}
+
+// copy all or part of the data store of a buffer object to the data store of another buffer object
+func CopyBufferSubData(readTarget uint32, writeTarget uint32, readOffset int, writeOffset int, size int) {
+	defaultContext.CopyBufferSubData(readTarget, writeTarget, readOffset, writeOffset, size)
+}
+
+// copy pixels into a 1D texture image
+func (ctx *Context) CopyTexImage1D(target uint32, level int32, internalformat uint32, x int32, y int32, width int32, border int32) {
+	purego.SyscallN(ctx.gpCopyTexImage1D, uintptr(target), uintptr(level), uintptr(internalformat), uintptr(x), uintptr(y), uintptr(width), uintptr(border))
+	if hooked {
+		ctx.called("CopyTexImage1D", target, level, internalformat, x, y, width, border)
+	}
+}
+
+// copy pixels into a 1D texture image
+func CopyTexImage1D(target uint32, level int32, internalformat uint32, x int32, y int32, width int32, border int32) {
+	defaultContext.CopyTexImage1D(target, level, internalformat, x, y, width, border)
+}
+
+// copy pixels into a 2D texture image
+func (ctx *Context) CopyTexImage2D(target uint32, level int32, internalformat uint32, x int32, y int32, width int32, height int32, border int32) {
+	purego.SyscallN(ctx.gpCopyTexImage2D, uintptr(target), uintptr(level), uintptr(internalformat), uintptr(x), uintptr(y), uintptr(width), uintptr(height), uintptr(border))
+	if hooked {
+		ctx.called("CopyTexImage2D", target, level, internalformat, x, y, width, height, border)
+	}
+}
+
+// copy pixels into a 2D texture image
+func CopyTexImage2D(target uint32, level int32, internalformat uint32, x int32, y int32, width int32, height int32, border int32) {
+	defaultContext.CopyTexImage2D(target, level, internalformat, x, y, width, height, border)
+}
+
+// copy a one-dimensional texture subimage
+func (ctx *Context) CopyTexSubImage1D(target uint32, level int32, xoffset int32, x int32, y int32, width int32) {
+	purego.SyscallN(ctx.gpCopyTexSubImage1D, uintptr(target), uintptr(level), uintptr(xoffset), uintptr(x), uintptr(y), uintptr(width))
+	if hooked {
+		ctx.called("CopyTexSubImage1D", target, level, xoffset, x, y, width)
+	}
+}
+
+// copy a one-dimensional texture subimage
+func CopyTexSubImage1D(target uint32, level int32, xoffset int32, x int32, y int32, width int32) {
+	defaultContext.CopyTexSubImage1D(target, level, xoffset, x, y, width)
+}
+
+// copy a two-dimensional texture subimage
+func (ctx *Context) CopyTexSubImage2D(target uint32, level int32, xoffset int32, yoffset int32, x int32, y int32, width int32, height int32) {
+	purego.SyscallN(ctx.gpCopyTexSubImage2D, uintptr(target), uintptr(level), uintptr(xoffset), uintptr(yoffset), uintptr(x), uintptr(y), uintptr(width), uintptr(height))
+	if hooked {
+		ctx.called("CopyTexSubImage2D", target, level, xoffset, yoffset, x, y, width, height)
+	}
+}
+
+// copy a two-dimensional texture subimage
+func CopyTexSubImage2D(target uint32, level int32, xoffset int32, yoffset int32, x int32, y int32, width int32, height int32) {
+	defaultContext.CopyTexSubImage2D(target, level, xoffset, yoffset, x, y, width, height)
+}
+
+// copy a three-dimensional texture subimage
+func (ctx *Context) CopyTexSubImage3D(target uint32, level int32, xoffset int32, yoffset int32, zoffset int32, x int32, y int32, width int32, height int32) {
+	purego.SyscallN(ctx.gpCopyTexSubImage3D, uintptr(target), uintptr(level), uintptr(xoffset), uintptr(yoffset), uintptr(zoffset), uintptr(x), uintptr(y), uintptr(width), uintptr(height))
+	if hooked {
+		ctx.called("CopyTexSubImage3D", target, level, xoffset, yoffset, zoffset, x, y, width, height)
+	}
+}
+
+// copy a three-dimensional texture subimage
+func CopyTexSubImage3D(target uint32, level int32, xoffset int32, yoffset int32, zoffset int32, x int32, y int32, width int32, height int32) {
+	defaultContext.CopyTexSubImage3D(target, level, xoffset, yoffset, zoffset, x, y, width, height)
+}
+
+// Creates a program object
+func (ctx *Context) CreateProgram() uint32 {
+	ret, _, _ := purego.SyscallN(ctx.gpCreateProgram)
+	if hooked {
+		ctx.returned("CreateProgram", (uint32)(ret))
+	}
+	return (uint32)(ret)
+}
+
+// Creates a program object
+func CreateProgram() uint32 {
+	return defaultContext.CreateProgram()
+}
+
+// Creates a shader object
+func (ctx *Context) CreateShader(xtype uint32) uint32 {
+	ret, _, _ := purego.SyscallN(ctx.gpCreateShader, uintptr(xtype))
+	if hooked {
+		ctx.returned("CreateShader", (uint32)(ret), xtype)
+	}
+	return (uint32)(ret)
+}
+
+// Creates a shader object
+func CreateShader(xtype uint32) uint32 {
+	return defaultContext.CreateShader(xtype)
+}
+
+// create a stand-alone program from an array of null-terminated source code strings
+func (ctx *Context) CreateShaderProgramv(xtype uint32, count int32, strings **uint8) uint32 {
+	ret, _, _ := purego.SyscallN(ctx.gpCreateShaderProgramv, uintptr(xtype), uintptr(count), uintptr(unsafe.Pointer(strings)))
+	if hooked {
+		ctx.returned("CreateShaderProgramv", (uint32)(ret), xtype, count, strings)
+	}
+	return (uint32)(ret)
+}
+
+// create a stand-alone program from an array of null-terminated source code strings
+func CreateShaderProgramv(xtype uint32, count int32, strings **uint8) uint32 {
+	return defaultContext.CreateShaderProgramv(xtype, count, strings)
+}
+
+// specify whether front- or back-facing facets can be culled
+func (ctx *Context) CullFace(mode uint32) {
+	purego.SyscallN(ctx.gpCullFace, uintptr(mode))
+	if hooked {
+		ctx.called("CullFace", mode)
+	}
+}
+
+// specify whether front- or back-facing facets can be culled
+func CullFace(mode uint32) {
+	defaultContext.CullFace(mode)
+}
+
+// delete named buffer objects
+func (ctx *Context) DeleteBuffers(n int32, buffers *uint32) {
+	purego.SyscallN(ctx.gpDeleteBuffers, uintptr(n), uintptr(unsafe.Pointer(buffers)))
+	if hooked {
+		ctx.called("DeleteBuffers", n, buffers)
+	}
+}
+
+// delete named buffer objects
+func DeleteBuffers(n int32, buffers *uint32) {
+	defaultContext.DeleteBuffers(n, buffers)
+}
+
+// delete framebuffer objects
+func (ctx *Context) DeleteFramebuffers(n int32, framebuffers *uint32) {
+	purego.SyscallN(ctx.gpDeleteFramebuffers, uintptr(n), uintptr(unsafe.Pointer(framebuffers)))
+	if hooked {
+		ctx.called("DeleteFramebuffers", n, framebuffers)
+	}
+}
+
+// delete framebuffer objects
+func DeleteFramebuffers(n int32, framebuffers *uint32) {
+	defaultContext.DeleteFramebuffers(n, framebuffers)
+}
+
+// Deletes a program object
+func (ctx *Context) DeleteProgram(program uint32) {
+	purego.SyscallN(ctx.gpDeleteProgram, uintptr(program))
+	if hooked {
+		ctx.called("DeleteProgram", program)
+	}
+}
+
+// Deletes a program object
+func DeleteProgram(program uint32) {
+	defaultContext.DeleteProgram(program)
+}
+
+// delete program pipeline objects
+func (ctx *Context) DeleteProgramPipelines(n int32, pipelines *uint32) {
+	purego.SyscallN(ctx.gpDeleteProgramPipelines, uintptr(n), uintptr(unsafe.Pointer(pipelines)))
+	if hooked {
+		ctx.called("DeleteProgramPipelines", n, pipelines)
+	}
+}
+
+// delete program pipeline objects
+func DeleteProgramPipelines(n int32, pipelines *uint32) {
+	defaultContext.DeleteProgramPipelines(n, pipelines)
+}
+
+// delete named query objects
+func (ctx *Context) DeleteQueries(n int32, ids *uint32) {
+	purego.SyscallN(ctx.gpDeleteQueries, uintptr(n), uintptr(unsafe.Pointer(ids)))
+	if hooked {
+		ctx.called("DeleteQueries", n, ids)
+	}
+}
+
+// delete named query objects
+func DeleteQueries(n int32, ids *uint32) {
+	defaultContext.DeleteQueries(n, ids)
+}
+
+// delete renderbuffer objects
+func (ctx *Context) DeleteRenderbuffers(n int32, renderbuffers *uint32) {
+	purego.SyscallN(ctx.gpDeleteRenderbuffers, uintptr(n), uintptr(unsafe.Pointer(renderbuffers)))
+	if hooked {
+		ctx.called("DeleteRenderbuffers", n, renderbuffers)
+	}
+}
+
+// delete renderbuffer objects
+func DeleteRenderbuffers(n int32, renderbuffers *uint32) {
+	defaultContext.DeleteRenderbuffers(n, renderbuffers)
+}
+
+// delete named sampler objects
+func (ctx *Context) DeleteSamplers(count int32, samplers *uint32) {
+	purego.SyscallN(ctx.gpDeleteSamplers, uintptr(count), uintptr(unsafe.Pointer(samplers)))
+	if hooked {
+		ctx.called("DeleteSamplers", count, samplers)
+	}
+}
+
+// delete named sampler objects
+func DeleteSamplers(count int32, samplers *uint32) {
+	defaultContext.DeleteSamplers(count, samplers)
+}
+
+// Deletes a shader object
+func (ctx *Context) DeleteShader(shader uint32) {
+	purego.SyscallN(ctx.gpDeleteShader, uintptr(shader))
+	if hooked {
+		ctx.called("DeleteShader", shader)
+	}
+}
+
+// Deletes a shader object
+func DeleteShader(shader uint32) {
+	defaultContext.DeleteShader(shader)
+}
+
+// delete a sync object
+func (ctx *Context) DeleteSync(sync uintptr) {
+	purego.SyscallN(ctx.gpDeleteSync, uintptr(sync))
+	if hooked {
+		ctx.called("DeleteSync", sync)
+	}
+}
+
+// delete a sync object
+func DeleteSync(sync uintptr) {
+	defaultContext.DeleteSync(sync)
+}
+
+// delete named textures
+func (ctx *Context) DeleteTextures(n int32, textures *uint32) {
+	purego.SyscallN(ctx.gpDeleteTextures, uintptr(n), uintptr(unsafe.Pointer(textures)))
+	if hooked {
+		ctx.called("DeleteTextures", n, textures)
+	}
+}
+
+// delete named textures
+func DeleteTextures(n int32, textures *uint32) {
+	defaultContext.DeleteTextures(n, textures)
+}
+
+// delete transform feedback objects
+func (ctx *Context) DeleteTransformFeedbacks(n int32, ids *uint32) {
+	purego.SyscallN(ctx.gpDeleteTransformFeedbacks, uintptr(n), uintptr(unsafe.Pointer(ids)))
+	if hooked {
+		ctx.called("DeleteTransformFeedbacks", n, ids)
+	}
+}
+
+// delete transform feedback objects
+func DeleteTransformFeedbacks(n int32, ids *uint32) {
+	defaultContext.DeleteTransformFeedbacks(n, ids)
+}
+
+// delete vertex array objects
+func (ctx *Context) DeleteVertexArrays(n int32, arrays *uint32) {
+	purego.SyscallN(ctx.gpDeleteVertexArrays, uintptr(n), uintptr(unsafe.Pointer(arrays)))
+	if hooked {
+		ctx.called("DeleteVertexArrays", n, arrays)
+	}
+}
+
+// delete vertex array objects
+func DeleteVertexArrays(n int32, arrays *uint32) {
+	defaultContext.DeleteVertexArrays(n, arrays)
+}
+
+// specify the value used for depth buffer comparisons
+func (ctx *Context) DepthFunc(xfunc uint32) {
+	purego.SyscallN(ctx.gpDepthFunc, uintptr(xfunc))
+	if hooked {
+		ctx.called("DepthFunc", xfunc)
+	}
+}
+
+// specify the value used for depth buffer comparisons
+func DepthFunc(xfunc uint32) {
+	defaultContext.DepthFunc(xfunc)
+}
+
+// enable or disable writing into the depth buffer
+func (ctx *Context) DepthMask(flag bool) {
+	purego.SyscallN(ctx.gpDepthMask, boolToUintptr(flag))
+	if hooked {
+		ctx.called("DepthMask", flag)
+	}
+}
+
+// enable or disable writing into the depth buffer
+func DepthMask(flag bool) {
+	defaultContext.DepthMask(flag)
+}
+
+// specify mapping of depth values from normalized device coordinates to window coordinates
+func (ctx *Context) DepthRange(n float64, f float64) {
+	ctx.fpDepthRange(n, f)
+	if hooked {
+		ctx.called("DepthRange", n, f)
+	}
+}
+
+// specify mapping of depth values from normalized device coordinates to window coordinates
+func DepthRange(n float64, f float64) {
+	defaultContext.DepthRange(n, f)
+}
+
+func (ctx *Context) DepthRangeArrayv(first uint32, count int32, v *float64) {
+	purego.SyscallN(ctx.gpDepthRangeArrayv, uintptr(first), uintptr(count), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("DepthRangeArrayv", first, count, v)
+	}
+}
+
+func DepthRangeArrayv(first uint32, count int32, v *float64) {
+	defaultContext.DepthRangeArrayv(first, count, v)
+}
+
+// specify mapping of depth values from normalized device coordinates to window coordinates for a specified viewport
+func (ctx *Context) DepthRangeIndexed(index uint32, n float64, f float64) {
+	ctx.fpDepthRangeIndexed(index, n, f)
+	if hooked {
+		ctx.called("DepthRangeIndexed", index, n, f)
+	}
+}
+
+// specify mapping of depth values from normalized device coordinates to window coordinates for a specified viewport
+func DepthRangeIndexed(index uint32, n float64, f float64) {
+	defaultContext.DepthRangeIndexed(index, n, f)
+}
+
+// specify mapping of depth values from normalized device coordinates to window coordinates
+func (ctx *Context) DepthRangef(n float32, f float32) {
+	ctx.fpDepthRangef(n, f)
+	if hooked {
+		ctx.called("DepthRangef", n, f)
+	}
+}
+
+// specify mapping of depth values from normalized device coordinates to window coordinates
+func DepthRangef(n float32, f float32) {
+	defaultContext.DepthRangef(n, f)
+}
+
+// Detaches a shader object from a program object to which it is attached
+func (ctx *Context) DetachShader(program uint32, shader uint32) {
+	purego.SyscallN(ctx.gpDetachShader, uintptr(program), uintptr(shader))
+	if hooked {
+		ctx.called("DetachShader", program, shader)
+	}
+}
+
+// Detaches a shader object from a program object to which it is attached
+func DetachShader(program uint32, shader uint32) {
+	defaultContext.DetachShader(program, shader)
+}
+
+func (ctx *Context) Disable(cap uint32) {
+	purego.SyscallN(ctx.gpDisable, uintptr(cap))
+	if hooked {
+		ctx.called("Disable", cap)
+	}
+}
+
+func Disable(cap uint32) {
+	defaultContext.Disable(cap)
+}
+
+// Enable or disable a generic vertex attribute     array
+func (ctx *Context) DisableVertexAttribArray(index uint32) {
+	purego.SyscallN(ctx.gpDisableVertexAttribArray, uintptr(index))
+	if hooked {
+		ctx.called("DisableVertexAttribArray", index)
+	}
+}
+
+// Enable or disable a generic vertex attribute     array
+func DisableVertexAttribArray(index uint32) {
+	defaultContext.DisableVertexAttribArray(index)
+}
+
+func (ctx *Context) Disablei(target uint32, index uint32) {
+	purego.SyscallN(ctx.gpDisablei, uintptr(target), uintptr(index))
+	if hooked {
+		ctx.called("Disablei", target, index)
+	}
+}
+
+func Disablei(target uint32, index uint32) {
+	defaultContext.Disablei(target, index)
+}
+
+// render primitives from array data
+func (ctx *Context) DrawArrays(mode uint32, first int32, count int32) {
+	purego.SyscallN(ctx.gpDrawArrays, uintptr(mode), uintptr(first), uintptr(count))
+	if hooked {
+		ctx.called("DrawArrays", mode, first, count)
+	}
+}
+
+// render primitives from array data
+func DrawArrays(mode uint32, first int32, count int32) {
+	defaultContext.DrawArrays(mode, first, count)
+}
+
+// render primitives from array data, taking parameters from memory
+func (ctx *Context) DrawArraysIndirect(mode uint32, indirect unsafe.Pointer) {
+	purego.SyscallN(ctx.gpDrawArraysIndirect, uintptr(mode), uintptr(indirect))
+	if hooked {
+		ctx.called("DrawArraysIndirect", mode, indirect)
+	}
+}
+
+// render primitives from array data, taking parameters from memory
+func DrawArraysIndirect(mode uint32, indirect unsafe.Pointer) {
+	defaultContext.DrawArraysIndirect(mode, indirect)
+}
+
+// draw multiple instances of a range of elements
+func (ctx *Context) DrawArraysInstanced(mode uint32, first int32, count int32, instancecount int32) {
+	purego.SyscallN(ctx.gpDrawArraysInstanced, uintptr(mode), uintptr(first), uintptr(count), uintptr(instancecount))
+	if hooked {
+		ctx.called("DrawArraysInstanced", mode, first, count, instancecount)
+	}
+}
+
+// draw multiple instances of a range of elements
+func DrawArraysInstanced(mode uint32, first int32, count int32, instancecount int32) {
+	defaultContext.DrawArraysInstanced(mode, first, count, instancecount)
+}
+
+// specify which color buffers are to be drawn into
+func (ctx *Context) DrawBuffer(buf uint32) {
+	purego.SyscallN(ctx.gpDrawBuffer, uintptr(buf))
+	if hooked {
+		ctx.called("DrawBuffer", buf)
+	}
+}
+
+// specify which color buffers are to be drawn into
+func DrawBuffer(buf uint32) {
+	defaultContext.DrawBuffer(buf)
+}
+
+// Specifies a list of color buffers to be drawn     into
+func (ctx *Context) DrawBuffers(n int32, bufs *uint32) {
+	purego.SyscallN(ctx.gpDrawBuffers, uintptr(n), uintptr(unsafe.Pointer(bufs)))
+	if hooked {
+		ctx.called("DrawBuffers", n, bufs)
+	}
+}
+
+// Specifies a list of color buffers to be drawn     into
+func DrawBuffers(n int32, bufs *uint32) {
+	defaultContext.DrawBuffers(n, bufs)
+}
+
+// render primitives from array data
+func (ctx *Context) DrawElements(mode uint32, count int32, xtype uint32, indices unsafe.Pointer) {
+	purego.SyscallN(ctx.gpDrawElements, uintptr(mode), uintptr(count), uintptr(xtype), uintptr(indices))
+	if hooked {
+		ctx.called("DrawElements", mode, count, xtype, indices)
+	}
+}
+
+// render primitives from array data
+func DrawElements(mode uint32, count int32, xtype uint32, indices unsafe.Pointer) {
+	defaultContext.DrawElements(mode, count, xtype, indices)
+}
+
+func (ctx *Context) DrawElementsWithOffset(mode uint32, count int32, xtype uint32, indices uintptr) {
+	purego.SyscallN(ctx.gpDrawElements, uintptr(mode), uintptr(count), uintptr(xtype), uintptr(indices))
+	if hooked {
+		ctx.called("DrawElementsWithOffset", mode, count, xtype, indices)
+	}
+}
+
+func DrawElementsWithOffset(mode uint32, count int32, xtype uint32, indices uintptr) {
+	defaultContext.DrawElementsWithOffset(mode, count, xtype, indices)
+}
+
+// render primitives from array data with a per-element offset
+func (ctx *Context) DrawElementsBaseVertex(mode uint32, count int32, xtype uint32, indices unsafe.Pointer, basevertex int32) {
+	purego.SyscallN(ctx.gpDrawElementsBaseVertex, uintptr(mode), uintptr(count), uintptr(xtype), uintptr(indices), uintptr(basevertex))
+	if hooked {
+		ctx.called("DrawElementsBaseVertex", mode, count, xtype, indices, basevertex)
+	}
+}
+
+// render primitives from array data with a per-element offset
+func DrawElementsBaseVertex(mode uint32, count int32, xtype uint32, indices unsafe.Pointer, basevertex int32) {
+	defaultContext.DrawElementsBaseVertex(mode, count, xtype, indices, basevertex)
+}
+
+// render indexed primitives from array data, taking parameters from memory
+func (ctx *Context) DrawElementsIndirect(mode uint32, xtype uint32, indirect unsafe.Pointer) {
+	purego.SyscallN(ctx.gpDrawElementsIndirect, uintptr(mode), uintptr(xtype), uintptr(indirect))
+	if hooked {
+		ctx.called("DrawElementsIndirect", mode, xtype, indirect)
+	}
+}
+
+// render indexed primitives from array data, taking parameters from memory
+func DrawElementsIndirect(mode uint32, xtype uint32, indirect unsafe.Pointer) {
+	defaultContext.DrawElementsIndirect(mode, xtype, indirect)
+}
+
+// draw multiple instances of a set of elements
+func (ctx *Context) DrawElementsInstanced(mode uint32, count int32, xtype uint32, indices unsafe.Pointer, instancecount int32) {
+	purego.SyscallN(ctx.gpDrawElementsInstanced, uintptr(mode), uintptr(count), uintptr(xtype), uintptr(indices), uintptr(instancecount))
+	if hooked {
+		ctx.called("DrawElementsInstanced", mode, count, xtype, indices, instancecount)
+	}
+}
+
+// draw multiple instances of a set of elements
+func DrawElementsInstanced(mode uint32, count int32, xtype uint32, indices unsafe.Pointer, instancecount int32) {
+	defaultContext.DrawElementsInstanced(mode, count, xtype, indices, instancecount)
+}
+
+// render multiple instances of a set of primitives from array data with a per-element offset
+func (ctx *Context) DrawElementsInstancedBaseVertex(mode uint32, count int32, xtype uint32, indices unsafe.Pointer, instancecount int32, basevertex int32) {
+	purego.SyscallN(ctx.gpDrawElementsInstancedBaseVertex, uintptr(mode), uintptr(count), uintptr(xtype), uintptr(indices), uintptr(instancecount), uintptr(basevertex))
+	if hooked {
+		ctx.called("DrawElementsInstancedBaseVertex", mode, count, xtype, indices, instancecount, basevertex)
+	}
+}
+
+// render multiple instances of a set of primitives from array data with a per-element offset
+func DrawElementsInstancedBaseVertex(mode uint32, count int32, xtype uint32, indices unsafe.Pointer, instancecount int32, basevertex int32) {
+	defaultContext.DrawElementsInstancedBaseVertex(mode, count, xtype, indices, instancecount, basevertex)
+}
+
+// render primitives from array data
+func (ctx *Context) DrawRangeElements(mode uint32, start uint32, end uint32, count int32, xtype uint32, indices unsafe.Pointer) {
+	purego.SyscallN(ctx.gpDrawRangeElements, uintptr(mode), uintptr(start), uintptr(end), uintptr(count), uintptr(xtype), uintptr(indices))
+	if hooked {
+		ctx.called("DrawRangeElements", mode, start, end, count, xtype, indices)
+	}
+}
+
+// render primitives from array data
+func DrawRangeElements(mode uint32, start uint32, end uint32, count int32, xtype uint32, indices unsafe.Pointer) {
+	defaultContext.DrawRangeElements(mode, start, end, count, xtype, indices)
+}
+
+// render primitives from array data with a per-element offset
+func (ctx *Context) DrawRangeElementsBaseVertex(mode uint32, start uint32, end uint32, count int32, xtype uint32, indices unsafe.Pointer, basevertex int32) {
+	purego.SyscallN(ctx.gpDrawRangeElementsBaseVertex, uintptr(mode), uintptr(start), uintptr(end), uintptr(count), uintptr(xtype), uintptr(indices), uintptr(basevertex))
+	if hooked {
+		ctx.called("DrawRangeElementsBaseVertex", mode, start, end, count, xtype, indices, basevertex)
+	}
+}
+
+// render primitives from array data with a per-element offset
+func DrawRangeElementsBaseVertex(mode uint32, start uint32, end uint32, count int32, xtype uint32, indices unsafe.Pointer, basevertex int32) {
+	defaultContext.DrawRangeElementsBaseVertex(mode, start, end, count, xtype, indices, basevertex)
+}
+
+// render primitives using a count derived from a transform feedback object
+func (ctx *Context) DrawTransformFeedback(mode uint32, id uint32) {
+	purego.SyscallN(ctx.gpDrawTransformFeedback, uintptr(mode), uintptr(id))
+	if hooked {
+		ctx.called("DrawTransformFeedback", mode, id)
+	}
+}
+
+// render primitives using a count derived from a transform feedback object
+func DrawTransformFeedback(mode uint32, id uint32) {
+	defaultContext.DrawTransformFeedback(mode, id)
+}
+
+// render primitives using a count derived from a specifed stream of a transform feedback object
+func (ctx *Context) DrawTransformFeedbackStream(mode uint32, id uint32, stream uint32) {
+	purego.SyscallN(ctx.gpDrawTransformFeedbackStream, uintptr(mode), uintptr(id), uintptr(stream))
+	if hooked {
+		ctx.called("DrawTransformFeedbackStream", mode, id, stream)
+	}
+}
+
+// render primitives using a count derived from a specifed stream of a transform feedback object
+func DrawTransformFeedbackStream(mode uint32, id uint32, stream uint32) {
+	defaultContext.DrawTransformFeedbackStream(mode, id, stream)
+}
+
+// enable or disable server-side GL capabilities
+func (ctx *Context) Enable(cap uint32) {
+	purego.SyscallN(ctx.gpEnable, uintptr(cap))
+	if hooked {
+		ctx.called("Enable", cap)
+	}
+}
+
+// enable or disable server-side GL capabilities
+func Enable(cap uint32) {
+	defaultContext.Enable(cap)
+}
+
+// Enable or disable a generic vertex attribute     array
+func (ctx *Context) EnableVertexAttribArray(index uint32) {
+	purego.SyscallN(ctx.gpEnableVertexAttribArray, uintptr(index))
+	if hooked {
+		ctx.called("EnableVertexAttribArray", index)
+	}
+}
+
+// Enable or disable a generic vertex attribute     array
+func EnableVertexAttribArray(index uint32) {
+	defaultContext.EnableVertexAttribArray(index)
+}
+
+func (ctx *Context) Enablei(target uint32, index uint32) {
+	purego.SyscallN(ctx.gpEnablei, uintptr(target), uintptr(index))
+	if hooked {
+		ctx.called("Enablei", target, index)
+	}
+}
+
+func Enablei(target uint32, index uint32) {
+	defaultContext.Enablei(target, index)
+}
+
+func (ctx *Context) EndConditionalRender() {
+	purego.SyscallN(ctx.gpEndConditionalRender)
+	if hooked {
+		ctx.called("EndConditionalRender")
+	}
+}
+
+func EndConditionalRender() {
+	defaultContext.EndConditionalRender()
+}
+
+func (ctx *Context) EndQuery(target uint32) {
+	purego.SyscallN(ctx.gpEndQuery, uintptr(target))
+	if hooked {
+		ctx.called("EndQuery", target)
+	}
+}
+
+func EndQuery(target uint32) {
+	defaultContext.EndQuery(target)
+}
+
+func (ctx *Context) EndQueryIndexed(target uint32, index uint32) {
+	purego.SyscallN(ctx.gpEndQueryIndexed, uintptr(target), uintptr(index))
+	if hooked {
+		ctx.called("EndQueryIndexed", target, index)
+	}
+}
+
+func EndQueryIndexed(target uint32, index uint32) {
+	defaultContext.EndQueryIndexed(target, index)
+}
+
+func (ctx *Context) EndTransformFeedback() {
+	purego.SyscallN(ctx.gpEndTransformFeedback)
+	if hooked {
+		ctx.called("EndTransformFeedback")
+	}
+}
+
+func EndTransformFeedback() {
+	defaultContext.EndTransformFeedback()
+}
+
+// create a new sync object and insert it into the GL command stream
+func (ctx *Context) FenceSync(condition uint32, flags uint32) uintptr {
+	ret, _, _ := purego.SyscallN(ctx.gpFenceSync, uintptr(condition), uintptr(flags))
+	if hooked {
+		ctx.returned("FenceSync", (uintptr)(ret), condition, flags)
+	}
+	return (uintptr)(ret)
+}
+
+// create a new sync object and insert it into the GL command stream
+func FenceSync(condition uint32, flags uint32) uintptr {
+	return defaultContext.FenceSync(condition, flags)
+}
+
+// block until all GL execution is complete
+func (ctx *Context) Finish() {
+	purego.SyscallN(ctx.gpFinish)
+	if hooked {
+		ctx.called("Finish")
+	}
+}
+
+// block until all GL execution is complete
+func Finish() {
+	defaultContext.Finish()
+}
+
+// force execution of GL commands in finite time
+func (ctx *Context) Flush() {
+	purego.SyscallN(ctx.gpFlush)
+	if hooked {
+		ctx.called("Flush")
+	}
+}
+
+// force execution of GL commands in finite time
+func Flush() {
+	defaultContext.Flush()
+}
+
+// indicate modifications to a range of a mapped buffer
+func (ctx *Context) FlushMappedBufferRange(target uint32, offset int, length int) {
+	purego.SyscallN(ctx.gpFlushMappedBufferRange, uintptr(target), uintptr(offset), uintptr(length))
+	if hooked {
+		ctx.called("FlushMappedBufferRange", target, offset, length)
+	}
+}
+
+// indicate modifications to a range of a mapped buffer
+func FlushMappedBufferRange(target uint32, offset int, length int) {
+	defaultContext.FlushMappedBufferRange(target, offset, length)
+}
+
+// attach a renderbuffer as a logical buffer of a framebuffer object
+func (ctx *Context) FramebufferRenderbuffer(target uint32, attachment uint32, renderbuffertarget uint32, renderbuffer uint32) {
+	purego.SyscallN(ctx.gpFramebufferRenderbuffer, uintptr(target), uintptr(attachment), uintptr(renderbuffertarget), uintptr(renderbuffer))
+	if hooked {
+		ctx.called("FramebufferRenderbuffer", target, attachment, renderbuffertarget, renderbuffer)
+	}
+}
+
+// attach a renderbuffer as a logical buffer of a framebuffer object
+func FramebufferRenderbuffer(target uint32, attachment uint32, renderbuffertarget uint32, renderbuffer uint32) {
+	defaultContext.FramebufferRenderbuffer(target, attachment, renderbuffertarget, renderbuffer)
+}
+
+// attach a level of a texture object as a logical buffer of a framebuffer object
+func (ctx *Context) FramebufferTexture(target uint32, attachment uint32, texture uint32, level int32) {
+	purego.SyscallN(ctx.gpFramebufferTexture, uintptr(target), uintptr(attachment), uintptr(texture), uintptr(level))
+	if hooked {
+		ctx.called("FramebufferTexture", target, attachment, texture, level)
+	}
+}
+
+// attach a level of a texture object as a logical buffer of a framebuffer object
+func FramebufferTexture(target uint32, attachment uint32, texture uint32, level int32) {
+	defaultContext.FramebufferTexture(target, attachment, texture, level)
+}
+
+func (ctx *Context) FramebufferTexture1D(target uint32, attachment uint32, textarget uint32, texture uint32, level int32) {
+	purego.SyscallN(ctx.gpFramebufferTexture1D, uintptr(target), uintptr(attachment), uintptr(textarget), uintptr(texture), uintptr(level))
+	if hooked {
+		ctx.called("FramebufferTexture1D", target, attachment, textarget, texture, level)
+	}
+}
+
+func FramebufferTexture1D(target uint32, attachment uint32, textarget uint32, texture uint32, level int32) {
+	defaultContext.FramebufferTexture1D(target, attachment, textarget, texture, level)
+}
+
+// attach a level of a texture object as a logical buffer to the currently bound framebuffer object
+func (ctx *Context) FramebufferTexture2D(target uint32, attachment uint32, textarget uint32, texture uint32, level int32) {
+	purego.SyscallN(ctx.gpFramebufferTexture2D, uintptr(target), uintptr(attachment), uintptr(textarget), uintptr(texture), uintptr(level))
+	if hooked {
+		ctx.called("FramebufferTexture2D", target, attachment, textarget, texture, level)
+	}
+}
+
+// attach a level of a texture object as a logical buffer to the currently bound framebuffer object
+func FramebufferTexture2D(target uint32, attachment uint32, textarget uint32, texture uint32, level int32) {
+	defaultContext.FramebufferTexture2D(target, attachment, textarget, texture, level)
+}
+
+func (ctx *Context) FramebufferTexture3D(target uint32, attachment uint32, textarget uint32, texture uint32, level int32, zoffset int32) {
+	purego.SyscallN(ctx.gpFramebufferTexture3D, uintptr(target), uintptr(attachment), uintptr(textarget), uintptr(texture), uintptr(level), uintptr(zoffset))
+	if hooked {
+		ctx.called("FramebufferTexture3D", target, attachment, textarget, texture, level, zoffset)
+	}
+}
+
+func FramebufferTexture3D(target uint32, attachment uint32, textarget uint32, texture uint32, level int32, zoffset int32) {
+	defaultContext.FramebufferTexture3D(target, attachment, textarget, texture, level, zoffset)
+}
+
+// attach a single layer of a texture object as a logical buffer of a framebuffer object
+func (ctx *Context) FramebufferTextureLayer(target uint32, attachment uint32, texture uint32, level int32, layer int32) {
+	purego.SyscallN(ctx.gpFramebufferTextureLayer, uintptr(target), uintptr(attachment), uintptr(texture), uintptr(level), uintptr(layer))
+	if hooked {
+		ctx.called("FramebufferTextureLayer", target, attachment, texture, level, layer)
+	}
+}
+
+// attach a single layer of a texture object as a logical buffer of a framebuffer object
+func FramebufferTextureLayer(target uint32, attachment uint32, texture uint32, level int32, layer int32) {
+	defaultContext.FramebufferTextureLayer(target, attachment, texture, level, layer)
+}
+
+// define front- and back-facing polygons
+func (ctx *Context) FrontFace(mode uint32) {
+	purego.SyscallN(ctx.gpFrontFace, uintptr(mode))
+	if hooked {
+		ctx.called("FrontFace", mode)
+	}
+}
+
+// define front- and back-facing polygons
+func FrontFace(mode uint32) {
+	defaultContext.FrontFace(mode)
+}
+
+// generate buffer object names
+func (ctx *Context) GenBuffers(n int32, buffers *uint32) {
+	purego.SyscallN(ctx.gpGenBuffers, uintptr(n), uintptr(unsafe.Pointer(buffers)))
+	if hooked {
+		ctx.called("GenBuffers", n, buffers)
+	}
+}
+
+// generate buffer object names
+func GenBuffers(n int32, buffers *uint32) {
+	defaultContext.GenBuffers(n, buffers)
+}
+
+// generate framebuffer object names
+func (ctx *Context) GenFramebuffers(n int32, framebuffers *uint32) {
+	purego.SyscallN(ctx.gpGenFramebuffers, uintptr(n), uintptr(unsafe.Pointer(framebuffers)))
+	if hooked {
+		ctx.called("GenFramebuffers", n, framebuffers)
+	}
+}
+
+// generate framebuffer object names
+func GenFramebuffers(n int32, framebuffers *uint32) {
+	defaultContext.GenFramebuffers(n, framebuffers)
+}
+
+// reserve program pipeline object names
+func (ctx *Context) GenProgramPipelines(n int32, pipelines *uint32) {
+	purego.SyscallN(ctx.gpGenProgramPipelines, uintptr(n), uintptr(unsafe.Pointer(pipelines)))
+	if hooked {
+		ctx.called("GenProgramPipelines", n, pipelines)
+	}
+}
+
+// reserve program pipeline object names
+func GenProgramPipelines(n int32, pipelines *uint32) {
+	defaultContext.GenProgramPipelines(n, pipelines)
+}
+
+// generate query object names
+func (ctx *Context) GenQueries(n int32, ids *uint32) {
+	purego.SyscallN(ctx.gpGenQueries, uintptr(n), uintptr(unsafe.Pointer(ids)))
+	if hooked {
+		ctx.called("GenQueries", n, ids)
+	}
+}
+
+// generate query object names
+func GenQueries(n int32, ids *uint32) {
+	defaultContext.GenQueries(n, ids)
+}
+
+// generate renderbuffer object names
+func (ctx *Context) GenRenderbuffers(n int32, renderbuffers *uint32) {
+	purego.SyscallN(ctx.gpGenRenderbuffers, uintptr(n), uintptr(unsafe.Pointer(renderbuffers)))
+	if hooked {
+		ctx.called("GenRenderbuffers", n, renderbuffers)
+	}
+}
+
+// generate renderbuffer object names
+func GenRenderbuffers(n int32, renderbuffers *uint32) {
+	defaultContext.GenRenderbuffers(n, renderbuffers)
+}
+
+// generate sampler object names
+func (ctx *Context) GenSamplers(count int32, samplers *uint32) {
+	purego.SyscallN(ctx.gpGenSamplers, uintptr(count), uintptr(unsafe.Pointer(samplers)))
+	if hooked {
+		ctx.called("GenSamplers", count, samplers)
+	}
+}
+
+// generate sampler object names
+func GenSamplers(count int32, samplers *uint32) {
+	defaultContext.GenSamplers(count, samplers)
+}
+
+// generate texture names
+func (ctx *Context) GenTextures(n int32, textures *uint32) {
+	purego.SyscallN(ctx.gpGenTextures, uintptr(n), uintptr(unsafe.Pointer(textures)))
+	if hooked {
+		ctx.called("GenTextures", n, textures)
+	}
+}
+
+// generate texture names
+func GenTextures(n int32, textures *uint32) {
+	defaultContext.GenTextures(n, textures)
+}
+
+// reserve transform feedback object names
+func (ctx *Context) GenTransformFeedbacks(n int32, ids *uint32) {
+	purego.SyscallN(ctx.gpGenTransformFeedbacks, uintptr(n), uintptr(unsafe.Pointer(ids)))
+	if hooked {
+		ctx.called("GenTransformFeedbacks", n, ids)
+	}
+}
+
+// reserve transform feedback object names
+func GenTransformFeedbacks(n int32, ids *uint32) {
+	defaultContext.GenTransformFeedbacks(n, ids)
+}
+
+// generate vertex array object names
+func (ctx *Context) GenVertexArrays(n int32, arrays *uint32) {
+	purego.SyscallN(ctx.gpGenVertexArrays, uintptr(n), uintptr(unsafe.Pointer(arrays)))
+	if hooked {
+		ctx.called("GenVertexArrays", n, arrays)
+	}
+}
+
+// generate vertex array object names
+func GenVertexArrays(n int32, arrays *uint32) {
+	defaultContext.GenVertexArrays(n, arrays)
+}
+
+// generate mipmaps for a specified texture object
+func (ctx *Context) GenerateMipmap(target uint32) {
+	purego.SyscallN(ctx.gpGenerateMipmap, uintptr(target))
+	if hooked {
+		ctx.called("GenerateMipmap", target)
+	}
+}
+
+// generate mipmaps for a specified texture object
+func GenerateMipmap(target uint32) {
+	defaultContext.GenerateMipmap(target)
+}
+
+// Returns information about an active attribute variable for the specified program object
+func (ctx *Context) GetActiveAttrib(program uint32, index uint32, bufSize int32, length *int32, size *int32, xtype *uint32, name *uint8) {
+	purego.SyscallN(ctx.gpGetActiveAttrib, uintptr(program), uintptr(index), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(size)), uintptr(unsafe.Pointer(xtype)), uintptr(unsafe.Pointer(name)))
+	if hooked {
+		ctx.called("GetActiveAttrib", program, index, bufSize, length, size, xtype, name)
+	}
+}
+
+// Returns information about an active attribute variable for the specified program object
+func GetActiveAttrib(program uint32, index uint32, bufSize int32, length *int32, size *int32, xtype *uint32, name *uint8) {
+	defaultContext.GetActiveAttrib(program, index, bufSize, length, size, xtype, name)
+}
+
+// query the name of an active shader subroutine
+func (ctx *Context) GetActiveSubroutineName(program uint32, shadertype uint32, index uint32, bufsize int32, length *int32, name *uint8) {
+	purego.SyscallN(ctx.gpGetActiveSubroutineName, uintptr(program), uintptr(shadertype), uintptr(index), uintptr(bufsize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(name)))
+	if hooked {
+		ctx.called("GetActiveSubroutineName", program, shadertype, index, bufsize, length, name)
+	}
+}
+
+// query the name of an active shader subroutine
+func GetActiveSubroutineName(program uint32, shadertype uint32, index uint32, bufsize int32, length *int32, name *uint8) {
+	defaultContext.GetActiveSubroutineName(program, shadertype, index, bufsize, length, name)
+}
+
+// query the name of an active shader subroutine uniform
+func (ctx *Context) GetActiveSubroutineUniformName(program uint32, shadertype uint32, index uint32, bufsize int32, length *int32, name *uint8) {
+	purego.SyscallN(ctx.gpGetActiveSubroutineUniformName, uintptr(program), uintptr(shadertype), uintptr(index), uintptr(bufsize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(name)))
+	if hooked {
+		ctx.called("GetActiveSubroutineUniformName", program, shadertype, index, bufsize, length, name)
+	}
+}
+
+// query the name of an active shader subroutine uniform
+func GetActiveSubroutineUniformName(program uint32, shadertype uint32, index uint32, bufsize int32, length *int32, name *uint8) {
+	defaultContext.GetActiveSubroutineUniformName(program, shadertype, index, bufsize, length, name)
+}
+
+func (ctx *Context) GetActiveSubroutineUniformiv(program uint32, shadertype uint32, index uint32, pname uint32, values *int32) {
+	purego.SyscallN(ctx.gpGetActiveSubroutineUniformiv, uintptr(program), uintptr(shadertype), uintptr(index), uintptr(pname), uintptr(unsafe.Pointer(values)))
+	if hooked {
+		ctx.called("GetActiveSubroutineUniformiv", program, shadertype, index, pname, values)
+	}
+}
+
+func GetActiveSubroutineUniformiv(program uint32, shadertype uint32, index uint32, pname uint32, values *int32) {
+	defaultContext.GetActiveSubroutineUniformiv(program, shadertype, index, pname, values)
+}
+
+// Returns information about an active uniform variable for the specified program object
+func (ctx *Context) GetActiveUniform(program uint32, index uint32, bufSize int32, length *int32, size *int32, xtype *uint32, name *uint8) {
+	purego.SyscallN(ctx.gpGetActiveUniform, uintptr(program), uintptr(index), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(size)), uintptr(unsafe.Pointer(xtype)), uintptr(unsafe.Pointer(name)))
+	if hooked {
+		ctx.called("GetActiveUniform", program, index, bufSize, length, size, xtype, name)
+	}
+}
+
+// Returns information about an active uniform variable for the specified program object
+func GetActiveUniform(program uint32, index uint32, bufSize int32, length *int32, size *int32, xtype *uint32, name *uint8) {
+	defaultContext.GetActiveUniform(program, index, bufSize, length, size, xtype, name)
+}
+
+// retrieve the name of an active uniform block
+func (ctx *Context) GetActiveUniformBlockName(program uint32, uniformBlockIndex uint32, bufSize int32, length *int32, uniformBlockName *uint8) {
+	purego.SyscallN(ctx.gpGetActiveUniformBlockName, uintptr(program), uintptr(uniformBlockIndex), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(uniformBlockName)))
+	if hooked {
+		ctx.called("GetActiveUniformBlockName", program, uniformBlockIndex, bufSize, length, uniformBlockName)
+	}
+}
+
+// retrieve the name of an active uniform block
+func GetActiveUniformBlockName(program uint32, uniformBlockIndex uint32, bufSize int32, length *int32, uniformBlockName *uint8) {
+	defaultContext.GetActiveUniformBlockName(program, uniformBlockIndex, bufSize, length, uniformBlockName)
+}
+
+// query information about an active uniform block
+func (ctx *Context) GetActiveUniformBlockiv(program uint32, uniformBlockIndex uint32, pname uint32, params *int32) {
+	purego.SyscallN(ctx.gpGetActiveUniformBlockiv, uintptr(program), uintptr(uniformBlockIndex), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetActiveUniformBlockiv", program, uniformBlockIndex, pname, params)
+	}
+}
+
+// query information about an active uniform block
+func GetActiveUniformBlockiv(program uint32, uniformBlockIndex uint32, pname uint32, params *int32) {
+	defaultContext.GetActiveUniformBlockiv(program, uniformBlockIndex, pname, params)
+}
+
+// query the name of an active uniform
+func (ctx *Context) GetActiveUniformName(program uint32, uniformIndex uint32, bufSize int32, length *int32, uniformName *uint8) {
+	purego.SyscallN(ctx.gpGetActiveUniformName, uintptr(program), uintptr(uniformIndex), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(uniformName)))
+	if hooked {
+		ctx.called("GetActiveUniformName", program, uniformIndex, bufSize, length, uniformName)
+	}
+}
+
+// query the name of an active uniform
+func GetActiveUniformName(program uint32, uniformIndex uint32, bufSize int32, length *int32, uniformName *uint8) {
+	defaultContext.GetActiveUniformName(program, uniformIndex, bufSize, length, uniformName)
+}
+
+// Returns information about several active uniform variables for the specified program object
+func (ctx *Context) GetActiveUniformsiv(program uint32, uniformCount int32, uniformIndices *uint32, pname uint32, params *int32) {
+	purego.SyscallN(ctx.gpGetActiveUniformsiv, uintptr(program), uintptr(uniformCount), uintptr(unsafe.Pointer(uniformIndices)), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetActiveUniformsiv", program, uniformCount, uniformIndices, pname, params)
+	}
+}
+
+// Returns information about several active uniform variables for the specified program object
+func GetActiveUniformsiv(program uint32, uniformCount int32, uniformIndices *uint32, pname uint32, params *int32) {
+	defaultContext.GetActiveUniformsiv(program, uniformCount, uniformIndices, pname, params)
+}
+
+// Returns the handles of the shader objects attached to a program object
+func (ctx *Context) GetAttachedShaders(program uint32, maxCount int32, count *int32, shaders *uint32) {
+	purego.SyscallN(ctx.gpGetAttachedShaders, uintptr(program), uintptr(maxCount), uintptr(unsafe.Pointer(count)), uintptr(unsafe.Pointer(shaders)))
+	if hooked {
+		ctx.called("GetAttachedShaders", program, maxCount, count, shaders)
+	}
+}
+
+// Returns the handles of the shader objects attached to a program object
+func GetAttachedShaders(program uint32, maxCount int32, count *int32, shaders *uint32) {
+	defaultContext.GetAttachedShaders(program, maxCount, count, shaders)
+}
+
+// Returns the location of an attribute variable
+func (ctx *Context) GetAttribLocation(program uint32, name *uint8) int32 {
+	ret, _, _ := purego.SyscallN(ctx.gpGetAttribLocation, uintptr(program), uintptr(unsafe.Pointer(name)))
+	if hooked {
+		ctx.returned("GetAttribLocation", (int32)(ret), program, name)
+	}
+	return (int32)(ret)
+}
+
+// Returns the location of an attribute variable
+func GetAttribLocation(program uint32, name *uint8) int32 {
+	return defaultContext.GetAttribLocation(program, name)
+}
+
+func (ctx *Context) GetBooleani_v(target uint32, index uint32, data *bool) {
+	purego.SyscallN(ctx.gpGetBooleani_v, uintptr(target), uintptr(index), uintptr(unsafe.Pointer(data)))
+	if hooked {
+		ctx.called("GetBooleani_v", target, index, data)
+	}
+}
+
+func GetBooleani_v(target uint32, index uint32, data *bool) {
+	defaultContext.GetBooleani_v(target, index, data)
+}
+
+func (ctx *Context) GetBooleanv(pname uint32, data *bool) {
+	purego.SyscallN(ctx.gpGetBooleanv, uintptr(pname), uintptr(unsafe.Pointer(data)))
+	if hooked {
+		ctx.called("GetBooleanv", pname, data)
+	}
+}
+
+func GetBooleanv(pname uint32, data *bool) {
+	defaultContext.GetBooleanv(pname, data)
+}
+
+// return parameters of a buffer object
+func (ctx *Context) GetBufferParameteri64v(target uint32, pname uint32, params *int64) {
+	purego.SyscallN(ctx.gpGetBufferParameteri64v, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetBufferParameteri64v", target, pname, params)
+	}
+}
+
+// return parameters of a buffer object
+func GetBufferParameteri64v(target uint32, pname uint32, params *int64) {
+	defaultContext.GetBufferParameteri64v(target, pname, params)
+}
+
+// return parameters of a buffer object
+func (ctx *Context) GetBufferParameteriv(target uint32, pname uint32, params *int32) {
+	purego.SyscallN(ctx.gpGetBufferParameteriv, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetBufferParameteriv", target, pname, params)
+	}
+}
+
+// return parameters of a buffer object
+func GetBufferParameteriv(target uint32, pname uint32, params *int32) {
+	defaultContext.GetBufferParameteriv(target, pname, params)
+}
+
+// return the pointer to a mapped buffer object's data store
+func (ctx *Context) GetBufferPointerv(target uint32, pname uint32, params *unsafe.Pointer) {
+	purego.SyscallN(ctx.gpGetBufferPointerv, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetBufferPointerv", target, pname, params)
+	}
+}
+
+// return the pointer to a mapped buffer object's data store
+func GetBufferPointerv(target uint32, pname uint32, params *unsafe.Pointer) {
+	defaultContext.GetBufferPointerv(target, pname, params)
+}
+
+// returns a subset of a buffer object's data store
+func (ctx *Context) GetBufferSubData(target uint32, offset int, size int, data unsafe.Pointer) {
+	purego.SyscallN(ctx.gpGetBufferSubData, uintptr(target), uintptr(offset), uintptr(size), uintptr(data))
+	if hooked {
+		ctx.called("GetBufferSubData", target, offset, size, data)
+	}
+}
+
+// returns a subset of a buffer object's data store
+func GetBufferSubData(target uint32, offset int, size int, data unsafe.Pointer) {
+	defaultContext.GetBufferSubData(target, offset, size, data)
+}
+
+// return a compressed texture image
+func (ctx *Context) GetCompressedTexImage(target uint32, level int32, img unsafe.Pointer) {
+	purego.SyscallN(ctx.gpGetCompressedTexImage, uintptr(target), uintptr(level), uintptr(img))
+	if hooked {
+		ctx.called("GetCompressedTexImage", target, level, img)
+	}
+}
+
+// return a compressed texture image
+func GetCompressedTexImage(target uint32, level int32, img unsafe.Pointer) {
+	defaultContext.GetCompressedTexImage(target, level, img)
+}
+
+func (ctx *Context) GetDoublei_v(target uint32, index uint32, data *float64) {
+	purego.SyscallN(ctx.gpGetDoublei_v, uintptr(target), uintptr(index), uintptr(unsafe.Pointer(data)))
+	if hooked {
+		ctx.called("GetDoublei_v", target, index, data)
+	}
+}
+
+func GetDoublei_v(target uint32, index uint32, data *float64) {
+	defaultContext.GetDoublei_v(target, index, data)
+}
+
+func (ctx *Context) GetDoublev(pname uint32, data *float64) {
+	purego.SyscallN(ctx.gpGetDoublev, uintptr(pname), uintptr(unsafe.Pointer(data)))
+	if hooked {
+		ctx.called("GetDoublev", pname, data)
+	}
+}
+
+func GetDoublev(pname uint32, data *float64) {
+	defaultContext.GetDoublev(pname, data)
+}
+
+// return error information
+func (ctx *Context) GetError() uint32 {
+	ret, _, _ := purego.SyscallN(ctx.gpGetError)
+	return (uint32)(ret)
+}
+
+// return error information
+func GetError() uint32 {
+	return defaultContext.GetError()
+}
+
+func (ctx *Context) GetFloati_v(target uint32, index uint32, data *float32) {
+	purego.SyscallN(ctx.gpGetFloati_v, uintptr(target), uintptr(index), uintptr(unsafe.Pointer(data)))
+	if hooked {
+		ctx.called("GetFloati_v", target, index, data)
+	}
+}
+
+func GetFloati_v(target uint32, index uint32, data *float32) {
+	defaultContext.GetFloati_v(target, index, data)
+}
+
+func (ctx *Context) GetFloatv(pname uint32, data *float32) {
+	purego.SyscallN(ctx.gpGetFloatv, uintptr(pname), uintptr(unsafe.Pointer(data)))
+	if hooked {
+		ctx.called("GetFloatv", pname, data)
+	}
+}
+
+func GetFloatv(pname uint32, data *float32) {
+	defaultContext.GetFloatv(pname, data)
+}
+
+// query the bindings of color indices to user-defined varying out variables
+func (ctx *Context) GetFragDataIndex(program uint32, name *uint8) int32 {
+	ret, _, _ := purego.SyscallN(ctx.gpGetFragDataIndex, uintptr(program), uintptr(unsafe.Pointer(name)))
+	if hooked {
+		ctx.returned("GetFragDataIndex", (int32)(ret), program, name)
+	}
+	return (int32)(ret)
+}
+
+// query the bindings of color indices to user-defined varying out variables
+func GetFragDataIndex(program uint32, name *uint8) int32 {
+	return defaultContext.GetFragDataIndex(program, name)
+}
+
+// query the bindings of color numbers to user-defined varying out variables
+func (ctx *Context) GetFragDataLocation(program uint32, name *uint8) int32 {
+	ret, _, _ := purego.SyscallN(ctx.gpGetFragDataLocation, uintptr(program), uintptr(unsafe.Pointer(name)))
+	if hooked {
+		ctx.returned("GetFragDataLocation", (int32)(ret), program, name)
+	}
+	return (int32)(ret)
+}
+
+// query the bindings of color numbers to user-defined varying out variables
+func GetFragDataLocation(program uint32, name *uint8) int32 {
+	return defaultContext.GetFragDataLocation(program, name)
+}
+
+// retrieve information about attachments of a bound framebuffer object
+func (ctx *Context) GetFramebufferAttachmentParameteriv(target uint32, attachment uint32, pname uint32, params *int32) {
+	purego.SyscallN(ctx.gpGetFramebufferAttachmentParameteriv, uintptr(target), uintptr(attachment), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetFramebufferAttachmentParameteriv", target, attachment, pname, params)
+	}
+}
+
+// retrieve information about attachments of a bound framebuffer object
+func GetFramebufferAttachmentParameteriv(target uint32, attachment uint32, pname uint32, params *int32) {
+	defaultContext.GetFramebufferAttachmentParameteriv(target, attachment, pname, params)
+}
+
+func (ctx *Context) GetInteger64i_v(target uint32, index uint32, data *int64) {
+	purego.SyscallN(ctx.gpGetInteger64i_v, uintptr(target), uintptr(index), uintptr(unsafe.Pointer(data)))
+	if hooked {
+		ctx.called("GetInteger64i_v", target, index, data)
+	}
+}
+
+func GetInteger64i_v(target uint32, index uint32, data *int64) {
+	defaultContext.GetInteger64i_v(target, index, data)
+}
+
+func (ctx *Context) GetInteger64v(pname uint32, data *int64) {
+	purego.SyscallN(ctx.gpGetInteger64v, uintptr(pname), uintptr(unsafe.Pointer(data)))
+	if hooked {
+		ctx.called("GetInteger64v", pname, data)
+	}
+}
+
+func GetInteger64v(pname uint32, data *int64) {
+	defaultContext.GetInteger64v(pname, data)
+}
+
+func (ctx *Context) GetIntegeri_v(target uint32, index uint32, data *int32) {
+	purego.SyscallN(ctx.gpGetIntegeri_v, uintptr(target), uintptr(index), uintptr(unsafe.Pointer(data)))
+	if hooked {
+		ctx.called("GetIntegeri_v", target, index, data)
+	}
+}
+
+func GetIntegeri_v(target uint32, index uint32, data *int32) {
+	defaultContext.GetIntegeri_v(target, index, data)
+}
+
+func (ctx *Context) GetIntegerv(pname uint32, data *int32) {
+	purego.SyscallN(ctx.gpGetIntegerv, uintptr(pname), uintptr(unsafe.Pointer(data)))
+	if hooked {
+		ctx.called("GetIntegerv", pname, data)
+	}
+}
+
+func GetIntegerv(pname uint32, data *int32) {
+	defaultContext.GetIntegerv(pname, data)
+}
+
+// retrieve the location of a sample
+func (ctx *Context) GetMultisamplefv(pname uint32, index uint32, val *float32) {
+	purego.SyscallN(ctx.gpGetMultisamplefv, uintptr(pname), uintptr(index), uintptr(unsafe.Pointer(val)))
+	if hooked {
+		ctx.called("GetMultisamplefv", pname, index, val)
+	}
+}
+
+// retrieve the location of a sample
+func GetMultisamplefv(pname uint32, index uint32, val *float32) {
+	defaultContext.GetMultisamplefv(pname, index, val)
+}
+
+// return the address of the specified pointer
+func (ctx *Context) GetPointerv(pname uint32, params *unsafe.Pointer) {
+	purego.SyscallN(ctx.gpGetPointerv, uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetPointerv", pname, params)
+	}
+}
+
+// return the address of the specified pointer
+func GetPointerv(pname uint32, params *unsafe.Pointer) {
+	defaultContext.GetPointerv(pname, params)
+}
+
+// return a binary representation of a program object's compiled and linked executable source
+func (ctx *Context) GetProgramBinary(program uint32, bufSize int32, length *int32, binaryFormat *uint32, binary unsafe.Pointer) {
+	purego.SyscallN(ctx.gpGetProgramBinary, uintptr(program), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(binaryFormat)), uintptr(binary))
+	if hooked {
+		ctx.called("GetProgramBinary", program, bufSize, length, binaryFormat, binary)
+	}
+}
+
+// return a binary representation of a program object's compiled and linked executable source
+func GetProgramBinary(program uint32, bufSize int32, length *int32, binaryFormat *uint32, binary unsafe.Pointer) {
+	defaultContext.GetProgramBinary(program, bufSize, length, binaryFormat, binary)
+}
+
+// Returns the information log for a program object
+func (ctx *Context) GetProgramInfoLog(program uint32, bufSize int32, length *int32, infoLog *uint8) {
+	purego.SyscallN(ctx.gpGetProgramInfoLog, uintptr(program), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(infoLog)))
+	if hooked {
+		ctx.called("GetProgramInfoLog", program, bufSize, length, infoLog)
+	}
+}
+
+// Returns the information log for a program object
+func GetProgramInfoLog(program uint32, bufSize int32, length *int32, infoLog *uint8) {
+	defaultContext.GetProgramInfoLog(program, bufSize, length, infoLog)
+}
+
+// retrieve the info log string from a program pipeline object
+func (ctx *Context) GetProgramPipelineInfoLog(pipeline uint32, bufSize int32, length *int32, infoLog *uint8) {
+	purego.SyscallN(ctx.gpGetProgramPipelineInfoLog, uintptr(pipeline), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(infoLog)))
+	if hooked {
+		ctx.called("GetProgramPipelineInfoLog", pipeline, bufSize, length, infoLog)
+	}
+}
+
+// retrieve the info log string from a program pipeline object
+func GetProgramPipelineInfoLog(pipeline uint32, bufSize int32, length *int32, infoLog *uint8) {
+	defaultContext.GetProgramPipelineInfoLog(pipeline, bufSize, length, infoLog)
+}
+
+func (ctx *Context) GetProgramPipelineiv(pipeline uint32, pname uint32, params *int32) {
+	purego.SyscallN(ctx.gpGetProgramPipelineiv, uintptr(pipeline), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetProgramPipelineiv", pipeline, pname, params)
+	}
+}
+
+func GetProgramPipelineiv(pipeline uint32, pname uint32, params *int32) {
+	defaultContext.GetProgramPipelineiv(pipeline, pname, params)
+}
+
+func (ctx *Context) GetProgramStageiv(program uint32, shadertype uint32, pname uint32, values *int32) {
+	purego.SyscallN(ctx.gpGetProgramStageiv, uintptr(program), uintptr(shadertype), uintptr(pname), uintptr(unsafe.Pointer(values)))
+	if hooked {
+		ctx.called("GetProgramStageiv", program, shadertype, pname, values)
+	}
+}
+
+func GetProgramStageiv(program uint32, shadertype uint32, pname uint32, values *int32) {
+	defaultContext.GetProgramStageiv(program, shadertype, pname, values)
+}
+
+// Returns a parameter from a program object
+func (ctx *Context) GetProgramiv(program uint32, pname uint32, params *int32) {
+	purego.SyscallN(ctx.gpGetProgramiv, uintptr(program), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetProgramiv", program, pname, params)
+	}
+}
+
+// Returns a parameter from a program object
+func GetProgramiv(program uint32, pname uint32, params *int32) {
+	defaultContext.GetProgramiv(program, pname, params)
+}
+
+// return parameters of an indexed query object target
+func (ctx *Context) GetQueryIndexediv(target uint32, index uint32, pname uint32, params *int32) {
+	purego.SyscallN(ctx.gpGetQueryIndexediv, uintptr(target), uintptr(index), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetQueryIndexediv", target, index, pname, params)
+	}
+}
+
+// return parameters of an indexed query object target
+func GetQueryIndexediv(target uint32, index uint32, pname uint32, params *int32) {
+	defaultContext.GetQueryIndexediv(target, index, pname, params)
+}
+
+func (ctx *Context) GetQueryObjecti64v(id uint32, pname uint32, params *int64) {
+	purego.SyscallN(ctx.gpGetQueryObjecti64v, uintptr(id), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetQueryObjecti64v", id, pname, params)
+	}
+}
+
+func GetQueryObjecti64v(id uint32, pname uint32, params *int64) {
+	defaultContext.GetQueryObjecti64v(id, pname, params)
+}
+
+func (ctx *Context) GetQueryObjectiv(id uint32, pname uint32, params *int32) {
+	purego.SyscallN(ctx.gpGetQueryObjectiv, uintptr(id), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetQueryObjectiv", id, pname, params)
+	}
+}
+
+func GetQueryObjectiv(id uint32, pname uint32, params *int32) {
+	defaultContext.GetQueryObjectiv(id, pname, params)
+}
+
+func (ctx *Context) GetQueryObjectui64v(id uint32, pname uint32, params *uint64) {
+	purego.SyscallN(ctx.gpGetQueryObjectui64v, uintptr(id), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetQueryObjectui64v", id, pname, params)
+	}
+}
+
+func GetQueryObjectui64v(id uint32, pname uint32, params *uint64) {
+	defaultContext.GetQueryObjectui64v(id, pname, params)
+}
+
+// return parameters of a query object
+func (ctx *Context) GetQueryObjectuiv(id uint32, pname uint32, params *uint32) {
+	purego.SyscallN(ctx.gpGetQueryObjectuiv, uintptr(id), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetQueryObjectuiv", id, pname, params)
+	}
+}
+
+// return parameters of a query object
+func GetQueryObjectuiv(id uint32, pname uint32, params *uint32) {
+	defaultContext.GetQueryObjectuiv(id, pname, params)
+}
+
+// return parameters of a query object target
+func (ctx *Context) GetQueryiv(target uint32, pname uint32, params *int32) {
+	purego.SyscallN(ctx.gpGetQueryiv, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetQueryiv", target, pname, params)
+	}
+}
+
+// return parameters of a query object target
+func GetQueryiv(target uint32, pname uint32, params *int32) {
+	defaultContext.GetQueryiv(target, pname, params)
+}
+
+// retrieve information about a bound renderbuffer object
+func (ctx *Context) GetRenderbufferParameteriv(target uint32, pname uint32, params *int32) {
+	purego.SyscallN(ctx.gpGetRenderbufferParameteriv, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetRenderbufferParameteriv", target, pname, params)
+	}
+}
+
+// retrieve information about a bound renderbuffer object
+func GetRenderbufferParameteriv(target uint32, pname uint32, params *int32) {
+	defaultContext.GetRenderbufferParameteriv(target, pname, params)
+}
+
+func (ctx *Context) GetSamplerParameterIiv(sampler uint32, pname uint32, params *int32) {
+	purego.SyscallN(ctx.gpGetSamplerParameterIiv, uintptr(sampler), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetSamplerParameterIiv", sampler, pname, params)
+	}
+}
+
+func GetSamplerParameterIiv(sampler uint32, pname uint32, params *int32) {
+	defaultContext.GetSamplerParameterIiv(sampler, pname, params)
+}
+
+func (ctx *Context) GetSamplerParameterIuiv(sampler uint32, pname uint32, params *uint32) {
+	purego.SyscallN(ctx.gpGetSamplerParameterIuiv, uintptr(sampler), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetSamplerParameterIuiv", sampler, pname, params)
+	}
+}
+
+func GetSamplerParameterIuiv(sampler uint32, pname uint32, params *uint32) {
+	defaultContext.GetSamplerParameterIuiv(sampler, pname, params)
+}
+
+func (ctx *Context) GetSamplerParameterfv(sampler uint32, pname uint32, params *float32) {
+	purego.SyscallN(ctx.gpGetSamplerParameterfv, uintptr(sampler), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetSamplerParameterfv", sampler, pname, params)
+	}
+}
+
+func GetSamplerParameterfv(sampler uint32, pname uint32, params *float32) {
+	defaultContext.GetSamplerParameterfv(sampler, pname, params)
+}
+
+func (ctx *Context) GetSamplerParameteriv(sampler uint32, pname uint32, params *int32) {
+	purego.SyscallN(ctx.gpGetSamplerParameteriv, uintptr(sampler), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetSamplerParameteriv", sampler, pname, params)
+	}
+}
+
+func GetSamplerParameteriv(sampler uint32, pname uint32, params *int32) {
+	defaultContext.GetSamplerParameteriv(sampler, pname, params)
+}
+
+// Returns the information log for a shader object
+func (ctx *Context) GetShaderInfoLog(shader uint32, bufSize int32, length *int32, infoLog *uint8) {
+	purego.SyscallN(ctx.gpGetShaderInfoLog, uintptr(shader), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(infoLog)))
+	if hooked {
+		ctx.called("GetShaderInfoLog", shader, bufSize, length, infoLog)
+	}
+}
+
+// Returns the information log for a shader object
+func GetShaderInfoLog(shader uint32, bufSize int32, length *int32, infoLog *uint8) {
+	defaultContext.GetShaderInfoLog(shader, bufSize, length, infoLog)
+}
+
+// retrieve the range and precision for numeric formats supported by the shader compiler
+func (ctx *Context) GetShaderPrecisionFormat(shadertype uint32, precisiontype uint32, xrange *int32, precision *int32) {
+	purego.SyscallN(ctx.gpGetShaderPrecisionFormat, uintptr(shadertype), uintptr(precisiontype), uintptr(unsafe.Pointer(xrange)), uintptr(unsafe.Pointer(precision)))
+	if hooked {
+		ctx.called("GetShaderPrecisionFormat", shadertype, precisiontype, xrange, precision)
+	}
+}
+
+// retrieve the range and precision for numeric formats supported by the shader compiler
+func GetShaderPrecisionFormat(shadertype uint32, precisiontype uint32, xrange *int32, precision *int32) {
+	defaultContext.GetShaderPrecisionFormat(shadertype, precisiontype, xrange, precision)
+}
+
+// Returns the source code string from a shader object
+func (ctx *Context) GetShaderSource(shader uint32, bufSize int32, length *int32, source *uint8) {
+	purego.SyscallN(ctx.gpGetShaderSource, uintptr(shader), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(source)))
+	if hooked {
+		ctx.called("GetShaderSource", shader, bufSize, length, source)
+	}
+}
+
+// Returns the source code string from a shader object
+func GetShaderSource(shader uint32, bufSize int32, length *int32, source *uint8) {
+	defaultContext.GetShaderSource(shader, bufSize, length, source)
+}
+
+// Returns a parameter from a shader object
+func (ctx *Context) GetShaderiv(shader uint32, pname uint32, params *int32) {
+	purego.SyscallN(ctx.gpGetShaderiv, uintptr(shader), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetShaderiv", shader, pname, params)
+	}
+}
+
+// Returns a parameter from a shader object
+func GetShaderiv(shader uint32, pname uint32, params *int32) {
+	defaultContext.GetShaderiv(shader, pname, params)
+}
+
+// return a string describing the current GL connection
+func (ctx *Context) GetString(name uint32) *uint8 {
+	ret, _, _ := purego.SyscallN(ctx.gpGetString, uintptr(name))
+	if hooked {
+		ctx.called("GetString", name)
+	}
+	return (*uint8)(unsafe.Pointer(ret))
+}
+
+// return a string describing the current GL connection
+func GetString(name uint32) *uint8 {
+	return defaultContext.GetString(name)
+}
+
+func (ctx *Context) GetStringi(name uint32, index uint32) *uint8 {
+	ret, _, _ := purego.SyscallN(ctx.gpGetStringi, uintptr(name), uintptr(index))
+	if hooked {
+		ctx.called("GetStringi", name, index)
+	}
+	return (*uint8)(unsafe.Pointer(ret))
+}
+
+func GetStringi(name uint32, index uint32) *uint8 {
+	return defaultContext.GetStringi(name, index)
+}
+
+// retrieve the index of a subroutine uniform of a given shader stage within a program
+func (ctx *Context) GetSubroutineIndex(program uint32, shadertype uint32, name *uint8) uint32 {
+	ret, _, _ := purego.SyscallN(ctx.gpGetSubroutineIndex, uintptr(program), uintptr(shadertype), uintptr(unsafe.Pointer(name)))
+	if hooked {
+		ctx.returned("GetSubroutineIndex", (uint32)(ret), program, shadertype, name)
+	}
+	return (uint32)(ret)
+}
+
+// retrieve the index of a subroutine uniform of a given shader stage within a program
+func GetSubroutineIndex(program uint32, shadertype uint32, name *uint8) uint32 {
+	return defaultContext.GetSubroutineIndex(program, shadertype, name)
+}
+
+// retrieve the location of a subroutine uniform of a given shader stage within a program
+func (ctx *Context) GetSubroutineUniformLocation(program uint32, shadertype uint32, name *uint8) int32 {
+	ret, _, _ := purego.SyscallN(ctx.gpGetSubroutineUniformLocation, uintptr(program), uintptr(shadertype), uintptr(unsafe.Pointer(name)))
+	if hooked {
+		ctx.returned("GetSubroutineUniformLocation", (int32)(ret), program, shadertype, name)
+	}
+	return (int32)(ret)
+}
+
+// retrieve the location of a subroutine uniform of a given shader stage within a program
+func GetSubroutineUniformLocation(program uint32, shadertype uint32, name *uint8) int32 {
+	return defaultContext.GetSubroutineUniformLocation(program, shadertype, name)
+}
+
+// query the properties of a sync object
+func (ctx *Context) GetSynciv(sync uintptr, pname uint32, bufSize int32, length *int32, values *int32) {
+	purego.SyscallN(ctx.gpGetSynciv, uintptr(sync), uintptr(pname), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(values)))
+	if hooked {
+		ctx.called("GetSynciv", sync, pname, bufSize, length, values)
+	}
+}
+
+// query the properties of a sync object
+func GetSynciv(sync uintptr, pname uint32, bufSize int32, length *int32, values *int32) {
+	defaultContext.GetSynciv(sync, pname, bufSize, length, values)
+}
+
+// return a texture image
+func (ctx *Context) GetTexImage(target uint32, level int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	purego.SyscallN(ctx.gpGetTexImage, uintptr(target), uintptr(level), uintptr(format), uintptr(xtype), uintptr(pixels))
+	if hooked {
+		ctx.called("GetTexImage", target, level, format, xtype, pixels)
+	}
+}
+
+// return a texture image
+func GetTexImage(target uint32, level int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	defaultContext.GetTexImage(target, level, format, xtype, pixels)
+}
+
+func (ctx *Context) GetTexLevelParameterfv(target uint32, level int32, pname uint32, params *float32) {
+	purego.SyscallN(ctx.gpGetTexLevelParameterfv, uintptr(target), uintptr(level), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetTexLevelParameterfv", target, level, pname, params)
+	}
+}
+
+func GetTexLevelParameterfv(target uint32, level int32, pname uint32, params *float32) {
+	defaultContext.GetTexLevelParameterfv(target, level, pname, params)
+}
+
+func (ctx *Context) GetTexLevelParameteriv(target uint32, level int32, pname uint32, params *int32) {
+	purego.SyscallN(ctx.gpGetTexLevelParameteriv, uintptr(target), uintptr(level), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetTexLevelParameteriv", target, level, pname, params)
+	}
+}
+
+func GetTexLevelParameteriv(target uint32, level int32, pname uint32, params *int32) {
+	defaultContext.GetTexLevelParameteriv(target, level, pname, params)
+}
+
+func (ctx *Context) GetTexParameterIiv(target uint32, pname uint32, params *int32) {
+	purego.SyscallN(ctx.gpGetTexParameterIiv, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetTexParameterIiv", target, pname, params)
+	}
+}
+
+func GetTexParameterIiv(target uint32, pname uint32, params *int32) {
+	defaultContext.GetTexParameterIiv(target, pname, params)
+}
+
+func (ctx *Context) GetTexParameterIuiv(target uint32, pname uint32, params *uint32) {
+	purego.SyscallN(ctx.gpGetTexParameterIuiv, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetTexParameterIuiv", target, pname, params)
+	}
+}
+
+func GetTexParameterIuiv(target uint32, pname uint32, params *uint32) {
+	defaultContext.GetTexParameterIuiv(target, pname, params)
+}
+
+func (ctx *Context) GetTexParameterfv(target uint32, pname uint32, params *float32) {
+	purego.SyscallN(ctx.gpGetTexParameterfv, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetTexParameterfv", target, pname, params)
+	}
+}
+
+func GetTexParameterfv(target uint32, pname uint32, params *float32) {
+	defaultContext.GetTexParameterfv(target, pname, params)
+}
+
+func (ctx *Context) GetTexParameteriv(target uint32, pname uint32, params *int32) {
+	purego.SyscallN(ctx.gpGetTexParameteriv, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetTexParameteriv", target, pname, params)
+	}
+}
+
+func GetTexParameteriv(target uint32, pname uint32, params *int32) {
+	defaultContext.GetTexParameteriv(target, pname, params)
+}
+
+// retrieve information about varying variables selected for transform feedback
+func (ctx *Context) GetTransformFeedbackVarying(program uint32, index uint32, bufSize int32, length *int32, size *int32, xtype *uint32, name *uint8) {
+	purego.SyscallN(ctx.gpGetTransformFeedbackVarying, uintptr(program), uintptr(index), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(size)), uintptr(unsafe.Pointer(xtype)), uintptr(unsafe.Pointer(name)))
+	if hooked {
+		ctx.called("GetTransformFeedbackVarying", program, index, bufSize, length, size, xtype, name)
+	}
+}
+
+// retrieve information about varying variables selected for transform feedback
+func GetTransformFeedbackVarying(program uint32, index uint32, bufSize int32, length *int32, size *int32, xtype *uint32, name *uint8) {
+	defaultContext.GetTransformFeedbackVarying(program, index, bufSize, length, size, xtype, name)
+}
+
+// retrieve the index of a named uniform block
+func (ctx *Context) GetUniformBlockIndex(program uint32, uniformBlockName *uint8) uint32 {
+	ret, _, _ := purego.SyscallN(ctx.gpGetUniformBlockIndex, uintptr(program), uintptr(unsafe.Pointer(uniformBlockName)))
+	if hooked {
+		ctx.returned("GetUniformBlockIndex", (uint32)(ret), program, uniformBlockName)
+	}
+	return (uint32)(ret)
+}
+
+// retrieve the index of a named uniform block
+func GetUniformBlockIndex(program uint32, uniformBlockName *uint8) uint32 {
+	return defaultContext.GetUniformBlockIndex(program, uniformBlockName)
+}
+
+// retrieve the index of a named uniform block
+func (ctx *Context) GetUniformIndices(program uint32, uniformCount int32, uniformNames **uint8, uniformIndices *uint32) {
+	purego.SyscallN(ctx.gpGetUniformIndices, uintptr(program), uintptr(uniformCount), uintptr(unsafe.Pointer(uniformNames)), uintptr(unsafe.Pointer(uniformIndices)))
+	if hooked {
+		ctx.called("GetUniformIndices", program, uniformCount, uniformNames, uniformIndices)
+	}
+}
+
+// retrieve the index of a named uniform block
+func GetUniformIndices(program uint32, uniformCount int32, uniformNames **uint8, uniformIndices *uint32) {
+	defaultContext.GetUniformIndices(program, uniformCount, uniformNames, uniformIndices)
+}
+
+// Returns the location of a uniform variable
+func (ctx *Context) GetUniformLocation(program uint32, name *uint8) int32 {
+	ret, _, _ := purego.SyscallN(ctx.gpGetUniformLocation, uintptr(program), uintptr(unsafe.Pointer(name)))
+	if hooked {
+		ctx.returned("GetUniformLocation", (int32)(ret), program, name)
+	}
+	return (int32)(ret)
+}
+
+// Returns the location of a uniform variable
+func GetUniformLocation(program uint32, name *uint8) int32 {
+	return defaultContext.GetUniformLocation(program, name)
+}
+
+func (ctx *Context) GetUniformSubroutineuiv(shadertype uint32, location int32, params *uint32) {
+	purego.SyscallN(ctx.gpGetUniformSubroutineuiv, uintptr(shadertype), uintptr(location), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetUniformSubroutineuiv", shadertype, location, params)
+	}
+}
+
+func GetUniformSubroutineuiv(shadertype uint32, location int32, params *uint32) {
+	defaultContext.GetUniformSubroutineuiv(shadertype, location, params)
+}
+
+func (ctx *Context) GetUniformdv(program uint32, location int32, params *float64) {
+	purego.SyscallN(ctx.gpGetUniformdv, uintptr(program), uintptr(location), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetUniformdv", program, location, params)
+	}
+}
+
+func GetUniformdv(program uint32, location int32, params *float64) {
+	defaultContext.GetUniformdv(program, location, params)
+}
+
+// Returns the value of a uniform variable
+func (ctx *Context) GetUniformfv(program uint32, location int32, params *float32) {
+	purego.SyscallN(ctx.gpGetUniformfv, uintptr(program), uintptr(location), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetUniformfv", program, location, params)
+	}
+}
+
+// Returns the value of a uniform variable
+func GetUniformfv(program uint32, location int32, params *float32) {
+	defaultContext.GetUniformfv(program, location, params)
+}
+
+// Returns the value of a uniform variable
+func (ctx *Context) GetUniformiv(program uint32, location int32, params *int32) {
+	purego.SyscallN(ctx.gpGetUniformiv, uintptr(program), uintptr(location), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetUniformiv", program, location, params)
+	}
+}
+
+// Returns the value of a uniform variable
+func GetUniformiv(program uint32, location int32, params *int32) {
+	defaultContext.GetUniformiv(program, location, params)
+}
+
+func (ctx *Context) GetUniformuiv(program uint32, location int32, params *uint32) {
+	purego.SyscallN(ctx.gpGetUniformuiv, uintptr(program), uintptr(location), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetUniformuiv", program, location, params)
+	}
+}
+
+func GetUniformuiv(program uint32, location int32, params *uint32) {
+	defaultContext.GetUniformuiv(program, location, params)
+}
+
+// Return a generic vertex attribute parameter
+func (ctx *Context) GetVertexAttribIiv(index uint32, pname uint32, params *int32) {
+	purego.SyscallN(ctx.gpGetVertexAttribIiv, uintptr(index), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetVertexAttribIiv", index, pname, params)
+	}
+}
+
+// Return a generic vertex attribute parameter
+func GetVertexAttribIiv(index uint32, pname uint32, params *int32) {
+	defaultContext.GetVertexAttribIiv(index, pname, params)
+}
+
+// Return a generic vertex attribute parameter
+func (ctx *Context) GetVertexAttribIuiv(index uint32, pname uint32, params *uint32) {
+	purego.SyscallN(ctx.gpGetVertexAttribIuiv, uintptr(index), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetVertexAttribIuiv", index, pname, params)
+	}
+}
+
+// Return a generic vertex attribute parameter
+func GetVertexAttribIuiv(index uint32, pname uint32, params *uint32) {
+	defaultContext.GetVertexAttribIuiv(index, pname, params)
+}
+
+// Return a generic vertex attribute parameter
+func (ctx *Context) GetVertexAttribLdv(index uint32, pname uint32, params *float64) {
+	purego.SyscallN(ctx.gpGetVertexAttribLdv, uintptr(index), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetVertexAttribLdv", index, pname, params)
+	}
+}
+
+// Return a generic vertex attribute parameter
+func GetVertexAttribLdv(index uint32, pname uint32, params *float64) {
+	defaultContext.GetVertexAttribLdv(index, pname, params)
+}
+
+// return the address of the specified generic vertex attribute pointer
+func (ctx *Context) GetVertexAttribPointerv(index uint32, pname uint32, pointer *unsafe.Pointer) {
+	purego.SyscallN(ctx.gpGetVertexAttribPointerv, uintptr(index), uintptr(pname), uintptr(unsafe.Pointer(pointer)))
+	if hooked {
+		ctx.called("GetVertexAttribPointerv", index, pname, pointer)
+	}
+}
+
+// return the address of the specified generic vertex attribute pointer
+func GetVertexAttribPointerv(index uint32, pname uint32, pointer *unsafe.Pointer) {
+	defaultContext.GetVertexAttribPointerv(index, pname, pointer)
+}
+
+func (ctx *Context) GetVertexAttribPointerWithOffsetv(index uint32, pname uint32, offset **uintptr) {
+	purego.SyscallN(ctx.gpGetVertexAttribPointerv, uintptr(index), uintptr(pname), uintptr(unsafe.Pointer(offset)))
+	if hooked {
+		ctx.called("GetVertexAttribPointerWithOffsetv", index, pname, offset)
+	}
+}
+
+func GetVertexAttribPointerWithOffsetv(index uint32, pname uint32, offset **uintptr) {
+	defaultContext.GetVertexAttribPointerWithOffsetv(index, pname, offset)
+}
+
+// Return a generic vertex attribute parameter
+func (ctx *Context) GetVertexAttribdv(index uint32, pname uint32, params *float64) {
+	purego.SyscallN(ctx.gpGetVertexAttribdv, uintptr(index), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetVertexAttribdv", index, pname, params)
+	}
+}
+
+// Return a generic vertex attribute parameter
+func GetVertexAttribdv(index uint32, pname uint32, params *float64) {
+	defaultContext.GetVertexAttribdv(index, pname, params)
+}
+
+// Return a generic vertex attribute parameter
+func (ctx *Context) GetVertexAttribfv(index uint32, pname uint32, params *float32) {
+	purego.SyscallN(ctx.gpGetVertexAttribfv, uintptr(index), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetVertexAttribfv", index, pname, params)
+	}
+}
+
+// Return a generic vertex attribute parameter
+func GetVertexAttribfv(index uint32, pname uint32, params *float32) {
+	defaultContext.GetVertexAttribfv(index, pname, params)
+}
+
+// Return a generic vertex attribute parameter
+func (ctx *Context) GetVertexAttribiv(index uint32, pname uint32, params *int32) {
+	purego.SyscallN(ctx.gpGetVertexAttribiv, uintptr(index), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetVertexAttribiv", index, pname, params)
+	}
+}
+
+// Return a generic vertex attribute parameter
+func GetVertexAttribiv(index uint32, pname uint32, params *int32) {
+	defaultContext.GetVertexAttribiv(index, pname, params)
+}
+
+// specify implementation-specific hints
+func (ctx *Context) Hint(target uint32, mode uint32) {
+	purego.SyscallN(ctx.gpHint, uintptr(target), uintptr(mode))
+	if hooked {
+		ctx.called("Hint", target, mode)
+	}
+}
+
+// specify implementation-specific hints
+func Hint(target uint32, mode uint32) {
+	defaultContext.Hint(target, mode)
+}
+
+// determine if a name corresponds to a buffer object
+func (ctx *Context) IsBuffer(buffer uint32) bool {
+	ret, _, _ := purego.SyscallN(ctx.gpIsBuffer, uintptr(buffer))
+	if hooked {
+		ctx.called("IsBuffer", buffer)
+	}
+	return uint8(ret) != 0
+}
+
+// determine if a name corresponds to a buffer object
+func IsBuffer(buffer uint32) bool {
+	return defaultContext.IsBuffer(buffer)
+}
+
+func (ctx *Context) IsEnabled(cap uint32) bool {
+	ret, _, _ := purego.SyscallN(ctx.gpIsEnabled, uintptr(cap))
+	if hooked {
+		ctx.called("IsEnabled", cap)
+	}
+	return uint8(ret) != 0
+}
+
+func IsEnabled(cap uint32) bool {
+	return defaultContext.IsEnabled(cap)
+}
+
+func (ctx *Context) IsEnabledi(target uint32, index uint32) bool {
+	ret, _, _ := purego.SyscallN(ctx.gpIsEnabledi, uintptr(target), uintptr(index))
+	if hooked {
+		ctx.called("IsEnabledi", target, index)
+	}
+	return uint8(ret) != 0
+}
+
+func IsEnabledi(target uint32, index uint32) bool {
+	return defaultContext.IsEnabledi(target, index)
+}
+
+// determine if a name corresponds to a framebuffer object
+func (ctx *Context) IsFramebuffer(framebuffer uint32) bool {
+	ret, _, _ := purego.SyscallN(ctx.gpIsFramebuffer, uintptr(framebuffer))
+	if hooked {
+		ctx.called("IsFramebuffer", framebuffer)
+	}
+	return uint8(ret) != 0
+}
+
+// determine if a name corresponds to a framebuffer object
+func IsFramebuffer(framebuffer uint32) bool {
+	return defaultContext.IsFramebuffer(framebuffer)
+}
+
+// Determines if a name corresponds to a program object
+func (ctx *Context) IsProgram(program uint32) bool {
+	ret, _, _ := purego.SyscallN(ctx.gpIsProgram, uintptr(program))
+	if hooked {
+		ctx.called("IsProgram", program)
+	}
+	return uint8(ret) != 0
+}
+
+// Determines if a name corresponds to a program object
+func IsProgram(program uint32) bool {
+	return defaultContext.IsProgram(program)
+}
+
+// determine if a name corresponds to a program pipeline object
+func (ctx *Context) IsProgramPipeline(pipeline uint32) bool {
+	ret, _, _ := purego.SyscallN(ctx.gpIsProgramPipeline, uintptr(pipeline))
+	if hooked {
+		ctx.called("IsProgramPipeline", pipeline)
+	}
+	return uint8(ret) != 0
+}
+
+// determine if a name corresponds to a program pipeline object
+func IsProgramPipeline(pipeline uint32) bool {
+	return defaultContext.IsProgramPipeline(pipeline)
+}
+
+// determine if a name corresponds to a query object
+func (ctx *Context) IsQuery(id uint32) bool {
+	ret, _, _ := purego.SyscallN(ctx.gpIsQuery, uintptr(id))
+	if hooked {
+		ctx.called("IsQuery", id)
+	}
+	return uint8(ret) != 0
+}
+
+// determine if a name corresponds to a query object
+func IsQuery(id uint32) bool {
+	return defaultContext.IsQuery(id)
+}
+
+// determine if a name corresponds to a renderbuffer object
+func (ctx *Context) IsRenderbuffer(renderbuffer uint32) bool {
+	ret, _, _ := purego.SyscallN(ctx.gpIsRenderbuffer, uintptr(renderbuffer))
+	if hooked {
+		ctx.called("IsRenderbuffer", renderbuffer)
+	}
+	return uint8(ret) != 0
+}
+
+// determine if a name corresponds to a renderbuffer object
+func IsRenderbuffer(renderbuffer uint32) bool {
+	return defaultContext.IsRenderbuffer(renderbuffer)
+}
+
+// determine if a name corresponds to a sampler object
+func (ctx *Context) IsSampler(sampler uint32) bool {
+	ret, _, _ := purego.SyscallN(ctx.gpIsSampler, uintptr(sampler))
+	if hooked {
+		ctx.called("IsSampler", sampler)
+	}
+	return uint8(ret) != 0
+}
+
+// determine if a name corresponds to a sampler object
+func IsSampler(sampler uint32) bool {
+	return defaultContext.IsSampler(sampler)
+}
+
+// Determines if a name corresponds to a shader object
+func (ctx *Context) IsShader(shader uint32) bool {
+	ret, _, _ := purego.SyscallN(ctx.gpIsShader, uintptr(shader))
+	if hooked {
+		ctx.called("IsShader", shader)
+	}
+	return uint8(ret) != 0
+}
+
+// Determines if a name corresponds to a shader object
+func IsShader(shader uint32) bool {
+	return defaultContext.IsShader(shader)
+}
+
+// determine if a name corresponds to a sync object
+func (ctx *Context) IsSync(sync uintptr) bool {
+	ret, _, _ := purego.SyscallN(ctx.gpIsSync, uintptr(sync))
+	if hooked {
+		ctx.called("IsSync", sync)
+	}
+	return uint8(ret) != 0
+}
+
+// determine if a name corresponds to a sync object
+func IsSync(sync uintptr) bool {
+	return defaultContext.IsSync(sync)
+}
+
+// determine if a name corresponds to a texture
+func (ctx *Context) IsTexture(texture uint32) bool {
+	ret, _, _ := purego.SyscallN(ctx.gpIsTexture, uintptr(texture))
+	if hooked {
+		ctx.called("IsTexture", texture)
+	}
+	return uint8(ret) != 0
+}
+
+// determine if a name corresponds to a texture
+func IsTexture(texture uint32) bool {
+	return defaultContext.IsTexture(texture)
+}
+
+// determine if a name corresponds to a transform feedback object
+func (ctx *Context) IsTransformFeedback(id uint32) bool {
+	ret, _, _ := purego.SyscallN(ctx.gpIsTransformFeedback, uintptr(id))
+	if hooked {
+		ctx.called("IsTransformFeedback", id)
+	}
+	return uint8(ret) != 0
+}
+
+// determine if a name corresponds to a transform feedback object
+func IsTransformFeedback(id uint32) bool {
+	return defaultContext.IsTransformFeedback(id)
+}
+
+// determine if a name corresponds to a vertex array object
+func (ctx *Context) IsVertexArray(array uint32) bool {
+	ret, _, _ := purego.SyscallN(ctx.gpIsVertexArray, uintptr(array))
+	if hooked {
+		ctx.called("IsVertexArray", array)
+	}
+	return uint8(ret) != 0
+}
+
+// determine if a name corresponds to a vertex array object
+func IsVertexArray(array uint32) bool {
+	return defaultContext.IsVertexArray(array)
+}
+
+// specify the width of rasterized lines
+func (ctx *Context) LineWidth(width float32) {
+	ctx.fpLineWidth(width)
+	if hooked {
+		ctx.called("LineWidth", width)
+	}
+}
+
+// specify the width of rasterized lines
+func LineWidth(width float32) {
+	defaultContext.LineWidth(width)
+}
+
+// Links a program object
+func (ctx *Context) LinkProgram(program uint32) {
+	purego.SyscallN(ctx.gpLinkProgram, uintptr(program))
+	if hooked {
+		ctx.called("LinkProgram", program)
+	}
+}
+
+// Links a program object
+func LinkProgram(program uint32) {
+	defaultContext.LinkProgram(program)
+}
+
+// specify a logical pixel operation for rendering
+func (ctx *Context) LogicOp(opcode uint32) {
+	purego.SyscallN(ctx.gpLogicOp, uintptr(opcode))
+	if hooked {
+		ctx.called("LogicOp", opcode)
+	}
+}
+
+// specify a logical pixel operation for rendering
+func LogicOp(opcode uint32) {
+	defaultContext.LogicOp(opcode)
+}
+
+// map all of a buffer object's data store into the client's address space
+func (ctx *Context) MapBuffer(target uint32, access uint32) unsafe.Pointer {
+	ret, _, _ := purego.SyscallN(ctx.gpMapBuffer, uintptr(target), uintptr(access))
+	if hooked {
+		ctx.called("MapBuffer", target, access)
+	}
+	return (unsafe.Pointer)(ret)
+}
+
+// map all of a buffer object's data store into the client's address space
+func MapBuffer(target uint32, access uint32) unsafe.Pointer {
+	return defaultContext.MapBuffer(target, access)
+}
+
+// map all or part of a buffer object's data store into the client's address space
+func (ctx *Context) MapBufferRange(target uint32, offset int, length int, access uint32) unsafe.Pointer {
+	ret, _, _ := purego.SyscallN(ctx.gpMapBufferRange, uintptr(target), uintptr(offset), uintptr(length), uintptr(access))
+	if hooked {
+		ctx.called("MapBufferRange", target, offset, length, access)
+	}
+	return (unsafe.Pointer)(ret)
+}
+
+// map all or part of a buffer object's data store into the client's address space
+func MapBufferRange(target uint32, offset int, length int, access uint32) unsafe.Pointer {
+	return defaultContext.MapBufferRange(target, offset, length, access)
+}
+
+// specifies minimum rate at which sample shaing takes place
+func (ctx *Context) MinSampleShading(value float32) {
+	ctx.fpMinSampleShading(value)
+	if hooked {
+		ctx.called("MinSampleShading", value)
+	}
+}
+
+// specifies minimum rate at which sample shaing takes place
+func MinSampleShading(value float32) {
+	defaultContext.MinSampleShading(value)
+}
+
+// render multiple sets of primitives from array data
+func (ctx *Context) MultiDrawArrays(mode uint32, first *int32, count *int32, drawcount int32) {
+	purego.SyscallN(ctx.gpMultiDrawArrays, uintptr(mode), uintptr(unsafe.Pointer(first)), uintptr(unsafe.Pointer(count)), uintptr(drawcount))
+	if hooked {
+		ctx.called("MultiDrawArrays", mode, first, count, drawcount)
+	}
+}
+
+// render multiple sets of primitives from array data
+func MultiDrawArrays(mode uint32, first *int32, count *int32, drawcount int32) {
+	defaultContext.MultiDrawArrays(mode, first, count, drawcount)
+}
+
+// render multiple sets of primitives by specifying indices of array data elements
+func (ctx *Context) MultiDrawElements(mode uint32, count *int32, xtype uint32, indices *unsafe.Pointer, drawcount int32) {
+	purego.SyscallN(ctx.gpMultiDrawElements, uintptr(mode), uintptr(unsafe.Pointer(count)), uintptr(xtype), uintptr(unsafe.Pointer(indices)), uintptr(drawcount))
+	if hooked {
+		ctx.called("MultiDrawElements", mode, count, xtype, indices, drawcount)
+	}
+}
+
+// render multiple sets of primitives by specifying indices of array data elements
+func MultiDrawElements(mode uint32, count *int32, xtype uint32, indices *unsafe.Pointer, drawcount int32) {
+	defaultContext.MultiDrawElements(mode, count, xtype, indices, drawcount)
+}
+
+// render multiple sets of primitives by specifying indices of array data elements and an index to apply to each index
+func (ctx *Context) MultiDrawElementsBaseVertex(mode uint32, count *int32, xtype uint32, indices *unsafe.Pointer, drawcount int32, basevertex *int32) {
+	purego.SyscallN(ctx.gpMultiDrawElementsBaseVertex, uintptr(mode), uintptr(unsafe.Pointer(count)), uintptr(xtype), uintptr(unsafe.Pointer(indices)), uintptr(drawcount), uintptr(unsafe.Pointer(basevertex)))
+	if hooked {
+		ctx.called("MultiDrawElementsBaseVertex", mode, count, xtype, indices, drawcount, basevertex)
+	}
+}
+
+// render multiple sets of primitives by specifying indices of array data elements and an index to apply to each index
+func MultiDrawElementsBaseVertex(mode uint32, count *int32, xtype uint32, indices *unsafe.Pointer, drawcount int32, basevertex *int32) {
+	defaultContext.MultiDrawElementsBaseVertex(mode, count, xtype, indices, drawcount, basevertex)
+}
+
+func (ctx *Context) PatchParameterfv(pname uint32, values *float32) {
+	purego.SyscallN(ctx.gpPatchParameterfv, uintptr(pname), uintptr(unsafe.Pointer(values)))
+	if hooked {
+		ctx.called("PatchParameterfv", pname, values)
+	}
+}
+
+func PatchParameterfv(pname uint32, values *float32) {
+	defaultContext.PatchParameterfv(pname, values)
+}
+
+// specifies the parameters for patch primitives
+func (ctx *Context) PatchParameteri(pname uint32, value int32) {
+	purego.SyscallN(ctx.gpPatchParameteri, uintptr(pname), uintptr(value))
+	if hooked {
+		ctx.called("PatchParameteri", pname, value)
+	}
+}
+
+// specifies the parameters for patch primitives
+func PatchParameteri(pname uint32, value int32) {
+	defaultContext.PatchParameteri(pname, value)
+}
+
+// pause transform feedback operations
+func (ctx *Context) PauseTransformFeedback() {
+	purego.SyscallN(ctx.gpPauseTransformFeedback)
+	if hooked {
+		ctx.called("PauseTransformFeedback")
+	}
+}
+
+// pause transform feedback operations
+func PauseTransformFeedback() {
+	defaultContext.PauseTransformFeedback()
+}
+
+func (ctx *Context) PixelStoref(pname uint32, param float32) {
+	ctx.fpPixelStoref(pname, param)
+	if hooked {
+		ctx.called("PixelStoref", pname, param)
+	}
+}
+
+func PixelStoref(pname uint32, param float32) {
+	defaultContext.PixelStoref(pname, param)
+}
+
+// set pixel storage modes
+func (ctx *Context) PixelStorei(pname uint32, param int32) {
+	purego.SyscallN(ctx.gpPixelStorei, uintptr(pname), uintptr(param))
+	if hooked {
+		ctx.called("PixelStorei", pname, param)
+	}
+}
+
+// set pixel storage modes
+func PixelStorei(pname uint32, param int32) {
+	defaultContext.PixelStorei(pname, param)
+}
+
+func (ctx *Context) PointParameterf(pname uint32, param float32) {
+	ctx.fpPointParameterf(pname, param)
+	if hooked {
+		ctx.called("PointParameterf", pname, param)
+	}
+}
+
+func PointParameterf(pname uint32, param float32) {
+	defaultContext.PointParameterf(pname, param)
+}
+
+func (ctx *Context) PointParameterfv(pname uint32, params *float32) {
+	purego.SyscallN(ctx.gpPointParameterfv, uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("PointParameterfv", pname, params)
+	}
+}
+
+func PointParameterfv(pname uint32, params *float32) {
+	defaultContext.PointParameterfv(pname, params)
+}
+
+func (ctx *Context) PointParameteri(pname uint32, param int32) {
+	purego.SyscallN(ctx.gpPointParameteri, uintptr(pname), uintptr(param))
+	if hooked {
+		ctx.called("PointParameteri", pname, param)
+	}
+}
+
+func PointParameteri(pname uint32, param int32) {
+	defaultContext.PointParameteri(pname, param)
+}
+
+func (ctx *Context) PointParameteriv(pname uint32, params *int32) {
+	purego.SyscallN(ctx.gpPointParameteriv, uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("PointParameteriv", pname, params)
+	}
+}
+
+func PointParameteriv(pname uint32, params *int32) {
+	defaultContext.PointParameteriv(pname, params)
+}
+
+// specify the diameter of rasterized points
+func (ctx *Context) PointSize(size float32) {
+	ctx.fpPointSize(size)
+	if hooked {
+		ctx.called("PointSize", size)
+	}
+}
+
+// specify the diameter of rasterized points
+func PointSize(size float32) {
+	defaultContext.PointSize(size)
+}
+
+// select a polygon rasterization mode
+func (ctx *Context) PolygonMode(face uint32, mode uint32) {
+	purego.SyscallN(ctx.gpPolygonMode, uintptr(face), uintptr(mode))
+	if hooked {
+		ctx.called("PolygonMode", face, mode)
+	}
+}
+
+// select a polygon rasterization mode
+func PolygonMode(face uint32, mode uint32) {
+	defaultContext.PolygonMode(face, mode)
+}
+
+// set the scale and units used to calculate depth values
+func (ctx *Context) PolygonOffset(factor float32, units float32) {
+	ctx.fpPolygonOffset(factor, units)
+	if hooked {
+		ctx.called("PolygonOffset", factor, units)
+	}
+}
+
+// set the scale and units used to calculate depth values
+func PolygonOffset(factor float32, units float32) {
+	defaultContext.PolygonOffset(factor, units)
+}
+
+// specify the primitive restart index
+func (ctx *Context) PrimitiveRestartIndex(index uint32) {
+	purego.SyscallN(ctx.gpPrimitiveRestartIndex, uintptr(index))
+	if hooked {
+		ctx.called("PrimitiveRestartIndex", index)
+	}
+}
+
+// specify the primitive restart index
+func PrimitiveRestartIndex(index uint32) {
+	defaultContext.PrimitiveRestartIndex(index)
+}
+
+// load a program object with a program binary
+func (ctx *Context) ProgramBinary(program uint32, binaryFormat uint32, binary unsafe.Pointer, length int32) {
+	purego.SyscallN(ctx.gpProgramBinary, uintptr(program), uintptr(binaryFormat), uintptr(binary), uintptr(length))
+	if hooked {
+		ctx.called("ProgramBinary", program, binaryFormat, binary, length)
+	}
+}
+
+// load a program object with a program binary
+func ProgramBinary(program uint32, binaryFormat uint32, binary unsafe.Pointer, length int32) {
+	defaultContext.ProgramBinary(program, binaryFormat, binary, length)
+}
+
+// specify a parameter for a program object
+func (ctx *Context) ProgramParameteri(program uint32, pname uint32, value int32) {
+	purego.SyscallN(ctx.gpProgramParameteri, uintptr(program), uintptr(pname), uintptr(value))
+	if hooked {
+		ctx.called("ProgramParameteri", program, pname, value)
+	}
+}
+
+// specify a parameter for a program object
+func ProgramParameteri(program uint32, pname uint32, value int32) {
+	defaultContext.ProgramParameteri(program, pname, value)
+}
+
+func (ctx *Context) ProgramUniform1d(program uint32, location int32, v0 float64) {
+	ctx.fpProgramUniform1d(program, location, v0)
+	if hooked {
+		ctx.called("ProgramUniform1d", program, location, v0)
+	}
+}
+
+func ProgramUniform1d(program uint32, location int32, v0 float64) {
+	defaultContext.ProgramUniform1d(program, location, v0)
+}
+
+func (ctx *Context) ProgramUniform1dv(program uint32, location int32, count int32, value *float64) {
+	purego.SyscallN(ctx.gpProgramUniform1dv, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniform1dv", program, location, count, value)
+	}
+}
+
+func ProgramUniform1dv(program uint32, location int32, count int32, value *float64) {
+	defaultContext.ProgramUniform1dv(program, location, count, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform1f(program uint32, location int32, v0 float32) {
+	ctx.fpProgramUniform1f(program, location, v0)
+	if hooked {
+		ctx.called("ProgramUniform1f", program, location, v0)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform1f(program uint32, location int32, v0 float32) {
+	defaultContext.ProgramUniform1f(program, location, v0)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform1fv(program uint32, location int32, count int32, value *float32) {
+	purego.SyscallN(ctx.gpProgramUniform1fv, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniform1fv", program, location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform1fv(program uint32, location int32, count int32, value *float32) {
+	defaultContext.ProgramUniform1fv(program, location, count, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform1i(program uint32, location int32, v0 int32) {
+	purego.SyscallN(ctx.gpProgramUniform1i, uintptr(program), uintptr(location), uintptr(v0))
+	if hooked {
+		ctx.called("ProgramUniform1i", program, location, v0)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform1i(program uint32, location int32, v0 int32) {
+	defaultContext.ProgramUniform1i(program, location, v0)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform1iv(program uint32, location int32, count int32, value *int32) {
+	purego.SyscallN(ctx.gpProgramUniform1iv, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniform1iv", program, location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform1iv(program uint32, location int32, count int32, value *int32) {
+	defaultContext.ProgramUniform1iv(program, location, count, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform1ui(program uint32, location int32, v0 uint32) {
+	purego.SyscallN(ctx.gpProgramUniform1ui, uintptr(program), uintptr(location), uintptr(v0))
+	if hooked {
+		ctx.called("ProgramUniform1ui", program, location, v0)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform1ui(program uint32, location int32, v0 uint32) {
+	defaultContext.ProgramUniform1ui(program, location, v0)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform1uiv(program uint32, location int32, count int32, value *uint32) {
+	purego.SyscallN(ctx.gpProgramUniform1uiv, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniform1uiv", program, location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform1uiv(program uint32, location int32, count int32, value *uint32) {
+	defaultContext.ProgramUniform1uiv(program, location, count, value)
+}
+
+func (ctx *Context) ProgramUniform2d(program uint32, location int32, v0 float64, v1 float64) {
+	ctx.fpProgramUniform2d(program, location, v0, v1)
+	if hooked {
+		ctx.called("ProgramUniform2d", program, location, v0, v1)
+	}
+}
+
+func ProgramUniform2d(program uint32, location int32, v0 float64, v1 float64) {
+	defaultContext.ProgramUniform2d(program, location, v0, v1)
+}
+
+func (ctx *Context) ProgramUniform2dv(program uint32, location int32, count int32, value *float64) {
+	purego.SyscallN(ctx.gpProgramUniform2dv, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniform2dv", program, location, count, value)
+	}
+}
+
+func ProgramUniform2dv(program uint32, location int32, count int32, value *float64) {
+	defaultContext.ProgramUniform2dv(program, location, count, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform2f(program uint32, location int32, v0 float32, v1 float32) {
+	ctx.fpProgramUniform2f(program, location, v0, v1)
+	if hooked {
+		ctx.called("ProgramUniform2f", program, location, v0, v1)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform2f(program uint32, location int32, v0 float32, v1 float32) {
+	defaultContext.ProgramUniform2f(program, location, v0, v1)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform2fv(program uint32, location int32, count int32, value *float32) {
+	purego.SyscallN(ctx.gpProgramUniform2fv, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniform2fv", program, location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform2fv(program uint32, location int32, count int32, value *float32) {
+	defaultContext.ProgramUniform2fv(program, location, count, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform2i(program uint32, location int32, v0 int32, v1 int32) {
+	purego.SyscallN(ctx.gpProgramUniform2i, uintptr(program), uintptr(location), uintptr(v0), uintptr(v1))
+	if hooked {
+		ctx.called("ProgramUniform2i", program, location, v0, v1)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform2i(program uint32, location int32, v0 int32, v1 int32) {
+	defaultContext.ProgramUniform2i(program, location, v0, v1)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform2iv(program uint32, location int32, count int32, value *int32) {
+	purego.SyscallN(ctx.gpProgramUniform2iv, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniform2iv", program, location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform2iv(program uint32, location int32, count int32, value *int32) {
+	defaultContext.ProgramUniform2iv(program, location, count, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform2ui(program uint32, location int32, v0 uint32, v1 uint32) {
+	purego.SyscallN(ctx.gpProgramUniform2ui, uintptr(program), uintptr(location), uintptr(v0), uintptr(v1))
+	if hooked {
+		ctx.called("ProgramUniform2ui", program, location, v0, v1)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform2ui(program uint32, location int32, v0 uint32, v1 uint32) {
+	defaultContext.ProgramUniform2ui(program, location, v0, v1)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform2uiv(program uint32, location int32, count int32, value *uint32) {
+	purego.SyscallN(ctx.gpProgramUniform2uiv, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniform2uiv", program, location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform2uiv(program uint32, location int32, count int32, value *uint32) {
+	defaultContext.ProgramUniform2uiv(program, location, count, value)
+}
+
+func (ctx *Context) ProgramUniform3d(program uint32, location int32, v0 float64, v1 float64, v2 float64) {
+	ctx.fpProgramUniform3d(program, location, v0, v1, v2)
+	if hooked {
+		ctx.called("ProgramUniform3d", program, location, v0, v1, v2)
+	}
+}
+
+func ProgramUniform3d(program uint32, location int32, v0 float64, v1 float64, v2 float64) {
+	defaultContext.ProgramUniform3d(program, location, v0, v1, v2)
+}
+
+func (ctx *Context) ProgramUniform3dv(program uint32, location int32, count int32, value *float64) {
+	purego.SyscallN(ctx.gpProgramUniform3dv, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniform3dv", program, location, count, value)
+	}
+}
+
+func ProgramUniform3dv(program uint32, location int32, count int32, value *float64) {
+	defaultContext.ProgramUniform3dv(program, location, count, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform3f(program uint32, location int32, v0 float32, v1 float32, v2 float32) {
+	ctx.fpProgramUniform3f(program, location, v0, v1, v2)
+	if hooked {
+		ctx.called("ProgramUniform3f", program, location, v0, v1, v2)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform3f(program uint32, location int32, v0 float32, v1 float32, v2 float32) {
+	defaultContext.ProgramUniform3f(program, location, v0, v1, v2)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform3fv(program uint32, location int32, count int32, value *float32) {
+	purego.SyscallN(ctx.gpProgramUniform3fv, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniform3fv", program, location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform3fv(program uint32, location int32, count int32, value *float32) {
+	defaultContext.ProgramUniform3fv(program, location, count, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform3i(program uint32, location int32, v0 int32, v1 int32, v2 int32) {
+	purego.SyscallN(ctx.gpProgramUniform3i, uintptr(program), uintptr(location), uintptr(v0), uintptr(v1), uintptr(v2))
+	if hooked {
+		ctx.called("ProgramUniform3i", program, location, v0, v1, v2)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform3i(program uint32, location int32, v0 int32, v1 int32, v2 int32) {
+	defaultContext.ProgramUniform3i(program, location, v0, v1, v2)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform3iv(program uint32, location int32, count int32, value *int32) {
+	purego.SyscallN(ctx.gpProgramUniform3iv, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniform3iv", program, location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform3iv(program uint32, location int32, count int32, value *int32) {
+	defaultContext.ProgramUniform3iv(program, location, count, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform3ui(program uint32, location int32, v0 uint32, v1 uint32, v2 uint32) {
+	purego.SyscallN(ctx.gpProgramUniform3ui, uintptr(program), uintptr(location), uintptr(v0), uintptr(v1), uintptr(v2))
+	if hooked {
+		ctx.called("ProgramUniform3ui", program, location, v0, v1, v2)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform3ui(program uint32, location int32, v0 uint32, v1 uint32, v2 uint32) {
+	defaultContext.ProgramUniform3ui(program, location, v0, v1, v2)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform3uiv(program uint32, location int32, count int32, value *uint32) {
+	purego.SyscallN(ctx.gpProgramUniform3uiv, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniform3uiv", program, location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform3uiv(program uint32, location int32, count int32, value *uint32) {
+	defaultContext.ProgramUniform3uiv(program, location, count, value)
+}
+
+func (ctx *Context) ProgramUniform4d(program uint32, location int32, v0 float64, v1 float64, v2 float64, v3 float64) {
+	ctx.fpProgramUniform4d(program, location, v0, v1, v2, v3)
+	if hooked {
+		ctx.called("ProgramUniform4d", program, location, v0, v1, v2, v3)
+	}
+}
+
+func ProgramUniform4d(program uint32, location int32, v0 float64, v1 float64, v2 float64, v3 float64) {
+	defaultContext.ProgramUniform4d(program, location, v0, v1, v2, v3)
+}
+
+func (ctx *Context) ProgramUniform4dv(program uint32, location int32, count int32, value *float64) {
+	purego.SyscallN(ctx.gpProgramUniform4dv, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniform4dv", program, location, count, value)
+	}
+}
+
+func ProgramUniform4dv(program uint32, location int32, count int32, value *float64) {
+	defaultContext.ProgramUniform4dv(program, location, count, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform4f(program uint32, location int32, v0 float32, v1 float32, v2 float32, v3 float32) {
+	ctx.fpProgramUniform4f(program, location, v0, v1, v2, v3)
+	if hooked {
+		ctx.called("ProgramUniform4f", program, location, v0, v1, v2, v3)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform4f(program uint32, location int32, v0 float32, v1 float32, v2 float32, v3 float32) {
+	defaultContext.ProgramUniform4f(program, location, v0, v1, v2, v3)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform4fv(program uint32, location int32, count int32, value *float32) {
+	purego.SyscallN(ctx.gpProgramUniform4fv, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniform4fv", program, location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform4fv(program uint32, location int32, count int32, value *float32) {
+	defaultContext.ProgramUniform4fv(program, location, count, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform4i(program uint32, location int32, v0 int32, v1 int32, v2 int32, v3 int32) {
+	purego.SyscallN(ctx.gpProgramUniform4i, uintptr(program), uintptr(location), uintptr(v0), uintptr(v1), uintptr(v2), uintptr(v3))
+	if hooked {
+		ctx.called("ProgramUniform4i", program, location, v0, v1, v2, v3)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform4i(program uint32, location int32, v0 int32, v1 int32, v2 int32, v3 int32) {
+	defaultContext.ProgramUniform4i(program, location, v0, v1, v2, v3)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform4iv(program uint32, location int32, count int32, value *int32) {
+	purego.SyscallN(ctx.gpProgramUniform4iv, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniform4iv", program, location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform4iv(program uint32, location int32, count int32, value *int32) {
+	defaultContext.ProgramUniform4iv(program, location, count, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform4ui(program uint32, location int32, v0 uint32, v1 uint32, v2 uint32, v3 uint32) {
+	purego.SyscallN(ctx.gpProgramUniform4ui, uintptr(program), uintptr(location), uintptr(v0), uintptr(v1), uintptr(v2), uintptr(v3))
+	if hooked {
+		ctx.called("ProgramUniform4ui", program, location, v0, v1, v2, v3)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform4ui(program uint32, location int32, v0 uint32, v1 uint32, v2 uint32, v3 uint32) {
+	defaultContext.ProgramUniform4ui(program, location, v0, v1, v2, v3)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform4uiv(program uint32, location int32, count int32, value *uint32) {
+	purego.SyscallN(ctx.gpProgramUniform4uiv, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniform4uiv", program, location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform4uiv(program uint32, location int32, count int32, value *uint32) {
+	defaultContext.ProgramUniform4uiv(program, location, count, value)
+}
+
+func (ctx *Context) ProgramUniformMatrix2dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	purego.SyscallN(ctx.gpProgramUniformMatrix2dv, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniformMatrix2dv", program, location, count, transpose, value)
+	}
+}
+
+func ProgramUniformMatrix2dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	defaultContext.ProgramUniformMatrix2dv(program, location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniformMatrix2fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	purego.SyscallN(ctx.gpProgramUniformMatrix2fv, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniformMatrix2fv", program, location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniformMatrix2fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	defaultContext.ProgramUniformMatrix2fv(program, location, count, transpose, value)
+}
+
+func (ctx *Context) ProgramUniformMatrix2x3dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	purego.SyscallN(ctx.gpProgramUniformMatrix2x3dv, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniformMatrix2x3dv", program, location, count, transpose, value)
+	}
+}
+
+func ProgramUniformMatrix2x3dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	defaultContext.ProgramUniformMatrix2x3dv(program, location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniformMatrix2x3fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	purego.SyscallN(ctx.gpProgramUniformMatrix2x3fv, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniformMatrix2x3fv", program, location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniformMatrix2x3fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	defaultContext.ProgramUniformMatrix2x3fv(program, location, count, transpose, value)
+}
+
+func (ctx *Context) ProgramUniformMatrix2x4dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	purego.SyscallN(ctx.gpProgramUniformMatrix2x4dv, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniformMatrix2x4dv", program, location, count, transpose, value)
+	}
+}
+
+func ProgramUniformMatrix2x4dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	defaultContext.ProgramUniformMatrix2x4dv(program, location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniformMatrix2x4fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	purego.SyscallN(ctx.gpProgramUniformMatrix2x4fv, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniformMatrix2x4fv", program, location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniformMatrix2x4fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	defaultContext.ProgramUniformMatrix2x4fv(program, location, count, transpose, value)
+}
+
+func (ctx *Context) ProgramUniformMatrix3dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	purego.SyscallN(ctx.gpProgramUniformMatrix3dv, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniformMatrix3dv", program, location, count, transpose, value)
+	}
+}
+
+func ProgramUniformMatrix3dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	defaultContext.ProgramUniformMatrix3dv(program, location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniformMatrix3fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	purego.SyscallN(ctx.gpProgramUniformMatrix3fv, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniformMatrix3fv", program, location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniformMatrix3fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	defaultContext.ProgramUniformMatrix3fv(program, location, count, transpose, value)
+}
+
+func (ctx *Context) ProgramUniformMatrix3x2dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	purego.SyscallN(ctx.gpProgramUniformMatrix3x2dv, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniformMatrix3x2dv", program, location, count, transpose, value)
+	}
+}
+
+func ProgramUniformMatrix3x2dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	defaultContext.ProgramUniformMatrix3x2dv(program, location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniformMatrix3x2fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	purego.SyscallN(ctx.gpProgramUniformMatrix3x2fv, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniformMatrix3x2fv", program, location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniformMatrix3x2fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	defaultContext.ProgramUniformMatrix3x2fv(program, location, count, transpose, value)
+}
+
+func (ctx *Context) ProgramUniformMatrix3x4dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	purego.SyscallN(ctx.gpProgramUniformMatrix3x4dv, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniformMatrix3x4dv", program, location, count, transpose, value)
+	}
+}
+
+func ProgramUniformMatrix3x4dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	defaultContext.ProgramUniformMatrix3x4dv(program, location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniformMatrix3x4fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	purego.SyscallN(ctx.gpProgramUniformMatrix3x4fv, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniformMatrix3x4fv", program, location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniformMatrix3x4fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	defaultContext.ProgramUniformMatrix3x4fv(program, location, count, transpose, value)
+}
+
+func (ctx *Context) ProgramUniformMatrix4dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	purego.SyscallN(ctx.gpProgramUniformMatrix4dv, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniformMatrix4dv", program, location, count, transpose, value)
+	}
+}
+
+func ProgramUniformMatrix4dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	defaultContext.ProgramUniformMatrix4dv(program, location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniformMatrix4fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	purego.SyscallN(ctx.gpProgramUniformMatrix4fv, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniformMatrix4fv", program, location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniformMatrix4fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	defaultContext.ProgramUniformMatrix4fv(program, location, count, transpose, value)
+}
+
+func (ctx *Context) ProgramUniformMatrix4x2dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	purego.SyscallN(ctx.gpProgramUniformMatrix4x2dv, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniformMatrix4x2dv", program, location, count, transpose, value)
+	}
+}
+
+func ProgramUniformMatrix4x2dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	defaultContext.ProgramUniformMatrix4x2dv(program, location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniformMatrix4x2fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	purego.SyscallN(ctx.gpProgramUniformMatrix4x2fv, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniformMatrix4x2fv", program, location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniformMatrix4x2fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	defaultContext.ProgramUniformMatrix4x2fv(program, location, count, transpose, value)
+}
+
+func (ctx *Context) ProgramUniformMatrix4x3dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	purego.SyscallN(ctx.gpProgramUniformMatrix4x3dv, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniformMatrix4x3dv", program, location, count, transpose, value)
+	}
+}
+
+func ProgramUniformMatrix4x3dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	defaultContext.ProgramUniformMatrix4x3dv(program, location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniformMatrix4x3fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	purego.SyscallN(ctx.gpProgramUniformMatrix4x3fv, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ProgramUniformMatrix4x3fv", program, location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniformMatrix4x3fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	defaultContext.ProgramUniformMatrix4x3fv(program, location, count, transpose, value)
+}
+
+// specifiy the vertex to be used as the source of data for flat shaded varyings
+func (ctx *Context) ProvokingVertex(mode uint32) {
+	purego.SyscallN(ctx.gpProvokingVertex, uintptr(mode))
+	if hooked {
+		ctx.called("ProvokingVertex", mode)
+	}
+}
+
+// specifiy the vertex to be used as the source of data for flat shaded varyings
+func ProvokingVertex(mode uint32) {
+	defaultContext.ProvokingVertex(mode)
+}
+
+// record the GL time into a query object after all previous commands have reached the GL server but have not yet necessarily executed.
+func (ctx *Context) QueryCounter(id uint32, target uint32) {
+	purego.SyscallN(ctx.gpQueryCounter, uintptr(id), uintptr(target))
+	if hooked {
+		ctx.called("QueryCounter", id, target)
+	}
+}
+
+// record the GL time into a query object after all previous commands have reached the GL server but have not yet necessarily executed.
+func QueryCounter(id uint32, target uint32) {
+	defaultContext.QueryCounter(id, target)
+}
+
+// select a color buffer source for pixels
+func (ctx *Context) ReadBuffer(src uint32) {
+	purego.SyscallN(ctx.gpReadBuffer, uintptr(src))
+	if hooked {
+		ctx.called("ReadBuffer", src)
+	}
+}
+
+// select a color buffer source for pixels
+func ReadBuffer(src uint32) {
+	defaultContext.ReadBuffer(src)
+}
+
+// read a block of pixels from the frame buffer
+func (ctx *Context) ReadPixels(x int32, y int32, width int32, height int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	purego.SyscallN(ctx.gpReadPixels, uintptr(x), uintptr(y), uintptr(width), uintptr(height), uintptr(format), uintptr(xtype), uintptr(pixels))
+	if hooked {
+		ctx.called("ReadPixels", x, y, width, height, format, xtype, pixels)
+	}
+}
+
+// read a block of pixels from the frame buffer
+func ReadPixels(x int32, y int32, width int32, height int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	defaultContext.ReadPixels(x, y, width, height, format, xtype, pixels)
+}
+
+// release resources consumed by the implementation's shader compiler
+func (ctx *Context) ReleaseShaderCompiler() {
+	purego.SyscallN(ctx.gpReleaseShaderCompiler)
+	if hooked {
+		ctx.called("ReleaseShaderCompiler")
+	}
+}
+
+// release resources consumed by the implementation's shader compiler
+func ReleaseShaderCompiler() {
+	defaultContext.ReleaseShaderCompiler()
+}
+
+// establish data storage, format and dimensions of a     renderbuffer object's image
+func (ctx *Context) RenderbufferStorage(target uint32, internalformat uint32, width int32, height int32) {
+	purego.SyscallN(ctx.gpRenderbufferStorage, uintptr(target), uintptr(internalformat), uintptr(width), uintptr(height))
+	if hooked {
+		ctx.called("RenderbufferStorage", target, internalformat, width, height)
+	}
+}
+
+// establish data storage, format and dimensions of a     renderbuffer object's image
+func RenderbufferStorage(target uint32, internalformat uint32, width int32, height int32) {
+	defaultContext.RenderbufferStorage(target, internalformat, width, height)
+}
+
+// establish data storage, format, dimensions and sample count of     a renderbuffer object's image
+func (ctx *Context) RenderbufferStorageMultisample(target uint32, samples int32, internalformat uint32, width int32, height int32) {
+	purego.SyscallN(ctx.gpRenderbufferStorageMultisample, uintptr(target), uintptr(samples), uintptr(internalformat), uintptr(width), uintptr(height))
+	if hooked {
+		ctx.called("RenderbufferStorageMultisample", target, samples, internalformat, width, height)
+	}
+}
+
+// establish data storage, format, dimensions and sample count of     a renderbuffer object's image
+func RenderbufferStorageMultisample(target uint32, samples int32, internalformat uint32, width int32, height int32) {
+	defaultContext.RenderbufferStorageMultisample(target, samples, internalformat, width, height)
+}
+
+// resume transform feedback operations
+func (ctx *Context) ResumeTransformFeedback() {
+	purego.SyscallN(ctx.gpResumeTransformFeedback)
+	if hooked {
+		ctx.called("ResumeTransformFeedback")
+	}
+}
+
+// resume transform feedback operations
+func ResumeTransformFeedback() {
+	defaultContext.ResumeTransformFeedback()
+}
+
+// specify multisample coverage parameters
+func (ctx *Context) SampleCoverage(value float32, invert bool) {
+	ctx.fpSampleCoverage(value, invert)
+	if hooked {
+		ctx.called("SampleCoverage", value, invert)
+	}
+}
+
+// specify multisample coverage parameters
+func SampleCoverage(value float32, invert bool) {
+	defaultContext.SampleCoverage(value, invert)
+}
+
+// set the value of a sub-word of the sample mask
+func (ctx *Context) SampleMaski(maskNumber uint32, mask uint32) {
+	purego.SyscallN(ctx.gpSampleMaski, uintptr(maskNumber), uintptr(mask))
+	if hooked {
+		ctx.called("SampleMaski", maskNumber, mask)
+	}
+}
+
+// set the value of a sub-word of the sample mask
+func SampleMaski(maskNumber uint32, mask uint32) {
+	defaultContext.SampleMaski(maskNumber, mask)
+}
+
+func (ctx *Context) SamplerParameterIiv(sampler uint32, pname uint32, param *int32) {
+	purego.SyscallN(ctx.gpSamplerParameterIiv, uintptr(sampler), uintptr(pname), uintptr(unsafe.Pointer(param)))
+	if hooked {
+		ctx.called("SamplerParameterIiv", sampler, pname, param)
+	}
+}
+
+func SamplerParameterIiv(sampler uint32, pname uint32, param *int32) {
+	defaultContext.SamplerParameterIiv(sampler, pname, param)
+}
+
+func (ctx *Context) SamplerParameterIuiv(sampler uint32, pname uint32, param *uint32) {
+	purego.SyscallN(ctx.gpSamplerParameterIuiv, uintptr(sampler), uintptr(pname), uintptr(unsafe.Pointer(param)))
+	if hooked {
+		ctx.called("SamplerParameterIuiv", sampler, pname, param)
+	}
+}
+
+func SamplerParameterIuiv(sampler uint32, pname uint32, param *uint32) {
+	defaultContext.SamplerParameterIuiv(sampler, pname, param)
+}
+
+func (ctx *Context) SamplerParameterf(sampler uint32, pname uint32, param float32) {
+	ctx.fpSamplerParameterf(sampler, pname, param)
+	if hooked {
+		ctx.called("SamplerParameterf", sampler, pname, param)
+	}
+}
+
+func SamplerParameterf(sampler uint32, pname uint32, param float32) {
+	defaultContext.SamplerParameterf(sampler, pname, param)
+}
+
+func (ctx *Context) SamplerParameterfv(sampler uint32, pname uint32, param *float32) {
+	purego.SyscallN(ctx.gpSamplerParameterfv, uintptr(sampler), uintptr(pname), uintptr(unsafe.Pointer(param)))
+	if hooked {
+		ctx.called("SamplerParameterfv", sampler, pname, param)
+	}
+}
+
+func SamplerParameterfv(sampler uint32, pname uint32, param *float32) {
+	defaultContext.SamplerParameterfv(sampler, pname, param)
+}
+
+func (ctx *Context) SamplerParameteri(sampler uint32, pname uint32, param int32) {
+	purego.SyscallN(ctx.gpSamplerParameteri, uintptr(sampler), uintptr(pname), uintptr(param))
+	if hooked {
+		ctx.called("SamplerParameteri", sampler, pname, param)
+	}
+}
+
+func SamplerParameteri(sampler uint32, pname uint32, param int32) {
+	defaultContext.SamplerParameteri(sampler, pname, param)
+}
+
+func (ctx *Context) SamplerParameteriv(sampler uint32, pname uint32, param *int32) {
+	purego.SyscallN(ctx.gpSamplerParameteriv, uintptr(sampler), uintptr(pname), uintptr(unsafe.Pointer(param)))
+	if hooked {
+		ctx.called("SamplerParameteriv", sampler, pname, param)
+	}
+}
+
+func SamplerParameteriv(sampler uint32, pname uint32, param *int32) {
+	defaultContext.SamplerParameteriv(sampler, pname, param)
+}
+
+// define the scissor box
+func (ctx *Context) Scissor(x int32, y int32, width int32, height int32) {
+	purego.SyscallN(ctx.gpScissor, uintptr(x), uintptr(y), uintptr(width), uintptr(height))
+	if hooked {
+		ctx.called("Scissor", x, y, width, height)
+	}
+}
+
+// define the scissor box
+func Scissor(x int32, y int32, width int32, height int32) {
+	defaultContext.Scissor(x, y, width, height)
+}
+
+func (ctx *Context) ScissorArrayv(first uint32, count int32, v *int32) {
+	purego.SyscallN(ctx.gpScissorArrayv, uintptr(first), uintptr(count), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("ScissorArrayv", first, count, v)
+	}
+}
+
+func ScissorArrayv(first uint32, count int32, v *int32) {
+	defaultContext.ScissorArrayv(first, count, v)
+}
+
+// define the scissor box for a specific viewport
+func (ctx *Context) ScissorIndexed(index uint32, left int32, bottom int32, width int32, height int32) {
+	purego.SyscallN(ctx.gpScissorIndexed, uintptr(index), uintptr(left), uintptr(bottom), uintptr(width), uintptr(height))
+	if hooked {
+		ctx.called("ScissorIndexed", index, left, bottom, width, height)
+	}
+}
+
+// define the scissor box for a specific viewport
+func ScissorIndexed(index uint32, left int32, bottom int32, width int32, height int32) {
+	defaultContext.ScissorIndexed(index, left, bottom, width, height)
+}
+
+func (ctx *Context) ScissorIndexedv(index uint32, v *int32) {
+	purego.SyscallN(ctx.gpScissorIndexedv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("ScissorIndexedv", index, v)
+	}
+}
+
+func ScissorIndexedv(index uint32, v *int32) {
+	defaultContext.ScissorIndexedv(index, v)
+}
+
+// load pre-compiled shader binaries
+func (ctx *Context) ShaderBinary(count int32, shaders *uint32, binaryformat uint32, binary unsafe.Pointer, length int32) {
+	purego.SyscallN(ctx.gpShaderBinary, uintptr(count), uintptr(unsafe.Pointer(shaders)), uintptr(binaryformat), uintptr(binary), uintptr(length))
+	if hooked {
+		ctx.called("ShaderBinary", count, shaders, binaryformat, binary, length)
+	}
+}
+
+// load pre-compiled shader binaries
+func ShaderBinary(count int32, shaders *uint32, binaryformat uint32, binary unsafe.Pointer, length int32) {
+	defaultContext.ShaderBinary(count, shaders, binaryformat, binary, length)
+}
+
+// Replaces the source code in a shader object
+func (ctx *Context) ShaderSource(shader uint32, count int32, xstring **uint8, length *int32) {
+	purego.SyscallN(ctx.gpShaderSource, uintptr(shader), uintptr(count), uintptr(unsafe.Pointer(xstring)), uintptr(unsafe.Pointer(length)))
+	if hooked {
+		ctx.called("ShaderSource", shader, count, xstring, length)
+	}
+}
+
+// Replaces the source code in a shader object
+func ShaderSource(shader uint32, count int32, xstring **uint8, length *int32) {
+	defaultContext.ShaderSource(shader, count, xstring, length)
+}
+
+// set front and back function and reference value for stencil testing
+func (ctx *Context) StencilFunc(xfunc uint32, ref int32, mask uint32) {
+	purego.SyscallN(ctx.gpStencilFunc, uintptr(xfunc), uintptr(ref), uintptr(mask))
+	if hooked {
+		ctx.called("StencilFunc", xfunc, ref, mask)
+	}
+}
+
+// set front and back function and reference value for stencil testing
+func StencilFunc(xfunc uint32, ref int32, mask uint32) {
+	defaultContext.StencilFunc(xfunc, ref, mask)
+}
+
+// set front and/or back function and reference value for stencil testing
+func (ctx *Context) StencilFuncSeparate(face uint32, xfunc uint32, ref int32, mask uint32) {
+	purego.SyscallN(ctx.gpStencilFuncSeparate, uintptr(face), uintptr(xfunc), uintptr(ref), uintptr(mask))
+	if hooked {
+		ctx.called("StencilFuncSeparate", face, xfunc, ref, mask)
+	}
+}
+
+// set front and/or back function and reference value for stencil testing
+func StencilFuncSeparate(face uint32, xfunc uint32, ref int32, mask uint32) {
+	defaultContext.StencilFuncSeparate(face, xfunc, ref, mask)
+}
+
+// control the front and back writing of individual bits in the stencil planes
+func (ctx *Context) StencilMask(mask uint32) {
+	purego.SyscallN(ctx.gpStencilMask, uintptr(mask))
+	if hooked {
+		ctx.called("StencilMask", mask)
+	}
+}
+
+// control the front and back writing of individual bits in the stencil planes
+func StencilMask(mask uint32) {
+	defaultContext.StencilMask(mask)
+}
+
+// control the front and/or back writing of individual bits in the stencil planes
+func (ctx *Context) StencilMaskSeparate(face uint32, mask uint32) {
+	purego.SyscallN(ctx.gpStencilMaskSeparate, uintptr(face), uintptr(mask))
+	if hooked {
+		ctx.called("StencilMaskSeparate", face, mask)
+	}
+}
+
+// control the front and/or back writing of individual bits in the stencil planes
+func StencilMaskSeparate(face uint32, mask uint32) {
+	defaultContext.StencilMaskSeparate(face, mask)
+}
+
+// set front and back stencil test actions
+func (ctx *Context) StencilOp(fail uint32, zfail uint32, zpass uint32) {
+	purego.SyscallN(ctx.gpStencilOp, uintptr(fail), uintptr(zfail), uintptr(zpass))
+	if hooked {
+		ctx.called("StencilOp", fail, zfail, zpass)
+	}
+}
+
+// set front and back stencil test actions
+func StencilOp(fail uint32, zfail uint32, zpass uint32) {
+	defaultContext.StencilOp(fail, zfail, zpass)
+}
+
+// set front and/or back stencil test actions
+func (ctx *Context) StencilOpSeparate(face uint32, sfail uint32, dpfail uint32, dppass uint32) {
+	purego.SyscallN(ctx.gpStencilOpSeparate, uintptr(face), uintptr(sfail), uintptr(dpfail), uintptr(dppass))
+	if hooked {
+		ctx.called("StencilOpSeparate", face, sfail, dpfail, dppass)
+	}
+}
+
+// set front and/or back stencil test actions
+func StencilOpSeparate(face uint32, sfail uint32, dpfail uint32, dppass uint32) {
+	defaultContext.StencilOpSeparate(face, sfail, dpfail, dppass)
+}
+
+// attach a buffer object's data store to a buffer texture object
+func (ctx *Context) TexBuffer(target uint32, internalformat uint32, buffer uint32) {
+	purego.SyscallN(ctx.gpTexBuffer, uintptr(target), uintptr(internalformat), uintptr(buffer))
+	if hooked {
+		ctx.called("TexBuffer", target, internalformat, buffer)
+	}
+}
+
+// attach a buffer object's data store to a buffer texture object
+func TexBuffer(target uint32, internalformat uint32, buffer uint32) {
+	defaultContext.TexBuffer(target, internalformat, buffer)
+}
+
+// specify a one-dimensional texture image
+func (ctx *Context) TexImage1D(target uint32, level int32, internalformat int32, width int32, border int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	purego.SyscallN(ctx.gpTexImage1D, uintptr(target), uintptr(level), uintptr(internalformat), uintptr(width), uintptr(border), uintptr(format), uintptr(xtype), uintptr(pixels))
+	if hooked {
+		ctx.called("TexImage1D", target, level, internalformat, width, border, format, xtype, pixels)
+	}
+}
+
+// specify a one-dimensional texture image
+func TexImage1D(target uint32, level int32, internalformat int32, width int32, border int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	defaultContext.TexImage1D(target, level, internalformat, width, border, format, xtype, pixels)
+}
+
+// specify a two-dimensional texture image
+func (ctx *Context) TexImage2D(target uint32, level int32, internalformat int32, width int32, height int32, border int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	purego.SyscallN(ctx.gpTexImage2D, uintptr(target), uintptr(level), uintptr(internalformat), uintptr(width), uintptr(height), uintptr(border), uintptr(format), uintptr(xtype), uintptr(pixels))
+	if hooked {
+		ctx.called("TexImage2D", target, level, internalformat, width, height, border, format, xtype, pixels)
+	}
+}
+
+// specify a two-dimensional texture image
+func TexImage2D(target uint32, level int32, internalformat int32, width int32, height int32, border int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	defaultContext.TexImage2D(target, level, internalformat, width, height, border, format, xtype, pixels)
+}
+
+// establish the data storage, format, dimensions, and number of samples of a multisample texture's image
+func (ctx *Context) TexImage2DMultisample(target uint32, samples int32, internalformat uint32, width int32, height int32, fixedsamplelocations bool) {
+	purego.SyscallN(ctx.gpTexImage2DMultisample, uintptr(target), uintptr(samples), uintptr(internalformat), uintptr(width), uintptr(height), boolToUintptr(fixedsamplelocations))
+	if hooked {
+		ctx.called("TexImage2DMultisample", target, samples, internalformat, width, height, fixedsamplelocations)
+	}
+}
+
+// establish the data storage, format, dimensions, and number of samples of a multisample texture's image
+func TexImage2DMultisample(target uint32, samples int32, internalformat uint32, width int32, height int32, fixedsamplelocations bool) {
+	defaultContext.TexImage2DMultisample(target, samples, internalformat, width, height, fixedsamplelocations)
+}
+
+// specify a three-dimensional texture image
+func (ctx *Context) TexImage3D(target uint32, level int32, internalformat int32, width int32, height int32, depth int32, border int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	purego.SyscallN(ctx.gpTexImage3D, uintptr(target), uintptr(level), uintptr(internalformat), uintptr(width), uintptr(height), uintptr(depth), uintptr(border), uintptr(format), uintptr(xtype), uintptr(pixels))
+	if hooked {
+		ctx.called("TexImage3D", target, level, internalformat, width, height, depth, border, format, xtype, pixels)
+	}
+}
+
+// specify a three-dimensional texture image
+func TexImage3D(target uint32, level int32, internalformat int32, width int32, height int32, depth int32, border int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	defaultContext.TexImage3D(target, level, internalformat, width, height, depth, border, format, xtype, pixels)
+}
+
+// establish the data storage, format, dimensions, and number of samples of a multisample texture's image
+func (ctx *Context) TexImage3DMultisample(target uint32, samples int32, internalformat uint32, width int32, height int32, depth int32, fixedsamplelocations bool) {
+	purego.SyscallN(ctx.gpTexImage3DMultisample, uintptr(target), uintptr(samples), uintptr(internalformat), uintptr(width), uintptr(height), uintptr(depth), boolToUintptr(fixedsamplelocations))
+	if hooked {
+		ctx.called("TexImage3DMultisample", target, samples, internalformat, width, height, depth, fixedsamplelocations)
+	}
+}
+
+// establish the data storage, format, dimensions, and number of samples of a multisample texture's image
+func TexImage3DMultisample(target uint32, samples int32, internalformat uint32, width int32, height int32, depth int32, fixedsamplelocations bool) {
+	defaultContext.TexImage3DMultisample(target, samples, internalformat, width, height, depth, fixedsamplelocations)
+}
+
+func (ctx *Context) TexParameterIiv(target uint32, pname uint32, params *int32) {
+	purego.SyscallN(ctx.gpTexParameterIiv, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("TexParameterIiv", target, pname, params)
+	}
+}
+
+func TexParameterIiv(target uint32, pname uint32, params *int32) {
+	defaultContext.TexParameterIiv(target, pname, params)
+}
+
+func (ctx *Context) TexParameterIuiv(target uint32, pname uint32, params *uint32) {
+	purego.SyscallN(ctx.gpTexParameterIuiv, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("TexParameterIuiv", target, pname, params)
+	}
+}
+
+func TexParameterIuiv(target uint32, pname uint32, params *uint32) {
+	defaultContext.TexParameterIuiv(target, pname, params)
+}
+
+func (ctx *Context) TexParameterf(target uint32, pname uint32, param float32) {
+	ctx.fpTexParameterf(target, pname, param)
+	if hooked {
+		ctx.called("TexParameterf", target, pname, param)
+	}
+}
+
+func TexParameterf(target uint32, pname uint32, param float32) {
+	defaultContext.TexParameterf(target, pname, param)
+}
+
+func (ctx *Context) TexParameterfv(target uint32, pname uint32, params *float32) {
+	purego.SyscallN(ctx.gpTexParameterfv, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("TexParameterfv", target, pname, params)
+	}
+}
+
+func TexParameterfv(target uint32, pname uint32, params *float32) {
+	defaultContext.TexParameterfv(target, pname, params)
+}
+
+func (ctx *Context) TexParameteri(target uint32, pname uint32, param int32) {
+	purego.SyscallN(ctx.gpTexParameteri, uintptr(target), uintptr(pname), uintptr(param))
+	if hooked {
+		ctx.called("TexParameteri", target, pname, param)
+	}
+}
+
+func TexParameteri(target uint32, pname uint32, param int32) {
+	defaultContext.TexParameteri(target, pname, param)
+}
+
+func (ctx *Context) TexParameteriv(target uint32, pname uint32, params *int32) {
+	purego.SyscallN(ctx.gpTexParameteriv, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("TexParameteriv", target, pname, params)
+	}
+}
+
+func TexParameteriv(target uint32, pname uint32, params *int32) {
+	defaultContext.TexParameteriv(target, pname, params)
+}
+
+// specify a one-dimensional texture subimage
+func (ctx *Context) TexSubImage1D(target uint32, level int32, xoffset int32, width int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	purego.SyscallN(ctx.gpTexSubImage1D, uintptr(target), uintptr(level), uintptr(xoffset), uintptr(width), uintptr(format), uintptr(xtype), uintptr(pixels))
+	if hooked {
+		ctx.called("TexSubImage1D", target, level, xoffset, width, format, xtype, pixels)
+	}
+}
+
+// specify a one-dimensional texture subimage
+func TexSubImage1D(target uint32, level int32, xoffset int32, width int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	defaultContext.TexSubImage1D(target, level, xoffset, width, format, xtype, pixels)
+}
+
+// specify a two-dimensional texture subimage
+func (ctx *Context) TexSubImage2D(target uint32, level int32, xoffset int32, yoffset int32, width int32, height int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	purego.SyscallN(ctx.gpTexSubImage2D, uintptr(target), uintptr(level), uintptr(xoffset), uintptr(yoffset), uintptr(width), uintptr(height), uintptr(format), uintptr(xtype), uintptr(pixels))
+	if hooked {
+		ctx.called("TexSubImage2D", target, level, xoffset, yoffset, width, height, format, xtype, pixels)
+	}
+}
+
+// specify a two-dimensional texture subimage
+func TexSubImage2D(target uint32, level int32, xoffset int32, yoffset int32, width int32, height int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	defaultContext.TexSubImage2D(target, level, xoffset, yoffset, width, height, format, xtype, pixels)
+}
+
+// specify a three-dimensional texture subimage
+func (ctx *Context) TexSubImage3D(target uint32, level int32, xoffset int32, yoffset int32, zoffset int32, width int32, height int32, depth int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	purego.SyscallN(ctx.gpTexSubImage3D, uintptr(target), uintptr(level), uintptr(xoffset), uintptr(yoffset), uintptr(zoffset), uintptr(width), uintptr(height), uintptr(depth), uintptr(format), uintptr(xtype), uintptr(pixels))
+	if hooked {
+		ctx.called("TexSubImage3D", target, level, xoffset, yoffset, zoffset, width, height, depth, format, xtype, pixels)
+	}
+}
+
+// specify a three-dimensional texture subimage
+func TexSubImage3D(target uint32, level int32, xoffset int32, yoffset int32, zoffset int32, width int32, height int32, depth int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	defaultContext.TexSubImage3D(target, level, xoffset, yoffset, zoffset, width, height, depth, format, xtype, pixels)
+}
+
+// specify values to record in transform feedback buffers
+func (ctx *Context) TransformFeedbackVaryings(program uint32, count int32, varyings **uint8, bufferMode uint32) {
+	purego.SyscallN(ctx.gpTransformFeedbackVaryings, uintptr(program), uintptr(count), uintptr(unsafe.Pointer(varyings)), uintptr(bufferMode))
+	if hooked {
+		ctx.called("TransformFeedbackVaryings", program, count, varyings, bufferMode)
+	}
+}
+
+// specify values to record in transform feedback buffers
+func TransformFeedbackVaryings(program uint32, count int32, varyings **uint8, bufferMode uint32) {
+	defaultContext.TransformFeedbackVaryings(program, count, varyings, bufferMode)
+}
+
+func (ctx *Context) Uniform1d(location int32, x float64) {
+	ctx.fpUniform1d(location, x)
+	if hooked {
+		ctx.called("Uniform1d", location, x)
+	}
+}
+
+func Uniform1d(location int32, x float64) {
+	defaultContext.Uniform1d(location, x)
+}
+
+func (ctx *Context) Uniform1dv(location int32, count int32, value *float64) {
+	purego.SyscallN(ctx.gpUniform1dv, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("Uniform1dv", location, count, value)
+	}
+}
+
+func Uniform1dv(location int32, count int32, value *float64) {
+	defaultContext.Uniform1dv(location, count, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform1f(location int32, v0 float32) {
+	ctx.fpUniform1f(location, v0)
+	if hooked {
+		ctx.called("Uniform1f", location, v0)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform1f(location int32, v0 float32) {
+	defaultContext.Uniform1f(location, v0)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform1fv(location int32, count int32, value *float32) {
+	purego.SyscallN(ctx.gpUniform1fv, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("Uniform1fv", location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform1fv(location int32, count int32, value *float32) {
+	defaultContext.Uniform1fv(location, count, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform1i(location int32, v0 int32) {
+	purego.SyscallN(ctx.gpUniform1i, uintptr(location), uintptr(v0))
+	if hooked {
+		ctx.called("Uniform1i", location, v0)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform1i(location int32, v0 int32) {
+	defaultContext.Uniform1i(location, v0)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform1iv(location int32, count int32, value *int32) {
+	purego.SyscallN(ctx.gpUniform1iv, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("Uniform1iv", location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform1iv(location int32, count int32, value *int32) {
+	defaultContext.Uniform1iv(location, count, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform1ui(location int32, v0 uint32) {
+	purego.SyscallN(ctx.gpUniform1ui, uintptr(location), uintptr(v0))
+	if hooked {
+		ctx.called("Uniform1ui", location, v0)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform1ui(location int32, v0 uint32) {
+	defaultContext.Uniform1ui(location, v0)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform1uiv(location int32, count int32, value *uint32) {
+	purego.SyscallN(ctx.gpUniform1uiv, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("Uniform1uiv", location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform1uiv(location int32, count int32, value *uint32) {
+	defaultContext.Uniform1uiv(location, count, value)
+}
+
+func (ctx *Context) Uniform2d(location int32, x float64, y float64) {
+	ctx.fpUniform2d(location, x, y)
+	if hooked {
+		ctx.called("Uniform2d", location, x, y)
+	}
+}
+
+func Uniform2d(location int32, x float64, y float64) {
+	defaultContext.Uniform2d(location, x, y)
+}
+
+func (ctx *Context) Uniform2dv(location int32, count int32, value *float64) {
+	purego.SyscallN(ctx.gpUniform2dv, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("Uniform2dv", location, count, value)
+	}
+}
+
+func Uniform2dv(location int32, count int32, value *float64) {
+	defaultContext.Uniform2dv(location, count, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform2f(location int32, v0 float32, v1 float32) {
+	ctx.fpUniform2f(location, v0, v1)
+	if hooked {
+		ctx.called("Uniform2f", location, v0, v1)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform2f(location int32, v0 float32, v1 float32) {
+	defaultContext.Uniform2f(location, v0, v1)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform2fv(location int32, count int32, value *float32) {
+	purego.SyscallN(ctx.gpUniform2fv, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("Uniform2fv", location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform2fv(location int32, count int32, value *float32) {
+	defaultContext.Uniform2fv(location, count, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform2i(location int32, v0 int32, v1 int32) {
+	purego.SyscallN(ctx.gpUniform2i, uintptr(location), uintptr(v0), uintptr(v1))
+	if hooked {
+		ctx.called("Uniform2i", location, v0, v1)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform2i(location int32, v0 int32, v1 int32) {
+	defaultContext.Uniform2i(location, v0, v1)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform2iv(location int32, count int32, value *int32) {
+	purego.SyscallN(ctx.gpUniform2iv, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("Uniform2iv", location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform2iv(location int32, count int32, value *int32) {
+	defaultContext.Uniform2iv(location, count, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform2ui(location int32, v0 uint32, v1 uint32) {
+	purego.SyscallN(ctx.gpUniform2ui, uintptr(location), uintptr(v0), uintptr(v1))
+	if hooked {
+		ctx.called("Uniform2ui", location, v0, v1)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform2ui(location int32, v0 uint32, v1 uint32) {
+	defaultContext.Uniform2ui(location, v0, v1)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform2uiv(location int32, count int32, value *uint32) {
+	purego.SyscallN(ctx.gpUniform2uiv, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("Uniform2uiv", location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform2uiv(location int32, count int32, value *uint32) {
+	defaultContext.Uniform2uiv(location, count, value)
+}
+
+func (ctx *Context) Uniform3d(location int32, x float64, y float64, z float64) {
+	ctx.fpUniform3d(location, x, y, z)
+	if hooked {
+		ctx.called("Uniform3d", location, x, y, z)
+	}
+}
+
+func Uniform3d(location int32, x float64, y float64, z float64) {
+	defaultContext.Uniform3d(location, x, y, z)
+}
+
+func (ctx *Context) Uniform3dv(location int32, count int32, value *float64) {
+	purego.SyscallN(ctx.gpUniform3dv, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("Uniform3dv", location, count, value)
+	}
+}
+
+func Uniform3dv(location int32, count int32, value *float64) {
+	defaultContext.Uniform3dv(location, count, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform3f(location int32, v0 float32, v1 float32, v2 float32) {
+	ctx.fpUniform3f(location, v0, v1, v2)
+	if hooked {
+		ctx.called("Uniform3f", location, v0, v1, v2)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform3f(location int32, v0 float32, v1 float32, v2 float32) {
+	defaultContext.Uniform3f(location, v0, v1, v2)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform3fv(location int32, count int32, value *float32) {
+	purego.SyscallN(ctx.gpUniform3fv, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("Uniform3fv", location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform3fv(location int32, count int32, value *float32) {
+	defaultContext.Uniform3fv(location, count, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform3i(location int32, v0 int32, v1 int32, v2 int32) {
+	purego.SyscallN(ctx.gpUniform3i, uintptr(location), uintptr(v0), uintptr(v1), uintptr(v2))
+	if hooked {
+		ctx.called("Uniform3i", location, v0, v1, v2)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform3i(location int32, v0 int32, v1 int32, v2 int32) {
+	defaultContext.Uniform3i(location, v0, v1, v2)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform3iv(location int32, count int32, value *int32) {
+	purego.SyscallN(ctx.gpUniform3iv, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("Uniform3iv", location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform3iv(location int32, count int32, value *int32) {
+	defaultContext.Uniform3iv(location, count, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform3ui(location int32, v0 uint32, v1 uint32, v2 uint32) {
+	purego.SyscallN(ctx.gpUniform3ui, uintptr(location), uintptr(v0), uintptr(v1), uintptr(v2))
+	if hooked {
+		ctx.called("Uniform3ui", location, v0, v1, v2)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform3ui(location int32, v0 uint32, v1 uint32, v2 uint32) {
+	defaultContext.Uniform3ui(location, v0, v1, v2)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform3uiv(location int32, count int32, value *uint32) {
+	purego.SyscallN(ctx.gpUniform3uiv, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("Uniform3uiv", location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform3uiv(location int32, count int32, value *uint32) {
+	defaultContext.Uniform3uiv(location, count, value)
+}
+
+func (ctx *Context) Uniform4d(location int32, x float64, y float64, z float64, w float64) {
+	ctx.fpUniform4d(location, x, y, z, w)
+	if hooked {
+		ctx.called("Uniform4d", location, x, y, z, w)
+	}
+}
+
+func Uniform4d(location int32, x float64, y float64, z float64, w float64) {
+	defaultContext.Uniform4d(location, x, y, z, w)
+}
+
+func (ctx *Context) Uniform4dv(location int32, count int32, value *float64) {
+	purego.SyscallN(ctx.gpUniform4dv, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("Uniform4dv", location, count, value)
+	}
+}
+
+func Uniform4dv(location int32, count int32, value *float64) {
+	defaultContext.Uniform4dv(location, count, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform4f(location int32, v0 float32, v1 float32, v2 float32, v3 float32) {
+	ctx.fpUniform4f(location, v0, v1, v2, v3)
+	if hooked {
+		ctx.called("Uniform4f", location, v0, v1, v2, v3)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform4f(location int32, v0 float32, v1 float32, v2 float32, v3 float32) {
+	defaultContext.Uniform4f(location, v0, v1, v2, v3)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform4fv(location int32, count int32, value *float32) {
+	purego.SyscallN(ctx.gpUniform4fv, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("Uniform4fv", location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform4fv(location int32, count int32, value *float32) {
+	defaultContext.Uniform4fv(location, count, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform4i(location int32, v0 int32, v1 int32, v2 int32, v3 int32) {
+	purego.SyscallN(ctx.gpUniform4i, uintptr(location), uintptr(v0), uintptr(v1), uintptr(v2), uintptr(v3))
+	if hooked {
+		ctx.called("Uniform4i", location, v0, v1, v2, v3)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform4i(location int32, v0 int32, v1 int32, v2 int32, v3 int32) {
+	defaultContext.Uniform4i(location, v0, v1, v2, v3)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform4iv(location int32, count int32, value *int32) {
+	purego.SyscallN(ctx.gpUniform4iv, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("Uniform4iv", location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform4iv(location int32, count int32, value *int32) {
+	defaultContext.Uniform4iv(location, count, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform4ui(location int32, v0 uint32, v1 uint32, v2 uint32, v3 uint32) {
+	purego.SyscallN(ctx.gpUniform4ui, uintptr(location), uintptr(v0), uintptr(v1), uintptr(v2), uintptr(v3))
+	if hooked {
+		ctx.called("Uniform4ui", location, v0, v1, v2, v3)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform4ui(location int32, v0 uint32, v1 uint32, v2 uint32, v3 uint32) {
+	defaultContext.Uniform4ui(location, v0, v1, v2, v3)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform4uiv(location int32, count int32, value *uint32) {
+	purego.SyscallN(ctx.gpUniform4uiv, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("Uniform4uiv", location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform4uiv(location int32, count int32, value *uint32) {
+	defaultContext.Uniform4uiv(location, count, value)
+}
+
+// assign a binding point to an active uniform block
+func (ctx *Context) UniformBlockBinding(program uint32, uniformBlockIndex uint32, uniformBlockBinding uint32) {
+	purego.SyscallN(ctx.gpUniformBlockBinding, uintptr(program), uintptr(uniformBlockIndex), uintptr(uniformBlockBinding))
+	if hooked {
+		ctx.called("UniformBlockBinding", program, uniformBlockIndex, uniformBlockBinding)
+	}
+}
+
+// assign a binding point to an active uniform block
+func UniformBlockBinding(program uint32, uniformBlockIndex uint32, uniformBlockBinding uint32) {
+	defaultContext.UniformBlockBinding(program, uniformBlockIndex, uniformBlockBinding)
+}
+
+func (ctx *Context) UniformMatrix2dv(location int32, count int32, transpose bool, value *float64) {
+	purego.SyscallN(ctx.gpUniformMatrix2dv, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("UniformMatrix2dv", location, count, transpose, value)
+	}
+}
+
+func UniformMatrix2dv(location int32, count int32, transpose bool, value *float64) {
+	defaultContext.UniformMatrix2dv(location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) UniformMatrix2fv(location int32, count int32, transpose bool, value *float32) {
+	purego.SyscallN(ctx.gpUniformMatrix2fv, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("UniformMatrix2fv", location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func UniformMatrix2fv(location int32, count int32, transpose bool, value *float32) {
+	defaultContext.UniformMatrix2fv(location, count, transpose, value)
+}
+
+func (ctx *Context) UniformMatrix2x3dv(location int32, count int32, transpose bool, value *float64) {
+	purego.SyscallN(ctx.gpUniformMatrix2x3dv, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("UniformMatrix2x3dv", location, count, transpose, value)
+	}
+}
+
+func UniformMatrix2x3dv(location int32, count int32, transpose bool, value *float64) {
+	defaultContext.UniformMatrix2x3dv(location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) UniformMatrix2x3fv(location int32, count int32, transpose bool, value *float32) {
+	purego.SyscallN(ctx.gpUniformMatrix2x3fv, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("UniformMatrix2x3fv", location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func UniformMatrix2x3fv(location int32, count int32, transpose bool, value *float32) {
+	defaultContext.UniformMatrix2x3fv(location, count, transpose, value)
+}
+
+func (ctx *Context) UniformMatrix2x4dv(location int32, count int32, transpose bool, value *float64) {
+	purego.SyscallN(ctx.gpUniformMatrix2x4dv, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("UniformMatrix2x4dv", location, count, transpose, value)
+	}
+}
+
+func UniformMatrix2x4dv(location int32, count int32, transpose bool, value *float64) {
+	defaultContext.UniformMatrix2x4dv(location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) UniformMatrix2x4fv(location int32, count int32, transpose bool, value *float32) {
+	purego.SyscallN(ctx.gpUniformMatrix2x4fv, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("UniformMatrix2x4fv", location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func UniformMatrix2x4fv(location int32, count int32, transpose bool, value *float32) {
+	defaultContext.UniformMatrix2x4fv(location, count, transpose, value)
+}
+
+func (ctx *Context) UniformMatrix3dv(location int32, count int32, transpose bool, value *float64) {
+	purego.SyscallN(ctx.gpUniformMatrix3dv, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("UniformMatrix3dv", location, count, transpose, value)
+	}
+}
+
+func UniformMatrix3dv(location int32, count int32, transpose bool, value *float64) {
+	defaultContext.UniformMatrix3dv(location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) UniformMatrix3fv(location int32, count int32, transpose bool, value *float32) {
+	purego.SyscallN(ctx.gpUniformMatrix3fv, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("UniformMatrix3fv", location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func UniformMatrix3fv(location int32, count int32, transpose bool, value *float32) {
+	defaultContext.UniformMatrix3fv(location, count, transpose, value)
+}
+
+func (ctx *Context) UniformMatrix3x2dv(location int32, count int32, transpose bool, value *float64) {
+	purego.SyscallN(ctx.gpUniformMatrix3x2dv, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("UniformMatrix3x2dv", location, count, transpose, value)
+	}
+}
+
+func UniformMatrix3x2dv(location int32, count int32, transpose bool, value *float64) {
+	defaultContext.UniformMatrix3x2dv(location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) UniformMatrix3x2fv(location int32, count int32, transpose bool, value *float32) {
+	purego.SyscallN(ctx.gpUniformMatrix3x2fv, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("UniformMatrix3x2fv", location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func UniformMatrix3x2fv(location int32, count int32, transpose bool, value *float32) {
+	defaultContext.UniformMatrix3x2fv(location, count, transpose, value)
+}
+
+func (ctx *Context) UniformMatrix3x4dv(location int32, count int32, transpose bool, value *float64) {
+	purego.SyscallN(ctx.gpUniformMatrix3x4dv, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("UniformMatrix3x4dv", location, count, transpose, value)
+	}
+}
+
+func UniformMatrix3x4dv(location int32, count int32, transpose bool, value *float64) {
+	defaultContext.UniformMatrix3x4dv(location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) UniformMatrix3x4fv(location int32, count int32, transpose bool, value *float32) {
+	purego.SyscallN(ctx.gpUniformMatrix3x4fv, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("UniformMatrix3x4fv", location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func UniformMatrix3x4fv(location int32, count int32, transpose bool, value *float32) {
+	defaultContext.UniformMatrix3x4fv(location, count, transpose, value)
+}
+
+func (ctx *Context) UniformMatrix4dv(location int32, count int32, transpose bool, value *float64) {
+	purego.SyscallN(ctx.gpUniformMatrix4dv, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("UniformMatrix4dv", location, count, transpose, value)
+	}
+}
+
+func UniformMatrix4dv(location int32, count int32, transpose bool, value *float64) {
+	defaultContext.UniformMatrix4dv(location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) UniformMatrix4fv(location int32, count int32, transpose bool, value *float32) {
+	purego.SyscallN(ctx.gpUniformMatrix4fv, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("UniformMatrix4fv", location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func UniformMatrix4fv(location int32, count int32, transpose bool, value *float32) {
+	defaultContext.UniformMatrix4fv(location, count, transpose, value)
+}
+
+func (ctx *Context) UniformMatrix4x2dv(location int32, count int32, transpose bool, value *float64) {
+	purego.SyscallN(ctx.gpUniformMatrix4x2dv, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("UniformMatrix4x2dv", location, count, transpose, value)
+	}
+}
+
+func UniformMatrix4x2dv(location int32, count int32, transpose bool, value *float64) {
+	defaultContext.UniformMatrix4x2dv(location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) UniformMatrix4x2fv(location int32, count int32, transpose bool, value *float32) {
+	purego.SyscallN(ctx.gpUniformMatrix4x2fv, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("UniformMatrix4x2fv", location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func UniformMatrix4x2fv(location int32, count int32, transpose bool, value *float32) {
+	defaultContext.UniformMatrix4x2fv(location, count, transpose, value)
+}
+
+func (ctx *Context) UniformMatrix4x3dv(location int32, count int32, transpose bool, value *float64) {
+	purego.SyscallN(ctx.gpUniformMatrix4x3dv, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("UniformMatrix4x3dv", location, count, transpose, value)
+	}
+}
+
+func UniformMatrix4x3dv(location int32, count int32, transpose bool, value *float64) {
+	defaultContext.UniformMatrix4x3dv(location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) UniformMatrix4x3fv(location int32, count int32, transpose bool, value *float32) {
+	purego.SyscallN(ctx.gpUniformMatrix4x3fv, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("UniformMatrix4x3fv", location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func UniformMatrix4x3fv(location int32, count int32, transpose bool, value *float32) {
+	defaultContext.UniformMatrix4x3fv(location, count, transpose, value)
+}
+
+func (ctx *Context) UniformSubroutinesuiv(shadertype uint32, count int32, indices *uint32) {
+	purego.SyscallN(ctx.gpUniformSubroutinesuiv, uintptr(shadertype), uintptr(count), uintptr(unsafe.Pointer(indices)))
+	if hooked {
+		ctx.called("UniformSubroutinesuiv", shadertype, count, indices)
+	}
+}
+
+func UniformSubroutinesuiv(shadertype uint32, count int32, indices *uint32) {
+	defaultContext.UniformSubroutinesuiv(shadertype, count, indices)
+}
+
+// release the mapping of a buffer object's data store into the client's address space
+func (ctx *Context) UnmapBuffer(target uint32) bool {
+	ret, _, _ := purego.SyscallN(ctx.gpUnmapBuffer, uintptr(target))
+	if hooked {
+		ctx.called("UnmapBuffer", target)
+	}
+	return uint8(ret) != 0
+}
+
+// release the mapping of a buffer object's data store into the client's address space
+func UnmapBuffer(target uint32) bool {
+	return defaultContext.UnmapBuffer(target)
+}
+
+// Installs a program object as part of current rendering state
+func (ctx *Context) UseProgram(program uint32) {
+	purego.SyscallN(ctx.gpUseProgram, uintptr(program))
+	if hooked {
+		ctx.called("UseProgram", program)
+	}
+}
+
+// Installs a program object as part of current rendering state
+func UseProgram(program uint32) {
+	defaultContext.UseProgram(program)
+}
+
+// bind stages of a program object to a program pipeline
+func (ctx *Context) UseProgramStages(pipeline uint32, stages uint32, program uint32) {
+	purego.SyscallN(ctx.gpUseProgramStages, uintptr(pipeline), uintptr(stages), uintptr(program))
+	if hooked {
+		ctx.called("UseProgramStages", pipeline, stages, program)
+	}
+}
+
+// bind stages of a program object to a program pipeline
+func UseProgramStages(pipeline uint32, stages uint32, program uint32) {
+	defaultContext.UseProgramStages(pipeline, stages, program)
+}
+
+// Validates a program object
+func (ctx *Context) ValidateProgram(program uint32) {
+	purego.SyscallN(ctx.gpValidateProgram, uintptr(program))
+	if hooked {
+		ctx.called("ValidateProgram", program)
+	}
+}
+
+// Validates a program object
+func ValidateProgram(program uint32) {
+	defaultContext.ValidateProgram(program)
+}
+
+// validate a program pipeline object against current GL state
+func (ctx *Context) ValidateProgramPipeline(pipeline uint32) {
+	purego.SyscallN(ctx.gpValidateProgramPipeline, uintptr(pipeline))
+	if hooked {
+		ctx.called("ValidateProgramPipeline", pipeline)
+	}
+}
+
+// validate a program pipeline object against current GL state
+func ValidateProgramPipeline(pipeline uint32) {
+	defaultContext.ValidateProgramPipeline(pipeline)
+}
+
+func (ctx *Context) VertexAttrib1d(index uint32, x float64) {
+	ctx.fpVertexAttrib1d(index, x)
+	if hooked {
+		ctx.called("VertexAttrib1d", index, x)
+	}
+}
+
+func VertexAttrib1d(index uint32, x float64) {
+	defaultContext.VertexAttrib1d(index, x)
+}
+
+func (ctx *Context) VertexAttrib1dv(index uint32, v *float64) {
+	purego.SyscallN(ctx.gpVertexAttrib1dv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttrib1dv", index, v)
+	}
+}
+
+func VertexAttrib1dv(index uint32, v *float64) {
+	defaultContext.VertexAttrib1dv(index, v)
+}
+
+func (ctx *Context) VertexAttrib1f(index uint32, x float32) {
+	ctx.fpVertexAttrib1f(index, x)
+	if hooked {
+		ctx.called("VertexAttrib1f", index, x)
+	}
+}
+
+func VertexAttrib1f(index uint32, x float32) {
+	defaultContext.VertexAttrib1f(index, x)
+}
+
+func (ctx *Context) VertexAttrib1fv(index uint32, v *float32) {
+	purego.SyscallN(ctx.gpVertexAttrib1fv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttrib1fv", index, v)
+	}
+}
+
+func VertexAttrib1fv(index uint32, v *float32) {
+	defaultContext.VertexAttrib1fv(index, v)
+}
+
+func (ctx *Context) VertexAttrib1s(index uint32, x int16) {
+	purego.SyscallN(ctx.gpVertexAttrib1s, uintptr(index), uintptr(x))
+	if hooked {
+		ctx.called("VertexAttrib1s", index, x)
+	}
+}
+
+func VertexAttrib1s(index uint32, x int16) {
+	defaultContext.VertexAttrib1s(index, x)
+}
+
+func (ctx *Context) VertexAttrib1sv(index uint32, v *int16) {
+	purego.SyscallN(ctx.gpVertexAttrib1sv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttrib1sv", index, v)
+	}
+}
+
+func VertexAttrib1sv(index uint32, v *int16) {
+	defaultContext.VertexAttrib1sv(index, v)
+}
+
+func (ctx *Context) VertexAttrib2d(index uint32, x float64, y float64) {
+	ctx.fpVertexAttrib2d(index, x, y)
+	if hooked {
+		ctx.called("VertexAttrib2d", index, x, y)
+	}
+}
+
+func VertexAttrib2d(index uint32, x float64, y float64) {
+	defaultContext.VertexAttrib2d(index, x, y)
+}
+
+func (ctx *Context) VertexAttrib2dv(index uint32, v *float64) {
+	purego.SyscallN(ctx.gpVertexAttrib2dv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttrib2dv", index, v)
+	}
+}
+
+func VertexAttrib2dv(index uint32, v *float64) {
+	defaultContext.VertexAttrib2dv(index, v)
+}
+
+func (ctx *Context) VertexAttrib2f(index uint32, x float32, y float32) {
+	ctx.fpVertexAttrib2f(index, x, y)
+	if hooked {
+		ctx.called("VertexAttrib2f", index, x, y)
+	}
+}
+
+func VertexAttrib2f(index uint32, x float32, y float32) {
+	defaultContext.VertexAttrib2f(index, x, y)
+}
+
+func (ctx *Context) VertexAttrib2fv(index uint32, v *float32) {
+	purego.SyscallN(ctx.gpVertexAttrib2fv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttrib2fv", index, v)
+	}
+}
+
+func VertexAttrib2fv(index uint32, v *float32) {
+	defaultContext.VertexAttrib2fv(index, v)
+}
+
+func (ctx *Context) VertexAttrib2s(index uint32, x int16, y int16) {
+	purego.SyscallN(ctx.gpVertexAttrib2s, uintptr(index), uintptr(x), uintptr(y))
+	if hooked {
+		ctx.called("VertexAttrib2s", index, x, y)
+	}
+}
+
+func VertexAttrib2s(index uint32, x int16, y int16) {
+	defaultContext.VertexAttrib2s(index, x, y)
+}
+
+func (ctx *Context) VertexAttrib2sv(index uint32, v *int16) {
+	purego.SyscallN(ctx.gpVertexAttrib2sv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttrib2sv", index, v)
+	}
+}
+
+func VertexAttrib2sv(index uint32, v *int16) {
+	defaultContext.VertexAttrib2sv(index, v)
+}
+
+func (ctx *Context) VertexAttrib3d(index uint32, x float64, y float64, z float64) {
+	ctx.fpVertexAttrib3d(index, x, y, z)
+	if hooked {
+		ctx.called("VertexAttrib3d", index, x, y, z)
+	}
+}
+
+func VertexAttrib3d(index uint32, x float64, y float64, z float64) {
+	defaultContext.VertexAttrib3d(index, x, y, z)
+}
+
+func (ctx *Context) VertexAttrib3dv(index uint32, v *float64) {
+	purego.SyscallN(ctx.gpVertexAttrib3dv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttrib3dv", index, v)
+	}
+}
+
+func VertexAttrib3dv(index uint32, v *float64) {
+	defaultContext.VertexAttrib3dv(index, v)
+}
+
+func (ctx *Context) VertexAttrib3f(index uint32, x float32, y float32, z float32) {
+	ctx.fpVertexAttrib3f(index, x, y, z)
+	if hooked {
+		ctx.called("VertexAttrib3f", index, x, y, z)
+	}
+}
+
+func VertexAttrib3f(index uint32, x float32, y float32, z float32) {
+	defaultContext.VertexAttrib3f(index, x, y, z)
+}
+
+func (ctx *Context) VertexAttrib3fv(index uint32, v *float32) {
+	purego.SyscallN(ctx.gpVertexAttrib3fv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttrib3fv", index, v)
+	}
+}
+
+func VertexAttrib3fv(index uint32, v *float32) {
+	defaultContext.VertexAttrib3fv(index, v)
+}
+
+func (ctx *Context) VertexAttrib3s(index uint32, x int16, y int16, z int16) {
+	purego.SyscallN(ctx.gpVertexAttrib3s, uintptr(index), uintptr(x), uintptr(y), uintptr(z))
+	if hooked {
+		ctx.called("VertexAttrib3s", index, x, y, z)
+	}
+}
+
+func VertexAttrib3s(index uint32, x int16, y int16, z int16) {
+	defaultContext.VertexAttrib3s(index, x, y, z)
+}
+
+func (ctx *Context) VertexAttrib3sv(index uint32, v *int16) {
+	purego.SyscallN(ctx.gpVertexAttrib3sv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttrib3sv", index, v)
+	}
+}
+
+func VertexAttrib3sv(index uint32, v *int16) {
+	defaultContext.VertexAttrib3sv(index, v)
+}
+
+func (ctx *Context) VertexAttrib4Nbv(index uint32, v *int8) {
+	purego.SyscallN(ctx.gpVertexAttrib4Nbv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttrib4Nbv", index, v)
+	}
+}
+
+func VertexAttrib4Nbv(index uint32, v *int8) {
+	defaultContext.VertexAttrib4Nbv(index, v)
+}
+
+func (ctx *Context) VertexAttrib4Niv(index uint32, v *int32) {
+	purego.SyscallN(ctx.gpVertexAttrib4Niv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttrib4Niv", index, v)
+	}
+}
+
+func VertexAttrib4Niv(index uint32, v *int32) {
+	defaultContext.VertexAttrib4Niv(index, v)
+}
+
+func (ctx *Context) VertexAttrib4Nsv(index uint32, v *int16) {
+	purego.SyscallN(ctx.gpVertexAttrib4Nsv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttrib4Nsv", index, v)
+	}
+}
+
+func VertexAttrib4Nsv(index uint32, v *int16) {
+	defaultContext.VertexAttrib4Nsv(index, v)
+}
+
+func (ctx *Context) VertexAttrib4Nub(index uint32, x uint8, y uint8, z uint8, w uint8) {
+	purego.SyscallN(ctx.gpVertexAttrib4Nub, uintptr(index), uintptr(x), uintptr(y), uintptr(z), uintptr(w))
+	if hooked {
+		ctx.called("VertexAttrib4Nub", index, x, y, z, w)
+	}
+}
+
+func VertexAttrib4Nub(index uint32, x uint8, y uint8, z uint8, w uint8) {
+	defaultContext.VertexAttrib4Nub(index, x, y, z, w)
+}
+
+func (ctx *Context) VertexAttrib4Nubv(index uint32, v *uint8) {
+	purego.SyscallN(ctx.gpVertexAttrib4Nubv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttrib4Nubv", index, v)
+	}
+}
+
+func VertexAttrib4Nubv(index uint32, v *uint8) {
+	defaultContext.VertexAttrib4Nubv(index, v)
+}
+
+func (ctx *Context) VertexAttrib4Nuiv(index uint32, v *uint32) {
+	purego.SyscallN(ctx.gpVertexAttrib4Nuiv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttrib4Nuiv", index, v)
+	}
+}
+
+func VertexAttrib4Nuiv(index uint32, v *uint32) {
+	defaultContext.VertexAttrib4Nuiv(index, v)
+}
+
+func (ctx *Context) VertexAttrib4Nusv(index uint32, v *uint16) {
+	purego.SyscallN(ctx.gpVertexAttrib4Nusv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttrib4Nusv", index, v)
+	}
+}
+
+func VertexAttrib4Nusv(index uint32, v *uint16) {
+	defaultContext.VertexAttrib4Nusv(index, v)
+}
+
+func (ctx *Context) VertexAttrib4bv(index uint32, v *int8) {
+	purego.SyscallN(ctx.gpVertexAttrib4bv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttrib4bv", index, v)
+	}
+}
+
+func VertexAttrib4bv(index uint32, v *int8) {
+	defaultContext.VertexAttrib4bv(index, v)
+}
+
+func (ctx *Context) VertexAttrib4d(index uint32, x float64, y float64, z float64, w float64) {
+	ctx.fpVertexAttrib4d(index, x, y, z, w)
+	if hooked {
+		ctx.called("VertexAttrib4d", index, x, y, z, w)
+	}
+}
+
+func VertexAttrib4d(index uint32, x float64, y float64, z float64, w float64) {
+	defaultContext.VertexAttrib4d(index, x, y, z, w)
+}
+
+func (ctx *Context) VertexAttrib4dv(index uint32, v *float64) {
+	purego.SyscallN(ctx.gpVertexAttrib4dv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttrib4dv", index, v)
+	}
+}
+
+func VertexAttrib4dv(index uint32, v *float64) {
+	defaultContext.VertexAttrib4dv(index, v)
+}
+
+func (ctx *Context) VertexAttrib4f(index uint32, x float32, y float32, z float32, w float32) {
+	ctx.fpVertexAttrib4f(index, x, y, z, w)
+	if hooked {
+		ctx.called("VertexAttrib4f", index, x, y, z, w)
+	}
+}
+
+func VertexAttrib4f(index uint32, x float32, y float32, z float32, w float32) {
+	defaultContext.VertexAttrib4f(index, x, y, z, w)
+}
+
+func (ctx *Context) VertexAttrib4fv(index uint32, v *float32) {
+	purego.SyscallN(ctx.gpVertexAttrib4fv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttrib4fv", index, v)
+	}
+}
+
+func VertexAttrib4fv(index uint32, v *float32) {
+	defaultContext.VertexAttrib4fv(index, v)
+}
+
+func (ctx *Context) VertexAttrib4iv(index uint32, v *int32) {
+	purego.SyscallN(ctx.gpVertexAttrib4iv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttrib4iv", index, v)
+	}
+}
+
+func VertexAttrib4iv(index uint32, v *int32) {
+	defaultContext.VertexAttrib4iv(index, v)
+}
+
+func (ctx *Context) VertexAttrib4s(index uint32, x int16, y int16, z int16, w int16) {
+	purego.SyscallN(ctx.gpVertexAttrib4s, uintptr(index), uintptr(x), uintptr(y), uintptr(z), uintptr(w))
+	if hooked {
+		ctx.called("VertexAttrib4s", index, x, y, z, w)
+	}
+}
+
+func VertexAttrib4s(index uint32, x int16, y int16, z int16, w int16) {
+	defaultContext.VertexAttrib4s(index, x, y, z, w)
+}
+
+func (ctx *Context) VertexAttrib4sv(index uint32, v *int16) {
+	purego.SyscallN(ctx.gpVertexAttrib4sv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttrib4sv", index, v)
+	}
+}
+
+func VertexAttrib4sv(index uint32, v *int16) {
+	defaultContext.VertexAttrib4sv(index, v)
+}
+
+func (ctx *Context) VertexAttrib4ubv(index uint32, v *uint8) {
+	purego.SyscallN(ctx.gpVertexAttrib4ubv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttrib4ubv", index, v)
+	}
+}
+
+func VertexAttrib4ubv(index uint32, v *uint8) {
+	defaultContext.VertexAttrib4ubv(index, v)
+}
+
+func (ctx *Context) VertexAttrib4uiv(index uint32, v *uint32) {
+	purego.SyscallN(ctx.gpVertexAttrib4uiv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttrib4uiv", index, v)
+	}
+}
+
+func VertexAttrib4uiv(index uint32, v *uint32) {
+	defaultContext.VertexAttrib4uiv(index, v)
+}
+
+func (ctx *Context) VertexAttrib4usv(index uint32, v *uint16) {
+	purego.SyscallN(ctx.gpVertexAttrib4usv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttrib4usv", index, v)
+	}
+}
+
+func VertexAttrib4usv(index uint32, v *uint16) {
+	defaultContext.VertexAttrib4usv(index, v)
+}
+
+// modify the rate at which generic vertex attributes advance during instanced rendering
+func (ctx *Context) VertexAttribDivisor(index uint32, divisor uint32) {
+	purego.SyscallN(ctx.gpVertexAttribDivisor, uintptr(index), uintptr(divisor))
+	if hooked {
+		ctx.called("VertexAttribDivisor", index, divisor)
+	}
+}
+
+// modify the rate at which generic vertex attributes advance during instanced rendering
+func VertexAttribDivisor(index uint32, divisor uint32) {
+	defaultContext.VertexAttribDivisor(index, divisor)
+}
+
+func (ctx *Context) VertexAttribI1i(index uint32, x int32) {
+	purego.SyscallN(ctx.gpVertexAttribI1i, uintptr(index), uintptr(x))
+	if hooked {
+		ctx.called("VertexAttribI1i", index, x)
+	}
+}
+
+func VertexAttribI1i(index uint32, x int32) {
+	defaultContext.VertexAttribI1i(index, x)
+}
+
+func (ctx *Context) VertexAttribI1iv(index uint32, v *int32) {
+	purego.SyscallN(ctx.gpVertexAttribI1iv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttribI1iv", index, v)
+	}
+}
+
+func VertexAttribI1iv(index uint32, v *int32) {
+	defaultContext.VertexAttribI1iv(index, v)
+}
+
+func (ctx *Context) VertexAttribI1ui(index uint32, x uint32) {
+	purego.SyscallN(ctx.gpVertexAttribI1ui, uintptr(index), uintptr(x))
+	if hooked {
+		ctx.called("VertexAttribI1ui", index, x)
+	}
+}
+
+func VertexAttribI1ui(index uint32, x uint32) {
+	defaultContext.VertexAttribI1ui(index, x)
+}
+
+func (ctx *Context) VertexAttribI1uiv(index uint32, v *uint32) {
+	purego.SyscallN(ctx.gpVertexAttribI1uiv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttribI1uiv", index, v)
+	}
+}
+
+func VertexAttribI1uiv(index uint32, v *uint32) {
+	defaultContext.VertexAttribI1uiv(index, v)
+}
+
+func (ctx *Context) VertexAttribI2i(index uint32, x int32, y int32) {
+	purego.SyscallN(ctx.gpVertexAttribI2i, uintptr(index), uintptr(x), uintptr(y))
+	if hooked {
+		ctx.called("VertexAttribI2i", index, x, y)
+	}
+}
+
+func VertexAttribI2i(index uint32, x int32, y int32) {
+	defaultContext.VertexAttribI2i(index, x, y)
+}
+
+func (ctx *Context) VertexAttribI2iv(index uint32, v *int32) {
+	purego.SyscallN(ctx.gpVertexAttribI2iv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttribI2iv", index, v)
+	}
+}
+
+func VertexAttribI2iv(index uint32, v *int32) {
+	defaultContext.VertexAttribI2iv(index, v)
+}
+
+func (ctx *Context) VertexAttribI2ui(index uint32, x uint32, y uint32) {
+	purego.SyscallN(ctx.gpVertexAttribI2ui, uintptr(index), uintptr(x), uintptr(y))
+	if hooked {
+		ctx.called("VertexAttribI2ui", index, x, y)
+	}
+}
+
+func VertexAttribI2ui(index uint32, x uint32, y uint32) {
+	defaultContext.VertexAttribI2ui(index, x, y)
+}
+
+func (ctx *Context) VertexAttribI2uiv(index uint32, v *uint32) {
+	purego.SyscallN(ctx.gpVertexAttribI2uiv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttribI2uiv", index, v)
+	}
+}
+
+func VertexAttribI2uiv(index uint32, v *uint32) {
+	defaultContext.VertexAttribI2uiv(index, v)
+}
+
+func (ctx *Context) VertexAttribI3i(index uint32, x int32, y int32, z int32) {
+	purego.SyscallN(ctx.gpVertexAttribI3i, uintptr(index), uintptr(x), uintptr(y), uintptr(z))
+	if hooked {
+		ctx.called("VertexAttribI3i", index, x, y, z)
+	}
+}
+
+func VertexAttribI3i(index uint32, x int32, y int32, z int32) {
+	defaultContext.VertexAttribI3i(index, x, y, z)
+}
+
+func (ctx *Context) VertexAttribI3iv(index uint32, v *int32) {
+	purego.SyscallN(ctx.gpVertexAttribI3iv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttribI3iv", index, v)
+	}
+}
+
+func VertexAttribI3iv(index uint32, v *int32) {
+	defaultContext.VertexAttribI3iv(index, v)
+}
+
+func (ctx *Context) VertexAttribI3ui(index uint32, x uint32, y uint32, z uint32) {
+	purego.SyscallN(ctx.gpVertexAttribI3ui, uintptr(index), uintptr(x), uintptr(y), uintptr(z))
+	if hooked {
+		ctx.called("VertexAttribI3ui", index, x, y, z)
+	}
+}
+
+func VertexAttribI3ui(index uint32, x uint32, y uint32, z uint32) {
+	defaultContext.VertexAttribI3ui(index, x, y, z)
+}
+
+func (ctx *Context) VertexAttribI3uiv(index uint32, v *uint32) {
+	purego.SyscallN(ctx.gpVertexAttribI3uiv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttribI3uiv", index, v)
+	}
+}
+
+func VertexAttribI3uiv(index uint32, v *uint32) {
+	defaultContext.VertexAttribI3uiv(index, v)
+}
+
+func (ctx *Context) VertexAttribI4bv(index uint32, v *int8) {
+	purego.SyscallN(ctx.gpVertexAttribI4bv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttribI4bv", index, v)
+	}
+}
+
+func VertexAttribI4bv(index uint32, v *int8) {
+	defaultContext.VertexAttribI4bv(index, v)
+}
+
+func (ctx *Context) VertexAttribI4i(index uint32, x int32, y int32, z int32, w int32) {
+	purego.SyscallN(ctx.gpVertexAttribI4i, uintptr(index), uintptr(x), uintptr(y), uintptr(z), uintptr(w))
+	if hooked {
+		ctx.called("VertexAttribI4i", index, x, y, z, w)
+	}
+}
+
+func VertexAttribI4i(index uint32, x int32, y int32, z int32, w int32) {
+	defaultContext.VertexAttribI4i(index, x, y, z, w)
+}
+
+func (ctx *Context) VertexAttribI4iv(index uint32, v *int32) {
+	purego.SyscallN(ctx.gpVertexAttribI4iv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttribI4iv", index, v)
+	}
+}
+
+func VertexAttribI4iv(index uint32, v *int32) {
+	defaultContext.VertexAttribI4iv(index, v)
+}
+
+func (ctx *Context) VertexAttribI4sv(index uint32, v *int16) {
+	purego.SyscallN(ctx.gpVertexAttribI4sv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttribI4sv", index, v)
+	}
+}
+
+func VertexAttribI4sv(index uint32, v *int16) {
+	defaultContext.VertexAttribI4sv(index, v)
+}
+
+func (ctx *Context) VertexAttribI4ubv(index uint32, v *uint8) {
+	purego.SyscallN(ctx.gpVertexAttribI4ubv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttribI4ubv", index, v)
+	}
+}
+
+func VertexAttribI4ubv(index uint32, v *uint8) {
+	defaultContext.VertexAttribI4ubv(index, v)
+}
+
+func (ctx *Context) VertexAttribI4ui(index uint32, x uint32, y uint32, z uint32, w uint32) {
+	purego.SyscallN(ctx.gpVertexAttribI4ui, uintptr(index), uintptr(x), uintptr(y), uintptr(z), uintptr(w))
+	if hooked {
+		ctx.called("VertexAttribI4ui", index, x, y, z, w)
+	}
+}
+
+func VertexAttribI4ui(index uint32, x uint32, y uint32, z uint32, w uint32) {
+	defaultContext.VertexAttribI4ui(index, x, y, z, w)
+}
+
+func (ctx *Context) VertexAttribI4uiv(index uint32, v *uint32) {
+	purego.SyscallN(ctx.gpVertexAttribI4uiv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttribI4uiv", index, v)
+	}
+}
+
+func VertexAttribI4uiv(index uint32, v *uint32) {
+	defaultContext.VertexAttribI4uiv(index, v)
+}
+
+func (ctx *Context) VertexAttribI4usv(index uint32, v *uint16) {
+	purego.SyscallN(ctx.gpVertexAttribI4usv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttribI4usv", index, v)
+	}
+}
+
+func VertexAttribI4usv(index uint32, v *uint16) {
+	defaultContext.VertexAttribI4usv(index, v)
+}
+
+func (ctx *Context) VertexAttribIPointer(index uint32, size int32, xtype uint32, stride int32, pointer unsafe.Pointer) {
+	purego.SyscallN(ctx.gpVertexAttribIPointer, uintptr(index), uintptr(size), uintptr(xtype), uintptr(stride), uintptr(pointer))
+	if hooked {
+		ctx.called("VertexAttribIPointer", index, size, xtype, stride, pointer)
+	}
+}
+
+func VertexAttribIPointer(index uint32, size int32, xtype uint32, stride int32, pointer unsafe.Pointer) {
+	defaultContext.VertexAttribIPointer(index, size, xtype, stride, pointer)
+}
+
+func (ctx *Context) VertexAttribL1d(index uint32, x float64) {
+	ctx.fpVertexAttribL1d(index, x)
+	if hooked {
+		ctx.called("VertexAttribL1d", index, x)
+	}
+}
+
+func VertexAttribL1d(index uint32, x float64) {
+	defaultContext.VertexAttribL1d(index, x)
+}
+
+func (ctx *Context) VertexAttribL1dv(index uint32, v *float64) {
+	purego.SyscallN(ctx.gpVertexAttribL1dv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttribL1dv", index, v)
+	}
+}
+
+func VertexAttribL1dv(index uint32, v *float64) {
+	defaultContext.VertexAttribL1dv(index, v)
+}
+
+func (ctx *Context) VertexAttribL2d(index uint32, x float64, y float64) {
+	ctx.fpVertexAttribL2d(index, x, y)
+	if hooked {
+		ctx.called("VertexAttribL2d", index, x, y)
+	}
+}
+
+func VertexAttribL2d(index uint32, x float64, y float64) {
+	defaultContext.VertexAttribL2d(index, x, y)
+}
+
+func (ctx *Context) VertexAttribL2dv(index uint32, v *float64) {
+	purego.SyscallN(ctx.gpVertexAttribL2dv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttribL2dv", index, v)
+	}
+}
+
+func VertexAttribL2dv(index uint32, v *float64) {
+	defaultContext.VertexAttribL2dv(index, v)
+}
+
+func (ctx *Context) VertexAttribL3d(index uint32, x float64, y float64, z float64) {
+	ctx.fpVertexAttribL3d(index, x, y, z)
+	if hooked {
+		ctx.called("VertexAttribL3d", index, x, y, z)
+	}
+}
+
+func VertexAttribL3d(index uint32, x float64, y float64, z float64) {
+	defaultContext.VertexAttribL3d(index, x, y, z)
+}
+
+func (ctx *Context) VertexAttribL3dv(index uint32, v *float64) {
+	purego.SyscallN(ctx.gpVertexAttribL3dv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttribL3dv", index, v)
+	}
+}
+
+func VertexAttribL3dv(index uint32, v *float64) {
+	defaultContext.VertexAttribL3dv(index, v)
+}
+
+func (ctx *Context) VertexAttribL4d(index uint32, x float64, y float64, z float64, w float64) {
+	ctx.fpVertexAttribL4d(index, x, y, z, w)
+	if hooked {
+		ctx.called("VertexAttribL4d", index, x, y, z, w)
+	}
+}
+
+func VertexAttribL4d(index uint32, x float64, y float64, z float64, w float64) {
+	defaultContext.VertexAttribL4d(index, x, y, z, w)
+}
+
+func (ctx *Context) VertexAttribL4dv(index uint32, v *float64) {
+	purego.SyscallN(ctx.gpVertexAttribL4dv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("VertexAttribL4dv", index, v)
+	}
+}
+
+func VertexAttribL4dv(index uint32, v *float64) {
+	defaultContext.VertexAttribL4dv(index, v)
+}
+
+func (ctx *Context) VertexAttribLPointer(index uint32, size int32, xtype uint32, stride int32, pointer unsafe.Pointer) {
+	purego.SyscallN(ctx.gpVertexAttribLPointer, uintptr(index), uintptr(size), uintptr(xtype), uintptr(stride), uintptr(pointer))
+	if hooked {
+		ctx.called("VertexAttribLPointer", index, size, xtype, stride, pointer)
+	}
+}
+
+func VertexAttribLPointer(index uint32, size int32, xtype uint32, stride int32, pointer unsafe.Pointer) {
+	defaultContext.VertexAttribLPointer(index, size, xtype, stride, pointer)
+}
+
+func (ctx *Context) VertexAttribP1ui(index uint32, xtype uint32, normalized bool, value uint32) {
+	purego.SyscallN(ctx.gpVertexAttribP1ui, uintptr(index), uintptr(xtype), boolToUintptr(normalized), uintptr(value))
+	if hooked {
+		ctx.called("VertexAttribP1ui", index, xtype, normalized, value)
+	}
+}
+
+func VertexAttribP1ui(index uint32, xtype uint32, normalized bool, value uint32) {
+	defaultContext.VertexAttribP1ui(index, xtype, normalized, value)
+}
+
+func (ctx *Context) VertexAttribP1uiv(index uint32, xtype uint32, normalized bool, value *uint32) {
+	purego.SyscallN(ctx.gpVertexAttribP1uiv, uintptr(index), uintptr(xtype), boolToUintptr(normalized), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("VertexAttribP1uiv", index, xtype, normalized, value)
+	}
+}
+
+func VertexAttribP1uiv(index uint32, xtype uint32, normalized bool, value *uint32) {
+	defaultContext.VertexAttribP1uiv(index, xtype, normalized, value)
+}
+
+func (ctx *Context) VertexAttribP2ui(index uint32, xtype uint32, normalized bool, value uint32) {
+	purego.SyscallN(ctx.gpVertexAttribP2ui, uintptr(index), uintptr(xtype), boolToUintptr(normalized), uintptr(value))
+	if hooked {
+		ctx.called("VertexAttribP2ui", index, xtype, normalized, value)
+	}
+}
+
+func VertexAttribP2ui(index uint32, xtype uint32, normalized bool, value uint32) {
+	defaultContext.VertexAttribP2ui(index, xtype, normalized, value)
+}
+
+func (ctx *Context) VertexAttribP2uiv(index uint32, xtype uint32, normalized bool, value *uint32) {
+	purego.SyscallN(ctx.gpVertexAttribP2uiv, uintptr(index), uintptr(xtype), boolToUintptr(normalized), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("VertexAttribP2uiv", index, xtype, normalized, value)
+	}
+}
+
+func VertexAttribP2uiv(index uint32, xtype uint32, normalized bool, value *uint32) {
+	defaultContext.VertexAttribP2uiv(index, xtype, normalized, value)
+}
+
+func (ctx *Context) VertexAttribP3ui(index uint32, xtype uint32, normalized bool, value uint32) {
+	purego.SyscallN(ctx.gpVertexAttribP3ui, uintptr(index), uintptr(xtype), boolToUintptr(normalized), uintptr(value))
+	if hooked {
+		ctx.called("VertexAttribP3ui", index, xtype, normalized, value)
+	}
+}
+
+func VertexAttribP3ui(index uint32, xtype uint32, normalized bool, value uint32) {
+	defaultContext.VertexAttribP3ui(index, xtype, normalized, value)
+}
+
+func (ctx *Context) VertexAttribP3uiv(index uint32, xtype uint32, normalized bool, value *uint32) {
+	purego.SyscallN(ctx.gpVertexAttribP3uiv, uintptr(index), uintptr(xtype), boolToUintptr(normalized), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("VertexAttribP3uiv", index, xtype, normalized, value)
+	}
+}
+
+func VertexAttribP3uiv(index uint32, xtype uint32, normalized bool, value *uint32) {
+	defaultContext.VertexAttribP3uiv(index, xtype, normalized, value)
+}
+
+func (ctx *Context) VertexAttribP4ui(index uint32, xtype uint32, normalized bool, value uint32) {
+	purego.SyscallN(ctx.gpVertexAttribP4ui, uintptr(index), uintptr(xtype), boolToUintptr(normalized), uintptr(value))
+	if hooked {
+		ctx.called("VertexAttribP4ui", index, xtype, normalized, value)
+	}
+}
+
+func VertexAttribP4ui(index uint32, xtype uint32, normalized bool, value uint32) {
+	defaultContext.VertexAttribP4ui(index, xtype, normalized, value)
+}
+
+func (ctx *Context) VertexAttribP4uiv(index uint32, xtype uint32, normalized bool, value *uint32) {
+	purego.SyscallN(ctx.gpVertexAttribP4uiv, uintptr(index), uintptr(xtype), boolToUintptr(normalized), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("VertexAttribP4uiv", index, xtype, normalized, value)
+	}
+}
+
+func VertexAttribP4uiv(index uint32, xtype uint32, normalized bool, value *uint32) {
+	defaultContext.VertexAttribP4uiv(index, xtype, normalized, value)
+}
+
+// define an array of generic vertex attribute data
+func (ctx *Context) VertexAttribPointer(index uint32, size int32, xtype uint32, normalized bool, stride int32, pointer unsafe.Pointer) {
+	purego.SyscallN(ctx.gpVertexAttribPointer, uintptr(index), uintptr(size), uintptr(xtype), boolToUintptr(normalized), uintptr(stride), uintptr(pointer))
+	if hooked {
+		ctx.called("VertexAttribPointer", index, size, xtype, normalized, stride, pointer)
+	}
+}
+
+// define an array of generic vertex attribute data
+func VertexAttribPointer(index uint32, size int32, xtype uint32, normalized bool, stride int32, pointer unsafe.Pointer) {
+	defaultContext.VertexAttribPointer(index, size, xtype, normalized, stride, pointer)
+}
+
+func (ctx *Context) VertexAttribPointerWithOffset(index uint32, size int32, xtype uint32, normalized bool, stride int32, offset uintptr) {
+	purego.SyscallN(ctx.gpVertexAttribPointer, uintptr(index), uintptr(size), uintptr(xtype), boolToUintptr(normalized), uintptr(stride), uintptr(offset))
+	if hooked {
+		ctx.called("VertexAttribPointerWithOffset", index, size, xtype, normalized, stride, offset)
+	}
+}
+
+func VertexAttribPointerWithOffset(index uint32, size int32, xtype uint32, normalized bool, stride int32, offset uintptr) {
+	defaultContext.VertexAttribPointerWithOffset(index, size, xtype, normalized, stride, offset)
+}
+
+// set the viewport
+func (ctx *Context) Viewport(x int32, y int32, width int32, height int32) {
+	purego.SyscallN(ctx.gpViewport, uintptr(x), uintptr(y), uintptr(width), uintptr(height))
+	if hooked {
+		ctx.called("Viewport", x, y, width, height)
+	}
+}
+
+// set the viewport
+func Viewport(x int32, y int32, width int32, height int32) {
+	defaultContext.Viewport(x, y, width, height)
+}
+
+func (ctx *Context) ViewportArrayv(first uint32, count int32, v *float32) {
+	purego.SyscallN(ctx.gpViewportArrayv, uintptr(first), uintptr(count), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("ViewportArrayv", first, count, v)
+	}
+}
+
+func ViewportArrayv(first uint32, count int32, v *float32) {
+	defaultContext.ViewportArrayv(first, count, v)
+}
+
+func (ctx *Context) ViewportIndexedf(index uint32, x float32, y float32, w float32, h float32) {
+	ctx.fpViewportIndexedf(index, x, y, w, h)
+	if hooked {
+		ctx.called("ViewportIndexedf", index, x, y, w, h)
+	}
+}
+
+func ViewportIndexedf(index uint32, x float32, y float32, w float32, h float32) {
+	defaultContext.ViewportIndexedf(index, x, y, w, h)
+}
+
+func (ctx *Context) ViewportIndexedfv(index uint32, v *float32) {
+	purego.SyscallN(ctx.gpViewportIndexedfv, uintptr(index), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("ViewportIndexedfv", index, v)
+	}
+}
+
+func ViewportIndexedfv(index uint32, v *float32) {
+	defaultContext.ViewportIndexedfv(index, v)
+}
+
+// instruct the GL server to block until the specified sync object becomes signaled
+func (ctx *Context) WaitSync(sync uintptr, flags uint32, timeout uint64) {
+	purego.SyscallN(ctx.gpWaitSync, uintptr(sync), uintptr(flags), uintptr(timeout))
+	if hooked {
+		ctx.called("WaitSync", sync, flags, timeout)
+	}
+}
+
+// instruct the GL server to block until the specified sync object becomes signaled
+func WaitSync(sync uintptr, flags uint32, timeout uint64) {
+	defaultContext.WaitSync(sync, flags, timeout)
+}
+
+// load loads the entry points of the OpenGL 4.1 core profile. It returns an error listing the
+// entry points that could not be found, which means that the current context
+// does not support the OpenGL 4.1 core profile.
+func (ctx *Context) load(getProcAddr func(name string) unsafe.Pointer) error {
+	var missing []string
+	load := func(name string) uintptr {
+		p := uintptr(getProcAddr(name))
+		if p == 0 {
+			missing = append(missing, name)
+		}
+		return p
+	}
+	ctx.gpActiveShaderProgram = load("glActiveShaderProgram")
+	ctx.gpActiveTexture = load("glActiveTexture")
+	ctx.gpAttachShader = load("glAttachShader")
+	ctx.gpBeginConditionalRender = load("glBeginConditionalRender")
+	ctx.gpBeginQuery = load("glBeginQuery")
+	ctx.gpBeginQueryIndexed = load("glBeginQueryIndexed")
+	ctx.gpBeginTransformFeedback = load("glBeginTransformFeedback")
+	ctx.gpBindAttribLocation = load("glBindAttribLocation")
+	ctx.gpBindBuffer = load("glBindBuffer")
+	ctx.gpBindBufferBase = load("glBindBufferBase")
+	ctx.gpBindBufferRange = load("glBindBufferRange")
+	ctx.gpBindFragDataLocation = load("glBindFragDataLocation")
+	ctx.gpBindFragDataLocationIndexed = load("glBindFragDataLocationIndexed")
+	ctx.gpBindFramebuffer = load("glBindFramebuffer")
+	ctx.gpBindProgramPipeline = load("glBindProgramPipeline")
+	ctx.gpBindRenderbuffer = load("glBindRenderbuffer")
+	ctx.gpBindSampler = load("glBindSampler")
+	ctx.gpBindTexture = load("glBindTexture")
+	ctx.gpBindTransformFeedback = load("glBindTransformFeedback")
+	ctx.gpBindVertexArray = load("glBindVertexArray")
+	ctx.gpBlendColor = load("glBlendColor")
+	if ctx.gpBlendColor != 0 {
+		purego.RegisterFunc(&ctx.fpBlendColor, ctx.gpBlendColor)
+	} else {
+		ctx.fpBlendColor = nil
+	}
+	ctx.gpBlendEquation = load("glBlendEquation")
+	ctx.gpBlendEquationSeparate = load("glBlendEquationSeparate")
+	ctx.gpBlendEquationSeparatei = load("glBlendEquationSeparatei")
+	ctx.gpBlendEquationi = load("glBlendEquationi")
+	ctx.gpBlendFunc = load("glBlendFunc")
+	ctx.gpBlendFuncSeparate = load("glBlendFuncSeparate")
+	ctx.gpBlendFuncSeparatei = load("glBlendFuncSeparatei")
+	ctx.gpBlendFunci = load("glBlendFunci")
+	ctx.gpBlitFramebuffer = load("glBlitFramebuffer")
+	ctx.gpBufferData = load("glBufferData")
+	ctx.gpBufferSubData = load("glBufferSubData")
+	ctx.gpCheckFramebufferStatus = load("glCheckFramebufferStatus")
+	ctx.gpClampColor = load("glClampColor")
+	ctx.gpClear = load("glClear")
+	ctx.gpClearBufferfi = load("glClearBufferfi")
+	if ctx.gpClearBufferfi != 0 {
+		purego.RegisterFunc(&ctx.fpClearBufferfi, ctx.gpClearBufferfi)
+	} else {
+		ctx.fpClearBufferfi = nil
+	}
+	ctx.gpClearBufferfv = load("glClearBufferfv")
+	ctx.gpClearBufferiv = load("glClearBufferiv")
+	ctx.gpClearBufferuiv = load("glClearBufferuiv")
+	ctx.gpClearColor = load("glClearColor")
+	if ctx.gpClearColor != 0 {
+		purego.RegisterFunc(&ctx.fpClearColor, ctx.gpClearColor)
+	} else {
+		ctx.fpClearColor = nil
+	}
+	ctx.gpClearDepth = load("glClearDepth")
+	if ctx.gpClearDepth != 0 {
+		purego.RegisterFunc(&ctx.fpClearDepth, ctx.gpClearDepth)
+	} else {
+		ctx.fpClearDepth = nil
+	}
+	ctx.gpClearDepthf = load("glClearDepthf")
+	if ctx.gpClearDepthf != 0 {
+		purego.RegisterFunc(&ctx.fpClearDepthf, ctx.gpClearDepthf)
+	} else {
+		ctx.fpClearDepthf = nil
+	}
+	ctx.gpClearStencil = load("glClearStencil")
+	ctx.gpClientWaitSync = load("glClientWaitSync")
+	ctx.gpColorMask = load("glColorMask")
+	ctx.gpColorMaski = load("glColorMaski")
+	ctx.gpCompileShader = load("glCompileShader")
+	ctx.gpCompressedTexImage1D = load("glCompressedTexImage1D")
+	ctx.gpCompressedTexImage2D = load("glCompressedTexImage2D")
+	ctx.gpCompressedTexImage3D = load("glCompressedTexImage3D")
+	ctx.gpCompressedTexSubImage1D = load("glCompressedTexSubImage1D")
+	ctx.gpCompressedTexSubImage2D = load("glCompressedTexSubImage2D")
+	ctx.gpCompressedTexSubImage3D = load("glCompressedTexSubImage3D")
+	ctx.gpCopyBufferSubData = load("glCopyBufferSubData")
+	ctx.gpCopyTexImage1D = load("glCopyTexImage1D")
+	ctx.gpCopyTexImage2D = load("glCopyTexImage2D")
+	ctx.gpCopyTexSubImage1D = load("glCopyTexSubImage1D")
+	ctx.gpCopyTexSubImage2D = load("glCopyTexSubImage2D")
+	ctx.gpCopyTexSubImage3D = load("glCopyTexSubImage3D")
+	ctx.gpCreateProgram = load("glCreateProgram")
+	ctx.gpCreateShader = load("glCreateShader")
+	ctx.gpCreateShaderProgramv = load("glCreateShaderProgramv")
+	ctx.gpCullFace = load("glCullFace")
+	ctx.gpDeleteBuffers = load("glDeleteBuffers")
+	ctx.gpDeleteFramebuffers = load("glDeleteFramebuffers")
+	ctx.gpDeleteProgram = load("glDeleteProgram")
+	ctx.gpDeleteProgramPipelines = load("glDeleteProgramPipelines")
+	ctx.gpDeleteQueries = load("glDeleteQueries")
+	ctx.gpDeleteRenderbuffers = load("glDeleteRenderbuffers")
+	ctx.gpDeleteSamplers = load("glDeleteSamplers")
+	ctx.gpDeleteShader = load("glDeleteShader")
+	ctx.gpDeleteSync = load("glDeleteSync")
+	ctx.gpDeleteTextures = load("glDeleteTextures")
+	ctx.gpDeleteTransformFeedbacks = load("glDeleteTransformFeedbacks")
+	ctx.gpDeleteVertexArrays = load("glDeleteVertexArrays")
+	ctx.gpDepthFunc = load("glDepthFunc")
+	ctx.gpDepthMask = load("glDepthMask")
+	ctx.gpDepthRange = load("glDepthRange")
+	if ctx.gpDepthRange != 0 {
+		purego.RegisterFunc(&ctx.fpDepthRange, ctx.gpDepthRange)
+	} else {
+		ctx.fpDepthRange = nil
+	}
+	ctx.gpDepthRangeArrayv = load("glDepthRangeArrayv")
+	ctx.gpDepthRangeIndexed = load("glDepthRangeIndexed")
+	if ctx.gpDepthRangeIndexed != 0 {
+		purego.RegisterFunc(&ctx.fpDepthRangeIndexed, ctx.gpDepthRangeIndexed)
+	} else {
+		ctx.fpDepthRangeIndexed = nil
+	}
+	ctx.gpDepthRangef = load("glDepthRangef")
+	if ctx.gpDepthRangef != 0 {
+		purego.RegisterFunc(&ctx.fpDepthRangef, ctx.gpDepthRangef)
+	} else {
+		ctx.fpDepthRangef = nil
+	}
+	ctx.gpDetachShader = load("glDetachShader")
+	ctx.gpDisable = load("glDisable")
+	ctx.gpDisableVertexAttribArray = load("glDisableVertexAttribArray")
+	ctx.gpDisablei = load("glDisablei")
+	ctx.gpDrawArrays = load("glDrawArrays")
+	ctx.gpDrawArraysIndirect = load("glDrawArraysIndirect")
+	ctx.gpDrawArraysInstanced = load("glDrawArraysInstanced")
+	ctx.gpDrawBuffer = load("glDrawBuffer")
+	ctx.gpDrawBuffers = load("glDrawBuffers")
+	ctx.gpDrawElements = load("glDrawElements")
+	ctx.gpDrawElementsBaseVertex = load("glDrawElementsBaseVertex")
+	ctx.gpDrawElementsIndirect = load("glDrawElementsIndirect")
+	ctx.gpDrawElementsInstanced = load("glDrawElementsInstanced")
+	ctx.gpDrawElementsInstancedBaseVertex = load("glDrawElementsInstancedBaseVertex")
+	ctx.gpDrawRangeElements = load("glDrawRangeElements")
+	ctx.gpDrawRangeElementsBaseVertex = load("glDrawRangeElementsBaseVertex")
+	ctx.gpDrawTransformFeedback = load("glDrawTransformFeedback")
+	ctx.gpDrawTransformFeedbackStream = load("glDrawTransformFeedbackStream")
+	ctx.gpEnable = load("glEnable")
+	ctx.gpEnableVertexAttribArray = load("glEnableVertexAttribArray")
+	ctx.gpEnablei = load("glEnablei")
+	ctx.gpEndConditionalRender = load("glEndConditionalRender")
+	ctx.gpEndQuery = load("glEndQuery")
+	ctx.gpEndQueryIndexed = load("glEndQueryIndexed")
+	ctx.gpEndTransformFeedback = load("glEndTransformFeedback")
+	ctx.gpFenceSync = load("glFenceSync")
+	ctx.gpFinish = load("glFinish")
+	ctx.gpFlush = load("glFlush")
+	ctx.gpFlushMappedBufferRange = load("glFlushMappedBufferRange")
+	ctx.gpFramebufferRenderbuffer = load("glFramebufferRenderbuffer")
+	ctx.gpFramebufferTexture = load("glFramebufferTexture")
+	ctx.gpFramebufferTexture1D = load("glFramebufferTexture1D")
+	ctx.gpFramebufferTexture2D = load("glFramebufferTexture2D")
+	ctx.gpFramebufferTexture3D = load("glFramebufferTexture3D")
+	ctx.gpFramebufferTextureLayer = load("glFramebufferTextureLayer")
+	ctx.gpFrontFace = load("glFrontFace")
+	ctx.gpGenBuffers = load("glGenBuffers")
+	ctx.gpGenFramebuffers = load("glGenFramebuffers")
+	ctx.gpGenProgramPipelines = load("glGenProgramPipelines")
+	ctx.gpGenQueries = load("glGenQueries")
+	ctx.gpGenRenderbuffers = load("glGenRenderbuffers")
+	ctx.gpGenSamplers = load("glGenSamplers")
+	ctx.gpGenTextures = load("glGenTextures")
+	ctx.gpGenTransformFeedbacks = load("glGenTransformFeedbacks")
+	ctx.gpGenVertexArrays = load("glGenVertexArrays")
+	ctx.gpGenerateMipmap = load("glGenerateMipmap")
+	ctx.gpGetActiveAttrib = load("glGetActiveAttrib")
+	ctx.gpGetActiveSubroutineName = load("glGetActiveSubroutineName")
+	ctx.gpGetActiveSubroutineUniformName = load("glGetActiveSubroutineUniformName")
+	ctx.gpGetActiveSubroutineUniformiv = load("glGetActiveSubroutineUniformiv")
+	ctx.gpGetActiveUniform = load("glGetActiveUniform")
+	ctx.gpGetActiveUniformBlockName = load("glGetActiveUniformBlockName")
+	ctx.gpGetActiveUniformBlockiv = load("glGetActiveUniformBlockiv")
+	ctx.gpGetActiveUniformName = load("glGetActiveUniformName")
+	ctx.gpGetActiveUniformsiv = load("glGetActiveUniformsiv")
+	ctx.gpGetAttachedShaders = load("glGetAttachedShaders")
+	ctx.gpGetAttribLocation = load("glGetAttribLocation")
+	ctx.gpGetBooleani_v = load("glGetBooleani_v")
+	ctx.gpGetBooleanv = load("glGetBooleanv")
+	ctx.gpGetBufferParameteri64v = load("glGetBufferParameteri64v")
+	ctx.gpGetBufferParameteriv = load("glGetBufferParameteriv")
+	ctx.gpGetBufferPointerv = load("glGetBufferPointerv")
+	ctx.gpGetBufferSubData = load("glGetBufferSubData")
+	ctx.gpGetCompressedTexImage = load("glGetCompressedTexImage")
+	ctx.gpGetDoublei_v = load("glGetDoublei_v")
+	ctx.gpGetDoublev = load("glGetDoublev")
+	ctx.gpGetError = load("glGetError")
+	ctx.gpGetFloati_v = load("glGetFloati_v")
+	ctx.gpGetFloatv = load("glGetFloatv")
+	ctx.gpGetFragDataIndex = load("glGetFragDataIndex")
+	ctx.gpGetFragDataLocation = load("glGetFragDataLocation")
+	ctx.gpGetFramebufferAttachmentParameteriv = load("glGetFramebufferAttachmentParameteriv")
+	ctx.gpGetInteger64i_v = load("glGetInteger64i_v")
+	ctx.gpGetInteger64v = load("glGetInteger64v")
+	ctx.gpGetIntegeri_v = load("glGetIntegeri_v")
+	ctx.gpGetIntegerv = load("glGetIntegerv")
+	ctx.gpGetMultisamplefv = load("glGetMultisamplefv")
+	ctx.gpGetPointerv = load("glGetPointerv")
+	ctx.gpGetProgramBinary = load("glGetProgramBinary")
+	ctx.gpGetProgramInfoLog = load("glGetProgramInfoLog")
+	ctx.gpGetProgramPipelineInfoLog = load("glGetProgramPipelineInfoLog")
+	ctx.gpGetProgramPipelineiv = load("glGetProgramPipelineiv")
+	ctx.gpGetProgramStageiv = load("glGetProgramStageiv")
+	ctx.gpGetProgramiv = load("glGetProgramiv")
+	ctx.gpGetQueryIndexediv = load("glGetQueryIndexediv")
+	ctx.gpGetQueryObjecti64v = load("glGetQueryObjecti64v")
+	ctx.gpGetQueryObjectiv = load("glGetQueryObjectiv")
+	ctx.gpGetQueryObjectui64v = load("glGetQueryObjectui64v")
+	ctx.gpGetQueryObjectuiv = load("glGetQueryObjectuiv")
+	ctx.gpGetQueryiv = load("glGetQueryiv")
+	ctx.gpGetRenderbufferParameteriv = load("glGetRenderbufferParameteriv")
+	ctx.gpGetSamplerParameterIiv = load("glGetSamplerParameterIiv")
+	ctx.gpGetSamplerParameterIuiv = load("glGetSamplerParameterIuiv")
+	ctx.gpGetSamplerParameterfv = load("glGetSamplerParameterfv")
+	ctx.gpGetSamplerParameteriv = load("glGetSamplerParameteriv")
+	ctx.gpGetShaderInfoLog = load("glGetShaderInfoLog")
+	ctx.gpGetShaderPrecisionFormat = load("glGetShaderPrecisionFormat")
+	ctx.gpGetShaderSource = load("glGetShaderSource")
+	ctx.gpGetShaderiv = load("glGetShaderiv")
+	ctx.gpGetString = load("glGetString")
+	ctx.gpGetStringi = load("glGetStringi")
+	ctx.gpGetSubroutineIndex = load("glGetSubroutineIndex")
+	ctx.gpGetSubroutineUniformLocation = load("glGetSubroutineUniformLocation")
+	ctx.gpGetSynciv = load("glGetSynciv")
+	ctx.gpGetTexImage = load("glGetTexImage")
+	ctx.gpGetTexLevelParameterfv = load("glGetTexLevelParameterfv")
+	ctx.gpGetTexLevelParameteriv = load("glGetTexLevelParameteriv")
+	ctx.gpGetTexParameterIiv = load("glGetTexParameterIiv")
+	ctx.gpGetTexParameterIuiv = load("glGetTexParameterIuiv")
+	ctx.gpGetTexParameterfv = load("glGetTexParameterfv")
+	ctx.gpGetTexParameteriv = load("glGetTexParameteriv")
+	ctx.gpGetTransformFeedbackVarying = load("glGetTransformFeedbackVarying")
+	ctx.gpGetUniformBlockIndex = load("glGetUniformBlockIndex")
+	ctx.gpGetUniformIndices = load("glGetUniformIndices")
+	ctx.gpGetUniformLocation = load("glGetUniformLocation")
+	ctx.gpGetUniformSubroutineuiv = load("glGetUniformSubroutineuiv")
+	ctx.gpGetUniformdv = load("glGetUniformdv")
+	ctx.gpGetUniformfv = load("glGetUniformfv")
+	ctx.gpGetUniformiv = load("glGetUniformiv")
+	ctx.gpGetUniformuiv = load("glGetUniformuiv")
+	ctx.gpGetVertexAttribIiv = load("glGetVertexAttribIiv")
+	ctx.gpGetVertexAttribIuiv = load("glGetVertexAttribIuiv")
+	ctx.gpGetVertexAttribLdv = load("glGetVertexAttribLdv")
+	ctx.gpGetVertexAttribPointerv = load("glGetVertexAttribPointerv")
+	ctx.gpGetVertexAttribdv = load("glGetVertexAttribdv")
+	ctx.gpGetVertexAttribfv = load("glGetVertexAttribfv")
+	ctx.gpGetVertexAttribiv = load("glGetVertexAttribiv")
+	ctx.gpHint = load("glHint")
+	ctx.gpIsBuffer = load("glIsBuffer")
+	ctx.gpIsEnabled = load("glIsEnabled")
+	ctx.gpIsEnabledi = load("glIsEnabledi")
+	ctx.gpIsFramebuffer = load("glIsFramebuffer")
+	ctx.gpIsProgram = load("glIsProgram")
+	ctx.gpIsProgramPipeline = load("glIsProgramPipeline")
+	ctx.gpIsQuery = load("glIsQuery")
+	ctx.gpIsRenderbuffer = load("glIsRenderbuffer")
+	ctx.gpIsSampler = load("glIsSampler")
+	ctx.gpIsShader = load("glIsShader")
+	ctx.gpIsSync = load("glIsSync")
+	ctx.gpIsTexture = load("glIsTexture")
+	ctx.gpIsTransformFeedback = load("glIsTransformFeedback")
+	ctx.gpIsVertexArray = load("glIsVertexArray")
+	ctx.gpLineWidth = load("glLineWidth")
+	if ctx.gpLineWidth != 0 {
+		purego.RegisterFunc(&ctx.fpLineWidth, ctx.gpLineWidth)
+	} else {
+		ctx.fpLineWidth = nil
+	}
+	ctx.gpLinkProgram = load("glLinkProgram")
+	ctx.gpLogicOp = load("glLogicOp")
+	ctx.gpMapBuffer = load("glMapBuffer")
+	ctx.gpMapBufferRange = load("glMapBufferRange")
+	ctx.gpMinSampleShading = load("glMinSampleShading")
+	if ctx.gpMinSampleShading != 0 {
+		purego.RegisterFunc(&ctx.fpMinSampleShading, ctx.gpMinSampleShading)
+	} else {
+		ctx.fpMinSampleShading = nil
+	}
+	ctx.gpMultiDrawArrays = load("glMultiDrawArrays")
+	ctx.gpMultiDrawElements = load("glMultiDrawElements")
+	ctx.gpMultiDrawElementsBaseVertex = load("glMultiDrawElementsBaseVertex")
+	ctx.gpPatchParameterfv = load("glPatchParameterfv")
+	ctx.gpPatchParameteri = load("glPatchParameteri")
+	ctx.gpPauseTransformFeedback = load("glPauseTransformFeedback")
+	ctx.gpPixelStoref = load("glPixelStoref")
+	if ctx.gpPixelStoref != 0 {
+		purego.RegisterFunc(&ctx.fpPixelStoref, ctx.gpPixelStoref)
+	} else {
+		ctx.fpPixelStoref = nil
+	}
+	ctx.gpPixelStorei = load("glPixelStorei")
+	ctx.gpPointParameterf = load("glPointParameterf")
+	if ctx.gpPointParameterf != 0 {
+		purego.RegisterFunc(&ctx.fpPointParameterf, ctx.gpPointParameterf)
+	} else {
+		ctx.fpPointParameterf = nil
+	}
+	ctx.gpPointParameterfv = load("glPointParameterfv")
+	ctx.gpPointParameteri = load("glPointParameteri")
+	ctx.gpPointParameteriv = load("glPointParameteriv")
+	ctx.gpPointSize = load("glPointSize")
+	if ctx.gpPointSize != 0 {
+		purego.RegisterFunc(&ctx.fpPointSize, ctx.gpPointSize)
+	} else {
+		ctx.fpPointSize = nil
+	}
+	ctx.gpPolygonMode = load("glPolygonMode")
+	ctx.gpPolygonOffset = load("glPolygonOffset")
+	if ctx.gpPolygonOffset != 0 {
+		purego.RegisterFunc(&ctx.fpPolygonOffset, ctx.gpPolygonOffset)
+	} else {
+		ctx.fpPolygonOffset = nil
+	}
+	ctx.gpPrimitiveRestartIndex = load("glPrimitiveRestartIndex")
+	ctx.gpProgramBinary = load("glProgramBinary")
+	ctx.gpProgramParameteri = load("glProgramParameteri")
+	ctx.gpProgramUniform1d = load("glProgramUniform1d")
+	if ctx.gpProgramUniform1d != 0 {
+		purego.RegisterFunc(&ctx.fpProgramUniform1d, ctx.gpProgramUniform1d)
+	} else {
+		ctx.fpProgramUniform1d = nil
+	}
+	ctx.gpProgramUniform1dv = load("glProgramUniform1dv")
+	ctx.gpProgramUniform1f = load("glProgramUniform1f")
+	if ctx.gpProgramUniform1f != 0 {
+		purego.RegisterFunc(&ctx.fpProgramUniform1f, ctx.gpProgramUniform1f)
+	} else {
+		ctx.fpProgramUniform1f = nil
+	}
+	ctx.gpProgramUniform1fv = load("glProgramUniform1fv")
+	ctx.gpProgramUniform1i = load("glProgramUniform1i")
+	ctx.gpProgramUniform1iv = load("glProgramUniform1iv")
+	ctx.gpProgramUniform1ui = load("glProgramUniform1ui")
+	ctx.gpProgramUniform1uiv = load("glProgramUniform1uiv")
+	ctx.gpProgramUniform2d = load("glProgramUniform2d")
+	if ctx.gpProgramUniform2d != 0 {
+		purego.RegisterFunc(&ctx.fpProgramUniform2d, ctx.gpProgramUniform2d)
+	} else {
+		ctx.fpProgramUniform2d = nil
+	}
+	ctx.gpProgramUniform2dv = load("glProgramUniform2dv")
+	ctx.gpProgramUniform2f = load("glProgramUniform2f")
+	if ctx.gpProgramUniform2f != 0 {
+		purego.RegisterFunc(&ctx.fpProgramUniform2f, ctx.gpProgramUniform2f)
+	} else {
+		ctx.fpProgramUniform2f = nil
+	}
+	ctx.gpProgramUniform2fv = load("glProgramUniform2fv")
+	ctx.gpProgramUniform2i = load("glProgramUniform2i")
+	ctx.gpProgramUniform2iv = load("glProgramUniform2iv")
+	ctx.gpProgramUniform2ui = load("glProgramUniform2ui")
+	ctx.gpProgramUniform2uiv = load("glProgramUniform2uiv")
+	ctx.gpProgramUniform3d = load("glProgramUniform3d")
+	if ctx.gpProgramUniform3d != 0 {
+		purego.RegisterFunc(&ctx.fpProgramUniform3d, ctx.gpProgramUniform3d)
+	} else {
+		ctx.fpProgramUniform3d = nil
+	}
+	ctx.gpProgramUniform3dv = load("glProgramUniform3dv")
+	ctx.gpProgramUniform3f = load("glProgramUniform3f")
+	if ctx.gpProgramUniform3f != 0 {
+		purego.RegisterFunc(&ctx.fpProgramUniform3f, ctx.gpProgramUniform3f)
+	} else {
+		ctx.fpProgramUniform3f = nil
+	}
+	ctx.gpProgramUniform3fv = load("glProgramUniform3fv")
+	ctx.gpProgramUniform3i = load("glProgramUniform3i")
+	ctx.gpProgramUniform3iv = load("glProgramUniform3iv")
+	ctx.gpProgramUniform3ui = load("glProgramUniform3ui")
+	ctx.gpProgramUniform3uiv = load("glProgramUniform3uiv")
+	ctx.gpProgramUniform4d = load("glProgramUniform4d")
+	if ctx.gpProgramUniform4d != 0 {
+		purego.RegisterFunc(&ctx.fpProgramUniform4d, ctx.gpProgramUniform4d)
+	} else {
+		ctx.fpProgramUniform4d = nil
+	}
+	ctx.gpProgramUniform4dv = load("glProgramUniform4dv")
+	ctx.gpProgramUniform4f = load("glProgramUniform4f")
+	if ctx.gpProgramUniform4f != 0 {
+		purego.RegisterFunc(&ctx.fpProgramUniform4f, ctx.gpProgramUniform4f)
+	} else {
+		ctx.fpProgramUniform4f = nil
+	}
+	ctx.gpProgramUniform4fv = load("glProgramUniform4fv")
+	ctx.gpProgramUniform4i = load("glProgramUniform4i")
+	ctx.gpProgramUniform4iv = load("glProgramUniform4iv")
+	ctx.gpProgramUniform4ui = load("glProgramUniform4ui")
+	ctx.gpProgramUniform4uiv = load("glProgramUniform4uiv")
+	ctx.gpProgramUniformMatrix2dv = load("glProgramUniformMatrix2dv")
+	ctx.gpProgramUniformMatrix2fv = load("glProgramUniformMatrix2fv")
+	ctx.gpProgramUniformMatrix2x3dv = load("glProgramUniformMatrix2x3dv")
+	ctx.gpProgramUniformMatrix2x3fv = load("glProgramUniformMatrix2x3fv")
+	ctx.gpProgramUniformMatrix2x4dv = load("glProgramUniformMatrix2x4dv")
+	ctx.gpProgramUniformMatrix2x4fv = load("glProgramUniformMatrix2x4fv")
+	ctx.gpProgramUniformMatrix3dv = load("glProgramUniformMatrix3dv")
+	ctx.gpProgramUniformMatrix3fv = load("glProgramUniformMatrix3fv")
+	ctx.gpProgramUniformMatrix3x2dv = load("glProgramUniformMatrix3x2dv")
+	ctx.gpProgramUniformMatrix3x2fv = load("glProgramUniformMatrix3x2fv")
+	ctx.gpProgramUniformMatrix3x4dv = load("glProgramUniformMatrix3x4dv")
+	ctx.gpProgramUniformMatrix3x4fv = load("glProgramUniformMatrix3x4fv")
+	ctx.gpProgramUniformMatrix4dv = load("glProgramUniformMatrix4dv")
+	ctx.gpProgramUniformMatrix4fv = load("glProgramUniformMatrix4fv")
+	ctx.gpProgramUniformMatrix4x2dv = load("glProgramUniformMatrix4x2dv")
+	ctx.gpProgramUniformMatrix4x2fv = load("glProgramUniformMatrix4x2fv")
+	ctx.gpProgramUniformMatrix4x3dv = load("glProgramUniformMatrix4x3dv")
+	ctx.gpProgramUniformMatrix4x3fv = load("glProgramUniformMatrix4x3fv")
+	ctx.gpProvokingVertex = load("glProvokingVertex")
+	ctx.gpQueryCounter = load("glQueryCounter")
+	ctx.gpReadBuffer = load("glReadBuffer")
+	ctx.gpReadPixels = load("glReadPixels")
+	ctx.gpReleaseShaderCompiler = load("glReleaseShaderCompiler")
+	ctx.gpRenderbufferStorage = load("glRenderbufferStorage")
+	ctx.gpRenderbufferStorageMultisample = load("glRenderbufferStorageMultisample")
+	ctx.gpResumeTransformFeedback = load("glResumeTransformFeedback")
+	ctx.gpSampleCoverage = load("glSampleCoverage")
+	if ctx.gpSampleCoverage != 0 {
+		purego.RegisterFunc(&ctx.fpSampleCoverage, ctx.gpSampleCoverage)
+	} else {
+		ctx.fpSampleCoverage = nil
+	}
+	ctx.gpSampleMaski = load("glSampleMaski")
+	ctx.gpSamplerParameterIiv = load("glSamplerParameterIiv")
+	ctx.gpSamplerParameterIuiv = load("glSamplerParameterIuiv")
+	ctx.gpSamplerParameterf = load("glSamplerParameterf")
+	if ctx.gpSamplerParameterf != 0 {
+		purego.RegisterFunc(&ctx.fpSamplerParameterf, ctx.gpSamplerParameterf)
+	} else {
+		ctx.fpSamplerParameterf = nil
+	}
+	ctx.gpSamplerParameterfv = load("glSamplerParameterfv")
+	ctx.gpSamplerParameteri = load("glSamplerParameteri")
+	ctx.gpSamplerParameteriv = load("glSamplerParameteriv")
+	ctx.gpScissor = load("glScissor")
+	ctx.gpScissorArrayv = load("glScissorArrayv")
+	ctx.gpScissorIndexed = load("glScissorIndexed")
+	ctx.gpScissorIndexedv = load("glScissorIndexedv")
+	ctx.gpShaderBinary = load("glShaderBinary")
+	ctx.gpShaderSource = load("glShaderSource")
+	ctx.gpStencilFunc = load("glStencilFunc")
+	ctx.gpStencilFuncSeparate = load("glStencilFuncSeparate")
+	ctx.gpStencilMask = load("glStencilMask")
+	ctx.gpStencilMaskSeparate = load("glStencilMaskSeparate")
+	ctx.gpStencilOp = load("glStencilOp")
+	ctx.gpStencilOpSeparate = load("glStencilOpSeparate")
+	ctx.gpTexBuffer = load("glTexBuffer")
+	ctx.gpTexImage1D = load("glTexImage1D")
+	ctx.gpTexImage2D = load("glTexImage2D")
+	ctx.gpTexImage2DMultisample = load("glTexImage2DMultisample")
+	ctx.gpTexImage3D = load("glTexImage3D")
+	ctx.gpTexImage3DMultisample = load("glTexImage3DMultisample")
+	ctx.gpTexParameterIiv = load("glTexParameterIiv")
+	ctx.gpTexParameterIuiv = load("glTexParameterIuiv")
+	ctx.gpTexParameterf = load("glTexParameterf")
+	if ctx.gpTexParameterf != 0 {
+		purego.RegisterFunc(&ctx.fpTexParameterf, ctx.gpTexParameterf)
+	} else {
+		ctx.fpTexParameterf = nil
+	}
+	ctx.gpTexParameterfv = load("glTexParameterfv")
+	ctx.gpTexParameteri = load("glTexParameteri")
+	ctx.gpTexParameteriv = load("glTexParameteriv")
+	ctx.gpTexSubImage1D = load("glTexSubImage1D")
+	ctx.gpTexSubImage2D = load("glTexSubImage2D")
+	ctx.gpTexSubImage3D = load("glTexSubImage3D")
+	ctx.gpTransformFeedbackVaryings = load("glTransformFeedbackVaryings")
+	ctx.gpUniform1d = load("glUniform1d")
+	if ctx.gpUniform1d != 0 {
+		purego.RegisterFunc(&ctx.fpUniform1d, ctx.gpUniform1d)
+	} else {
+		ctx.fpUniform1d = nil
+	}
+	ctx.gpUniform1dv = load("glUniform1dv")
+	ctx.gpUniform1f = load("glUniform1f")
+	if ctx.gpUniform1f != 0 {
+		purego.RegisterFunc(&ctx.fpUniform1f, ctx.gpUniform1f)
+	} else {
+		ctx.fpUniform1f = nil
+	}
+	ctx.gpUniform1fv = load("glUniform1fv")
+	ctx.gpUniform1i = load("glUniform1i")
+	ctx.gpUniform1iv = load("glUniform1iv")
+	ctx.gpUniform1ui = load("glUniform1ui")
+	ctx.gpUniform1uiv = load("glUniform1uiv")
+	ctx.gpUniform2d = load("glUniform2d")
+	if ctx.gpUniform2d != 0 {
+		purego.RegisterFunc(&ctx.fpUniform2d, ctx.gpUniform2d)
+	} else {
+		ctx.fpUniform2d = nil
+	}
+	ctx.gpUniform2dv = load("glUniform2dv")
+	ctx.gpUniform2f = load("glUniform2f")
+	if ctx.gpUniform2f != 0 {
+		purego.RegisterFunc(&ctx.fpUniform2f, ctx.gpUniform2f)
+	} else {
+		ctx.fpUniform2f = nil
+	}
+	ctx.gpUniform2fv = load("glUniform2fv")
+	ctx.gpUniform2i = load("glUniform2i")
+	ctx.gpUniform2iv = load("glUniform2iv")
+	ctx.gpUniform2ui = load("glUniform2ui")
+	ctx.gpUniform2uiv = load("glUniform2uiv")
+	ctx.gpUniform3d = load("glUniform3d")
+	if ctx.gpUniform3d != 0 {
+		purego.RegisterFunc(&ctx.fpUniform3d, ctx.gpUniform3d)
+	} else {
+		ctx.fpUniform3d = nil
+	}
+	ctx.gpUniform3dv = load("glUniform3dv")
+	ctx.gpUniform3f = load("glUniform3f")
+	if ctx.gpUniform3f != 0 {
+		purego.RegisterFunc(&ctx.fpUniform3f, ctx.gpUniform3f)
+	} else {
+		ctx.fpUniform3f = nil
+	}
+	ctx.gpUniform3fv = load("glUniform3fv")
+	ctx.gpUniform3i = load("glUniform3i")
+	ctx.gpUniform3iv = load("glUniform3iv")
+	ctx.gpUniform3ui = load("glUniform3ui")
+	ctx.gpUniform3uiv = load("glUniform3uiv")
+	ctx.gpUniform4d = load("glUniform4d")
+	if ctx.gpUniform4d != 0 {
+		purego.RegisterFunc(&ctx.fpUniform4d, ctx.gpUniform4d)
+	} else {
+		ctx.fpUniform4d = nil
+	}
+	ctx.gpUniform4dv = load("glUniform4dv")
+	ctx.gpUniform4f = load("glUniform4f")
+	if ctx.gpUniform4f != 0 {
+		purego.RegisterFunc(&ctx.fpUniform4f, ctx.gpUniform4f)
+	} else {
+		ctx.fpUniform4f = nil
+	}
+	ctx.gpUniform4fv = load("glUniform4fv")
+	ctx.gpUniform4i = load("glUniform4i")
+	ctx.gpUniform4iv = load("glUniform4iv")
+	ctx.gpUniform4ui = load("glUniform4ui")
+	ctx.gpUniform4uiv = load("glUniform4uiv")
+	ctx.gpUniformBlockBinding = load("glUniformBlockBinding")
+	ctx.gpUniformMatrix2dv = load("glUniformMatrix2dv")
+	ctx.gpUniformMatrix2fv = load("glUniformMatrix2fv")
+	ctx.gpUniformMatrix2x3dv = load("glUniformMatrix2x3dv")
+	ctx.gpUniformMatrix2x3fv = load("glUniformMatrix2x3fv")
+	ctx.gpUniformMatrix2x4dv = load("glUniformMatrix2x4dv")
+	ctx.gpUniformMatrix2x4fv = load("glUniformMatrix2x4fv")
+	ctx.gpUniformMatrix3dv = load("glUniformMatrix3dv")
+	ctx.gpUniformMatrix3fv = load("glUniformMatrix3fv")
+	ctx.gpUniformMatrix3x2dv = load("glUniformMatrix3x2dv")
+	ctx.gpUniformMatrix3x2fv = load("glUniformMatrix3x2fv")
+	ctx.gpUniformMatrix3x4dv = load("glUniformMatrix3x4dv")
+	ctx.gpUniformMatrix3x4fv = load("glUniformMatrix3x4fv")
+	ctx.gpUniformMatrix4dv = load("glUniformMatrix4dv")
+	ctx.gpUniformMatrix4fv = load("glUniformMatrix4fv")
+	ctx.gpUniformMatrix4x2dv = load("glUniformMatrix4x2dv")
+	ctx.gpUniformMatrix4x2fv = load("glUniformMatrix4x2fv")
+	ctx.gpUniformMatrix4x3dv = load("glUniformMatrix4x3dv")
+	ctx.gpUniformMatrix4x3fv = load("glUniformMatrix4x3fv")
+	ctx.gpUniformSubroutinesuiv = load("glUniformSubroutinesuiv")
+	ctx.gpUnmapBuffer = load("glUnmapBuffer")
+	ctx.gpUseProgram = load("glUseProgram")
+	ctx.gpUseProgramStages = load("glUseProgramStages")
+	ctx.gpValidateProgram = load("glValidateProgram")
+	ctx.gpValidateProgramPipeline = load("glValidateProgramPipeline")
+	ctx.gpVertexAttrib1d = load("glVertexAttrib1d")
+	if ctx.gpVertexAttrib1d != 0 {
+		purego.RegisterFunc(&ctx.fpVertexAttrib1d, ctx.gpVertexAttrib1d)
+	} else {
+		ctx.fpVertexAttrib1d = nil
+	}
+	ctx.gpVertexAttrib1dv = load("glVertexAttrib1dv")
+	ctx.gpVertexAttrib1f = load("glVertexAttrib1f")
+	if ctx.gpVertexAttrib1f != 0 {
+		purego.RegisterFunc(&ctx.fpVertexAttrib1f, ctx.gpVertexAttrib1f)
+	} else {
+		ctx.fpVertexAttrib1f = nil
+	}
+	ctx.gpVertexAttrib1fv = load("glVertexAttrib1fv")
+	ctx.gpVertexAttrib1s = load("glVertexAttrib1s")
+	ctx.gpVertexAttrib1sv = load("glVertexAttrib1sv")
+	ctx.gpVertexAttrib2d = load("glVertexAttrib2d")
+	if ctx.gpVertexAttrib2d != 0 {
+		purego.RegisterFunc(&ctx.fpVertexAttrib2d, ctx.gpVertexAttrib2d)
+	} else {
+		ctx.fpVertexAttrib2d = nil
+	}
+	ctx.gpVertexAttrib2dv = load("glVertexAttrib2dv")
+	ctx.gpVertexAttrib2f = load("glVertexAttrib2f")
+	if ctx.gpVertexAttrib2f != 0 {
+		purego.RegisterFunc(&ctx.fpVertexAttrib2f, ctx.gpVertexAttrib2f)
+	} else {
+		ctx.fpVertexAttrib2f = nil
+	}
+	ctx.gpVertexAttrib2fv = load("glVertexAttrib2fv")
+	ctx.gpVertexAttrib2s = load("glVertexAttrib2s")
+	ctx.gpVertexAttrib2sv = load("glVertexAttrib2sv")
+	ctx.gpVertexAttrib3d = load("glVertexAttrib3d")
+	if ctx.gpVertexAttrib3d != 0 {
+		purego.RegisterFunc(&ctx.fpVertexAttrib3d, ctx.gpVertexAttrib3d)
+	} else {
+		ctx.fpVertexAttrib3d = nil
+	}
+	ctx.gpVertexAttrib3dv = load("glVertexAttrib3dv")
+	ctx.gpVertexAttrib3f = load("glVertexAttrib3f")
+	if ctx.gpVertexAttrib3f != 0 {
+		purego.RegisterFunc(&ctx.fpVertexAttrib3f, ctx.gpVertexAttrib3f)
+	} else {
+		ctx.fpVertexAttrib3f = nil
+	}
+	ctx.gpVertexAttrib3fv = load("glVertexAttrib3fv")
+	ctx.gpVertexAttrib3s = load("glVertexAttrib3s")
+	ctx.gpVertexAttrib3sv = load("glVertexAttrib3sv")
+	ctx.gpVertexAttrib4Nbv = load("glVertexAttrib4Nbv")
+	ctx.gpVertexAttrib4Niv = load("glVertexAttrib4Niv")
+	ctx.gpVertexAttrib4Nsv = load("glVertexAttrib4Nsv")
+	ctx.gpVertexAttrib4Nub = load("glVertexAttrib4Nub")
+	ctx.gpVertexAttrib4Nubv = load("glVertexAttrib4Nubv")
+	ctx.gpVertexAttrib4Nuiv = load("glVertexAttrib4Nuiv")
+	ctx.gpVertexAttrib4Nusv = load("glVertexAttrib4Nusv")
+	ctx.gpVertexAttrib4bv = load("glVertexAttrib4bv")
+	ctx.gpVertexAttrib4d = load("glVertexAttrib4d")
+	if ctx.gpVertexAttrib4d != 0 {
+		purego.RegisterFunc(&ctx.fpVertexAttrib4d, ctx.gpVertexAttrib4d)
+	} else {
+		ctx.fpVertexAttrib4d = nil
+	}
+	ctx.gpVertexAttrib4dv = load("glVertexAttrib4dv")
+	ctx.gpVertexAttrib4f = load("glVertexAttrib4f")
+	if ctx.gpVertexAttrib4f != 0 {
+		purego.RegisterFunc(&ctx.fpVertexAttrib4f, ctx.gpVertexAttrib4f)
+	} else {
+		ctx.fpVertexAttrib4f = nil
+	}
+	ctx.gpVertexAttrib4fv = load("glVertexAttrib4fv")
+	ctx.gpVertexAttrib4iv = load("glVertexAttrib4iv")
+	ctx.gpVertexAttrib4s = load("glVertexAttrib4s")
+	ctx.gpVertexAttrib4sv = load("glVertexAttrib4sv")
+	ctx.gpVertexAttrib4ubv = load("glVertexAttrib4ubv")
+	ctx.gpVertexAttrib4uiv = load("glVertexAttrib4uiv")
+	ctx.gpVertexAttrib4usv = load("glVertexAttrib4usv")
+	ctx.gpVertexAttribDivisor = load("glVertexAttribDivisor")
+	ctx.gpVertexAttribI1i = load("glVertexAttribI1i")
+	ctx.gpVertexAttribI1iv = load("glVertexAttribI1iv")
+	ctx.gpVertexAttribI1ui = load("glVertexAttribI1ui")
+	ctx.gpVertexAttribI1uiv = load("glVertexAttribI1uiv")
+	ctx.gpVertexAttribI2i = load("glVertexAttribI2i")
+	ctx.gpVertexAttribI2iv = load("glVertexAttribI2iv")
+	ctx.gpVertexAttribI2ui = load("glVertexAttribI2ui")
+	ctx.gpVertexAttribI2uiv = load("glVertexAttribI2uiv")
+	ctx.gpVertexAttribI3i = load("glVertexAttribI3i")
+	ctx.gpVertexAttribI3iv = load("glVertexAttribI3iv")
+	ctx.gpVertexAttribI3ui = load("glVertexAttribI3ui")
+	ctx.gpVertexAttribI3uiv = load("glVertexAttribI3uiv")
+	ctx.gpVertexAttribI4bv = load("glVertexAttribI4bv")
+	ctx.gpVertexAttribI4i = load("glVertexAttribI4i")
+	ctx.gpVertexAttribI4iv = load("glVertexAttribI4iv")
+	ctx.gpVertexAttribI4sv = load("glVertexAttribI4sv")
+	ctx.gpVertexAttribI4ubv = load("glVertexAttribI4ubv")
+	ctx.gpVertexAttribI4ui = load("glVertexAttribI4ui")
+	ctx.gpVertexAttribI4uiv = load("glVertexAttribI4uiv")
+	ctx.gpVertexAttribI4usv = load("glVertexAttribI4usv")
+	ctx.gpVertexAttribIPointer = load("glVertexAttribIPointer")
+	ctx.gpVertexAttribL1d = load("glVertexAttribL1d")
+	if ctx.gpVertexAttribL1d != 0 {
+		purego.RegisterFunc(&ctx.fpVertexAttribL1d, ctx.gpVertexAttribL1d)
+	} else {
+		ctx.fpVertexAttribL1d = nil
+	}
+	ctx.gpVertexAttribL1dv = load("glVertexAttribL1dv")
+	ctx.gpVertexAttribL2d = load("glVertexAttribL2d")
+	if ctx.gpVertexAttribL2d != 0 {
+		purego.RegisterFunc(&ctx.fpVertexAttribL2d, ctx.gpVertexAttribL2d)
+	} else {
+		ctx.fpVertexAttribL2d = nil
+	}
+	ctx.gpVertexAttribL2dv = load("glVertexAttribL2dv")
+	ctx.gpVertexAttribL3d = load("glVertexAttribL3d")
+	if ctx.gpVertexAttribL3d != 0 {
+		purego.RegisterFunc(&ctx.fpVertexAttribL3d, ctx.gpVertexAttribL3d)
+	} else {
+		ctx.fpVertexAttribL3d = nil
+	}
+	ctx.gpVertexAttribL3dv = load("glVertexAttribL3dv")
+	ctx.gpVertexAttribL4d = load("glVertexAttribL4d")
+	if ctx.gpVertexAttribL4d != 0 {
+		purego.RegisterFunc(&ctx.fpVertexAttribL4d, ctx.gpVertexAttribL4d)
+	} else {
+		ctx.fpVertexAttribL4d = nil
+	}
+	ctx.gpVertexAttribL4dv = load("glVertexAttribL4dv")
+	ctx.gpVertexAttribLPointer = load("glVertexAttribLPointer")
+	ctx.gpVertexAttribP1ui = load("glVertexAttribP1ui")
+	ctx.gpVertexAttribP1uiv = load("glVertexAttribP1uiv")
+	ctx.gpVertexAttribP2ui = load("glVertexAttribP2ui")
+	ctx.gpVertexAttribP2uiv = load("glVertexAttribP2uiv")
+	ctx.gpVertexAttribP3ui = load("glVertexAttribP3ui")
+	ctx.gpVertexAttribP3uiv = load("glVertexAttribP3uiv")
+	ctx.gpVertexAttribP4ui = load("glVertexAttribP4ui")
+	ctx.gpVertexAttribP4uiv = load("glVertexAttribP4uiv")
+	ctx.gpVertexAttribPointer = load("glVertexAttribPointer")
+	ctx.gpViewport = load("glViewport")
+	ctx.gpViewportArrayv = load("glViewportArrayv")
+	ctx.gpViewportIndexedf = load("glViewportIndexedf")
+	if ctx.gpViewportIndexedf != 0 {
+		purego.RegisterFunc(&ctx.fpViewportIndexedf, ctx.gpViewportIndexedf)
+	} else {
+		ctx.fpViewportIndexedf = nil
+	}
+	ctx.gpViewportIndexedfv = load("glViewportIndexedfv")
+	ctx.gpWaitSync = load("glWaitSync")
+	if len(missing) > 0 {
+		return fmt.Errorf("gl: %d entry points are missing: %s", len(missing), strings.Join(missing, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,475 @@
+//go:build windows || (linux && (amd64 || arm64))
+
+package gl
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"unsafe"
+)
+
+// A trace starts with traceMagic, followed by records. A record is an
+// opName byte followed by the name of the next function, an opCall byte
+// followed by the index of the function name, the number of arguments and
+// the arguments, or an opReturn byte followed by the same as opCall and the
+// result. Each argument, and the result, is a kind byte followed by its value.
+const traceMagic = "GLTRACE2"
+
+const (
+	opName = iota
+	opCall
+	opReturn
+)
+
+const (
+	argInt     = iota + 1 // Signed integer, as a varint
+	argUint               // Unsigned integer or uintptr, as an uvarint
+	argFloat32            // 4 bytes
+	argFloat64            // 8 bytes
+	argBool               // 1 byte
+	argNil                // Nil pointer
+	argAddr               // Pointer to client memory of unknown content, as an uvarint
+	argBytes              // Pointer to data, as its length and content
+	argCString            // Pointer to a null terminated string, as its length and content
+	argStrings            // Array of strings, as their count, and the length and content of each
+	argFunc               // Callback, not recorded
+	argOffset             // Offset in the buffer object bound for the call, as an uvarint
+)
+
+// tracer writes the calls of all contexts to a trace
+type tracer struct {
+	w     *bufio.Writer
+	names map[string]uint64
+	state map[*Context]*traceState
+	buf   []byte
+	err   error
+}
+
+// traceState is the state of a context needed to know whether a pointer
+// is an offset in a buffer object, and otherwise the size of its data
+type traceState struct {
+	buffer         uint32            // The buffer bound to GL_PIXEL_UNPACK_BUFFER
+	alignment      int               // GL_UNPACK_ALIGNMENT
+	rowLength      int               // GL_UNPACK_ROW_LENGTH
+	arrayBuffer    uint32            // The buffer bound to GL_ARRAY_BUFFER
+	indirectBuffer uint32            // The buffer bound to GL_DRAW_INDIRECT_BUFFER
+	vertexArray    uint32            // The bound vertex array object
+	elementBuffers map[uint32]uint32 // The GL_ELEMENT_ARRAY_BUFFER of each vertex array
+}
+
+var (
+	traceMu     sync.Mutex
+	traceWriter *tracer
+)
+
+// StartCapture starts writing every call, with its arguments and the data
+// they reference, to a trace that can be replayed with Replay and printed
+// with DumpTrace. The calls of all contexts are written to the same trace.
+// Capture requires a build with the gltrace tag.
+//
+// The content of the data referenced by pointers is recorded for the
+// functions creating, deleting and filling objects, such as BufferData,
+// TexImage2D, GenBuffers and the Uniform functions, and for the shader
+// sources, the parameter and clear values, and the client side indices. The
+// results of the functions returning an integer, such as CreateProgram and
+// GetUniformLocation, are recorded.
+// The pointers used with a buffer object bound, such as the vertex attribute
+// pointers and the indices with an element array buffer, are recorded as
+// offsets. Other pointers, such as client side vertex arrays, are recorded as
+// addresses, and the calls using them cannot be replayed.
+func StartCapture(w io.Writer) error {
+	if !capture {
+		return errors.New("gl: capture requires a build with the gltrace tag")
+	}
+	traceMu.Lock()
+	defer traceMu.Unlock()
+	if traceWriter != nil {
+		return errors.New("gl: capture already started")
+	}
+	t := &tracer{w: bufio.NewWriter(w), names: map[string]uint64{}, state: map[*Context]*traceState{}}
+	if _, err := t.w.WriteString(traceMagic); err != nil {
+		return err
+	}
+	traceWriter = t
+	return nil
+}
+
+// StopCapture stops the capture started by StartCapture, and flushes the
+// trace. It returns the first error writing the trace.
+func StopCapture() error {
+	traceMu.Lock()
+	defer traceMu.Unlock()
+	t := traceWriter
+	if t == nil {
+		return errors.New("gl: capture not started")
+	}
+	traceWriter = nil
+	if t.err != nil {
+		return t.err
+	}
+	return t.w.Flush()
+}
+
+// record writes a call to the trace, if capture is started. The result is
+// nil for the functions not returning an integer.
+func (ctx *Context) record(name string, result any, args []any) {
+	traceMu.Lock()
+	defer traceMu.Unlock()
+	if t := traceWriter; t != nil && t.err == nil {
+		t.call(ctx, name, result, args)
+	}
+}
+
+func (t *tracer) call(ctx *Context, name string, result any, args []any) {
+	st := t.state[ctx]
+	if st == nil {
+		st = &traceState{alignment: 4, elementBuffers: map[uint32]uint32{}}
+		t.state[ctx] = st
+	}
+	st.update(name, args)
+	id, ok := t.names[name]
+	if !ok {
+		id = uint64(len(t.names))
+		t.names[name] = id
+		t.buf = append(t.buf[:0], opName)
+		t.buf = appendString(t.buf, name)
+		if _, t.err = t.w.Write(t.buf); t.err != nil {
+			return
+		}
+	}
+	op := byte(opCall)
+	if result != nil {
+		op = opReturn
+	}
+	b := append(t.buf[:0], op)
+	b = binary.AppendUvarint(b, id)
+	b = binary.AppendUvarint(b, uint64(len(args)))
+	for i := range args {
+		b = appendArg(b, st, name, args, i)
+	}
+	if result != nil {
+		b = appendArg(b, st, name, []any{result}, 0)
+	}
+	t.buf = b
+	_, t.err = t.w.Write(b)
+}
+
+// drawIndirectBuffer is GL_DRAW_INDIRECT_BUFFER, which is not in all the
+// version specific packages
+const drawIndirectBuffer = 0x8F3F
+
+func (st *traceState) update(name string, args []any) {
+	switch name {
+	case "BindBuffer":
+		buffer := uint32(intArg(args[1]))
+		switch intArg(args[0]) {
+		case PIXEL_UNPACK_BUFFER:
+			st.buffer = buffer
+		case ARRAY_BUFFER:
+			st.arrayBuffer = buffer
+		case ELEMENT_ARRAY_BUFFER:
+			st.elementBuffers[st.vertexArray] = buffer
+		case drawIndirectBuffer:
+			st.indirectBuffer = buffer
+		}
+	case "PixelStorei":
+		switch intArg(args[0]) {
+		case UNPACK_ALIGNMENT:
+			st.alignment = intArg(args[1])
+		case UNPACK_ROW_LENGTH:
+			st.rowLength = intArg(args[1])
+		}
+	case "BindVertexArray":
+		st.vertexArray = uint32(intArg(args[0]))
+	case "VertexArrayElementBuffer":
+		st.elementBuffers[uint32(intArg(args[0]))] = uint32(intArg(args[1]))
+	case "DeleteBuffers":
+		// Deleted buffers are unbound from the context and the bound vertex array
+		for _, b := range uint32Array(args[1], intArg(args[0])) {
+			for _, bound := range []*uint32{&st.buffer, &st.arrayBuffer, &st.indirectBuffer} {
+				if *bound == b {
+					*bound = 0
+				}
+			}
+			if st.elementBuffers[st.vertexArray] == b {
+				delete(st.elementBuffers, st.vertexArray)
+			}
+		}
+	case "DeleteVertexArrays":
+		for _, a := range uint32Array(args[1], intArg(args[0])) {
+			delete(st.elementBuffers, a)
+			if st.vertexArray == a {
+				st.vertexArray = 0
+			}
+		}
+	}
+}
+
+// uint32Array returns the names in an array argument
+func uint32Array(a any, n int) []uint32 {
+	p, ok := a.(*uint32)
+	if !ok || p == nil || n <= 0 {
+		return nil
+	}
+	return unsafe.Slice(p, n)
+}
+
+func appendString(b []byte, s string) []byte {
+	b = binary.AppendUvarint(b, uint64(len(s)))
+	return append(b, s...)
+}
+
+func appendArg(b []byte, st *traceState, name string, args []any, i int) []byte {
+	v := reflect.ValueOf(args[i])
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return append(b, argBool, 1)
+		}
+		return append(b, argBool, 0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return binary.AppendVarint(append(b, argInt), v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return binary.AppendUvarint(append(b, argUint), v.Uint())
+	case reflect.Float32:
+		return binary.LittleEndian.AppendUint32(append(b, argFloat32), math.Float32bits(float32(v.Float())))
+	case reflect.Float64:
+		return binary.LittleEndian.AppendUint64(append(b, argFloat64), math.Float64bits(v.Float()))
+	case reflect.Func:
+		return append(b, argFunc)
+	case reflect.Pointer, reflect.UnsafePointer:
+		p := v.UnsafePointer()
+		cstring, ok := cstringArgs[name]
+		switch {
+		case p == nil:
+			return append(b, argNil)
+		case v.Type() == reflect.TypeFor[**uint8]():
+			// The count precedes the array in ShaderSource and TransformFeedbackVaryings
+			strs := unsafe.Slice((**uint8)(p), intArg(args[i-1]))
+			var lengths []int32
+			if name == "ShaderSource" && args[i+1].(*int32) != nil {
+				lengths = unsafe.Slice(args[i+1].(*int32), len(strs))
+			}
+			b = binary.AppendUvarint(append(b, argStrings), uint64(len(strs)))
+			for j, s := range strs {
+				if lengths != nil && lengths[j] >= 0 {
+					b = appendString(b, string(unsafe.Slice(s, lengths[j])))
+				} else {
+					b = appendString(b, GoStr(s))
+				}
+			}
+			return b
+		case name == "ShaderSource" && i == 3:
+			// The lengths are applied to the recorded strings
+			return append(b, argNil)
+		case ok && cstring == i:
+			return appendString(append(b, argCString), GoStr((*uint8)(p)))
+		}
+		if st.isOffset(name, args, i) {
+			return binary.AppendUvarint(append(b, argOffset), uint64(uintptr(p)))
+		}
+		if n := dataSize(st, name, args, i); n > 0 {
+			b = binary.AppendUvarint(append(b, argBytes), uint64(n))
+			return append(b, unsafe.Slice((*byte)(p), n)...)
+		}
+		return binary.AppendUvarint(append(b, argAddr), uint64(uintptr(p)))
+	}
+	panic("gl: cannot record argument of type " + v.Type().String())
+}
+
+// intArg returns an integer argument as an int
+func intArg(a any) int {
+	v := reflect.ValueOf(a)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return int(v.Uint())
+	}
+	return 0
+}
+
+// cstringArgs is the index of the null terminated name argument of functions
+var cstringArgs = map[string]int{
+	"GetUniformLocation":   1,
+	"GetAttribLocation":    1,
+	"GetUniformBlockIndex": 1,
+	"GetFragDataLocation":  1,
+	"BindAttribLocation":   2,
+	"BindFragDataLocation": 2,
+}
+
+var (
+	objectsRe   = regexp.MustCompile(`^(Gen|Create|Delete)(Buffers|Textures|VertexArrays|Framebuffers|Renderbuffers|Queries|Samplers|TransformFeedbacks|ProgramPipelines)$`)
+	uniformRe   = regexp.MustCompile(`^(Program)?Uniform([1-4])(f|i|ui)v$`)
+	matrixRe    = regexp.MustCompile(`^(Program)?UniformMatrix([2-4])(x([2-4]))?fv$`)
+	attribRe    = regexp.MustCompile(`^VertexAttrib([1-4])fv$`)
+	elementsRe  = regexp.MustCompile(`^Draw(Range)?Elements(Instanced)?(BaseVertex)?(BaseInstance)?$`)
+	texImageRe  = regexp.MustCompile(`^(Compressed)?Tex(ture)?(Sub)?Image[1-3]D$`)
+	parameterRe = regexp.MustCompile(`^(Tex|Texture|Sampler)Parameter(f|i|Ii|Iui)v$`)
+	clearDataRe = regexp.MustCompile(`^Clear(Named)?Buffer(Sub)?Data$|^ClearTex(Sub)?Image$`)
+)
+
+// isOffset returns true if argument i of a call is an offset in the buffer
+// object bound for it, instead of a pointer to client memory
+func (st *traceState) isOffset(name string, args []any, i int) bool {
+	last := i == len(args)-1
+	switch {
+	case name == "VertexAttribPointer" || name == "VertexAttribIPointer" || name == "VertexAttribLPointer":
+		return last && st.arrayBuffer != 0
+	case strings.HasSuffix(name, "Indirect"):
+		return st.indirectBuffer != 0
+	case elementsRe.MatchString(name):
+		return st.elementBuffers[st.vertexArray] != 0
+	case texImageRe.MatchString(name):
+		return last && st.buffer != 0
+	}
+	return false
+}
+
+// dataSize returns the size in bytes of the data referenced by argument i
+// of a call, or 0 if it is unknown
+func dataSize(st *traceState, name string, args []any, i int) int {
+	last := i == len(args)-1
+	switch name {
+	case "BufferData", "NamedBufferData":
+		if i == 2 {
+			return intArg(args[1])
+		}
+	case "BufferSubData", "NamedBufferSubData":
+		if i == 3 {
+			return intArg(args[2])
+		}
+	case "DrawBuffers":
+		if i == 1 {
+			return 4 * intArg(args[0])
+		}
+	case "TexImage1D":
+		return st.imageSize(args[3], 1, 1, args[5], args[6])
+	case "TexSubImage1D":
+		return st.imageSize(args[3], 1, 1, args[4], args[5])
+	case "TexImage2D":
+		return st.imageSize(args[3], args[4], 1, args[6], args[7])
+	case "TexSubImage2D":
+		return st.imageSize(args[4], args[5], 1, args[6], args[7])
+	case "TexImage3D":
+		return st.imageSize(args[3], args[4], args[5], args[7], args[8])
+	case "TexSubImage3D":
+		return st.imageSize(args[5], args[6], args[7], args[8], args[9])
+	case "CompressedTexImage2D", "CompressedTexSubImage2D", "CompressedTexImage3D", "CompressedTexSubImage3D":
+		return intArg(args[len(args)-2])
+	case "ClearBufferfv", "ClearBufferiv", "ClearBufferuiv":
+		return 4 * clearValues(args[0])
+	case "ClearNamedFramebufferfv", "ClearNamedFramebufferiv", "ClearNamedFramebufferuiv":
+		return 4 * clearValues(args[1])
+	}
+	if objectsRe.MatchString(name) && last {
+		return 4 * intArg(args[len(args)-2])
+	}
+	if elementsRe.MatchString(name) {
+		// The count and type precede the indices
+		var size int
+		switch intArg(args[i-1]) {
+		case UNSIGNED_BYTE:
+			size = 1
+		case UNSIGNED_SHORT:
+			size = 2
+		case UNSIGNED_INT:
+			size = 4
+		}
+		return size * intArg(args[i-2])
+	}
+	if parameterRe.MatchString(name) && last {
+		switch intArg(args[1]) {
+		case 0x1004, 0x8E46: // GL_TEXTURE_BORDER_COLOR, GL_TEXTURE_SWIZZLE_RGBA
+			return 16
+		}
+		return 4
+	}
+	if clearDataRe.MatchString(name) && last {
+		// One value of the format and type
+		return pixelSize(uint32(intArg(args[len(args)-3])), uint32(intArg(args[len(args)-2])))
+	}
+	if m := uniformRe.FindStringSubmatch(name); m != nil && last {
+		return 4 * int(m[2][0]-'0') * intArg(args[len(args)-2])
+	}
+	if m := matrixRe.FindStringSubmatch(name); m != nil && last {
+		rows := m[2]
+		if m[4] != "" {
+			rows = m[4]
+		}
+		return 4 * int(m[2][0]-'0') * int(rows[0]-'0') * intArg(args[len(args)-3])
+	}
+	if m := attribRe.FindStringSubmatch(name); m != nil && last {
+		return 4 * int(m[1][0]-'0')
+	}
+	return 0
+}
+
+// clearValues returns the number of values used to clear a buffer, 4 for
+// GL_COLOR and 1 for GL_DEPTH and GL_STENCIL
+func clearValues(buffer any) int {
+	if intArg(buffer) == 0x1800 { // GL_COLOR
+		return 4
+	}
+	return 1
+}
+
+// imageSize returns the size of an image in client memory, as read with the
+// unpack alignment and row length
+func (st *traceState) imageSize(width, height, depth, format, xtype any) int {
+	if st.buffer != 0 {
+		return 0
+	}
+	pixel := pixelSize(uint32(intArg(format)), uint32(intArg(xtype)))
+	w, h, d := intArg(width), intArg(height), intArg(depth)
+	if pixel == 0 || w <= 0 || h <= 0 || d <= 0 {
+		return 0
+	}
+	rowLength := w
+	if st.rowLength > 0 {
+		rowLength = st.rowLength
+	}
+	align := max(st.alignment, 1)
+	row := (rowLength*pixel + align - 1) / align * align
+	return row*(h*d-1) + w*pixel
+}
+
+// pixelSize returns the size of a pixel of a format and type, or 0 if it is
+// unknown. The enums of the compatibility profile are not in the version
+// specific packages, so their values are used.
+func pixelSize(format, xtype uint32) int {
+	var size int
+	switch xtype {
+	case 0x1400, 0x1401: // GL_BYTE, GL_UNSIGNED_BYTE
+		size = 1
+	case 0x1402, 0x1403, 0x140B: // GL_SHORT, GL_UNSIGNED_SHORT, GL_HALF_FLOAT
+		size = 2
+	case 0x1404, 0x1405, 0x1406: // GL_INT, GL_UNSIGNED_INT, GL_FLOAT
+		size = 4
+	case 0x8363, 0x8033, 0x8034, 0x8364, 0x8365, 0x8366: // GL_UNSIGNED_SHORT_5_6_5 and the other packed 16 bit types
+		return 2
+	case 0x8035, 0x8036, 0x8367, 0x8368, 0x8C3B, 0x8C3E, 0x84FA: // GL_UNSIGNED_INT_8_8_8_8 and the other packed 32 bit types
+		return 4
+	default:
+		return 0
+	}
+	switch format {
+	case 0x1903, 0x1904, 0x1905, 0x1906, 0x1902, 0x1901, 0x8D94, 0x8D95, 0x8D96, 0x1909: // GL_RED, GL_GREEN, GL_BLUE, GL_ALPHA, GL_DEPTH_COMPONENT, GL_STENCIL_INDEX, GL_RED_INTEGER, GL_GREEN_INTEGER, GL_BLUE_INTEGER, GL_LUMINANCE
+		return size
+	case 0x8227, 0x8228, 0x84F9, 0x190A: // GL_RG, GL_RG_INTEGER, GL_DEPTH_STENCIL, GL_LUMINANCE_ALPHA
+		return 2 * size
+	case 0x1907, 0x80E0, 0x8D98, 0x8D9A: // GL_RGB, GL_BGR, GL_RGB_INTEGER, GL_BGR_INTEGER
+		return 3 * size
+	case 0x1908, 0x80E1, 0x8D99, 0x8D9B: // GL_RGBA, GL_BGRA, GL_RGBA_INTEGER, GL_BGRA_INTEGER
+		return 4 * size
+	}
+	return 0
+}
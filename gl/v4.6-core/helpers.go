@@ -0,0 +1,186 @@
+package gl
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"unsafe"
+)
+
+// sliceData returns the address and size in bytes of the elements of a slice
+func sliceData[T any](data []T) (unsafe.Pointer, int) {
+	if len(data) == 0 {
+		return nil, 0
+	}
+	return unsafe.Pointer(unsafe.SliceData(data)), len(data) * int(unsafe.Sizeof(data[0]))
+}
+
+// BufferDataSlice creates the data store of the buffer bound to target, with
+// the elements of data, which must not contain Go pointers.
+func BufferDataSlice[T any](target uint32, data []T, usage uint32) {
+	p, size := sliceData(data)
+	BufferData(target, size, p, usage)
+}
+
+// BufferSubDataSlice replaces the data of the buffer bound to target from
+// offset, in bytes, with the elements of data.
+func BufferSubDataSlice[T any](target uint32, offset int, data []T) {
+	p, size := sliceData(data)
+	if size > 0 {
+		BufferSubData(target, offset, size, p)
+	}
+}
+
+// ShaderSourceString sets the source of a shader. The source does not need a
+// null terminator.
+func ShaderSourceString(shader uint32, source string) {
+	var nul uint8
+	p := &nul
+	if source != "" {
+		p = unsafe.StringData(source)
+	}
+	length := int32(len(source))
+	// The array of strings is passed to C, so it must not point to unpinned memory
+	var pinner runtime.Pinner
+	pinner.Pin(p)
+	defer pinner.Unpin()
+	ShaderSource(shader, 1, &p, &length)
+}
+
+// infoLog returns an info log of the given length, got by get
+func infoLog(length int32, get func(bufSize int32, length *int32, infoLog *uint8)) string {
+	if length <= 1 {
+		return ""
+	}
+	buf := make([]uint8, length)
+	get(length, &length, &buf[0])
+	return strings.TrimRight(string(buf[:length]), "\x00\n")
+}
+
+// GetShaderInfoLogString returns the info log of a shader, written by
+// CompileShader.
+func GetShaderInfoLogString(shader uint32) string {
+	var length int32
+	GetShaderiv(shader, INFO_LOG_LENGTH, &length)
+	return infoLog(length, func(bufSize int32, length *int32, log *uint8) {
+		GetShaderInfoLog(shader, bufSize, length, log)
+	})
+}
+
+// GetProgramInfoLogString returns the info log of a program, written by
+// LinkProgram and ValidateProgram.
+func GetProgramInfoLogString(program uint32) string {
+	var length int32
+	GetProgramiv(program, INFO_LOG_LENGTH, &length)
+	return infoLog(length, func(bufSize int32, length *int32, log *uint8) {
+		GetProgramInfoLog(program, bufSize, length, log)
+	})
+}
+
+// UniformMatrix2 sets a mat2 uniform of the current program, from a column
+// major matrix.
+func UniformMatrix2(location int32, m [4]float32) {
+	UniformMatrix2fv(location, 1, false, &m[0])
+}
+
+// UniformMatrix3 sets a mat3 uniform of the current program, from a column
+// major matrix.
+func UniformMatrix3(location int32, m [9]float32) {
+	UniformMatrix3fv(location, 1, false, &m[0])
+}
+
+// UniformMatrix4 sets a mat4 uniform of the current program, from a column
+// major matrix.
+func UniformMatrix4(location int32, m [16]float32) {
+	UniformMatrix4fv(location, 1, false, &m[0])
+}
+
+// UniformMatrix4Slice sets a mat4 array uniform of the current program, from
+// column major matrices.
+func UniformMatrix4Slice(location int32, m [][16]float32) {
+	if len(m) > 0 {
+		UniformMatrix4fv(location, int32(len(m)), false, &m[0][0])
+	}
+}
+
+// shaderName returns the name of a shader type in errors. The values are
+// used, as not all the types are in the version specific packages.
+func shaderName(xtype uint32) string {
+	switch xtype {
+	case 0x8B31: // GL_VERTEX_SHADER
+		return "vertex shader"
+	case 0x8B30: // GL_FRAGMENT_SHADER
+		return "fragment shader"
+	case 0x8DD9: // GL_GEOMETRY_SHADER
+		return "geometry shader"
+	case 0x8E88: // GL_TESS_CONTROL_SHADER
+		return "tessellation control shader"
+	case 0x8E87: // GL_TESS_EVALUATION_SHADER
+		return "tessellation evaluation shader"
+	case 0x91B9: // GL_COMPUTE_SHADER
+		return "compute shader"
+	}
+	return fmt.Sprintf("shader 0x%X", xtype)
+}
+
+// CompileShaderSource creates and compiles a shader of the given type, such
+// as VERTEX_SHADER. If the compilation fails, the shader is deleted and the
+// error holds the info log.
+func CompileShaderSource(xtype uint32, source string) (uint32, error) {
+	shader := CreateShader(xtype)
+	if shader == 0 {
+		return 0, fmt.Errorf("gl: cannot create %s", shaderName(xtype))
+	}
+	ShaderSourceString(shader, source)
+	CompileShader(shader)
+	var status int32
+	GetShaderiv(shader, COMPILE_STATUS, &status)
+	if status == FALSE {
+		log := GetShaderInfoLogString(shader)
+		DeleteShader(shader)
+		return 0, fmt.Errorf("gl: compiling %s: %s", shaderName(xtype), log)
+	}
+	return shader, nil
+}
+
+// LinkShaders creates a program, and links it with the shaders. The shaders
+// are detached after linking, and can be deleted. If the link fails, the
+// program is deleted and the error holds the info log.
+func LinkShaders(shaders ...uint32) (uint32, error) {
+	program := CreateProgram()
+	if program == 0 {
+		return 0, errors.New("gl: cannot create program")
+	}
+	for _, s := range shaders {
+		AttachShader(program, s)
+	}
+	LinkProgram(program)
+	for _, s := range shaders {
+		DetachShader(program, s)
+	}
+	var status int32
+	GetProgramiv(program, LINK_STATUS, &status)
+	if status == FALSE {
+		log := GetProgramInfoLogString(program)
+		DeleteProgram(program)
+		return 0, fmt.Errorf("gl: linking program: %s", log)
+	}
+	return program, nil
+}
+
+// NewProgram compiles a vertex and a fragment shader, and links them in a
+// program. The shaders are deleted.
+func NewProgram(vertexSource, fragmentSource string) (uint32, error) {
+	vertex, err := CompileShaderSource(VERTEX_SHADER, vertexSource)
+	if err != nil {
+		return 0, err
+	}
+	defer DeleteShader(vertex)
+	fragment, err := CompileShaderSource(FRAGMENT_SHADER, fragmentSource)
+	if err != nil {
+		return 0, err
+	}
+	defer DeleteShader(fragment)
+	return LinkShaders(vertex, fragment)
+}
@@ -0,0 +1,9517 @@
+// Code generated by genversions.go from the all-core bindings and glcorearb.h. DO NOT EDIT.
+
+package gl
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"syscall"
+	"unsafe"
+)
+
+// Context holds the OpenGL entry points of one context, and has a method
+// for each function of the package. The package-level functions call the
+// default context, loaded by Init and InitWithProcAddrFunc.
+type Context struct {
+	inBegin bool // Between Begin and End, where glGetError must not be called
+
+	gpActiveShaderProgram                         uintptr
+	gpActiveTexture                               uintptr
+	gpAttachShader                                uintptr
+	gpBeginConditionalRender                      uintptr
+	gpBeginQuery                                  uintptr
+	gpBeginQueryIndexed                           uintptr
+	gpBeginTransformFeedback                      uintptr
+	gpBindAttribLocation                          uintptr
+	gpBindBuffer                                  uintptr
+	gpBindBufferBase                              uintptr
+	gpBindBufferRange                             uintptr
+	gpBindBuffersBase                             uintptr
+	gpBindBuffersRange                            uintptr
+	gpBindFragDataLocation                        uintptr
+	gpBindFragDataLocationIndexed                 uintptr
+	gpBindFramebuffer                             uintptr
+	gpBindImageTexture                            uintptr
+	gpBindImageTextures                           uintptr
+	gpBindProgramPipeline                         uintptr
+	gpBindRenderbuffer                            uintptr
+	gpBindSampler                                 uintptr
+	gpBindSamplers                                uintptr
+	gpBindTexture                                 uintptr
+	gpBindTextureUnit                             uintptr
+	gpBindTextures                                uintptr
+	gpBindTransformFeedback                       uintptr
+	gpBindVertexArray                             uintptr
+	gpBindVertexBuffer                            uintptr
+	gpBindVertexBuffers                           uintptr
+	gpBlendColor                                  uintptr
+	gpBlendEquation                               uintptr
+	gpBlendEquationSeparate                       uintptr
+	gpBlendEquationSeparatei                      uintptr
+	gpBlendEquationi                              uintptr
+	gpBlendFunc                                   uintptr
+	gpBlendFuncSeparate                           uintptr
+	gpBlendFuncSeparatei                          uintptr
+	gpBlendFunci                                  uintptr
+	gpBlitFramebuffer                             uintptr
+	gpBlitNamedFramebuffer                        uintptr
+	gpBufferData                                  uintptr
+	gpBufferStorage                               uintptr
+	gpBufferSubData                               uintptr
+	gpCheckFramebufferStatus                      uintptr
+	gpCheckNamedFramebufferStatus                 uintptr
+	gpClampColor                                  uintptr
+	gpClear                                       uintptr
+	gpClearBufferData                             uintptr
+	gpClearBufferSubData                          uintptr
+	gpClearBufferfi                               uintptr
+	gpClearBufferfv                               uintptr
+	gpClearBufferiv                               uintptr
+	gpClearBufferuiv                              uintptr
+	gpClearColor                                  uintptr
+	gpClearDepth                                  uintptr
+	gpClearDepthf                                 uintptr
+	gpClearNamedBufferData                        uintptr
+	gpClearNamedBufferSubData                     uintptr
+	gpClearNamedFramebufferfi                     uintptr
+	gpClearNamedFramebufferfv                     uintptr
+	gpClearNamedFramebufferiv                     uintptr
+	gpClearNamedFramebufferuiv                    uintptr
+	gpClearStencil                                uintptr
+	gpClearTexImage                               uintptr
+	gpClearTexSubImage                            uintptr
+	gpClientWaitSync                              uintptr
+	gpClipControl                                 uintptr
+	gpColorMask                                   uintptr
+	gpColorMaski                                  uintptr
+	gpCompileShader                               uintptr
+	gpCompressedTexImage1D                        uintptr
+	gpCompressedTexImage2D                        uintptr
+	gpCompressedTexImage3D                        uintptr
+	gpCompressedTexSubImage1D                     uintptr
+	gpCompressedTexSubImage2D                     uintptr
+	gpCompressedTexSubImage3D                     uintptr
+	gpCompressedTextureSubImage1D                 uintptr
+	gpCompressedTextureSubImage2D                 uintptr
+	gpCompressedTextureSubImage3D                 uintptr
+	gpCopyBufferSubData                           uintptr
+	gpCopyImageSubData                            uintptr
+	gpCopyNamedBufferSubData                      uintptr
+	gpCopyTexImage1D                              uintptr
+	gpCopyTexImage2D                              uintptr
+	gpCopyTexSubImage1D                           uintptr
+	gpCopyTexSubImage2D                           uintptr
+	gpCopyTexSubImage3D                           uintptr
+	gpCopyTextureSubImage1D                       uintptr
+	gpCopyTextureSubImage2D                       uintptr
+	gpCopyTextureSubImage3D                       uintptr
+	gpCreateBuffers                               uintptr
+	gpCreateFramebuffers                          uintptr
+	gpCreateProgram                               uintptr
+	gpCreateProgramPipelines                      uintptr
+	gpCreateQueries                               uintptr
+	gpCreateRenderbuffers                         uintptr
+	gpCreateSamplers                              uintptr
+	gpCreateShader                                uintptr
+	gpCreateShaderProgramv                        uintptr
+	gpCreateTextures                              uintptr
+	gpCreateTransformFeedbacks                    uintptr
+	gpCreateVertexArrays                          uintptr
+	gpCullFace                                    uintptr
+	gpDebugMessageCallback                        uintptr
+	gpDebugMessageControl                         uintptr
+	gpDebugMessageInsert                          uintptr
+	gpDeleteBuffers                               uintptr
+	gpDeleteFramebuffers                          uintptr
+	gpDeleteProgram                               uintptr
+	gpDeleteProgramPipelines                      uintptr
+	gpDeleteQueries                               uintptr
+	gpDeleteRenderbuffers                         uintptr
+	gpDeleteSamplers                              uintptr
+	gpDeleteShader                                uintptr
+	gpDeleteSync                                  uintptr
+	gpDeleteTextures                              uintptr
+	gpDeleteTransformFeedbacks                    uintptr
+	gpDeleteVertexArrays                          uintptr
+	gpDepthFunc                                   uintptr
+	gpDepthMask                                   uintptr
+	gpDepthRange                                  uintptr
+	gpDepthRangeArrayv                            uintptr
+	gpDepthRangeIndexed                           uintptr
+	gpDepthRangef                                 uintptr
+	gpDetachShader                                uintptr
+	gpDisable                                     uintptr
+	gpDisableVertexArrayAttrib                    uintptr
+	gpDisableVertexAttribArray                    uintptr
+	gpDisablei                                    uintptr
+	gpDispatchCompute                             uintptr
+	gpDispatchComputeIndirect                     uintptr
+	gpDrawArrays                                  uintptr
+	gpDrawArraysIndirect                          uintptr
+	gpDrawArraysInstanced                         uintptr
+	gpDrawArraysInstancedBaseInstance             uintptr
+	gpDrawBuffer                                  uintptr
+	gpDrawBuffers                                 uintptr
+	gpDrawElements                                uintptr
+	gpDrawElementsBaseVertex                      uintptr
+	gpDrawElementsIndirect                        uintptr
+	gpDrawElementsInstanced                       uintptr
+	gpDrawElementsInstancedBaseInstance           uintptr
+	gpDrawElementsInstancedBaseVertex             uintptr
+	gpDrawElementsInstancedBaseVertexBaseInstance uintptr
+	gpDrawRangeElements                           uintptr
+	gpDrawRangeElementsBaseVertex                 uintptr
+	gpDrawTransformFeedback                       uintptr
+	gpDrawTransformFeedbackInstanced              uintptr
+	gpDrawTransformFeedbackStream                 uintptr
+	gpDrawTransformFeedbackStreamInstanced        uintptr
+	gpEnable                                      uintptr
+	gpEnableVertexArrayAttrib                     uintptr
+	gpEnableVertexAttribArray                     uintptr
+	gpEnablei                                     uintptr
+	gpEndConditionalRender                        uintptr
+	gpEndQuery                                    uintptr
+	gpEndQueryIndexed                             uintptr
+	gpEndTransformFeedback                        uintptr
+	gpFenceSync                                   uintptr
+	gpFinish                                      uintptr
+	gpFlush                                       uintptr
+	gpFlushMappedBufferRange                      uintptr
+	gpFlushMappedNamedBufferRange                 uintptr
+	gpFramebufferParameteri                       uintptr
+	gpFramebufferRenderbuffer                     uintptr
+	gpFramebufferTexture                          uintptr
+	gpFramebufferTexture1D                        uintptr
+	gpFramebufferTexture2D                        uintptr
+	gpFramebufferTexture3D                        uintptr
+	gpFramebufferTextureLayer                     uintptr
+	gpFrontFace                                   uintptr
+	gpGenBuffers                                  uintptr
+	gpGenFramebuffers                             uintptr
+	gpGenProgramPipelines                         uintptr
+	gpGenQueries                                  uintptr
+	gpGenRenderbuffers                            uintptr
+	gpGenSamplers                                 uintptr
+	gpGenTextures                                 uintptr
+	gpGenTransformFeedbacks                       uintptr
+	gpGenVertexArrays                             uintptr
+	gpGenerateMipmap                              uintptr
+	gpGenerateTextureMipmap                       uintptr
+	gpGetActiveAtomicCounterBufferiv              uintptr
+	gpGetActiveAttrib                             uintptr
+	gpGetActiveSubroutineName                     uintptr
+	gpGetActiveSubroutineUniformName              uintptr
+	gpGetActiveSubroutineUniformiv                uintptr
+	gpGetActiveUniform                            uintptr
+	gpGetActiveUniformBlockName                   uintptr
+	gpGetActiveUniformBlockiv                     uintptr
+	gpGetActiveUniformName                        uintptr
+	gpGetActiveUniformsiv                         uintptr
+	gpGetAttachedShaders                          uintptr
+	gpGetAttribLocation                           uintptr
+	gpGetBooleani_v                               uintptr
+	gpGetBooleanv                                 uintptr
+	gpGetBufferParameteri64v                      uintptr
+	gpGetBufferParameteriv                        uintptr
+	gpGetBufferPointerv                           uintptr
+	gpGetBufferSubData                            uintptr
+	gpGetCompressedTexImage                       uintptr
+	gpGetCompressedTextureImage                   uintptr
+	gpGetCompressedTextureSubImage                uintptr
+	gpGetDebugMessageLog                          uintptr
+	gpGetDoublei_v                                uintptr
+	gpGetDoublev                                  uintptr
+	gpGetError                                    uintptr
+	gpGetFloati_v                                 uintptr
+	gpGetFloatv                                   uintptr
+	gpGetFragDataIndex                            uintptr
+	gpGetFragDataLocation                         uintptr
+	gpGetFramebufferAttachmentParameteriv         uintptr
+	gpGetFramebufferParameteriv                   uintptr
+	gpGetGraphicsResetStatus                      uintptr
+	gpGetInteger64i_v                             uintptr
+	gpGetInteger64v                               uintptr
+	gpGetIntegeri_v                               uintptr
+	gpGetIntegerv                                 uintptr
+	gpGetInternalformati64v                       uintptr
+	gpGetInternalformativ                         uintptr
+	gpGetMultisamplefv                            uintptr
+	gpGetNamedBufferParameteri64v                 uintptr
+	gpGetNamedBufferParameteriv                   uintptr
+	gpGetNamedBufferPointerv                      uintptr
+	gpGetNamedBufferSubData                       uintptr
+	gpGetNamedFramebufferAttachmentParameteriv    uintptr
+	gpGetNamedFramebufferParameteriv              uintptr
+	gpGetNamedRenderbufferParameteriv             uintptr
+	gpGetObjectLabel                              uintptr
+	gpGetObjectPtrLabel                           uintptr
+	gpGetPointerv                                 uintptr
+	gpGetProgramBinary                            uintptr
+	gpGetProgramInfoLog                           uintptr
+	gpGetProgramInterfaceiv                       uintptr
+	gpGetProgramPipelineInfoLog                   uintptr
+	gpGetProgramPipelineiv                        uintptr
+	gpGetProgramResourceIndex                     uintptr
+	gpGetProgramResourceLocation                  uintptr
+	gpGetProgramResourceLocationIndex             uintptr
+	gpGetProgramResourceName                      uintptr
+	gpGetProgramResourceiv                        uintptr
+	gpGetProgramStageiv                           uintptr
+	gpGetProgramiv                                uintptr
+	gpGetQueryBufferObjecti64v                    uintptr
+	gpGetQueryBufferObjectiv                      uintptr
+	gpGetQueryBufferObjectui64v                   uintptr
+	gpGetQueryBufferObjectuiv                     uintptr
+	gpGetQueryIndexediv                           uintptr
+	gpGetQueryObjecti64v                          uintptr
+	gpGetQueryObjectiv                            uintptr
+	gpGetQueryObjectui64v                         uintptr
+	gpGetQueryObjectuiv                           uintptr
+	gpGetQueryiv                                  uintptr
+	gpGetRenderbufferParameteriv                  uintptr
+	gpGetSamplerParameterIiv                      uintptr
+	gpGetSamplerParameterIuiv                     uintptr
+	gpGetSamplerParameterfv                       uintptr
+	gpGetSamplerParameteriv                       uintptr
+	gpGetShaderInfoLog                            uintptr
+	gpGetShaderPrecisionFormat                    uintptr
+	gpGetShaderSource                             uintptr
+	gpGetShaderiv                                 uintptr
+	gpGetString                                   uintptr
+	gpGetStringi                                  uintptr
+	gpGetSubroutineIndex                          uintptr
+	gpGetSubroutineUniformLocation                uintptr
+	gpGetSynciv                                   uintptr
+	gpGetTexImage                                 uintptr
+	gpGetTexLevelParameterfv                      uintptr
+	gpGetTexLevelParameteriv                      uintptr
+	gpGetTexParameterIiv                          uintptr
+	gpGetTexParameterIuiv                         uintptr
+	gpGetTexParameterfv                           uintptr
+	gpGetTexParameteriv                           uintptr
+	gpGetTextureImage                             uintptr
+	gpGetTextureLevelParameterfv                  uintptr
+	gpGetTextureLevelParameteriv                  uintptr
+	gpGetTextureParameterIiv                      uintptr
+	gpGetTextureParameterIuiv                     uintptr
+	gpGetTextureParameterfv                       uintptr
+	gpGetTextureParameteriv                       uintptr
+	gpGetTextureSubImage                          uintptr
+	gpGetTransformFeedbackVarying                 uintptr
+	gpGetTransformFeedbacki64_v                   uintptr
+	gpGetTransformFeedbacki_v                     uintptr
+	gpGetTransformFeedbackiv                      uintptr
+	gpGetUniformBlockIndex                        uintptr
+	gpGetUniformIndices                           uintptr
+	gpGetUniformLocation                          uintptr
+	gpGetUniformSubroutineuiv                     uintptr
+	gpGetUniformdv                                uintptr
+	gpGetUniformfv                                uintptr
+	gpGetUniformiv                                uintptr
+	gpGetUniformuiv                               uintptr
+	gpGetVertexArrayIndexed64iv                   uintptr
+	gpGetVertexArrayIndexediv                     uintptr
+	gpGetVertexArrayiv                            uintptr
+	gpGetVertexAttribIiv                          uintptr
+	gpGetVertexAttribIuiv                         uintptr
+	gpGetVertexAttribLdv                          uintptr
+	gpGetVertexAttribPointerv                     uintptr
+	gpGetVertexAttribdv                           uintptr
+	gpGetVertexAttribfv                           uintptr
+	gpGetVertexAttribiv                           uintptr
+	gpGetnCompressedTexImage                      uintptr
+	gpGetnTexImage                                uintptr
+	gpGetnUniformdv                               uintptr
+	gpGetnUniformfv                               uintptr
+	gpGetnUniformiv                               uintptr
+	gpGetnUniformuiv                              uintptr
+	gpHint                                        uintptr
+	gpInvalidateBufferData                        uintptr
+	gpInvalidateBufferSubData                     uintptr
+	gpInvalidateFramebuffer                       uintptr
+	gpInvalidateNamedFramebufferData              uintptr
+	gpInvalidateNamedFramebufferSubData           uintptr
+	gpInvalidateSubFramebuffer                    uintptr
+	gpInvalidateTexImage                          uintptr
+	gpInvalidateTexSubImage                       uintptr
+	gpIsBuffer                                    uintptr
+	gpIsEnabled                                   uintptr
+	gpIsEnabledi                                  uintptr
+	gpIsFramebuffer                               uintptr
+	gpIsProgram                                   uintptr
+	gpIsProgramPipeline                           uintptr
+	gpIsQuery                                     uintptr
+	gpIsRenderbuffer                              uintptr
+	gpIsSampler                                   uintptr
+	gpIsShader                                    uintptr
+	gpIsSync                                      uintptr
+	gpIsTexture                                   uintptr
+	gpIsTransformFeedback                         uintptr
+	gpIsVertexArray                               uintptr
+	gpLineWidth                                   uintptr
+	gpLinkProgram                                 uintptr
+	gpLogicOp                                     uintptr
+	gpMapBuffer                                   uintptr
+	gpMapBufferRange                              uintptr
+	gpMapNamedBuffer                              uintptr
+	gpMapNamedBufferRange                         uintptr
+	gpMemoryBarrier                               uintptr
+	gpMemoryBarrierByRegion                       uintptr
+	gpMinSampleShading                            uintptr
+	gpMultiDrawArrays                             uintptr
+	gpMultiDrawArraysIndirect                     uintptr
+	gpMultiDrawArraysIndirectCount                uintptr
+	gpMultiDrawElements                           uintptr
+	gpMultiDrawElementsBaseVertex                 uintptr
+	gpMultiDrawElementsIndirect                   uintptr
+	gpMultiDrawElementsIndirectCount              uintptr
+	gpNamedBufferData                             uintptr
+	gpNamedBufferStorage                          uintptr
+	gpNamedBufferSubData                          uintptr
+	gpNamedFramebufferDrawBuffer                  uintptr
+	gpNamedFramebufferDrawBuffers                 uintptr
+	gpNamedFramebufferParameteri                  uintptr
+	gpNamedFramebufferReadBuffer                  uintptr
+	gpNamedFramebufferRenderbuffer                uintptr
+	gpNamedFramebufferTexture                     uintptr
+	gpNamedFramebufferTextureLayer                uintptr
+	gpNamedRenderbufferStorage                    uintptr
+	gpNamedRenderbufferStorageMultisample         uintptr
+	gpObjectLabel                                 uintptr
+	gpObjectPtrLabel                              uintptr
+	gpPatchParameterfv                            uintptr
+	gpPatchParameteri                             uintptr
+	gpPauseTransformFeedback                      uintptr
+	gpPixelStoref                                 uintptr
+	gpPixelStorei                                 uintptr
+	gpPointParameterf                             uintptr
+	gpPointParameterfv                            uintptr
+	gpPointParameteri                             uintptr
+	gpPointParameteriv                            uintptr
+	gpPointSize                                   uintptr
+	gpPolygonMode                                 uintptr
+	gpPolygonOffset                               uintptr
+	gpPolygonOffsetClamp                          uintptr
+	gpPopDebugGroup                               uintptr
+	gpPrimitiveRestartIndex                       uintptr
+	gpProgramBinary                               uintptr
+	gpProgramParameteri                           uintptr
+	gpProgramUniform1d                            uintptr
+	gpProgramUniform1dv                           uintptr
+	gpProgramUniform1f                            uintptr
+	gpProgramUniform1fv                           uintptr
+	gpProgramUniform1i                            uintptr
+	gpProgramUniform1iv                           uintptr
+	gpProgramUniform1ui                           uintptr
+	gpProgramUniform1uiv                          uintptr
+	gpProgramUniform2d                            uintptr
+	gpProgramUniform2dv                           uintptr
+	gpProgramUniform2f                            uintptr
+	gpProgramUniform2fv                           uintptr
+	gpProgramUniform2i                            uintptr
+	gpProgramUniform2iv                           uintptr
+	gpProgramUniform2ui                           uintptr
+	gpProgramUniform2uiv                          uintptr
+	gpProgramUniform3d                            uintptr
+	gpProgramUniform3dv                           uintptr
+	gpProgramUniform3f                            uintptr
+	gpProgramUniform3fv                           uintptr
+	gpProgramUniform3i                            uintptr
+	gpProgramUniform3iv                           uintptr
+	gpProgramUniform3ui                           uintptr
+	gpProgramUniform3uiv                          uintptr
+	gpProgramUniform4d                            uintptr
+	gpProgramUniform4dv                           uintptr
+	gpProgramUniform4f                            uintptr
+	gpProgramUniform4fv                           uintptr
+	gpProgramUniform4i                            uintptr
+	gpProgramUniform4iv                           uintptr
+	gpProgramUniform4ui                           uintptr
+	gpProgramUniform4uiv                          uintptr
+	gpProgramUniformMatrix2dv                     uintptr
+	gpProgramUniformMatrix2fv                     uintptr
+	gpProgramUniformMatrix2x3dv                   uintptr
+	gpProgramUniformMatrix2x3fv                   uintptr
+	gpProgramUniformMatrix2x4dv                   uintptr
+	gpProgramUniformMatrix2x4fv                   uintptr
+	gpProgramUniformMatrix3dv                     uintptr
+	gpProgramUniformMatrix3fv                     uintptr
+	gpProgramUniformMatrix3x2dv                   uintptr
+	gpProgramUniformMatrix3x2fv                   uintptr
+	gpProgramUniformMatrix3x4dv                   uintptr
+	gpProgramUniformMatrix3x4fv                   uintptr
+	gpProgramUniformMatrix4dv                     uintptr
+	gpProgramUniformMatrix4fv                     uintptr
+	gpProgramUniformMatrix4x2dv                   uintptr
+	gpProgramUniformMatrix4x2fv                   uintptr
+	gpProgramUniformMatrix4x3dv                   uintptr
+	gpProgramUniformMatrix4x3fv                   uintptr
+	gpProvokingVertex                             uintptr
+	gpPushDebugGroup                              uintptr
+	gpQueryCounter                                uintptr
+	gpReadBuffer                                  uintptr
+	gpReadPixels                                  uintptr
+	gpReadnPixels                                 uintptr
+	gpReleaseShaderCompiler                       uintptr
+	gpRenderbufferStorage                         uintptr
+	gpRenderbufferStorageMultisample              uintptr
+	gpResumeTransformFeedback                     uintptr
+	gpSampleCoverage                              uintptr
+	gpSampleMaski                                 uintptr
+	gpSamplerParameterIiv                         uintptr
+	gpSamplerParameterIuiv                        uintptr
+	gpSamplerParameterf                           uintptr
+	gpSamplerParameterfv                          uintptr
+	gpSamplerParameteri                           uintptr
+	gpSamplerParameteriv                          uintptr
+	gpScissor                                     uintptr
+	gpScissorArrayv                               uintptr
+	gpScissorIndexed                              uintptr
+	gpScissorIndexedv                             uintptr
+	gpShaderBinary                                uintptr
+	gpShaderSource                                uintptr
+	gpShaderStorageBlockBinding                   uintptr
+	gpSpecializeShader                            uintptr
+	gpStencilFunc                                 uintptr
+	gpStencilFuncSeparate                         uintptr
+	gpStencilMask                                 uintptr
+	gpStencilMaskSeparate                         uintptr
+	gpStencilOp                                   uintptr
+	gpStencilOpSeparate                           uintptr
+	gpTexBuffer                                   uintptr
+	gpTexBufferRange                              uintptr
+	gpTexImage1D                                  uintptr
+	gpTexImage2D                                  uintptr
+	gpTexImage2DMultisample                       uintptr
+	gpTexImage3D                                  uintptr
+	gpTexImage3DMultisample                       uintptr
+	gpTexParameterIiv                             uintptr
+	gpTexParameterIuiv                            uintptr
+	gpTexParameterf                               uintptr
+	gpTexParameterfv                              uintptr
+	gpTexParameteri                               uintptr
+	gpTexParameteriv                              uintptr
+	gpTexStorage1D                                uintptr
+	gpTexStorage2D                                uintptr
+	gpTexStorage2DMultisample                     uintptr
+	gpTexStorage3D                                uintptr
+	gpTexStorage3DMultisample                     uintptr
+	gpTexSubImage1D                               uintptr
+	gpTexSubImage2D                               uintptr
+	gpTexSubImage3D                               uintptr
+	gpTextureBarrier                              uintptr
+	gpTextureBuffer                               uintptr
+	gpTextureBufferRange                          uintptr
+	gpTextureParameterIiv                         uintptr
+	gpTextureParameterIuiv                        uintptr
+	gpTextureParameterf                           uintptr
+	gpTextureParameterfv                          uintptr
+	gpTextureParameteri                           uintptr
+	gpTextureParameteriv                          uintptr
+	gpTextureStorage1D                            uintptr
+	gpTextureStorage2D                            uintptr
+	gpTextureStorage2DMultisample                 uintptr
+	gpTextureStorage3D                            uintptr
+	gpTextureStorage3DMultisample                 uintptr
+	gpTextureSubImage1D                           uintptr
+	gpTextureSubImage2D                           uintptr
+	gpTextureSubImage3D                           uintptr
+	gpTextureView                                 uintptr
+	gpTransformFeedbackBufferBase                 uintptr
+	gpTransformFeedbackBufferRange                uintptr
+	gpTransformFeedbackVaryings                   uintptr
+	gpUniform1d                                   uintptr
+	gpUniform1dv                                  uintptr
+	gpUniform1f                                   uintptr
+	gpUniform1fv                                  uintptr
+	gpUniform1i                                   uintptr
+	gpUniform1iv                                  uintptr
+	gpUniform1ui                                  uintptr
+	gpUniform1uiv                                 uintptr
+	gpUniform2d                                   uintptr
+	gpUniform2dv                                  uintptr
+	gpUniform2f                                   uintptr
+	gpUniform2fv                                  uintptr
+	gpUniform2i                                   uintptr
+	gpUniform2iv                                  uintptr
+	gpUniform2ui                                  uintptr
+	gpUniform2uiv                                 uintptr
+	gpUniform3d                                   uintptr
+	gpUniform3dv                                  uintptr
+	gpUniform3f                                   uintptr
+	gpUniform3fv                                  uintptr
+	gpUniform3i                                   uintptr
+	gpUniform3iv                                  uintptr
+	gpUniform3ui                                  uintptr
+	gpUniform3uiv                                 uintptr
+	gpUniform4d                                   uintptr
+	gpUniform4dv                                  uintptr
+	gpUniform4f                                   uintptr
+	gpUniform4fv                                  uintptr
+	gpUniform4i                                   uintptr
+	gpUniform4iv                                  uintptr
+	gpUniform4ui                                  uintptr
+	gpUniform4uiv                                 uintptr
+	gpUniformBlockBinding                         uintptr
+	gpUniformMatrix2dv                            uintptr
+	gpUniformMatrix2fv                            uintptr
+	gpUniformMatrix2x3dv                          uintptr
+	gpUniformMatrix2x3fv                          uintptr
+	gpUniformMatrix2x4dv                          uintptr
+	gpUniformMatrix2x4fv                          uintptr
+	gpUniformMatrix3dv                            uintptr
+	gpUniformMatrix3fv                            uintptr
+	gpUniformMatrix3x2dv                          uintptr
+	gpUniformMatrix3x2fv                          uintptr
+	gpUniformMatrix3x4dv                          uintptr
+	gpUniformMatrix3x4fv                          uintptr
+	gpUniformMatrix4dv                            uintptr
+	gpUniformMatrix4fv                            uintptr
+	gpUniformMatrix4x2dv                          uintptr
+	gpUniformMatrix4x2fv                          uintptr
+	gpUniformMatrix4x3dv                          uintptr
+	gpUniformMatrix4x3fv                          uintptr
+	gpUniformSubroutinesuiv                       uintptr
+	gpUnmapBuffer                                 uintptr
+	gpUnmapNamedBuffer                            uintptr
+	gpUseProgram                                  uintptr
+	gpUseProgramStages                            uintptr
+	gpValidateProgram                             uintptr
+	gpValidateProgramPipeline                     uintptr
+	gpVertexArrayAttribBinding                    uintptr
+	gpVertexArrayAttribFormat                     uintptr
+	gpVertexArrayAttribIFormat                    uintptr
+	gpVertexArrayAttribLFormat                    uintptr
+	gpVertexArrayBindingDivisor                   uintptr
+	gpVertexArrayElementBuffer                    uintptr
+	gpVertexArrayVertexBuffer                     uintptr
+	gpVertexArrayVertexBuffers                    uintptr
+	gpVertexAttrib1d                              uintptr
+	gpVertexAttrib1dv                             uintptr
+	gpVertexAttrib1f                              uintptr
+	gpVertexAttrib1fv                             uintptr
+	gpVertexAttrib1s                              uintptr
+	gpVertexAttrib1sv                             uintptr
+	gpVertexAttrib2d                              uintptr
+	gpVertexAttrib2dv                             uintptr
+	gpVertexAttrib2f                              uintptr
+	gpVertexAttrib2fv                             uintptr
+	gpVertexAttrib2s                              uintptr
+	gpVertexAttrib2sv                             uintptr
+	gpVertexAttrib3d                              uintptr
+	gpVertexAttrib3dv                             uintptr
+	gpVertexAttrib3f                              uintptr
+	gpVertexAttrib3fv                             uintptr
+	gpVertexAttrib3s                              uintptr
+	gpVertexAttrib3sv                             uintptr
+	gpVertexAttrib4Nbv                            uintptr
+	gpVertexAttrib4Niv                            uintptr
+	gpVertexAttrib4Nsv                            uintptr
+	gpVertexAttrib4Nub                            uintptr
+	gpVertexAttrib4Nubv                           uintptr
+	gpVertexAttrib4Nuiv                           uintptr
+	gpVertexAttrib4Nusv                           uintptr
+	gpVertexAttrib4bv                             uintptr
+	gpVertexAttrib4d                              uintptr
+	gpVertexAttrib4dv                             uintptr
+	gpVertexAttrib4f                              uintptr
+	gpVertexAttrib4fv                             uintptr
+	gpVertexAttrib4iv                             uintptr
+	gpVertexAttrib4s                              uintptr
+	gpVertexAttrib4sv                             uintptr
+	gpVertexAttrib4ubv                            uintptr
+	gpVertexAttrib4uiv                            uintptr
+	gpVertexAttrib4usv                            uintptr
+	gpVertexAttribBinding                         uintptr
+	gpVertexAttribDivisor                         uintptr
+	gpVertexAttribFormat                          uintptr
+	gpVertexAttribI1i                             uintptr
+	gpVertexAttribI1iv                            uintptr
+	gpVertexAttribI1ui                            uintptr
+	gpVertexAttribI1uiv                           uintptr
+	gpVertexAttribI2i                             uintptr
+	gpVertexAttribI2iv                            uintptr
+	gpVertexAttribI2ui                            uintptr
+	gpVertexAttribI2uiv                           uintptr
+	gpVertexAttribI3i                             uintptr
+	gpVertexAttribI3iv                            uintptr
+	gpVertexAttribI3ui                            uintptr
+	gpVertexAttribI3uiv                           uintptr
+	gpVertexAttribI4bv                            uintptr
+	gpVertexAttribI4i                             uintptr
+	gpVertexAttribI4iv                            uintptr
+	gpVertexAttribI4sv                            uintptr
+	gpVertexAttribI4ubv                           uintptr
+	gpVertexAttribI4ui                            uintptr
+	gpVertexAttribI4uiv                           uintptr
+	gpVertexAttribI4usv                           uintptr
+	gpVertexAttribIFormat                         uintptr
+	gpVertexAttribIPointer                        uintptr
+	gpVertexAttribL1d                             uintptr
+	gpVertexAttribL1dv                            uintptr
+	gpVertexAttribL2d                             uintptr
+	gpVertexAttribL2dv                            uintptr
+	gpVertexAttribL3d                             uintptr
+	gpVertexAttribL3dv                            uintptr
+	gpVertexAttribL4d                             uintptr
+	gpVertexAttribL4dv                            uintptr
+	gpVertexAttribLFormat                         uintptr
+	gpVertexAttribLPointer                        uintptr
+	gpVertexAttribP1ui                            uintptr
+	gpVertexAttribP1uiv                           uintptr
+	gpVertexAttribP2ui                            uintptr
+	gpVertexAttribP2uiv                           uintptr
+	gpVertexAttribP3ui                            uintptr
+	gpVertexAttribP3uiv                           uintptr
+	gpVertexAttribP4ui                            uintptr
+	gpVertexAttribP4uiv                           uintptr
+	gpVertexAttribPointer                         uintptr
+	gpVertexBindingDivisor                        uintptr
+	gpViewport                                    uintptr
+	gpViewportArrayv                              uintptr
+	gpViewportIndexedf                            uintptr
+	gpViewportIndexedfv                           uintptr
+	gpWaitSync                                    uintptr
+}
+
+func boolToUintptr(b bool) uintptr {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// set the active program object for a program pipeline object
+func (ctx *Context) ActiveShaderProgram(pipeline uint32, program uint32) {
+	syscall.Syscall(ctx.gpActiveShaderProgram, 2, uintptr(pipeline), uintptr(program), 0)
+	if hooked {
+		ctx.called("ActiveShaderProgram", pipeline, program)
+	}
+}
+
+// set the active program object for a program pipeline object
+func ActiveShaderProgram(pipeline uint32, program uint32) {
+	defaultContext.ActiveShaderProgram(pipeline, program)
+}
+
+// select active texture unit
+func (ctx *Context) ActiveTexture(texture uint32) {
+	syscall.Syscall(ctx.gpActiveTexture, 1, uintptr(texture), 0, 0)
+	if hooked {
+		ctx.called("ActiveTexture", texture)
+	}
+}
+
+// select active texture unit
+func ActiveTexture(texture uint32) {
+	defaultContext.ActiveTexture(texture)
+}
+
+// Attaches a shader object to a program object
+func (ctx *Context) AttachShader(program uint32, shader uint32) {
+	syscall.Syscall(ctx.gpAttachShader, 2, uintptr(program), uintptr(shader), 0)
+	if hooked {
+		ctx.called("AttachShader", program, shader)
+	}
+}
+
+// Attaches a shader object to a program object
+func AttachShader(program uint32, shader uint32) {
+	defaultContext.AttachShader(program, shader)
+}
+
+// start conditional rendering
+func (ctx *Context) BeginConditionalRender(id uint32, mode uint32) {
+	syscall.Syscall(ctx.gpBeginConditionalRender, 2, uintptr(id), uintptr(mode), 0)
+	if hooked {
+		ctx.called("BeginConditionalRender", id, mode)
+	}
+}
+
+// start conditional rendering
+func BeginConditionalRender(id uint32, mode uint32) {
+	defaultContext.BeginConditionalRender(id, mode)
+}
+
+// delimit the boundaries of a query object
+func (ctx *Context) BeginQuery(target uint32, id uint32) {
+	syscall.Syscall(ctx.gpBeginQuery, 2, uintptr(target), uintptr(id), 0)
+	if hooked {
+		ctx.called("BeginQuery", target, id)
+	}
+}
+
+// delimit the boundaries of a query object
+func BeginQuery(target uint32, id uint32) {
+	defaultContext.BeginQuery(target, id)
+}
+
+func (ctx *Context) BeginQueryIndexed(target uint32, index uint32, id uint32) {
+	syscall.Syscall(ctx.gpBeginQueryIndexed, 3, uintptr(target), uintptr(index), uintptr(id))
+	if hooked {
+		ctx.called("BeginQueryIndexed", target, index, id)
+	}
+}
+
+func BeginQueryIndexed(target uint32, index uint32, id uint32) {
+	defaultContext.BeginQueryIndexed(target, index, id)
+}
+
+// start transform feedback operation
+func (ctx *Context) BeginTransformFeedback(primitiveMode uint32) {
+	syscall.Syscall(ctx.gpBeginTransformFeedback, 1, uintptr(primitiveMode), 0, 0)
+	if hooked {
+		ctx.called("BeginTransformFeedback", primitiveMode)
+	}
+}
+
+// start transform feedback operation
+func BeginTransformFeedback(primitiveMode uint32) {
+	defaultContext.BeginTransformFeedback(primitiveMode)
+}
+
+// Associates a generic vertex attribute index with a named attribute variable
+func (ctx *Context) BindAttribLocation(program uint32, index uint32, name *uint8) {
+	syscall.Syscall(ctx.gpBindAttribLocation, 3, uintptr(program), uintptr(index), uintptr(unsafe.Pointer(name)))
+	if hooked {
+		ctx.called("BindAttribLocation", program, index, name)
+	}
+}
+
+// Associates a generic vertex attribute index with a named attribute variable
+func BindAttribLocation(program uint32, index uint32, name *uint8) {
+	defaultContext.BindAttribLocation(program, index, name)
+}
+
+// bind a named buffer object
+func (ctx *Context) BindBuffer(target uint32, buffer uint32) {
+	syscall.Syscall(ctx.gpBindBuffer, 2, uintptr(target), uintptr(buffer), 0)
+	if hooked {
+		ctx.called("BindBuffer", target, buffer)
+	}
+}
+
+// bind a named buffer object
+func BindBuffer(target uint32, buffer uint32) {
+	defaultContext.BindBuffer(target, buffer)
+}
+
+// bind a buffer object to an indexed buffer target
+func (ctx *Context) BindBufferBase(target uint32, index uint32, buffer uint32) {
+	syscall.Syscall(ctx.gpBindBufferBase, 3, uintptr(target), uintptr(index), uintptr(buffer))
+	if hooked {
+		ctx.called("BindBufferBase", target, index, buffer)
+	}
+}
+
+// bind a buffer object to an indexed buffer target
+func BindBufferBase(target uint32, index uint32, buffer uint32) {
+	defaultContext.BindBufferBase(target, index, buffer)
+}
+
+// bind a range within a buffer object to an indexed buffer target
+func (ctx *Context) BindBufferRange(target uint32, index uint32, buffer uint32, offset int, size int) {
+	syscall.Syscall6(ctx.gpBindBufferRange, 5, uintptr(target), uintptr(index), uintptr(buffer), uintptr(offset), uintptr(size), 0)
+	if hooked {
+		ctx.called("BindBufferRange", target, index, buffer, offset, size)
+	}
+}
+
+// bind a range within a buffer object to an indexed buffer target
+func BindBufferRange(target uint32, index uint32, buffer uint32, offset int, size int) {
+	defaultContext.BindBufferRange(target, index, buffer, offset, size)
+}
+
+// bind one or more buffer objects to a sequence of indexed buffer targets
+func (ctx *Context) BindBuffersBase(target uint32, first uint32, count int32, buffers *uint32) {
+	syscall.Syscall6(ctx.gpBindBuffersBase, 4, uintptr(target), uintptr(first), uintptr(count), uintptr(unsafe.Pointer(buffers)), 0, 0)
+	if hooked {
+		ctx.called("BindBuffersBase", target, first, count, buffers)
+	}
+}
+
+// bind one or more buffer objects to a sequence of indexed buffer targets
+func BindBuffersBase(target uint32, first uint32, count int32, buffers *uint32) {
+	defaultContext.BindBuffersBase(target, first, count, buffers)
+}
+
+// bind ranges of one or more buffer objects to a sequence of indexed buffer targets
+func (ctx *Context) BindBuffersRange(target uint32, first uint32, count int32, buffers *uint32, offsets *int, sizes *int) {
+	syscall.Syscall6(ctx.gpBindBuffersRange, 6, uintptr(target), uintptr(first), uintptr(count), uintptr(unsafe.Pointer(buffers)), uintptr(unsafe.Pointer(offsets)), uintptr(unsafe.Pointer(sizes)))
+	if hooked {
+		ctx.called("BindBuffersRange", target, first, count, buffers, offsets, sizes)
+	}
+}
+
+// bind ranges of one or more buffer objects to a sequence of indexed buffer targets
+func BindBuffersRange(target uint32, first uint32, count int32, buffers *uint32, offsets *int, sizes *int) {
+	defaultContext.BindBuffersRange(target, first, count, buffers, offsets, sizes)
+}
+
+// bind a user-defined varying out variable to a fragment shader color number
+func (ctx *Context) BindFragDataLocation(program uint32, color uint32, name *uint8) {
+	syscall.Syscall(ctx.gpBindFragDataLocation, 3, uintptr(program), uintptr(color), uintptr(unsafe.Pointer(name)))
+	if hooked {
+		ctx.called("BindFragDataLocation", program, color, name)
+	}
+}
+
+// bind a user-defined varying out variable to a fragment shader color number
+func BindFragDataLocation(program uint32, color uint32, name *uint8) {
+	defaultContext.BindFragDataLocation(program, color, name)
+}
+
+// bind a user-defined varying out variable to a fragment shader color number and index
+func (ctx *Context) BindFragDataLocationIndexed(program uint32, colorNumber uint32, index uint32, name *uint8) {
+	syscall.Syscall6(ctx.gpBindFragDataLocationIndexed, 4, uintptr(program), uintptr(colorNumber), uintptr(index), uintptr(unsafe.Pointer(name)), 0, 0)
+	if hooked {
+		ctx.called("BindFragDataLocationIndexed", program, colorNumber, index, name)
+	}
+}
+
+// bind a user-defined varying out variable to a fragment shader color number and index
+func BindFragDataLocationIndexed(program uint32, colorNumber uint32, index uint32, name *uint8) {
+	defaultContext.BindFragDataLocationIndexed(program, colorNumber, index, name)
+}
+
+// bind a framebuffer to a framebuffer target
+func (ctx *Context) BindFramebuffer(target uint32, framebuffer uint32) {
+	syscall.Syscall(ctx.gpBindFramebuffer, 2, uintptr(target), uintptr(framebuffer), 0)
+	if hooked {
+		ctx.called("BindFramebuffer", target, framebuffer)
+	}
+}
+
+// bind a framebuffer to a framebuffer target
+func BindFramebuffer(target uint32, framebuffer uint32) {
+	defaultContext.BindFramebuffer(target, framebuffer)
+}
+
+// bind a level of a texture to an image unit
+func (ctx *Context) BindImageTexture(unit uint32, texture uint32, level int32, layered bool, layer int32, access uint32, format uint32) {
+	syscall.Syscall9(ctx.gpBindImageTexture, 7, uintptr(unit), uintptr(texture), uintptr(level), boolToUintptr(layered), uintptr(layer), uintptr(access), uintptr(format), 0, 0)
+	if hooked {
+		ctx.called("BindImageTexture", unit, texture, level, layered, layer, access, format)
+	}
+}
+
+// bind a level of a texture to an image unit
+func BindImageTexture(unit uint32, texture uint32, level int32, layered bool, layer int32, access uint32, format uint32) {
+	defaultContext.BindImageTexture(unit, texture, level, layered, layer, access, format)
+}
+
+// bind one or more named texture images to a sequence of consecutive image units
+func (ctx *Context) BindImageTextures(first uint32, count int32, textures *uint32) {
+	syscall.Syscall(ctx.gpBindImageTextures, 3, uintptr(first), uintptr(count), uintptr(unsafe.Pointer(textures)))
+	if hooked {
+		ctx.called("BindImageTextures", first, count, textures)
+	}
+}
+
+// bind one or more named texture images to a sequence of consecutive image units
+func BindImageTextures(first uint32, count int32, textures *uint32) {
+	defaultContext.BindImageTextures(first, count, textures)
+}
+
+// bind a program pipeline to the current context
+func (ctx *Context) BindProgramPipeline(pipeline uint32) {
+	syscall.Syscall(ctx.gpBindProgramPipeline, 1, uintptr(pipeline), 0, 0)
+	if hooked {
+		ctx.called("BindProgramPipeline", pipeline)
+	}
+}
+
+// bind a program pipeline to the current context
+func BindProgramPipeline(pipeline uint32) {
+	defaultContext.BindProgramPipeline(pipeline)
+}
+
+// bind a renderbuffer to a renderbuffer target
+func (ctx *Context) BindRenderbuffer(target uint32, renderbuffer uint32) {
+	syscall.Syscall(ctx.gpBindRenderbuffer, 2, uintptr(target), uintptr(renderbuffer), 0)
+	if hooked {
+		ctx.called("BindRenderbuffer", target, renderbuffer)
+	}
+}
+
+// bind a renderbuffer to a renderbuffer target
+func BindRenderbuffer(target uint32, renderbuffer uint32) {
+	defaultContext.BindRenderbuffer(target, renderbuffer)
+}
+
+// bind a named sampler to a texturing target
+func (ctx *Context) BindSampler(unit uint32, sampler uint32) {
+	syscall.Syscall(ctx.gpBindSampler, 2, uintptr(unit), uintptr(sampler), 0)
+	if hooked {
+		ctx.called("BindSampler", unit, sampler)
+	}
+}
+
+// bind a named sampler to a texturing target
+func BindSampler(unit uint32, sampler uint32) {
+	defaultContext.BindSampler(unit, sampler)
+}
+
+// bind one or more named sampler objects to a sequence of consecutive sampler units
+func (ctx *Context) BindSamplers(first uint32, count int32, samplers *uint32) {
+	syscall.Syscall(ctx.gpBindSamplers, 3, uintptr(first), uintptr(count), uintptr(unsafe.Pointer(samplers)))
+	if hooked {
+		ctx.called("BindSamplers", first, count, samplers)
+	}
+}
+
+// bind one or more named sampler objects to a sequence of consecutive sampler units
+func BindSamplers(first uint32, count int32, samplers *uint32) {
+	defaultContext.BindSamplers(first, count, samplers)
+}
+
+// bind a named texture to a texturing target
+func (ctx *Context) BindTexture(target uint32, texture uint32) {
+	syscall.Syscall(ctx.gpBindTexture, 2, uintptr(target), uintptr(texture), 0)
+	if hooked {
+		ctx.called("BindTexture", target, texture)
+	}
+}
+
+// bind a named texture to a texturing target
+func BindTexture(target uint32, texture uint32) {
+	defaultContext.BindTexture(target, texture)
+}
+
+// bind an existing texture object to the specified texture unit
+func (ctx *Context) BindTextureUnit(unit uint32, texture uint32) {
+	syscall.Syscall(ctx.gpBindTextureUnit, 2, uintptr(unit), uintptr(texture), 0)
+	if hooked {
+		ctx.called("BindTextureUnit", unit, texture)
+	}
+}
+
+// bind an existing texture object to the specified texture unit
+func BindTextureUnit(unit uint32, texture uint32) {
+	defaultContext.BindTextureUnit(unit, texture)
+}
+
+// bind one or more named textures to a sequence of consecutive texture units
+func (ctx *Context) BindTextures(first uint32, count int32, textures *uint32) {
+	syscall.Syscall(ctx.gpBindTextures, 3, uintptr(first), uintptr(count), uintptr(unsafe.Pointer(textures)))
+	if hooked {
+		ctx.called("BindTextures", first, count, textures)
+	}
+}
+
+// bind one or more named textures to a sequence of consecutive texture units
+func BindTextures(first uint32, count int32, textures *uint32) {
+	defaultContext.BindTextures(first, count, textures)
+}
+
+// bind a transform feedback object
+func (ctx *Context) BindTransformFeedback(target uint32, id uint32) {
+	syscall.Syscall(ctx.gpBindTransformFeedback, 2, uintptr(target), uintptr(id), 0)
+	if hooked {
+		ctx.called("BindTransformFeedback", target, id)
+	}
+}
+
+// bind a transform feedback object
+func BindTransformFeedback(target uint32, id uint32) {
+	defaultContext.BindTransformFeedback(target, id)
+}
+
+// bind a vertex array object
+func (ctx *Context) BindVertexArray(array uint32) {
+	syscall.Syscall(ctx.gpBindVertexArray, 1, uintptr(array), 0, 0)
+	if hooked {
+		ctx.called("BindVertexArray", array)
+	}
+}
+
+// bind a vertex array object
+func BindVertexArray(array uint32) {
+	defaultContext.BindVertexArray(array)
+}
+
+// bind a buffer to a vertex buffer bind point
+func (ctx *Context) BindVertexBuffer(bindingindex uint32, buffer uint32, offset int, stride int32) {
+	syscall.Syscall6(ctx.gpBindVertexBuffer, 4, uintptr(bindingindex), uintptr(buffer), uintptr(offset), uintptr(stride), 0, 0)
+	if hooked {
+		ctx.called("BindVertexBuffer", bindingindex, buffer, offset, stride)
+	}
+}
+
+// bind a buffer to a vertex buffer bind point
+func BindVertexBuffer(bindingindex uint32, buffer uint32, offset int, stride int32) {
+	defaultContext.BindVertexBuffer(bindingindex, buffer, offset, stride)
+}
+
+// attach multiple buffer objects to a vertex array object
+func (ctx *Context) BindVertexBuffers(first uint32, count int32, buffers *uint32, offsets *int, strides *int32) {
+	syscall.Syscall6(ctx.gpBindVertexBuffers, 5, uintptr(first), uintptr(count), uintptr(unsafe.Pointer(buffers)), uintptr(unsafe.Pointer(offsets)), uintptr(unsafe.Pointer(strides)), 0)
+	if hooked {
+		ctx.called("BindVertexBuffers", first, count, buffers, offsets, strides)
+	}
+}
+
+// attach multiple buffer objects to a vertex array object
+func BindVertexBuffers(first uint32, count int32, buffers *uint32, offsets *int, strides *int32) {
+	defaultContext.BindVertexBuffers(first, count, buffers, offsets, strides)
+}
+
+// set the blend color
+func (ctx *Context) BlendColor(red float32, green float32, blue float32, alpha float32) {
+	syscall.Syscall6(ctx.gpBlendColor, 4, uintptr(math.Float32bits(red)), uintptr(math.Float32bits(green)), uintptr(math.Float32bits(blue)), uintptr(math.Float32bits(alpha)), 0, 0)
+	if hooked {
+		ctx.called("BlendColor", red, green, blue, alpha)
+	}
+}
+
+// set the blend color
+func BlendColor(red float32, green float32, blue float32, alpha float32) {
+	defaultContext.BlendColor(red, green, blue, alpha)
+}
+
+// specify the equation used for both the RGB blend equation and the Alpha blend equation
+func (ctx *Context) BlendEquation(mode uint32) {
+	syscall.Syscall(ctx.gpBlendEquation, 1, uintptr(mode), 0, 0)
+	if hooked {
+		ctx.called("BlendEquation", mode)
+	}
+}
+
+// specify the equation used for both the RGB blend equation and the Alpha blend equation
+func BlendEquation(mode uint32) {
+	defaultContext.BlendEquation(mode)
+}
+
+// set the RGB blend equation and the alpha blend equation separately
+func (ctx *Context) BlendEquationSeparate(modeRGB uint32, modeAlpha uint32) {
+	syscall.Syscall(ctx.gpBlendEquationSeparate, 2, uintptr(modeRGB), uintptr(modeAlpha), 0)
+	if hooked {
+		ctx.called("BlendEquationSeparate", modeRGB, modeAlpha)
+	}
+}
+
+// set the RGB blend equation and the alpha blend equation separately
+func BlendEquationSeparate(modeRGB uint32, modeAlpha uint32) {
+	defaultContext.BlendEquationSeparate(modeRGB, modeAlpha)
+}
+
+func (ctx *Context) BlendEquationSeparatei(buf uint32, modeRGB uint32, modeAlpha uint32) {
+	syscall.Syscall(ctx.gpBlendEquationSeparatei, 3, uintptr(buf), uintptr(modeRGB), uintptr(modeAlpha))
+	if hooked {
+		ctx.called("BlendEquationSeparatei", buf, modeRGB, modeAlpha)
+	}
+}
+
+func BlendEquationSeparatei(buf uint32, modeRGB uint32, modeAlpha uint32) {
+	defaultContext.BlendEquationSeparatei(buf, modeRGB, modeAlpha)
+}
+
+func (ctx *Context) BlendEquationi(buf uint32, mode uint32) {
+	syscall.Syscall(ctx.gpBlendEquationi, 2, uintptr(buf), uintptr(mode), 0)
+	if hooked {
+		ctx.called("BlendEquationi", buf, mode)
+	}
+}
+
+func BlendEquationi(buf uint32, mode uint32) {
+	defaultContext.BlendEquationi(buf, mode)
+}
+
+// specify pixel arithmetic
+func (ctx *Context) BlendFunc(sfactor uint32, dfactor uint32) {
+	syscall.Syscall(ctx.gpBlendFunc, 2, uintptr(sfactor), uintptr(dfactor), 0)
+	if hooked {
+		ctx.called("BlendFunc", sfactor, dfactor)
+	}
+}
+
+// specify pixel arithmetic
+func BlendFunc(sfactor uint32, dfactor uint32) {
+	defaultContext.BlendFunc(sfactor, dfactor)
+}
+
+// specify pixel arithmetic for RGB and alpha components separately
+func (ctx *Context) BlendFuncSeparate(sfactorRGB uint32, dfactorRGB uint32, sfactorAlpha uint32, dfactorAlpha uint32) {
+	syscall.Syscall6(ctx.gpBlendFuncSeparate, 4, uintptr(sfactorRGB), uintptr(dfactorRGB), uintptr(sfactorAlpha), uintptr(dfactorAlpha), 0, 0)
+	if hooked {
+		ctx.called("BlendFuncSeparate", sfactorRGB, dfactorRGB, sfactorAlpha, dfactorAlpha)
+	}
+}
+
+// specify pixel arithmetic for RGB and alpha components separately
+func BlendFuncSeparate(sfactorRGB uint32, dfactorRGB uint32, sfactorAlpha uint32, dfactorAlpha uint32) {
+	defaultContext.BlendFuncSeparate(sfactorRGB, dfactorRGB, sfactorAlpha, dfactorAlpha)
+}
+
+func (ctx *Context) BlendFuncSeparatei(buf uint32, srcRGB uint32, dstRGB uint32, srcAlpha uint32, dstAlpha uint32) {
+	syscall.Syscall6(ctx.gpBlendFuncSeparatei, 5, uintptr(buf), uintptr(srcRGB), uintptr(dstRGB), uintptr(srcAlpha), uintptr(dstAlpha), 0)
+	if hooked {
+		ctx.called("BlendFuncSeparatei", buf, srcRGB, dstRGB, srcAlpha, dstAlpha)
+	}
+}
+
+func BlendFuncSeparatei(buf uint32, srcRGB uint32, dstRGB uint32, srcAlpha uint32, dstAlpha uint32) {
+	defaultContext.BlendFuncSeparatei(buf, srcRGB, dstRGB, srcAlpha, dstAlpha)
+}
+
+func (ctx *Context) BlendFunci(buf uint32, src uint32, dst uint32) {
+	syscall.Syscall(ctx.gpBlendFunci, 3, uintptr(buf), uintptr(src), uintptr(dst))
+	if hooked {
+		ctx.called("BlendFunci", buf, src, dst)
+	}
+}
+
+func BlendFunci(buf uint32, src uint32, dst uint32) {
+	defaultContext.BlendFunci(buf, src, dst)
+}
+
+// copy a block of pixels from one framebuffer object to another
+func (ctx *Context) BlitFramebuffer(srcX0 int32, srcY0 int32, srcX1 int32, srcY1 int32, dstX0 int32, dstY0 int32, dstX1 int32, dstY1 int32, mask uint32, filter uint32) {
+	syscall.Syscall12(ctx.gpBlitFramebuffer, 10, uintptr(srcX0), uintptr(srcY0), uintptr(srcX1), uintptr(srcY1), uintptr(dstX0), uintptr(dstY0), uintptr(dstX1), uintptr(dstY1), uintptr(mask), uintptr(filter), 0, 0)
+	if hooked {
+		ctx.called("BlitFramebuffer", srcX0, srcY0, srcX1, srcY1, dstX0, dstY0, dstX1, dstY1, mask, filter)
+	}
+}
+
+// copy a block of pixels from one framebuffer object to another
+func BlitFramebuffer(srcX0 int32, srcY0 int32, srcX1 int32, srcY1 int32, dstX0 int32, dstY0 int32, dstX1 int32, dstY1 int32, mask uint32, filter uint32) {
+	defaultContext.BlitFramebuffer(srcX0, srcY0, srcX1, srcY1, dstX0, dstY0, dstX1, dstY1, mask, filter)
+}
+
+// copy a block of pixels from one framebuffer object to another
+func (ctx *Context) BlitNamedFramebuffer(readFramebuffer uint32, drawFramebuffer uint32, srcX0 int32, srcY0 int32, srcX1 int32, srcY1 int32, dstX0 int32, dstY0 int32, dstX1 int32, dstY1 int32, mask uint32, filter uint32) {
+	syscall.Syscall12(ctx.gpBlitNamedFramebuffer, 12, uintptr(readFramebuffer), uintptr(drawFramebuffer), uintptr(srcX0), uintptr(srcY0), uintptr(srcX1), uintptr(srcY1), uintptr(dstX0), uintptr(dstY0), uintptr(dstX1), uintptr(dstY1), uintptr(mask), uintptr(filter))
+	if hooked {
+		ctx.called("BlitNamedFramebuffer", readFramebuffer, drawFramebuffer, srcX0, srcY0, srcX1, srcY1, dstX0, dstY0, dstX1, dstY1, mask, filter)
+	}
+}
+
+// copy a block of pixels from one framebuffer object to another
+func BlitNamedFramebuffer(readFramebuffer uint32, drawFramebuffer uint32, srcX0 int32, srcY0 int32, srcX1 int32, srcY1 int32, dstX0 int32, dstY0 int32, dstX1 int32, dstY1 int32, mask uint32, filter uint32) {
+	defaultContext.BlitNamedFramebuffer(readFramebuffer, drawFramebuffer, srcX0, srcY0, srcX1, srcY1, dstX0, dstY0, dstX1, dstY1, mask, filter)
+}
+
+// creates and initializes a buffer object's data     store
+func (ctx *Context) BufferData(target uint32, size int, data unsafe.Pointer, usage uint32) {
+	syscall.Syscall6(ctx.gpBufferData, 4, uintptr(target), uintptr(size), uintptr(data), uintptr(usage), 0, 0)
+	if hooked {
+		ctx.called("BufferData", target, size, data, usage)
+	}
+}
+
+// creates and initializes a buffer object's data     store
+func BufferData(target uint32, size int, data unsafe.Pointer, usage uint32) {
+	defaultContext.BufferData(target, size, data, usage)
+}
+
+// creates and initializes a buffer object's immutable data     store
+func (ctx *Context) BufferStorage(target uint32, size int, data unsafe.Pointer, flags uint32) {
+	syscall.Syscall6(ctx.gpBufferStorage, 4, uintptr(target), uintptr(size), uintptr(data), uintptr(flags), 0, 0)
+	if hooked {
+		ctx.called("BufferStorage", target, size, data, flags)
+	}
+}
+
+// creates and initializes a buffer object's immutable data     store
+func BufferStorage(target uint32, size int, data unsafe.Pointer, flags uint32) {
+	defaultContext.BufferStorage(target, size, data, flags)
+}
+
+// updates a subset of a buffer object's data store
+func (ctx *Context) BufferSubData(target uint32, offset int, size int, data unsafe.Pointer) {
+	syscall.Syscall6(ctx.gpBufferSubData, 4, uintptr(target), uintptr(offset), uintptr(size), uintptr(data), 0, 0)
+	if hooked {
+		ctx.called("BufferSubData", target, offset, size, data)
+	}
+}
+
+// updates a subset of a buffer object's data store
+func BufferSubData(target uint32, offset int, size int, data unsafe.Pointer) {
+	defaultContext.BufferSubData(target, offset, size, data)
+}
+
+// check the completeness status of a framebuffer
+func (ctx *Context) CheckFramebufferStatus(target uint32) uint32 {
+	ret, _, _ := syscall.Syscall(ctx.gpCheckFramebufferStatus, 1, uintptr(target), 0, 0)
+	if hooked {
+		ctx.returned("CheckFramebufferStatus", (uint32)(ret), target)
+	}
+	return (uint32)(ret)
+}
+
+// check the completeness status of a framebuffer
+func CheckFramebufferStatus(target uint32) uint32 {
+	return defaultContext.CheckFramebufferStatus(target)
+}
+
+// check the completeness status of a framebuffer
+func (ctx *Context) CheckNamedFramebufferStatus(framebuffer uint32, target uint32) uint32 {
+	ret, _, _ := syscall.Syscall(ctx.gpCheckNamedFramebufferStatus, 2, uintptr(framebuffer), uintptr(target), 0)
+	if hooked {
+		ctx.returned("CheckNamedFramebufferStatus", (uint32)(ret), framebuffer, target)
+	}
+	return (uint32)(ret)
+}
+
+// check the completeness status of a framebuffer
+func CheckNamedFramebufferStatus(framebuffer uint32, target uint32) uint32 {
+	return defaultContext.CheckNamedFramebufferStatus(framebuffer, target)
+}
+
+// specify whether data read via  should be clamped
+func (ctx *Context) ClampColor(target uint32, clamp uint32) {
+	syscall.Syscall(ctx.gpClampColor, 2, uintptr(target), uintptr(clamp), 0)
+	if hooked {
+		ctx.called("ClampColor", target, clamp)
+	}
+}
+
+// specify whether data read via  should be clamped
+func ClampColor(target uint32, clamp uint32) {
+	defaultContext.ClampColor(target, clamp)
+}
+
+// clear buffers to preset values
+func (ctx *Context) Clear(mask uint32) {
+	syscall.Syscall(ctx.gpClear, 1, uintptr(mask), 0, 0)
+	if hooked {
+		ctx.called("Clear", mask)
+	}
+}
+
+// clear buffers to preset values
+func Clear(mask uint32) {
+	defaultContext.Clear(mask)
+}
+
+// fill a buffer object's data store with a fixed value
+func (ctx *Context) ClearBufferData(target uint32, internalformat uint32, format uint32, xtype uint32, data unsafe.Pointer) {
+	syscall.Syscall6(ctx.gpClearBufferData, 5, uintptr(target), uintptr(internalformat), uintptr(format), uintptr(xtype), uintptr(data), 0)
+	if hooked {
+		ctx.called("ClearBufferData", target, internalformat, format, xtype, data)
+	}
+}
+
+// fill a buffer object's data store with a fixed value
+func ClearBufferData(target uint32, internalformat uint32, format uint32, xtype uint32, data unsafe.Pointer) {
+	defaultContext.ClearBufferData(target, internalformat, format, xtype, data)
+}
+
+// fill all or part of buffer object's data store with a fixed value
+func (ctx *Context) ClearBufferSubData(target uint32, internalformat uint32, offset int, size int, format uint32, xtype uint32, data unsafe.Pointer) {
+	syscall.Syscall9(ctx.gpClearBufferSubData, 7, uintptr(target), uintptr(internalformat), uintptr(offset), uintptr(size), uintptr(format), uintptr(xtype), uintptr(data), 0, 0)
+	if hooked {
+		ctx.called("ClearBufferSubData", target, internalformat, offset, size, format, xtype, data)
+	}
+}
+
+// fill all or part of buffer object's data store with a fixed value
+func ClearBufferSubData(target uint32, internalformat uint32, offset int, size int, format uint32, xtype uint32, data unsafe.Pointer) {
+	defaultContext.ClearBufferSubData(target, internalformat, offset, size, format, xtype, data)
+}
+
+func (ctx *Context) ClearBufferfi(buffer uint32, drawbuffer int32, depth float32, stencil int32) {
+	syscall.Syscall6(ctx.gpClearBufferfi, 4, uintptr(buffer), uintptr(drawbuffer), uintptr(math.Float32bits(depth)), uintptr(stencil), 0, 0)
+	if hooked {
+		ctx.called("ClearBufferfi", buffer, drawbuffer, depth, stencil)
+	}
+}
+
+func ClearBufferfi(buffer uint32, drawbuffer int32, depth float32, stencil int32) {
+	defaultContext.ClearBufferfi(buffer, drawbuffer, depth, stencil)
+}
+
+func (ctx *Context) ClearBufferfv(buffer uint32, drawbuffer int32, value *float32) {
+	syscall.Syscall(ctx.gpClearBufferfv, 3, uintptr(buffer), uintptr(drawbuffer), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ClearBufferfv", buffer, drawbuffer, value)
+	}
+}
+
+func ClearBufferfv(buffer uint32, drawbuffer int32, value *float32) {
+	defaultContext.ClearBufferfv(buffer, drawbuffer, value)
+}
+
+func (ctx *Context) ClearBufferiv(buffer uint32, drawbuffer int32, value *int32) {
+	syscall.Syscall(ctx.gpClearBufferiv, 3, uintptr(buffer), uintptr(drawbuffer), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ClearBufferiv", buffer, drawbuffer, value)
+	}
+}
+
+func ClearBufferiv(buffer uint32, drawbuffer int32, value *int32) {
+	defaultContext.ClearBufferiv(buffer, drawbuffer, value)
+}
+
+func (ctx *Context) ClearBufferuiv(buffer uint32, drawbuffer int32, value *uint32) {
+	syscall.Syscall(ctx.gpClearBufferuiv, 3, uintptr(buffer), uintptr(drawbuffer), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("ClearBufferuiv", buffer, drawbuffer, value)
+	}
+}
+
+func ClearBufferuiv(buffer uint32, drawbuffer int32, value *uint32) {
+	defaultContext.ClearBufferuiv(buffer, drawbuffer, value)
+}
+
+// specify clear values for the color buffers
+func (ctx *Context) ClearColor(red float32, green float32, blue float32, alpha float32) {
+	syscall.Syscall6(ctx.gpClearColor, 4, uintptr(math.Float32bits(red)), uintptr(math.Float32bits(green)), uintptr(math.Float32bits(blue)), uintptr(math.Float32bits(alpha)), 0, 0)
+	if hooked {
+		ctx.called("ClearColor", red, green, blue, alpha)
+	}
+}
+
+// specify clear values for the color buffers
+func ClearColor(red float32, green float32, blue float32, alpha float32) {
+	defaultContext.ClearColor(red, green, blue, alpha)
+}
+
+// specify the clear value for the depth buffer
+func (ctx *Context) ClearDepth(depth float64) {
+	syscall.Syscall(ctx.gpClearDepth, 1, uintptr(math.Float64bits(depth)), 0, 0)
+	if hooked {
+		ctx.called("ClearDepth", depth)
+	}
+}
+
+// specify the clear value for the depth buffer
+func ClearDepth(depth float64) {
+	defaultContext.ClearDepth(depth)
+}
+
+// specify the clear value for the depth buffer
+func (ctx *Context) ClearDepthf(d float32) {
+	syscall.Syscall(ctx.gpClearDepthf, 1, uintptr(math.Float32bits(d)), 0, 0)
+	if hooked {
+		ctx.called("ClearDepthf", d)
+	}
+}
+
+// specify the clear value for the depth buffer
+func ClearDepthf(d float32) {
+	defaultContext.ClearDepthf(d)
+}
+
+// fill a buffer object's data store with a fixed value
+func (ctx *Context) ClearNamedBufferData(buffer uint32, internalformat uint32, format uint32, xtype uint32, data unsafe.Pointer) {
+	syscall.Syscall6(ctx.gpClearNamedBufferData, 5, uintptr(buffer), uintptr(internalformat), uintptr(format), uintptr(xtype), uintptr(data), 0)
+	if hooked {
+		ctx.called("ClearNamedBufferData", buffer, internalformat, format, xtype, data)
+	}
+}
+
+// fill a buffer object's data store with a fixed value
+func ClearNamedBufferData(buffer uint32, internalformat uint32, format uint32, xtype uint32, data unsafe.Pointer) {
+	defaultContext.ClearNamedBufferData(buffer, internalformat, format, xtype, data)
+}
+
+// fill all or part of buffer object's data store with a fixed value
+func (ctx *Context) ClearNamedBufferSubData(buffer uint32, internalformat uint32, offset int, size int, format uint32, xtype uint32, data unsafe.Pointer) {
+	syscall.Syscall9(ctx.gpClearNamedBufferSubData, 7, uintptr(buffer), uintptr(internalformat), uintptr(offset), uintptr(size), uintptr(format), uintptr(xtype), uintptr(data), 0, 0)
+	if hooked {
+		ctx.called("ClearNamedBufferSubData", buffer, internalformat, offset, size, format, xtype, data)
+	}
+}
+
+// fill all or part of buffer object's data store with a fixed value
+func ClearNamedBufferSubData(buffer uint32, internalformat uint32, offset int, size int, format uint32, xtype uint32, data unsafe.Pointer) {
+	defaultContext.ClearNamedBufferSubData(buffer, internalformat, offset, size, format, xtype, data)
+}
+
+func (ctx *Context) ClearNamedFramebufferfi(framebuffer uint32, buffer uint32, drawbuffer int32, depth float32, stencil int32) {
+	syscall.Syscall6(ctx.gpClearNamedFramebufferfi, 5, uintptr(framebuffer), uintptr(buffer), uintptr(drawbuffer), uintptr(math.Float32bits(depth)), uintptr(stencil), 0)
+	if hooked {
+		ctx.called("ClearNamedFramebufferfi", framebuffer, buffer, drawbuffer, depth, stencil)
+	}
+}
+
+func ClearNamedFramebufferfi(framebuffer uint32, buffer uint32, drawbuffer int32, depth float32, stencil int32) {
+	defaultContext.ClearNamedFramebufferfi(framebuffer, buffer, drawbuffer, depth, stencil)
+}
+
+func (ctx *Context) ClearNamedFramebufferfv(framebuffer uint32, buffer uint32, drawbuffer int32, value *float32) {
+	syscall.Syscall6(ctx.gpClearNamedFramebufferfv, 4, uintptr(framebuffer), uintptr(buffer), uintptr(drawbuffer), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("ClearNamedFramebufferfv", framebuffer, buffer, drawbuffer, value)
+	}
+}
+
+func ClearNamedFramebufferfv(framebuffer uint32, buffer uint32, drawbuffer int32, value *float32) {
+	defaultContext.ClearNamedFramebufferfv(framebuffer, buffer, drawbuffer, value)
+}
+
+func (ctx *Context) ClearNamedFramebufferiv(framebuffer uint32, buffer uint32, drawbuffer int32, value *int32) {
+	syscall.Syscall6(ctx.gpClearNamedFramebufferiv, 4, uintptr(framebuffer), uintptr(buffer), uintptr(drawbuffer), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("ClearNamedFramebufferiv", framebuffer, buffer, drawbuffer, value)
+	}
+}
+
+func ClearNamedFramebufferiv(framebuffer uint32, buffer uint32, drawbuffer int32, value *int32) {
+	defaultContext.ClearNamedFramebufferiv(framebuffer, buffer, drawbuffer, value)
+}
+
+func (ctx *Context) ClearNamedFramebufferuiv(framebuffer uint32, buffer uint32, drawbuffer int32, value *uint32) {
+	syscall.Syscall6(ctx.gpClearNamedFramebufferuiv, 4, uintptr(framebuffer), uintptr(buffer), uintptr(drawbuffer), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("ClearNamedFramebufferuiv", framebuffer, buffer, drawbuffer, value)
+	}
+}
+
+func ClearNamedFramebufferuiv(framebuffer uint32, buffer uint32, drawbuffer int32, value *uint32) {
+	defaultContext.ClearNamedFramebufferuiv(framebuffer, buffer, drawbuffer, value)
+}
+
+// specify the clear value for the stencil buffer
+func (ctx *Context) ClearStencil(s int32) {
+	syscall.Syscall(ctx.gpClearStencil, 1, uintptr(s), 0, 0)
+	if hooked {
+		ctx.called("ClearStencil", s)
+	}
+}
+
+// specify the clear value for the stencil buffer
+func ClearStencil(s int32) {
+	defaultContext.ClearStencil(s)
+}
+
+// fills all a texture image with a constant value
+func (ctx *Context) ClearTexImage(texture uint32, level int32, format uint32, xtype uint32, data unsafe.Pointer) {
+	syscall.Syscall6(ctx.gpClearTexImage, 5, uintptr(texture), uintptr(level), uintptr(format), uintptr(xtype), uintptr(data), 0)
+	if hooked {
+		ctx.called("ClearTexImage", texture, level, format, xtype, data)
+	}
+}
+
+// fills all a texture image with a constant value
+func ClearTexImage(texture uint32, level int32, format uint32, xtype uint32, data unsafe.Pointer) {
+	defaultContext.ClearTexImage(texture, level, format, xtype, data)
+}
+
+// fills all or part of a texture image with a constant value
+func (ctx *Context) ClearTexSubImage(texture uint32, level int32, xoffset int32, yoffset int32, zoffset int32, width int32, height int32, depth int32, format uint32, xtype uint32, data unsafe.Pointer) {
+	syscall.Syscall12(ctx.gpClearTexSubImage, 11, uintptr(texture), uintptr(level), uintptr(xoffset), uintptr(yoffset), uintptr(zoffset), uintptr(width), uintptr(height), uintptr(depth), uintptr(format), uintptr(xtype), uintptr(data), 0)
+	if hooked {
+		ctx.called("ClearTexSubImage", texture, level, xoffset, yoffset, zoffset, width, height, depth, format, xtype, data)
+	}
+}
+
+// fills all or part of a texture image with a constant value
+func ClearTexSubImage(texture uint32, level int32, xoffset int32, yoffset int32, zoffset int32, width int32, height int32, depth int32, format uint32, xtype uint32, data unsafe.Pointer) {
+	defaultContext.ClearTexSubImage(texture, level, xoffset, yoffset, zoffset, width, height, depth, format, xtype, data)
+}
+
+// block and wait for a sync object to become signaled
+func (ctx *Context) ClientWaitSync(sync uintptr, flags uint32, timeout uint64) uint32 {
+	ret, _, _ := syscall.Syscall(ctx.gpClientWaitSync, 3, uintptr(sync), uintptr(flags), uintptr(timeout))
+	if hooked {
+		ctx.returned("ClientWaitSync", (uint32)(ret), sync, flags, timeout)
+	}
+	return (uint32)(ret)
+}
+
+// block and wait for a sync object to become signaled
+func ClientWaitSync(sync uintptr, flags uint32, timeout uint64) uint32 {
+	return defaultContext.ClientWaitSync(sync, flags, timeout)
+}
+
+// control clip coordinate to window coordinate behavior
+func (ctx *Context) ClipControl(origin uint32, depth uint32) {
+	syscall.Syscall(ctx.gpClipControl, 2, uintptr(origin), uintptr(depth), 0)
+	if hooked {
+		ctx.called("ClipControl", origin, depth)
+	}
+}
+
+// control clip coordinate to window coordinate behavior
+func ClipControl(origin uint32, depth uint32) {
+	defaultContext.ClipControl(origin, depth)
+}
+
+func (ctx *Context) ColorMask(red bool, green bool, blue bool, alpha bool) {
+	syscall.Syscall6(ctx.gpColorMask, 4, boolToUintptr(red), boolToUintptr(green), boolToUintptr(blue), boolToUintptr(alpha), 0, 0)
+	if hooked {
+		ctx.called("ColorMask", red, green, blue, alpha)
+	}
+}
+
+func ColorMask(red bool, green bool, blue bool, alpha bool) {
+	defaultContext.ColorMask(red, green, blue, alpha)
+}
+
+func (ctx *Context) ColorMaski(index uint32, r bool, g bool, b bool, a bool) {
+	syscall.Syscall6(ctx.gpColorMaski, 5, uintptr(index), boolToUintptr(r), boolToUintptr(g), boolToUintptr(b), boolToUintptr(a), 0)
+	if hooked {
+		ctx.called("ColorMaski", index, r, g, b, a)
+	}
+}
+
+func ColorMaski(index uint32, r bool, g bool, b bool, a bool) {
+	defaultContext.ColorMaski(index, r, g, b, a)
+}
+
+// Compiles a shader object
+func (ctx *Context) CompileShader(shader uint32) {
+	syscall.Syscall(ctx.gpCompileShader, 1, uintptr(shader), 0, 0)
+	if hooked {
+		ctx.called("CompileShader", shader)
+	}
+}
+
+// Compiles a shader object
+func CompileShader(shader uint32) {
+	defaultContext.CompileShader(shader)
+}
+
+// specify a one-dimensional texture image in a compressed format
+func (ctx *Context) CompressedTexImage1D(target uint32, level int32, internalformat uint32, width int32, border int32, imageSize int32, data unsafe.Pointer) {
+	syscall.Syscall9(ctx.gpCompressedTexImage1D, 7, uintptr(target), uintptr(level), uintptr(internalformat), uintptr(width), uintptr(border), uintptr(imageSize), uintptr(data), 0, 0)
+	if hooked {
+		ctx.called("CompressedTexImage1D", target, level, internalformat, width, border, imageSize, data)
+	}
+}
+
+// specify a one-dimensional texture image in a compressed format
+func CompressedTexImage1D(target uint32, level int32, internalformat uint32, width int32, border int32, imageSize int32, data unsafe.Pointer) {
+	defaultContext.CompressedTexImage1D(target, level, internalformat, width, border, imageSize, data)
+}
+
+// specify a two-dimensional texture image in a compressed format
+func (ctx *Context) CompressedTexImage2D(target uint32, level int32, internalformat uint32, width int32, height int32, border int32, imageSize int32, data unsafe.Pointer) {
+	syscall.Syscall9(ctx.gpCompressedTexImage2D, 8, uintptr(target), uintptr(level), uintptr(internalformat), uintptr(width), uintptr(height), uintptr(border), uintptr(imageSize), uintptr(data), 0)
+	if hooked {
+		ctx.called("CompressedTexImage2D", target, level, internalformat, width, height, border, imageSize, data)
+	}
+}
+
+// specify a two-dimensional texture image in a compressed format
+func CompressedTexImage2D(target uint32, level int32, internalformat uint32, width int32, height int32, border int32, imageSize int32, data unsafe.Pointer) {
+	defaultContext.CompressedTexImage2D(target, level, internalformat, width, height, border, imageSize, data)
+}
+
+// specify a three-dimensional texture image in a compressed format
+func (ctx *Context) CompressedTexImage3D(target uint32, level int32, internalformat uint32, width int32, height int32, depth int32, border int32, imageSize int32, data unsafe.Pointer) {
+	syscall.Syscall9(ctx.gpCompressedTexImage3D, 9, uintptr(target), uintptr(level), uintptr(internalformat), uintptr(width), uintptr(height), uintptr(depth), uintptr(border), uintptr(imageSize), uintptr(data))
+	if hooked {
+		ctx.called("CompressedTexImage3D", target, level, internalformat, width, height, depth, border, imageSize, data)
+	}
+}
+
+// specify a three-dimensional texture image in a compressed format
+func CompressedTexImage3D(target uint32, level int32, internalformat uint32, width int32, height int32, depth int32, border int32, imageSize int32, data unsafe.Pointer) {
+	defaultContext.CompressedTexImage3D(target, level, internalformat, width, height, depth, border, imageSize, data)
+}
+
+// specify a one-dimensional texture subimage in a compressed     format
+func (ctx *Context) CompressedTexSubImage1D(target uint32, level int32, xoffset int32, width int32, format uint32, imageSize int32, data unsafe.Pointer) {
+	syscall.Syscall9(ctx.gpCompressedTexSubImage1D, 7, uintptr(target), uintptr(level), uintptr(xoffset), uintptr(width), uintptr(format), uintptr(imageSize), uintptr(data), 0, 0)
+	if hooked {
+		ctx.called("CompressedTexSubImage1D", target, level, xoffset, width, format, imageSize, data)
+	}
+}
+
+// specify a one-dimensional texture subimage in a compressed     format
+func CompressedTexSubImage1D(target uint32, level int32, xoffset int32, width int32, format uint32, imageSize int32, data unsafe.Pointer) {
+	defaultContext.CompressedTexSubImage1D(target, level, xoffset, width, format, imageSize, data)
+}
+
+// specify a two-dimensional texture subimage in a compressed format
+func (ctx *Context) CompressedTexSubImage2D(target uint32, level int32, xoffset int32, yoffset int32, width int32, height int32, format uint32, imageSize int32, data unsafe.Pointer) {
+	syscall.Syscall9(ctx.gpCompressedTexSubImage2D, 9, uintptr(target), uintptr(level), uintptr(xoffset), uintptr(yoffset), uintptr(width), uintptr(height), uintptr(format), uintptr(imageSize), uintptr(data))
+	if hooked {
+		ctx.called("CompressedTexSubImage2D", target, level, xoffset, yoffset, width, height, format, imageSize, data)
+	}
+}
+
+// specify a two-dimensional texture subimage in a compressed format
+func CompressedTexSubImage2D(target uint32, level int32, xoffset int32, yoffset int32, width int32, height int32, format uint32, imageSize int32, data unsafe.Pointer) {
+	defaultContext.CompressedTexSubImage2D(target, level, xoffset, yoffset, width, height, format, imageSize, data)
+}
+
+// specify a three-dimensional texture subimage in a compressed format
+func (ctx *Context) CompressedTexSubImage3D(target uint32, level int32, xoffset int32, yoffset int32, zoffset int32, width int32, height int32, depth int32, format uint32, imageSize int32, data unsafe.Pointer) {
+	syscall.Syscall12(ctx.gpCompressedTexSubImage3D, 11, uintptr(target), uintptr(level), uintptr(xoffset), uintptr(yoffset), uintptr(zoffset), uintptr(width), uintptr(height), uintptr(depth), uintptr(format), uintptr(imageSize), uintptr(data), 0)
+	if hooked {
+		ctx.called("CompressedTexSubImage3D", target, level, xoffset, yoffset, zoffset, width, height, depth, format, imageSize, data)
+	}
+}
+
+// specify a three-dimensional texture subimage in a compressed format
+func CompressedTexSubImage3D(target uint32, level int32, xoffset int32, yoffset int32, zoffset int32, width int32, height int32, depth int32, format uint32, imageSize int32, data unsafe.Pointer) {
+	defaultContext.CompressedTexSubImage3D(target, level, xoffset, yoffset, zoffset, width, height, depth, format, imageSize, data)
+}
+
+// specify a one-dimensional texture subimage in a compressed     format
+func (ctx *Context) CompressedTextureSubImage1D(texture uint32, level int32, xoffset int32, width int32, format uint32, imageSize int32, data unsafe.Pointer) {
+	syscall.Syscall9(ctx.gpCompressedTextureSubImage1D, 7, uintptr(texture), uintptr(level), uintptr(xoffset), uintptr(width), uintptr(format), uintptr(imageSize), uintptr(data), 0, 0)
+	if hooked {
+		ctx.called("CompressedTextureSubImage1D", texture, level, xoffset, width, format, imageSize, data)
+	}
+}
+
+// specify a one-dimensional texture subimage in a compressed     format
+func CompressedTextureSubImage1D(texture uint32, level int32, xoffset int32, width int32, format uint32, imageSize int32, data unsafe.Pointer) {
+	defaultContext.CompressedTextureSubImage1D(texture, level, xoffset, width, format, imageSize, data)
+}
+
+// specify a two-dimensional texture subimage in a compressed format
+func (ctx *Context) CompressedTextureSubImage2D(texture uint32, level int32, xoffset int32, yoffset int32, width int32, height int32, format uint32, imageSize int32, data unsafe.Pointer) {
+	syscall.Syscall9(ctx.gpCompressedTextureSubImage2D, 9, uintptr(texture), uintptr(level), uintptr(xoffset), uintptr(yoffset), uintptr(width), uintptr(height), uintptr(format), uintptr(imageSize), uintptr(data))
+	if hooked {
+		ctx.called("CompressedTextureSubImage2D", texture, level, xoffset, yoffset, width, height, format, imageSize, data)
+	}
+}
+
+// specify a two-dimensional texture subimage in a compressed format
+func CompressedTextureSubImage2D(texture uint32, level int32, xoffset int32, yoffset int32, width int32, height int32, format uint32, imageSize int32, data unsafe.Pointer) {
+	defaultContext.CompressedTextureSubImage2D(texture, level, xoffset, yoffset, width, height, format, imageSize, data)
+}
+
+// specify a three-dimensional texture subimage in a compressed format
+func (ctx *Context) CompressedTextureSubImage3D(texture uint32, level int32, xoffset int32, yoffset int32, zoffset int32, width int32, height int32, depth int32, format uint32, imageSize int32, data unsafe.Pointer) {
+	syscall.Syscall12(ctx.gpCompressedTextureSubImage3D, 11, uintptr(texture), uintptr(level), uintptr(xoffset), uintptr(yoffset), uintptr(zoffset), uintptr(width), uintptr(height), uintptr(depth), uintptr(format), uintptr(imageSize), uintptr(data), 0)
+	if hooked {
+		ctx.called("CompressedTextureSubImage3D", texture, level, xoffset, yoffset, zoffset, width, height, depth, format, imageSize, data)
+	}
+}
+
+// specify a three-dimensional texture subimage in a compressed format
+func CompressedTextureSubImage3D(texture uint32, level int32, xoffset int32, yoffset int32, zoffset int32, width int32, height int32, depth int32, format uint32, imageSize int32, data unsafe.Pointer) {
+	defaultContext.CompressedTextureSubImage3D(texture, level, xoffset, yoffset, zoffset, width, height, depth, format, imageSize, data)
+}
+
+// copy all or part of the data store of a buffer object to the data store of another buffer object
+func (ctx *Context) CopyBufferSubData(readTarget uint32, writeTarget uint32, readOffset int, writeOffset int, size int) {
+	syscall.Syscall6(ctx.gpCopyBufferSubData, 5, uintptr(readTarget), uintptr(writeTarget), uintptr(readOffset), uintptr(writeOffset), uintptr(size), 0)
+	if hooked {
+		ctx.called("CopyBufferSubData", readTarget, writeTarget, readOffset, writeOffset, size)
+	}
+}
+
+// copy all or part of the data store of a buffer object to the data store of another buffer object
+func CopyBufferSubData(readTarget uint32, writeTarget uint32, readOffset int, writeOffset int, size int) {
+	defaultContext.CopyBufferSubData(readTarget, writeTarget, readOffset, writeOffset, size)
+}
+
+// perform a raw data copy between two images
+func (ctx *Context) CopyImageSubData(srcName uint32, srcTarget uint32, srcLevel int32, srcX int32, srcY int32, srcZ int32, dstName uint32, dstTarget uint32, dstLevel int32, dstX int32, dstY int32, dstZ int32, srcWidth int32, srcHeight int32, srcDepth int32) {
+	syscall.Syscall15(ctx.gpCopyImageSubData, 15, uintptr(srcName), uintptr(srcTarget), uintptr(srcLevel), uintptr(srcX), uintptr(srcY), uintptr(srcZ), uintptr(dstName), uintptr(dstTarget), uintptr(dstLevel), uintptr(dstX), uintptr(dstY), uintptr(dstZ), uintptr(srcWidth), uintptr(srcHeight), uintptr(srcDepth))
+	if hooked {
+		ctx.called("CopyImageSubData", srcName, srcTarget, srcLevel, srcX, srcY, srcZ, dstName, dstTarget, dstLevel, dstX, dstY, dstZ, srcWidth, srcHeight, srcDepth)
+	}
+}
+
+// perform a raw data copy between two images
+func CopyImageSubData(srcName uint32, srcTarget uint32, srcLevel int32, srcX int32, srcY int32, srcZ int32, dstName uint32, dstTarget uint32, dstLevel int32, dstX int32, dstY int32, dstZ int32, srcWidth int32, srcHeight int32, srcDepth int32) {
+	defaultContext.CopyImageSubData(srcName, srcTarget, srcLevel, srcX, srcY, srcZ, dstName, dstTarget, dstLevel, dstX, dstY, dstZ, srcWidth, srcHeight, srcDepth)
+}
+
+// copy all or part of the data store of a buffer object to the data store of another buffer object
+func (ctx *Context) CopyNamedBufferSubData(readBuffer uint32, writeBuffer uint32, readOffset int, writeOffset int, size int) {
+	syscall.Syscall6(ctx.gpCopyNamedBufferSubData, 5, uintptr(readBuffer), uintptr(writeBuffer), uintptr(readOffset), uintptr(writeOffset), uintptr(size), 0)
+	if hooked {
+		ctx.called("CopyNamedBufferSubData", readBuffer, writeBuffer, readOffset, writeOffset, size)
+	}
+}
+
+// copy all or part of the data store of a buffer object to the data store of another buffer object
+func CopyNamedBufferSubData(readBuffer uint32, writeBuffer uint32, readOffset int, writeOffset int, size int) {
+	defaultContext.CopyNamedBufferSubData(readBuffer, writeBuffer, readOffset, writeOffset, size)
+}
+
+// copy pixels into a 1D texture image
+func (ctx *Context) CopyTexImage1D(target uint32, level int32, internalformat uint32, x int32, y int32, width int32, border int32) {
+	syscall.Syscall9(ctx.gpCopyTexImage1D, 7, uintptr(target), uintptr(level), uintptr(internalformat), uintptr(x), uintptr(y), uintptr(width), uintptr(border), 0, 0)
+	if hooked {
+		ctx.called("CopyTexImage1D", target, level, internalformat, x, y, width, border)
+	}
+}
+
+// copy pixels into a 1D texture image
+func CopyTexImage1D(target uint32, level int32, internalformat uint32, x int32, y int32, width int32, border int32) {
+	defaultContext.CopyTexImage1D(target, level, internalformat, x, y, width, border)
+}
+
+// copy pixels into a 2D texture image
+func (ctx *Context) CopyTexImage2D(target uint32, level int32, internalformat uint32, x int32, y int32, width int32, height int32, border int32) {
+	syscall.Syscall9(ctx.gpCopyTexImage2D, 8, uintptr(target), uintptr(level), uintptr(internalformat), uintptr(x), uintptr(y), uintptr(width), uintptr(height), uintptr(border), 0)
+	if hooked {
+		ctx.called("CopyTexImage2D", target, level, internalformat, x, y, width, height, border)
+	}
+}
+
+// copy pixels into a 2D texture image
+func CopyTexImage2D(target uint32, level int32, internalformat uint32, x int32, y int32, width int32, height int32, border int32) {
+	defaultContext.CopyTexImage2D(target, level, internalformat, x, y, width, height, border)
+}
+
+// copy a one-dimensional texture subimage
+func (ctx *Context) CopyTexSubImage1D(target uint32, level int32, xoffset int32, x int32, y int32, width int32) {
+	syscall.Syscall6(ctx.gpCopyTexSubImage1D, 6, uintptr(target), uintptr(level), uintptr(xoffset), uintptr(x), uintptr(y), uintptr(width))
+	if hooked {
+		ctx.called("CopyTexSubImage1D", target, level, xoffset, x, y, width)
+	}
+}
+
+// copy a one-dimensional texture subimage
+func CopyTexSubImage1D(target uint32, level int32, xoffset int32, x int32, y int32, width int32) {
+	defaultContext.CopyTexSubImage1D(target, level, xoffset, x, y, width)
+}
+
+// copy a two-dimensional texture subimage
+func (ctx *Context) CopyTexSubImage2D(target uint32, level int32, xoffset int32, yoffset int32, x int32, y int32, width int32, height int32) {
+	syscall.Syscall9(ctx.gpCopyTexSubImage2D, 8, uintptr(target), uintptr(level), uintptr(xoffset), uintptr(yoffset), uintptr(x), uintptr(y), uintptr(width), uintptr(height), 0)
+	if hooked {
+		ctx.called("CopyTexSubImage2D", target, level, xoffset, yoffset, x, y, width, height)
+	}
+}
+
+// copy a two-dimensional texture subimage
+func CopyTexSubImage2D(target uint32, level int32, xoffset int32, yoffset int32, x int32, y int32, width int32, height int32) {
+	defaultContext.CopyTexSubImage2D(target, level, xoffset, yoffset, x, y, width, height)
+}
+
+// copy a three-dimensional texture subimage
+func (ctx *Context) CopyTexSubImage3D(target uint32, level int32, xoffset int32, yoffset int32, zoffset int32, x int32, y int32, width int32, height int32) {
+	syscall.Syscall9(ctx.gpCopyTexSubImage3D, 9, uintptr(target), uintptr(level), uintptr(xoffset), uintptr(yoffset), uintptr(zoffset), uintptr(x), uintptr(y), uintptr(width), uintptr(height))
+	if hooked {
+		ctx.called("CopyTexSubImage3D", target, level, xoffset, yoffset, zoffset, x, y, width, height)
+	}
+}
+
+// copy a three-dimensional texture subimage
+func CopyTexSubImage3D(target uint32, level int32, xoffset int32, yoffset int32, zoffset int32, x int32, y int32, width int32, height int32) {
+	defaultContext.CopyTexSubImage3D(target, level, xoffset, yoffset, zoffset, x, y, width, height)
+}
+
+// copy a one-dimensional texture subimage
+func (ctx *Context) CopyTextureSubImage1D(texture uint32, level int32, xoffset int32, x int32, y int32, width int32) {
+	syscall.Syscall6(ctx.gpCopyTextureSubImage1D, 6, uintptr(texture), uintptr(level), uintptr(xoffset), uintptr(x), uintptr(y), uintptr(width))
+	if hooked {
+		ctx.called("CopyTextureSubImage1D", texture, level, xoffset, x, y, width)
+	}
+}
+
+// copy a one-dimensional texture subimage
+func CopyTextureSubImage1D(texture uint32, level int32, xoffset int32, x int32, y int32, width int32) {
+	defaultContext.CopyTextureSubImage1D(texture, level, xoffset, x, y, width)
+}
+
+// copy a two-dimensional texture subimage
+func (ctx *Context) CopyTextureSubImage2D(texture uint32, level int32, xoffset int32, yoffset int32, x int32, y int32, width int32, height int32) {
+	syscall.Syscall9(ctx.gpCopyTextureSubImage2D, 8, uintptr(texture), uintptr(level), uintptr(xoffset), uintptr(yoffset), uintptr(x), uintptr(y), uintptr(width), uintptr(height), 0)
+	if hooked {
+		ctx.called("CopyTextureSubImage2D", texture, level, xoffset, yoffset, x, y, width, height)
+	}
+}
+
+// copy a two-dimensional texture subimage
+func CopyTextureSubImage2D(texture uint32, level int32, xoffset int32, yoffset int32, x int32, y int32, width int32, height int32) {
+	defaultContext.CopyTextureSubImage2D(texture, level, xoffset, yoffset, x, y, width, height)
+}
+
+// copy a three-dimensional texture subimage
+func (ctx *Context) CopyTextureSubImage3D(texture uint32, level int32, xoffset int32, yoffset int32, zoffset int32, x int32, y int32, width int32, height int32) {
+	syscall.Syscall9(ctx.gpCopyTextureSubImage3D, 9, uintptr(texture), uintptr(level), uintptr(xoffset), uintptr(yoffset), uintptr(zoffset), uintptr(x), uintptr(y), uintptr(width), uintptr(height))
+	if hooked {
+		ctx.called("CopyTextureSubImage3D", texture, level, xoffset, yoffset, zoffset, x, y, width, height)
+	}
+}
+
+// copy a three-dimensional texture subimage
+func CopyTextureSubImage3D(texture uint32, level int32, xoffset int32, yoffset int32, zoffset int32, x int32, y int32, width int32, height int32) {
+	defaultContext.CopyTextureSubImage3D(texture, level, xoffset, yoffset, zoffset, x, y, width, height)
+}
+
+// create buffer objects
+func (ctx *Context) CreateBuffers(n int32, buffers *uint32) {
+	syscall.Syscall(ctx.gpCreateBuffers, 2, uintptr(n), uintptr(unsafe.Pointer(buffers)), 0)
+	if hooked {
+		ctx.called("CreateBuffers", n, buffers)
+	}
+}
+
+// create buffer objects
+func CreateBuffers(n int32, buffers *uint32) {
+	defaultContext.CreateBuffers(n, buffers)
+}
+
+// create framebuffer objects
+func (ctx *Context) CreateFramebuffers(n int32, framebuffers *uint32) {
+	syscall.Syscall(ctx.gpCreateFramebuffers, 2, uintptr(n), uintptr(unsafe.Pointer(framebuffers)), 0)
+	if hooked {
+		ctx.called("CreateFramebuffers", n, framebuffers)
+	}
+}
+
+// create framebuffer objects
+func CreateFramebuffers(n int32, framebuffers *uint32) {
+	defaultContext.CreateFramebuffers(n, framebuffers)
+}
+
+// Creates a program object
+func (ctx *Context) CreateProgram() uint32 {
+	ret, _, _ := syscall.Syscall(ctx.gpCreateProgram, 0, 0, 0, 0)
+	if hooked {
+		ctx.returned("CreateProgram", (uint32)(ret))
+	}
+	return (uint32)(ret)
+}
+
+// Creates a program object
+func CreateProgram() uint32 {
+	return defaultContext.CreateProgram()
+}
+
+// create program pipeline objects
+func (ctx *Context) CreateProgramPipelines(n int32, pipelines *uint32) {
+	syscall.Syscall(ctx.gpCreateProgramPipelines, 2, uintptr(n), uintptr(unsafe.Pointer(pipelines)), 0)
+	if hooked {
+		ctx.called("CreateProgramPipelines", n, pipelines)
+	}
+}
+
+// create program pipeline objects
+func CreateProgramPipelines(n int32, pipelines *uint32) {
+	defaultContext.CreateProgramPipelines(n, pipelines)
+}
+
+// create query objects
+func (ctx *Context) CreateQueries(target uint32, n int32, ids *uint32) {
+	syscall.Syscall(ctx.gpCreateQueries, 3, uintptr(target), uintptr(n), uintptr(unsafe.Pointer(ids)))
+	if hooked {
+		ctx.called("CreateQueries", target, n, ids)
+	}
+}
+
+// create query objects
+func CreateQueries(target uint32, n int32, ids *uint32) {
+	defaultContext.CreateQueries(target, n, ids)
+}
+
+// create renderbuffer objects
+func (ctx *Context) CreateRenderbuffers(n int32, renderbuffers *uint32) {
+	syscall.Syscall(ctx.gpCreateRenderbuffers, 2, uintptr(n), uintptr(unsafe.Pointer(renderbuffers)), 0)
+	if hooked {
+		ctx.called("CreateRenderbuffers", n, renderbuffers)
+	}
+}
+
+// create renderbuffer objects
+func CreateRenderbuffers(n int32, renderbuffers *uint32) {
+	defaultContext.CreateRenderbuffers(n, renderbuffers)
+}
+
+// create sampler objects
+func (ctx *Context) CreateSamplers(n int32, samplers *uint32) {
+	syscall.Syscall(ctx.gpCreateSamplers, 2, uintptr(n), uintptr(unsafe.Pointer(samplers)), 0)
+	if hooked {
+		ctx.called("CreateSamplers", n, samplers)
+	}
+}
+
+// create sampler objects
+func CreateSamplers(n int32, samplers *uint32) {
+	defaultContext.CreateSamplers(n, samplers)
+}
+
+// Creates a shader object
+func (ctx *Context) CreateShader(xtype uint32) uint32 {
+	ret, _, _ := syscall.Syscall(ctx.gpCreateShader, 1, uintptr(xtype), 0, 0)
+	if hooked {
+		ctx.returned("CreateShader", (uint32)(ret), xtype)
+	}
+	return (uint32)(ret)
+}
+
+// Creates a shader object
+func CreateShader(xtype uint32) uint32 {
+	return defaultContext.CreateShader(xtype)
+}
+
+// create a stand-alone program from an array of null-terminated source code strings
+func (ctx *Context) CreateShaderProgramv(xtype uint32, count int32, strings **uint8) uint32 {
+	ret, _, _ := syscall.Syscall(ctx.gpCreateShaderProgramv, 3, uintptr(xtype), uintptr(count), uintptr(unsafe.Pointer(strings)))
+	if hooked {
+		ctx.returned("CreateShaderProgramv", (uint32)(ret), xtype, count, strings)
+	}
+	return (uint32)(ret)
+}
+
+// create a stand-alone program from an array of null-terminated source code strings
+func CreateShaderProgramv(xtype uint32, count int32, strings **uint8) uint32 {
+	return defaultContext.CreateShaderProgramv(xtype, count, strings)
+}
+
+// create texture objects
+func (ctx *Context) CreateTextures(target uint32, n int32, textures *uint32) {
+	syscall.Syscall(ctx.gpCreateTextures, 3, uintptr(target), uintptr(n), uintptr(unsafe.Pointer(textures)))
+	if hooked {
+		ctx.called("CreateTextures", target, n, textures)
+	}
+}
+
+// create texture objects
+func CreateTextures(target uint32, n int32, textures *uint32) {
+	defaultContext.CreateTextures(target, n, textures)
+}
+
+// create transform feedback objects
+func (ctx *Context) CreateTransformFeedbacks(n int32, ids *uint32) {
+	syscall.Syscall(ctx.gpCreateTransformFeedbacks, 2, uintptr(n), uintptr(unsafe.Pointer(ids)), 0)
+	if hooked {
+		ctx.called("CreateTransformFeedbacks", n, ids)
+	}
+}
+
+// create transform feedback objects
+func CreateTransformFeedbacks(n int32, ids *uint32) {
+	defaultContext.CreateTransformFeedbacks(n, ids)
+}
+
+// create vertex array objects
+func (ctx *Context) CreateVertexArrays(n int32, arrays *uint32) {
+	syscall.Syscall(ctx.gpCreateVertexArrays, 2, uintptr(n), uintptr(unsafe.Pointer(arrays)), 0)
+	if hooked {
+		ctx.called("CreateVertexArrays", n, arrays)
+	}
+}
+
+// create vertex array objects
+func CreateVertexArrays(n int32, arrays *uint32) {
+	defaultContext.CreateVertexArrays(n, arrays)
+}
+
+// specify whether front- or back-facing facets can be culled
+func (ctx *Context) CullFace(mode uint32) {
+	syscall.Syscall(ctx.gpCullFace, 1, uintptr(mode), 0, 0)
+	if hooked {
+		ctx.called("CullFace", mode)
+	}
+}
+
+// specify whether front- or back-facing facets can be culled
+func CullFace(mode uint32) {
+	defaultContext.CullFace(mode)
+}
+
+// specify a callback to receive debugging messages from the GL
+func (ctx *Context) DebugMessageCallback(callback DebugProc, userParam unsafe.Pointer) {
+	syscall.Syscall(ctx.gpDebugMessageCallback, 2, newDebugProcCallback(callback), uintptr(userParam), 0)
+	if hooked {
+		ctx.called("DebugMessageCallback", callback, userParam)
+	}
+}
+
+// specify a callback to receive debugging messages from the GL
+func DebugMessageCallback(callback DebugProc, userParam unsafe.Pointer) {
+	defaultContext.DebugMessageCallback(callback, userParam)
+}
+
+// control the reporting of debug messages in a debug context
+func (ctx *Context) DebugMessageControl(source uint32, xtype uint32, severity uint32, count int32, ids *uint32, enabled bool) {
+	syscall.Syscall6(ctx.gpDebugMessageControl, 6, uintptr(source), uintptr(xtype), uintptr(severity), uintptr(count), uintptr(unsafe.Pointer(ids)), boolToUintptr(enabled))
+	if hooked {
+		ctx.called("DebugMessageControl", source, xtype, severity, count, ids, enabled)
+	}
+}
+
+// control the reporting of debug messages in a debug context
+func DebugMessageControl(source uint32, xtype uint32, severity uint32, count int32, ids *uint32, enabled bool) {
+	defaultContext.DebugMessageControl(source, xtype, severity, count, ids, enabled)
+}
+
+// inject an application-supplied message into the debug message queue
+func (ctx *Context) DebugMessageInsert(source uint32, xtype uint32, id uint32, severity uint32, length int32, buf *uint8) {
+	syscall.Syscall6(ctx.gpDebugMessageInsert, 6, uintptr(source), uintptr(xtype), uintptr(id), uintptr(severity), uintptr(length), uintptr(unsafe.Pointer(buf)))
+	if hooked {
+		ctx.called("DebugMessageInsert", source, xtype, id, severity, length, buf)
+	}
+}
+
+// inject an application-supplied message into the debug message queue
+func DebugMessageInsert(source uint32, xtype uint32, id uint32, severity uint32, length int32, buf *uint8) {
+	defaultContext.DebugMessageInsert(source, xtype, id, severity, length, buf)
+}
+
+// delete named buffer objects
+func (ctx *Context) DeleteBuffers(n int32, buffers *uint32) {
+	syscall.Syscall(ctx.gpDeleteBuffers, 2, uintptr(n), uintptr(unsafe.Pointer(buffers)), 0)
+	if hooked {
+		ctx.called("DeleteBuffers", n, buffers)
+	}
+}
+
+// delete named buffer objects
+func DeleteBuffers(n int32, buffers *uint32) {
+	defaultContext.DeleteBuffers(n, buffers)
+}
+
+// delete framebuffer objects
+func (ctx *Context) DeleteFramebuffers(n int32, framebuffers *uint32) {
+	syscall.Syscall(ctx.gpDeleteFramebuffers, 2, uintptr(n), uintptr(unsafe.Pointer(framebuffers)), 0)
+	if hooked {
+		ctx.called("DeleteFramebuffers", n, framebuffers)
+	}
+}
+
+// delete framebuffer objects
+func DeleteFramebuffers(n int32, framebuffers *uint32) {
+	defaultContext.DeleteFramebuffers(n, framebuffers)
+}
+
+// Deletes a program object
+func (ctx *Context) DeleteProgram(program uint32) {
+	syscall.Syscall(ctx.gpDeleteProgram, 1, uintptr(program), 0, 0)
+	if hooked {
+		ctx.called("DeleteProgram", program)
+	}
+}
+
+// Deletes a program object
+func DeleteProgram(program uint32) {
+	defaultContext.DeleteProgram(program)
+}
+
+// delete program pipeline objects
+func (ctx *Context) DeleteProgramPipelines(n int32, pipelines *uint32) {
+	syscall.Syscall(ctx.gpDeleteProgramPipelines, 2, uintptr(n), uintptr(unsafe.Pointer(pipelines)), 0)
+	if hooked {
+		ctx.called("DeleteProgramPipelines", n, pipelines)
+	}
+}
+
+// delete program pipeline objects
+func DeleteProgramPipelines(n int32, pipelines *uint32) {
+	defaultContext.DeleteProgramPipelines(n, pipelines)
+}
+
+// delete named query objects
+func (ctx *Context) DeleteQueries(n int32, ids *uint32) {
+	syscall.Syscall(ctx.gpDeleteQueries, 2, uintptr(n), uintptr(unsafe.Pointer(ids)), 0)
+	if hooked {
+		ctx.called("DeleteQueries", n, ids)
+	}
+}
+
+// delete named query objects
+func DeleteQueries(n int32, ids *uint32) {
+	defaultContext.DeleteQueries(n, ids)
+}
+
+// delete renderbuffer objects
+func (ctx *Context) DeleteRenderbuffers(n int32, renderbuffers *uint32) {
+	syscall.Syscall(ctx.gpDeleteRenderbuffers, 2, uintptr(n), uintptr(unsafe.Pointer(renderbuffers)), 0)
+	if hooked {
+		ctx.called("DeleteRenderbuffers", n, renderbuffers)
+	}
+}
+
+// delete renderbuffer objects
+func DeleteRenderbuffers(n int32, renderbuffers *uint32) {
+	defaultContext.DeleteRenderbuffers(n, renderbuffers)
+}
+
+// delete named sampler objects
+func (ctx *Context) DeleteSamplers(count int32, samplers *uint32) {
+	syscall.Syscall(ctx.gpDeleteSamplers, 2, uintptr(count), uintptr(unsafe.Pointer(samplers)), 0)
+	if hooked {
+		ctx.called("DeleteSamplers", count, samplers)
+	}
+}
+
+// delete named sampler objects
+func DeleteSamplers(count int32, samplers *uint32) {
+	defaultContext.DeleteSamplers(count, samplers)
+}
+
+// Deletes a shader object
+func (ctx *Context) DeleteShader(shader uint32) {
+	syscall.Syscall(ctx.gpDeleteShader, 1, uintptr(shader), 0, 0)
+	if hooked {
+		ctx.called("DeleteShader", shader)
+	}
+}
+
+// Deletes a shader object
+func DeleteShader(shader uint32) {
+	defaultContext.DeleteShader(shader)
+}
+
+// delete a sync object
+func (ctx *Context) DeleteSync(sync uintptr) {
+	syscall.Syscall(ctx.gpDeleteSync, 1, uintptr(sync), 0, 0)
+	if hooked {
+		ctx.called("DeleteSync", sync)
+	}
+}
+
+// delete a sync object
+func DeleteSync(sync uintptr) {
+	defaultContext.DeleteSync(sync)
+}
+
+// delete named textures
+func (ctx *Context) DeleteTextures(n int32, textures *uint32) {
+	syscall.Syscall(ctx.gpDeleteTextures, 2, uintptr(n), uintptr(unsafe.Pointer(textures)), 0)
+	if hooked {
+		ctx.called("DeleteTextures", n, textures)
+	}
+}
+
+// delete named textures
+func DeleteTextures(n int32, textures *uint32) {
+	defaultContext.DeleteTextures(n, textures)
+}
+
+// delete transform feedback objects
+func (ctx *Context) DeleteTransformFeedbacks(n int32, ids *uint32) {
+	syscall.Syscall(ctx.gpDeleteTransformFeedbacks, 2, uintptr(n), uintptr(unsafe.Pointer(ids)), 0)
+	if hooked {
+		ctx.called("DeleteTransformFeedbacks", n, ids)
+	}
+}
+
+// delete transform feedback objects
+func DeleteTransformFeedbacks(n int32, ids *uint32) {
+	defaultContext.DeleteTransformFeedbacks(n, ids)
+}
+
+// delete vertex array objects
+func (ctx *Context) DeleteVertexArrays(n int32, arrays *uint32) {
+	syscall.Syscall(ctx.gpDeleteVertexArrays, 2, uintptr(n), uintptr(unsafe.Pointer(arrays)), 0)
+	if hooked {
+		ctx.called("DeleteVertexArrays", n, arrays)
+	}
+}
+
+// delete vertex array objects
+func DeleteVertexArrays(n int32, arrays *uint32) {
+	defaultContext.DeleteVertexArrays(n, arrays)
+}
+
+// specify the value used for depth buffer comparisons
+func (ctx *Context) DepthFunc(xfunc uint32) {
+	syscall.Syscall(ctx.gpDepthFunc, 1, uintptr(xfunc), 0, 0)
+	if hooked {
+		ctx.called("DepthFunc", xfunc)
+	}
+}
+
+// specify the value used for depth buffer comparisons
+func DepthFunc(xfunc uint32) {
+	defaultContext.DepthFunc(xfunc)
+}
+
+// enable or disable writing into the depth buffer
+func (ctx *Context) DepthMask(flag bool) {
+	syscall.Syscall(ctx.gpDepthMask, 1, boolToUintptr(flag), 0, 0)
+	if hooked {
+		ctx.called("DepthMask", flag)
+	}
+}
+
+// enable or disable writing into the depth buffer
+func DepthMask(flag bool) {
+	defaultContext.DepthMask(flag)
+}
+
+// specify mapping of depth values from normalized device coordinates to window coordinates
+func (ctx *Context) DepthRange(n float64, f float64) {
+	syscall.Syscall(ctx.gpDepthRange, 2, uintptr(math.Float64bits(n)), uintptr(math.Float64bits(f)), 0)
+	if hooked {
+		ctx.called("DepthRange", n, f)
+	}
+}
+
+// specify mapping of depth values from normalized device coordinates to window coordinates
+func DepthRange(n float64, f float64) {
+	defaultContext.DepthRange(n, f)
+}
+
+func (ctx *Context) DepthRangeArrayv(first uint32, count int32, v *float64) {
+	syscall.Syscall(ctx.gpDepthRangeArrayv, 3, uintptr(first), uintptr(count), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("DepthRangeArrayv", first, count, v)
+	}
+}
+
+func DepthRangeArrayv(first uint32, count int32, v *float64) {
+	defaultContext.DepthRangeArrayv(first, count, v)
+}
+
+// specify mapping of depth values from normalized device coordinates to window coordinates for a specified viewport
+func (ctx *Context) DepthRangeIndexed(index uint32, n float64, f float64) {
+	syscall.Syscall(ctx.gpDepthRangeIndexed, 3, uintptr(index), uintptr(math.Float64bits(n)), uintptr(math.Float64bits(f)))
+	if hooked {
+		ctx.called("DepthRangeIndexed", index, n, f)
+	}
+}
+
+// specify mapping of depth values from normalized device coordinates to window coordinates for a specified viewport
+func DepthRangeIndexed(index uint32, n float64, f float64) {
+	defaultContext.DepthRangeIndexed(index, n, f)
+}
+
+// specify mapping of depth values from normalized device coordinates to window coordinates
+func (ctx *Context) DepthRangef(n float32, f float32) {
+	syscall.Syscall(ctx.gpDepthRangef, 2, uintptr(math.Float32bits(n)), uintptr(math.Float32bits(f)), 0)
+	if hooked {
+		ctx.called("DepthRangef", n, f)
+	}
+}
+
+// specify mapping of depth values from normalized device coordinates to window coordinates
+func DepthRangef(n float32, f float32) {
+	defaultContext.DepthRangef(n, f)
+}
+
+// Detaches a shader object from a program object to which it is attached
+func (ctx *Context) DetachShader(program uint32, shader uint32) {
+	syscall.Syscall(ctx.gpDetachShader, 2, uintptr(program), uintptr(shader), 0)
+	if hooked {
+		ctx.called("DetachShader", program, shader)
+	}
+}
+
+// Detaches a shader object from a program object to which it is attached
+func DetachShader(program uint32, shader uint32) {
+	defaultContext.DetachShader(program, shader)
+}
+
+func (ctx *Context) Disable(cap uint32) {
+	syscall.Syscall(ctx.gpDisable, 1, uintptr(cap), 0, 0)
+	if hooked {
+		ctx.called("Disable", cap)
+	}
+}
+
+func Disable(cap uint32) {
+	defaultContext.Disable(cap)
+}
+
+// Enable or disable a generic vertex attribute     array
+func (ctx *Context) DisableVertexArrayAttrib(vaobj uint32, index uint32) {
+	syscall.Syscall(ctx.gpDisableVertexArrayAttrib, 2, uintptr(vaobj), uintptr(index), 0)
+	if hooked {
+		ctx.called("DisableVertexArrayAttrib", vaobj, index)
+	}
+}
+
+// Enable or disable a generic vertex attribute     array
+func DisableVertexArrayAttrib(vaobj uint32, index uint32) {
+	defaultContext.DisableVertexArrayAttrib(vaobj, index)
+}
+
+// Enable or disable a generic vertex attribute     array
+func (ctx *Context) DisableVertexAttribArray(index uint32) {
+	syscall.Syscall(ctx.gpDisableVertexAttribArray, 1, uintptr(index), 0, 0)
+	if hooked {
+		ctx.called("DisableVertexAttribArray", index)
+	}
+}
+
+// Enable or disable a generic vertex attribute     array
+func DisableVertexAttribArray(index uint32) {
+	defaultContext.DisableVertexAttribArray(index)
+}
+
+func (ctx *Context) Disablei(target uint32, index uint32) {
+	syscall.Syscall(ctx.gpDisablei, 2, uintptr(target), uintptr(index), 0)
+	if hooked {
+		ctx.called("Disablei", target, index)
+	}
+}
+
+func Disablei(target uint32, index uint32) {
+	defaultContext.Disablei(target, index)
+}
+
+// launch one or more compute work groups
+func (ctx *Context) DispatchCompute(num_groups_x uint32, num_groups_y uint32, num_groups_z uint32) {
+	syscall.Syscall(ctx.gpDispatchCompute, 3, uintptr(num_groups_x), uintptr(num_groups_y), uintptr(num_groups_z))
+	if hooked {
+		ctx.called("DispatchCompute", num_groups_x, num_groups_y, num_groups_z)
+	}
+}
+
+// launch one or more compute work groups
+func DispatchCompute(num_groups_x uint32, num_groups_y uint32, num_groups_z uint32) {
+	defaultContext.DispatchCompute(num_groups_x, num_groups_y, num_groups_z)
+}
+
+// launch one or more compute work groups using parameters stored in a buffer
+func (ctx *Context) DispatchComputeIndirect(indirect int) {
+	syscall.Syscall(ctx.gpDispatchComputeIndirect, 1, uintptr(indirect), 0, 0)
+	if hooked {
+		ctx.called("DispatchComputeIndirect", indirect)
+	}
+}
+
+// launch one or more compute work groups using parameters stored in a buffer
+func DispatchComputeIndirect(indirect int) {
+	defaultContext.DispatchComputeIndirect(indirect)
+}
+
+// render primitives from array data
+func (ctx *Context) DrawArrays(mode uint32, first int32, count int32) {
+	syscall.Syscall(ctx.gpDrawArrays, 3, uintptr(mode), uintptr(first), uintptr(count))
+	if hooked {
+		ctx.called("DrawArrays", mode, first, count)
+	}
+}
+
+// render primitives from array data
+func DrawArrays(mode uint32, first int32, count int32) {
+	defaultContext.DrawArrays(mode, first, count)
+}
+
+// render primitives from array data, taking parameters from memory
+func (ctx *Context) DrawArraysIndirect(mode uint32, indirect unsafe.Pointer) {
+	syscall.Syscall(ctx.gpDrawArraysIndirect, 2, uintptr(mode), uintptr(indirect), 0)
+	if hooked {
+		ctx.called("DrawArraysIndirect", mode, indirect)
+	}
+}
+
+// render primitives from array data, taking parameters from memory
+func DrawArraysIndirect(mode uint32, indirect unsafe.Pointer) {
+	defaultContext.DrawArraysIndirect(mode, indirect)
+}
+
+// draw multiple instances of a range of elements
+func (ctx *Context) DrawArraysInstanced(mode uint32, first int32, count int32, instancecount int32) {
+	syscall.Syscall6(ctx.gpDrawArraysInstanced, 4, uintptr(mode), uintptr(first), uintptr(count), uintptr(instancecount), 0, 0)
+	if hooked {
+		ctx.called("DrawArraysInstanced", mode, first, count, instancecount)
+	}
+}
+
+// draw multiple instances of a range of elements
+func DrawArraysInstanced(mode uint32, first int32, count int32, instancecount int32) {
+	defaultContext.DrawArraysInstanced(mode, first, count, instancecount)
+}
+
+// draw multiple instances of a range of elements with offset applied to instanced attributes
+func (ctx *Context) DrawArraysInstancedBaseInstance(mode uint32, first int32, count int32, instancecount int32, baseinstance uint32) {
+	syscall.Syscall6(ctx.gpDrawArraysInstancedBaseInstance, 5, uintptr(mode), uintptr(first), uintptr(count), uintptr(instancecount), uintptr(baseinstance), 0)
+	if hooked {
+		ctx.called("DrawArraysInstancedBaseInstance", mode, first, count, instancecount, baseinstance)
+	}
+}
+
+// draw multiple instances of a range of elements with offset applied to instanced attributes
+func DrawArraysInstancedBaseInstance(mode uint32, first int32, count int32, instancecount int32, baseinstance uint32) {
+	defaultContext.DrawArraysInstancedBaseInstance(mode, first, count, instancecount, baseinstance)
+}
+
+// specify which color buffers are to be drawn into
+func (ctx *Context) DrawBuffer(buf uint32) {
+	syscall.Syscall(ctx.gpDrawBuffer, 1, uintptr(buf), 0, 0)
+	if hooked {
+		ctx.called("DrawBuffer", buf)
+	}
+}
+
+// specify which color buffers are to be drawn into
+func DrawBuffer(buf uint32) {
+	defaultContext.DrawBuffer(buf)
+}
+
+// Specifies a list of color buffers to be drawn     into
+func (ctx *Context) DrawBuffers(n int32, bufs *uint32) {
+	syscall.Syscall(ctx.gpDrawBuffers, 2, uintptr(n), uintptr(unsafe.Pointer(bufs)), 0)
+	if hooked {
+		ctx.called("DrawBuffers", n, bufs)
+	}
+}
+
+// Specifies a list of color buffers to be drawn     into
+func DrawBuffers(n int32, bufs *uint32) {
+	defaultContext.DrawBuffers(n, bufs)
+}
+
+// render primitives from array data
+func (ctx *Context) DrawElements(mode uint32, count int32, xtype uint32, indices unsafe.Pointer) {
+	syscall.Syscall6(ctx.gpDrawElements, 4, uintptr(mode), uintptr(count), uintptr(xtype), uintptr(indices), 0, 0)
+	if hooked {
+		ctx.called("DrawElements", mode, count, xtype, indices)
+	}
+}
+
+// render primitives from array data
+func DrawElements(mode uint32, count int32, xtype uint32, indices unsafe.Pointer) {
+	defaultContext.DrawElements(mode, count, xtype, indices)
+}
+
+func (ctx *Context) DrawElementsWithOffset(mode uint32, count int32, xtype uint32, indices uintptr) {
+	syscall.Syscall6(ctx.gpDrawElements, 4, uintptr(mode), uintptr(count), uintptr(xtype), uintptr(indices), 0, 0)
+	if hooked {
+		ctx.called("DrawElementsWithOffset", mode, count, xtype, indices)
+	}
+}
+
+func DrawElementsWithOffset(mode uint32, count int32, xtype uint32, indices uintptr) {
+	defaultContext.DrawElementsWithOffset(mode, count, xtype, indices)
+}
+
+// render primitives from array data with a per-element offset
+func (ctx *Context) DrawElementsBaseVertex(mode uint32, count int32, xtype uint32, indices unsafe.Pointer, basevertex int32) {
+	syscall.Syscall6(ctx.gpDrawElementsBaseVertex, 5, uintptr(mode), uintptr(count), uintptr(xtype), uintptr(indices), uintptr(basevertex), 0)
+	if hooked {
+		ctx.called("DrawElementsBaseVertex", mode, count, xtype, indices, basevertex)
+	}
+}
+
+// render primitives from array data with a per-element offset
+func DrawElementsBaseVertex(mode uint32, count int32, xtype uint32, indices unsafe.Pointer, basevertex int32) {
+	defaultContext.DrawElementsBaseVertex(mode, count, xtype, indices, basevertex)
+}
+
+// render indexed primitives from array data, taking parameters from memory
+func (ctx *Context) DrawElementsIndirect(mode uint32, xtype uint32, indirect unsafe.Pointer) {
+	syscall.Syscall(ctx.gpDrawElementsIndirect, 3, uintptr(mode), uintptr(xtype), uintptr(indirect))
+	if hooked {
+		ctx.called("DrawElementsIndirect", mode, xtype, indirect)
+	}
+}
+
+// render indexed primitives from array data, taking parameters from memory
+func DrawElementsIndirect(mode uint32, xtype uint32, indirect unsafe.Pointer) {
+	defaultContext.DrawElementsIndirect(mode, xtype, indirect)
+}
+
+// draw multiple instances of a set of elements
+func (ctx *Context) DrawElementsInstanced(mode uint32, count int32, xtype uint32, indices unsafe.Pointer, instancecount int32) {
+	syscall.Syscall6(ctx.gpDrawElementsInstanced, 5, uintptr(mode), uintptr(count), uintptr(xtype), uintptr(indices), uintptr(instancecount), 0)
+	if hooked {
+		ctx.called("DrawElementsInstanced", mode, count, xtype, indices, instancecount)
+	}
+}
+
+// draw multiple instances of a set of elements
+func DrawElementsInstanced(mode uint32, count int32, xtype uint32, indices unsafe.Pointer, instancecount int32) {
+	defaultContext.DrawElementsInstanced(mode, count, xtype, indices, instancecount)
+}
+
+// draw multiple instances of a set of elements with offset applied to instanced attributes
+func (ctx *Context) DrawElementsInstancedBaseInstance(mode uint32, count int32, xtype uint32, indices unsafe.Pointer, instancecount int32, baseinstance uint32) {
+	syscall.Syscall6(ctx.gpDrawElementsInstancedBaseInstance, 6, uintptr(mode), uintptr(count), uintptr(xtype), uintptr(indices), uintptr(instancecount), uintptr(baseinstance))
+	if hooked {
+		ctx.called("DrawElementsInstancedBaseInstance", mode, count, xtype, indices, instancecount, baseinstance)
+	}
+}
+
+// draw multiple instances of a set of elements with offset applied to instanced attributes
+func DrawElementsInstancedBaseInstance(mode uint32, count int32, xtype uint32, indices unsafe.Pointer, instancecount int32, baseinstance uint32) {
+	defaultContext.DrawElementsInstancedBaseInstance(mode, count, xtype, indices, instancecount, baseinstance)
+}
+
+// render multiple instances of a set of primitives from array data with a per-element offset
+func (ctx *Context) DrawElementsInstancedBaseVertex(mode uint32, count int32, xtype uint32, indices unsafe.Pointer, instancecount int32, basevertex int32) {
+	syscall.Syscall6(ctx.gpDrawElementsInstancedBaseVertex, 6, uintptr(mode), uintptr(count), uintptr(xtype), uintptr(indices), uintptr(instancecount), uintptr(basevertex))
+	if hooked {
+		ctx.called("DrawElementsInstancedBaseVertex", mode, count, xtype, indices, instancecount, basevertex)
+	}
+}
+
+// render multiple instances of a set of primitives from array data with a per-element offset
+func DrawElementsInstancedBaseVertex(mode uint32, count int32, xtype uint32, indices unsafe.Pointer, instancecount int32, basevertex int32) {
+	defaultContext.DrawElementsInstancedBaseVertex(mode, count, xtype, indices, instancecount, basevertex)
+}
+
+// render multiple instances of a set of primitives from array data with a per-element offset
+func (ctx *Context) DrawElementsInstancedBaseVertexBaseInstance(mode uint32, count int32, xtype uint32, indices unsafe.Pointer, instancecount int32, basevertex int32, baseinstance uint32) {
+	syscall.Syscall9(ctx.gpDrawElementsInstancedBaseVertexBaseInstance, 7, uintptr(mode), uintptr(count), uintptr(xtype), uintptr(indices), uintptr(instancecount), uintptr(basevertex), uintptr(baseinstance), 0, 0)
+	if hooked {
+		ctx.called("DrawElementsInstancedBaseVertexBaseInstance", mode, count, xtype, indices, instancecount, basevertex, baseinstance)
+	}
+}
+
+// render multiple instances of a set of primitives from array data with a per-element offset
+func DrawElementsInstancedBaseVertexBaseInstance(mode uint32, count int32, xtype uint32, indices unsafe.Pointer, instancecount int32, basevertex int32, baseinstance uint32) {
+	defaultContext.DrawElementsInstancedBaseVertexBaseInstance(mode, count, xtype, indices, instancecount, basevertex, baseinstance)
+}
+
+// render primitives from array data
+func (ctx *Context) DrawRangeElements(mode uint32, start uint32, end uint32, count int32, xtype uint32, indices unsafe.Pointer) {
+	syscall.Syscall6(ctx.gpDrawRangeElements, 6, uintptr(mode), uintptr(start), uintptr(end), uintptr(count), uintptr(xtype), uintptr(indices))
+	if hooked {
+		ctx.called("DrawRangeElements", mode, start, end, count, xtype, indices)
+	}
+}
+
+// render primitives from array data
+func DrawRangeElements(mode uint32, start uint32, end uint32, count int32, xtype uint32, indices unsafe.Pointer) {
+	defaultContext.DrawRangeElements(mode, start, end, count, xtype, indices)
+}
+
+// render primitives from array data with a per-element offset
+func (ctx *Context) DrawRangeElementsBaseVertex(mode uint32, start uint32, end uint32, count int32, xtype uint32, indices unsafe.Pointer, basevertex int32) {
+	syscall.Syscall9(ctx.gpDrawRangeElementsBaseVertex, 7, uintptr(mode), uintptr(start), uintptr(end), uintptr(count), uintptr(xtype), uintptr(indices), uintptr(basevertex), 0, 0)
+	if hooked {
+		ctx.called("DrawRangeElementsBaseVertex", mode, start, end, count, xtype, indices, basevertex)
+	}
+}
+
+// render primitives from array data with a per-element offset
+func DrawRangeElementsBaseVertex(mode uint32, start uint32, end uint32, count int32, xtype uint32, indices unsafe.Pointer, basevertex int32) {
+	defaultContext.DrawRangeElementsBaseVertex(mode, start, end, count, xtype, indices, basevertex)
+}
+
+// render primitives using a count derived from a transform feedback object
+func (ctx *Context) DrawTransformFeedback(mode uint32, id uint32) {
+	syscall.Syscall(ctx.gpDrawTransformFeedback, 2, uintptr(mode), uintptr(id), 0)
+	if hooked {
+		ctx.called("DrawTransformFeedback", mode, id)
+	}
+}
+
+// render primitives using a count derived from a transform feedback object
+func DrawTransformFeedback(mode uint32, id uint32) {
+	defaultContext.DrawTransformFeedback(mode, id)
+}
+
+// render multiple instances of primitives using a count derived from a transform feedback object
+func (ctx *Context) DrawTransformFeedbackInstanced(mode uint32, id uint32, instancecount int32) {
+	syscall.Syscall(ctx.gpDrawTransformFeedbackInstanced, 3, uintptr(mode), uintptr(id), uintptr(instancecount))
+	if hooked {
+		ctx.called("DrawTransformFeedbackInstanced", mode, id, instancecount)
+	}
+}
+
+// render multiple instances of primitives using a count derived from a transform feedback object
+func DrawTransformFeedbackInstanced(mode uint32, id uint32, instancecount int32) {
+	defaultContext.DrawTransformFeedbackInstanced(mode, id, instancecount)
+}
+
+// render primitives using a count derived from a specifed stream of a transform feedback object
+func (ctx *Context) DrawTransformFeedbackStream(mode uint32, id uint32, stream uint32) {
+	syscall.Syscall(ctx.gpDrawTransformFeedbackStream, 3, uintptr(mode), uintptr(id), uintptr(stream))
+	if hooked {
+		ctx.called("DrawTransformFeedbackStream", mode, id, stream)
+	}
+}
+
+// render primitives using a count derived from a specifed stream of a transform feedback object
+func DrawTransformFeedbackStream(mode uint32, id uint32, stream uint32) {
+	defaultContext.DrawTransformFeedbackStream(mode, id, stream)
+}
+
+// render multiple instances of primitives using a count derived from a specifed stream of a transform feedback object
+func (ctx *Context) DrawTransformFeedbackStreamInstanced(mode uint32, id uint32, stream uint32, instancecount int32) {
+	syscall.Syscall6(ctx.gpDrawTransformFeedbackStreamInstanced, 4, uintptr(mode), uintptr(id), uintptr(stream), uintptr(instancecount), 0, 0)
+	if hooked {
+		ctx.called("DrawTransformFeedbackStreamInstanced", mode, id, stream, instancecount)
+	}
+}
+
+// render multiple instances of primitives using a count derived from a specifed stream of a transform feedback object
+func DrawTransformFeedbackStreamInstanced(mode uint32, id uint32, stream uint32, instancecount int32) {
+	defaultContext.DrawTransformFeedbackStreamInstanced(mode, id, stream, instancecount)
+}
+
+// enable or disable server-side GL capabilities
+func (ctx *Context) Enable(cap uint32) {
+	syscall.Syscall(ctx.gpEnable, 1, uintptr(cap), 0, 0)
+	if hooked {
+		ctx.called("Enable", cap)
+	}
+}
+
+// enable or disable server-side GL capabilities
+func Enable(cap uint32) {
+	defaultContext.Enable(cap)
+}
+
+// Enable or disable a generic vertex attribute     array
+func (ctx *Context) EnableVertexArrayAttrib(vaobj uint32, index uint32) {
+	syscall.Syscall(ctx.gpEnableVertexArrayAttrib, 2, uintptr(vaobj), uintptr(index), 0)
+	if hooked {
+		ctx.called("EnableVertexArrayAttrib", vaobj, index)
+	}
+}
+
+// Enable or disable a generic vertex attribute     array
+func EnableVertexArrayAttrib(vaobj uint32, index uint32) {
+	defaultContext.EnableVertexArrayAttrib(vaobj, index)
+}
+
+// Enable or disable a generic vertex attribute     array
+func (ctx *Context) EnableVertexAttribArray(index uint32) {
+	syscall.Syscall(ctx.gpEnableVertexAttribArray, 1, uintptr(index), 0, 0)
+	if hooked {
+		ctx.called("EnableVertexAttribArray", index)
+	}
+}
+
+// Enable or disable a generic vertex attribute     array
+func EnableVertexAttribArray(index uint32) {
+	defaultContext.EnableVertexAttribArray(index)
+}
+
+func (ctx *Context) Enablei(target uint32, index uint32) {
+	syscall.Syscall(ctx.gpEnablei, 2, uintptr(target), uintptr(index), 0)
+	if hooked {
+		ctx.called("Enablei", target, index)
+	}
+}
+
+func Enablei(target uint32, index uint32) {
+	defaultContext.Enablei(target, index)
+}
+
+func (ctx *Context) EndConditionalRender() {
+	syscall.Syscall(ctx.gpEndConditionalRender, 0, 0, 0, 0)
+	if hooked {
+		ctx.called("EndConditionalRender")
+	}
+}
+
+func EndConditionalRender() {
+	defaultContext.EndConditionalRender()
+}
+
+func (ctx *Context) EndQuery(target uint32) {
+	syscall.Syscall(ctx.gpEndQuery, 1, uintptr(target), 0, 0)
+	if hooked {
+		ctx.called("EndQuery", target)
+	}
+}
+
+func EndQuery(target uint32) {
+	defaultContext.EndQuery(target)
+}
+
+func (ctx *Context) EndQueryIndexed(target uint32, index uint32) {
+	syscall.Syscall(ctx.gpEndQueryIndexed, 2, uintptr(target), uintptr(index), 0)
+	if hooked {
+		ctx.called("EndQueryIndexed", target, index)
+	}
+}
+
+func EndQueryIndexed(target uint32, index uint32) {
+	defaultContext.EndQueryIndexed(target, index)
+}
+
+func (ctx *Context) EndTransformFeedback() {
+	syscall.Syscall(ctx.gpEndTransformFeedback, 0, 0, 0, 0)
+	if hooked {
+		ctx.called("EndTransformFeedback")
+	}
+}
+
+func EndTransformFeedback() {
+	defaultContext.EndTransformFeedback()
+}
+
+// create a new sync object and insert it into the GL command stream
+func (ctx *Context) FenceSync(condition uint32, flags uint32) uintptr {
+	ret, _, _ := syscall.Syscall(ctx.gpFenceSync, 2, uintptr(condition), uintptr(flags), 0)
+	if hooked {
+		ctx.returned("FenceSync", (uintptr)(ret), condition, flags)
+	}
+	return (uintptr)(ret)
+}
+
+// create a new sync object and insert it into the GL command stream
+func FenceSync(condition uint32, flags uint32) uintptr {
+	return defaultContext.FenceSync(condition, flags)
+}
+
+// block until all GL execution is complete
+func (ctx *Context) Finish() {
+	syscall.Syscall(ctx.gpFinish, 0, 0, 0, 0)
+	if hooked {
+		ctx.called("Finish")
+	}
+}
+
+// block until all GL execution is complete
+func Finish() {
+	defaultContext.Finish()
+}
+
+// force execution of GL commands in finite time
+func (ctx *Context) Flush() {
+	syscall.Syscall(ctx.gpFlush, 0, 0, 0, 0)
+	if hooked {
+		ctx.called("Flush")
+	}
+}
+
+// force execution of GL commands in finite time
+func Flush() {
+	defaultContext.Flush()
+}
+
+// indicate modifications to a range of a mapped buffer
+func (ctx *Context) FlushMappedBufferRange(target uint32, offset int, length int) {
+	syscall.Syscall(ctx.gpFlushMappedBufferRange, 3, uintptr(target), uintptr(offset), uintptr(length))
+	if hooked {
+		ctx.called("FlushMappedBufferRange", target, offset, length)
+	}
+}
+
+// indicate modifications to a range of a mapped buffer
+func FlushMappedBufferRange(target uint32, offset int, length int) {
+	defaultContext.FlushMappedBufferRange(target, offset, length)
+}
+
+// indicate modifications to a range of a mapped buffer
+func (ctx *Context) FlushMappedNamedBufferRange(buffer uint32, offset int, length int) {
+	syscall.Syscall(ctx.gpFlushMappedNamedBufferRange, 3, uintptr(buffer), uintptr(offset), uintptr(length))
+	if hooked {
+		ctx.called("FlushMappedNamedBufferRange", buffer, offset, length)
+	}
+}
+
+// indicate modifications to a range of a mapped buffer
+func FlushMappedNamedBufferRange(buffer uint32, offset int, length int) {
+	defaultContext.FlushMappedNamedBufferRange(buffer, offset, length)
+}
+
+// set a named parameter of a framebuffer object
+func (ctx *Context) FramebufferParameteri(target uint32, pname uint32, param int32) {
+	syscall.Syscall(ctx.gpFramebufferParameteri, 3, uintptr(target), uintptr(pname), uintptr(param))
+	if hooked {
+		ctx.called("FramebufferParameteri", target, pname, param)
+	}
+}
+
+// set a named parameter of a framebuffer object
+func FramebufferParameteri(target uint32, pname uint32, param int32) {
+	defaultContext.FramebufferParameteri(target, pname, param)
+}
+
+// attach a renderbuffer as a logical buffer of a framebuffer object
+func (ctx *Context) FramebufferRenderbuffer(target uint32, attachment uint32, renderbuffertarget uint32, renderbuffer uint32) {
+	syscall.Syscall6(ctx.gpFramebufferRenderbuffer, 4, uintptr(target), uintptr(attachment), uintptr(renderbuffertarget), uintptr(renderbuffer), 0, 0)
+	if hooked {
+		ctx.called("FramebufferRenderbuffer", target, attachment, renderbuffertarget, renderbuffer)
+	}
+}
+
+// attach a renderbuffer as a logical buffer of a framebuffer object
+func FramebufferRenderbuffer(target uint32, attachment uint32, renderbuffertarget uint32, renderbuffer uint32) {
+	defaultContext.FramebufferRenderbuffer(target, attachment, renderbuffertarget, renderbuffer)
+}
+
+// attach a level of a texture object as a logical buffer of a framebuffer object
+func (ctx *Context) FramebufferTexture(target uint32, attachment uint32, texture uint32, level int32) {
+	syscall.Syscall6(ctx.gpFramebufferTexture, 4, uintptr(target), uintptr(attachment), uintptr(texture), uintptr(level), 0, 0)
+	if hooked {
+		ctx.called("FramebufferTexture", target, attachment, texture, level)
+	}
+}
+
+// attach a level of a texture object as a logical buffer of a framebuffer object
+func FramebufferTexture(target uint32, attachment uint32, texture uint32, level int32) {
+	defaultContext.FramebufferTexture(target, attachment, texture, level)
+}
+
+func (ctx *Context) FramebufferTexture1D(target uint32, attachment uint32, textarget uint32, texture uint32, level int32) {
+	syscall.Syscall6(ctx.gpFramebufferTexture1D, 5, uintptr(target), uintptr(attachment), uintptr(textarget), uintptr(texture), uintptr(level), 0)
+	if hooked {
+		ctx.called("FramebufferTexture1D", target, attachment, textarget, texture, level)
+	}
+}
+
+func FramebufferTexture1D(target uint32, attachment uint32, textarget uint32, texture uint32, level int32) {
+	defaultContext.FramebufferTexture1D(target, attachment, textarget, texture, level)
+}
+
+// attach a level of a texture object as a logical buffer to the currently bound framebuffer object
+func (ctx *Context) FramebufferTexture2D(target uint32, attachment uint32, textarget uint32, texture uint32, level int32) {
+	syscall.Syscall6(ctx.gpFramebufferTexture2D, 5, uintptr(target), uintptr(attachment), uintptr(textarget), uintptr(texture), uintptr(level), 0)
+	if hooked {
+		ctx.called("FramebufferTexture2D", target, attachment, textarget, texture, level)
+	}
+}
+
+// attach a level of a texture object as a logical buffer to the currently bound framebuffer object
+func FramebufferTexture2D(target uint32, attachment uint32, textarget uint32, texture uint32, level int32) {
+	defaultContext.FramebufferTexture2D(target, attachment, textarget, texture, level)
+}
+
+func (ctx *Context) FramebufferTexture3D(target uint32, attachment uint32, textarget uint32, texture uint32, level int32, zoffset int32) {
+	syscall.Syscall6(ctx.gpFramebufferTexture3D, 6, uintptr(target), uintptr(attachment), uintptr(textarget), uintptr(texture), uintptr(level), uintptr(zoffset))
+	if hooked {
+		ctx.called("FramebufferTexture3D", target, attachment, textarget, texture, level, zoffset)
+	}
+}
+
+func FramebufferTexture3D(target uint32, attachment uint32, textarget uint32, texture uint32, level int32, zoffset int32) {
+	defaultContext.FramebufferTexture3D(target, attachment, textarget, texture, level, zoffset)
+}
+
+// attach a single layer of a texture object as a logical buffer of a framebuffer object
+func (ctx *Context) FramebufferTextureLayer(target uint32, attachment uint32, texture uint32, level int32, layer int32) {
+	syscall.Syscall6(ctx.gpFramebufferTextureLayer, 5, uintptr(target), uintptr(attachment), uintptr(texture), uintptr(level), uintptr(layer), 0)
+	if hooked {
+		ctx.called("FramebufferTextureLayer", target, attachment, texture, level, layer)
+	}
+}
+
+// attach a single layer of a texture object as a logical buffer of a framebuffer object
+func FramebufferTextureLayer(target uint32, attachment uint32, texture uint32, level int32, layer int32) {
+	defaultContext.FramebufferTextureLayer(target, attachment, texture, level, layer)
+}
+
+// define front- and back-facing polygons
+func (ctx *Context) FrontFace(mode uint32) {
+	syscall.Syscall(ctx.gpFrontFace, 1, uintptr(mode), 0, 0)
+	if hooked {
+		ctx.called("FrontFace", mode)
+	}
+}
+
+// define front- and back-facing polygons
+func FrontFace(mode uint32) {
+	defaultContext.FrontFace(mode)
+}
+
+// generate buffer object names
+func (ctx *Context) GenBuffers(n int32, buffers *uint32) {
+	syscall.Syscall(ctx.gpGenBuffers, 2, uintptr(n), uintptr(unsafe.Pointer(buffers)), 0)
+	if hooked {
+		ctx.called("GenBuffers", n, buffers)
+	}
+}
+
+// generate buffer object names
+func GenBuffers(n int32, buffers *uint32) {
+	defaultContext.GenBuffers(n, buffers)
+}
+
+// generate framebuffer object names
+func (ctx *Context) GenFramebuffers(n int32, framebuffers *uint32) {
+	syscall.Syscall(ctx.gpGenFramebuffers, 2, uintptr(n), uintptr(unsafe.Pointer(framebuffers)), 0)
+	if hooked {
+		ctx.called("GenFramebuffers", n, framebuffers)
+	}
+}
+
+// generate framebuffer object names
+func GenFramebuffers(n int32, framebuffers *uint32) {
+	defaultContext.GenFramebuffers(n, framebuffers)
+}
+
+// reserve program pipeline object names
+func (ctx *Context) GenProgramPipelines(n int32, pipelines *uint32) {
+	syscall.Syscall(ctx.gpGenProgramPipelines, 2, uintptr(n), uintptr(unsafe.Pointer(pipelines)), 0)
+	if hooked {
+		ctx.called("GenProgramPipelines", n, pipelines)
+	}
+}
+
+// reserve program pipeline object names
+func GenProgramPipelines(n int32, pipelines *uint32) {
+	defaultContext.GenProgramPipelines(n, pipelines)
+}
+
+// generate query object names
+func (ctx *Context) GenQueries(n int32, ids *uint32) {
+	syscall.Syscall(ctx.gpGenQueries, 2, uintptr(n), uintptr(unsafe.Pointer(ids)), 0)
+	if hooked {
+		ctx.called("GenQueries", n, ids)
+	}
+}
+
+// generate query object names
+func GenQueries(n int32, ids *uint32) {
+	defaultContext.GenQueries(n, ids)
+}
+
+// generate renderbuffer object names
+func (ctx *Context) GenRenderbuffers(n int32, renderbuffers *uint32) {
+	syscall.Syscall(ctx.gpGenRenderbuffers, 2, uintptr(n), uintptr(unsafe.Pointer(renderbuffers)), 0)
+	if hooked {
+		ctx.called("GenRenderbuffers", n, renderbuffers)
+	}
+}
+
+// generate renderbuffer object names
+func GenRenderbuffers(n int32, renderbuffers *uint32) {
+	defaultContext.GenRenderbuffers(n, renderbuffers)
+}
+
+// generate sampler object names
+func (ctx *Context) GenSamplers(count int32, samplers *uint32) {
+	syscall.Syscall(ctx.gpGenSamplers, 2, uintptr(count), uintptr(unsafe.Pointer(samplers)), 0)
+	if hooked {
+		ctx.called("GenSamplers", count, samplers)
+	}
+}
+
+// generate sampler object names
+func GenSamplers(count int32, samplers *uint32) {
+	defaultContext.GenSamplers(count, samplers)
+}
+
+// generate texture names
+func (ctx *Context) GenTextures(n int32, textures *uint32) {
+	syscall.Syscall(ctx.gpGenTextures, 2, uintptr(n), uintptr(unsafe.Pointer(textures)), 0)
+	if hooked {
+		ctx.called("GenTextures", n, textures)
+	}
+}
+
+// generate texture names
+func GenTextures(n int32, textures *uint32) {
+	defaultContext.GenTextures(n, textures)
+}
+
+// reserve transform feedback object names
+func (ctx *Context) GenTransformFeedbacks(n int32, ids *uint32) {
+	syscall.Syscall(ctx.gpGenTransformFeedbacks, 2, uintptr(n), uintptr(unsafe.Pointer(ids)), 0)
+	if hooked {
+		ctx.called("GenTransformFeedbacks", n, ids)
+	}
+}
+
+// reserve transform feedback object names
+func GenTransformFeedbacks(n int32, ids *uint32) {
+	defaultContext.GenTransformFeedbacks(n, ids)
+}
+
+// generate vertex array object names
+func (ctx *Context) GenVertexArrays(n int32, arrays *uint32) {
+	syscall.Syscall(ctx.gpGenVertexArrays, 2, uintptr(n), uintptr(unsafe.Pointer(arrays)), 0)
+	if hooked {
+		ctx.called("GenVertexArrays", n, arrays)
+	}
+}
+
+// generate vertex array object names
+func GenVertexArrays(n int32, arrays *uint32) {
+	defaultContext.GenVertexArrays(n, arrays)
+}
+
+// generate mipmaps for a specified texture object
+func (ctx *Context) GenerateMipmap(target uint32) {
+	syscall.Syscall(ctx.gpGenerateMipmap, 1, uintptr(target), 0, 0)
+	if hooked {
+		ctx.called("GenerateMipmap", target)
+	}
+}
+
+// generate mipmaps for a specified texture object
+func GenerateMipmap(target uint32) {
+	defaultContext.GenerateMipmap(target)
+}
+
+// generate mipmaps for a specified texture object
+func (ctx *Context) GenerateTextureMipmap(texture uint32) {
+	syscall.Syscall(ctx.gpGenerateTextureMipmap, 1, uintptr(texture), 0, 0)
+	if hooked {
+		ctx.called("GenerateTextureMipmap", texture)
+	}
+}
+
+// generate mipmaps for a specified texture object
+func GenerateTextureMipmap(texture uint32) {
+	defaultContext.GenerateTextureMipmap(texture)
+}
+
+// retrieve information about the set of active atomic counter buffers for a program
+func (ctx *Context) GetActiveAtomicCounterBufferiv(program uint32, bufferIndex uint32, pname uint32, params *int32) {
+	syscall.Syscall6(ctx.gpGetActiveAtomicCounterBufferiv, 4, uintptr(program), uintptr(bufferIndex), uintptr(pname), uintptr(unsafe.Pointer(params)), 0, 0)
+	if hooked {
+		ctx.called("GetActiveAtomicCounterBufferiv", program, bufferIndex, pname, params)
+	}
+}
+
+// retrieve information about the set of active atomic counter buffers for a program
+func GetActiveAtomicCounterBufferiv(program uint32, bufferIndex uint32, pname uint32, params *int32) {
+	defaultContext.GetActiveAtomicCounterBufferiv(program, bufferIndex, pname, params)
+}
+
+// Returns information about an active attribute variable for the specified program object
+func (ctx *Context) GetActiveAttrib(program uint32, index uint32, bufSize int32, length *int32, size *int32, xtype *uint32, name *uint8) {
+	syscall.Syscall9(ctx.gpGetActiveAttrib, 7, uintptr(program), uintptr(index), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(size)), uintptr(unsafe.Pointer(xtype)), uintptr(unsafe.Pointer(name)), 0, 0)
+	if hooked {
+		ctx.called("GetActiveAttrib", program, index, bufSize, length, size, xtype, name)
+	}
+}
+
+// Returns information about an active attribute variable for the specified program object
+func GetActiveAttrib(program uint32, index uint32, bufSize int32, length *int32, size *int32, xtype *uint32, name *uint8) {
+	defaultContext.GetActiveAttrib(program, index, bufSize, length, size, xtype, name)
+}
+
+// query the name of an active shader subroutine
+func (ctx *Context) GetActiveSubroutineName(program uint32, shadertype uint32, index uint32, bufsize int32, length *int32, name *uint8) {
+	syscall.Syscall6(ctx.gpGetActiveSubroutineName, 6, uintptr(program), uintptr(shadertype), uintptr(index), uintptr(bufsize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(name)))
+	if hooked {
+		ctx.called("GetActiveSubroutineName", program, shadertype, index, bufsize, length, name)
+	}
+}
+
+// query the name of an active shader subroutine
+func GetActiveSubroutineName(program uint32, shadertype uint32, index uint32, bufsize int32, length *int32, name *uint8) {
+	defaultContext.GetActiveSubroutineName(program, shadertype, index, bufsize, length, name)
+}
+
+// query the name of an active shader subroutine uniform
+func (ctx *Context) GetActiveSubroutineUniformName(program uint32, shadertype uint32, index uint32, bufsize int32, length *int32, name *uint8) {
+	syscall.Syscall6(ctx.gpGetActiveSubroutineUniformName, 6, uintptr(program), uintptr(shadertype), uintptr(index), uintptr(bufsize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(name)))
+	if hooked {
+		ctx.called("GetActiveSubroutineUniformName", program, shadertype, index, bufsize, length, name)
+	}
+}
+
+// query the name of an active shader subroutine uniform
+func GetActiveSubroutineUniformName(program uint32, shadertype uint32, index uint32, bufsize int32, length *int32, name *uint8) {
+	defaultContext.GetActiveSubroutineUniformName(program, shadertype, index, bufsize, length, name)
+}
+
+func (ctx *Context) GetActiveSubroutineUniformiv(program uint32, shadertype uint32, index uint32, pname uint32, values *int32) {
+	syscall.Syscall6(ctx.gpGetActiveSubroutineUniformiv, 5, uintptr(program), uintptr(shadertype), uintptr(index), uintptr(pname), uintptr(unsafe.Pointer(values)), 0)
+	if hooked {
+		ctx.called("GetActiveSubroutineUniformiv", program, shadertype, index, pname, values)
+	}
+}
+
+func GetActiveSubroutineUniformiv(program uint32, shadertype uint32, index uint32, pname uint32, values *int32) {
+	defaultContext.GetActiveSubroutineUniformiv(program, shadertype, index, pname, values)
+}
+
+// Returns information about an active uniform variable for the specified program object
+func (ctx *Context) GetActiveUniform(program uint32, index uint32, bufSize int32, length *int32, size *int32, xtype *uint32, name *uint8) {
+	syscall.Syscall9(ctx.gpGetActiveUniform, 7, uintptr(program), uintptr(index), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(size)), uintptr(unsafe.Pointer(xtype)), uintptr(unsafe.Pointer(name)), 0, 0)
+	if hooked {
+		ctx.called("GetActiveUniform", program, index, bufSize, length, size, xtype, name)
+	}
+}
+
+// Returns information about an active uniform variable for the specified program object
+func GetActiveUniform(program uint32, index uint32, bufSize int32, length *int32, size *int32, xtype *uint32, name *uint8) {
+	defaultContext.GetActiveUniform(program, index, bufSize, length, size, xtype, name)
+}
+
+// retrieve the name of an active uniform block
+func (ctx *Context) GetActiveUniformBlockName(program uint32, uniformBlockIndex uint32, bufSize int32, length *int32, uniformBlockName *uint8) {
+	syscall.Syscall6(ctx.gpGetActiveUniformBlockName, 5, uintptr(program), uintptr(uniformBlockIndex), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(uniformBlockName)), 0)
+	if hooked {
+		ctx.called("GetActiveUniformBlockName", program, uniformBlockIndex, bufSize, length, uniformBlockName)
+	}
+}
+
+// retrieve the name of an active uniform block
+func GetActiveUniformBlockName(program uint32, uniformBlockIndex uint32, bufSize int32, length *int32, uniformBlockName *uint8) {
+	defaultContext.GetActiveUniformBlockName(program, uniformBlockIndex, bufSize, length, uniformBlockName)
+}
+
+// query information about an active uniform block
+func (ctx *Context) GetActiveUniformBlockiv(program uint32, uniformBlockIndex uint32, pname uint32, params *int32) {
+	syscall.Syscall6(ctx.gpGetActiveUniformBlockiv, 4, uintptr(program), uintptr(uniformBlockIndex), uintptr(pname), uintptr(unsafe.Pointer(params)), 0, 0)
+	if hooked {
+		ctx.called("GetActiveUniformBlockiv", program, uniformBlockIndex, pname, params)
+	}
+}
+
+// query information about an active uniform block
+func GetActiveUniformBlockiv(program uint32, uniformBlockIndex uint32, pname uint32, params *int32) {
+	defaultContext.GetActiveUniformBlockiv(program, uniformBlockIndex, pname, params)
+}
+
+// query the name of an active uniform
+func (ctx *Context) GetActiveUniformName(program uint32, uniformIndex uint32, bufSize int32, length *int32, uniformName *uint8) {
+	syscall.Syscall6(ctx.gpGetActiveUniformName, 5, uintptr(program), uintptr(uniformIndex), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(uniformName)), 0)
+	if hooked {
+		ctx.called("GetActiveUniformName", program, uniformIndex, bufSize, length, uniformName)
+	}
+}
+
+// query the name of an active uniform
+func GetActiveUniformName(program uint32, uniformIndex uint32, bufSize int32, length *int32, uniformName *uint8) {
+	defaultContext.GetActiveUniformName(program, uniformIndex, bufSize, length, uniformName)
+}
+
+// Returns information about several active uniform variables for the specified program object
+func (ctx *Context) GetActiveUniformsiv(program uint32, uniformCount int32, uniformIndices *uint32, pname uint32, params *int32) {
+	syscall.Syscall6(ctx.gpGetActiveUniformsiv, 5, uintptr(program), uintptr(uniformCount), uintptr(unsafe.Pointer(uniformIndices)), uintptr(pname), uintptr(unsafe.Pointer(params)), 0)
+	if hooked {
+		ctx.called("GetActiveUniformsiv", program, uniformCount, uniformIndices, pname, params)
+	}
+}
+
+// Returns information about several active uniform variables for the specified program object
+func GetActiveUniformsiv(program uint32, uniformCount int32, uniformIndices *uint32, pname uint32, params *int32) {
+	defaultContext.GetActiveUniformsiv(program, uniformCount, uniformIndices, pname, params)
+}
+
+// Returns the handles of the shader objects attached to a program object
+func (ctx *Context) GetAttachedShaders(program uint32, maxCount int32, count *int32, shaders *uint32) {
+	syscall.Syscall6(ctx.gpGetAttachedShaders, 4, uintptr(program), uintptr(maxCount), uintptr(unsafe.Pointer(count)), uintptr(unsafe.Pointer(shaders)), 0, 0)
+	if hooked {
+		ctx.called("GetAttachedShaders", program, maxCount, count, shaders)
+	}
+}
+
+// Returns the handles of the shader objects attached to a program object
+func GetAttachedShaders(program uint32, maxCount int32, count *int32, shaders *uint32) {
+	defaultContext.GetAttachedShaders(program, maxCount, count, shaders)
+}
+
+// Returns the location of an attribute variable
+func (ctx *Context) GetAttribLocation(program uint32, name *uint8) int32 {
+	ret, _, _ := syscall.Syscall(ctx.gpGetAttribLocation, 2, uintptr(program), uintptr(unsafe.Pointer(name)), 0)
+	if hooked {
+		ctx.returned("GetAttribLocation", (int32)(ret), program, name)
+	}
+	return (int32)(ret)
+}
+
+// Returns the location of an attribute variable
+func GetAttribLocation(program uint32, name *uint8) int32 {
+	return defaultContext.GetAttribLocation(program, name)
+}
+
+func (ctx *Context) GetBooleani_v(target uint32, index uint32, data *bool) {
+	syscall.Syscall(ctx.gpGetBooleani_v, 3, uintptr(target), uintptr(index), uintptr(unsafe.Pointer(data)))
+	if hooked {
+		ctx.called("GetBooleani_v", target, index, data)
+	}
+}
+
+func GetBooleani_v(target uint32, index uint32, data *bool) {
+	defaultContext.GetBooleani_v(target, index, data)
+}
+
+func (ctx *Context) GetBooleanv(pname uint32, data *bool) {
+	syscall.Syscall(ctx.gpGetBooleanv, 2, uintptr(pname), uintptr(unsafe.Pointer(data)), 0)
+	if hooked {
+		ctx.called("GetBooleanv", pname, data)
+	}
+}
+
+func GetBooleanv(pname uint32, data *bool) {
+	defaultContext.GetBooleanv(pname, data)
+}
+
+// return parameters of a buffer object
+func (ctx *Context) GetBufferParameteri64v(target uint32, pname uint32, params *int64) {
+	syscall.Syscall(ctx.gpGetBufferParameteri64v, 3, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetBufferParameteri64v", target, pname, params)
+	}
+}
+
+// return parameters of a buffer object
+func GetBufferParameteri64v(target uint32, pname uint32, params *int64) {
+	defaultContext.GetBufferParameteri64v(target, pname, params)
+}
+
+// return parameters of a buffer object
+func (ctx *Context) GetBufferParameteriv(target uint32, pname uint32, params *int32) {
+	syscall.Syscall(ctx.gpGetBufferParameteriv, 3, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetBufferParameteriv", target, pname, params)
+	}
+}
+
+// return parameters of a buffer object
+func GetBufferParameteriv(target uint32, pname uint32, params *int32) {
+	defaultContext.GetBufferParameteriv(target, pname, params)
+}
+
+// return the pointer to a mapped buffer object's data store
+func (ctx *Context) GetBufferPointerv(target uint32, pname uint32, params *unsafe.Pointer) {
+	syscall.Syscall(ctx.gpGetBufferPointerv, 3, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetBufferPointerv", target, pname, params)
+	}
+}
+
+// return the pointer to a mapped buffer object's data store
+func GetBufferPointerv(target uint32, pname uint32, params *unsafe.Pointer) {
+	defaultContext.GetBufferPointerv(target, pname, params)
+}
+
+// returns a subset of a buffer object's data store
+func (ctx *Context) GetBufferSubData(target uint32, offset int, size int, data unsafe.Pointer) {
+	syscall.Syscall6(ctx.gpGetBufferSubData, 4, uintptr(target), uintptr(offset), uintptr(size), uintptr(data), 0, 0)
+	if hooked {
+		ctx.called("GetBufferSubData", target, offset, size, data)
+	}
+}
+
+// returns a subset of a buffer object's data store
+func GetBufferSubData(target uint32, offset int, size int, data unsafe.Pointer) {
+	defaultContext.GetBufferSubData(target, offset, size, data)
+}
+
+// return a compressed texture image
+func (ctx *Context) GetCompressedTexImage(target uint32, level int32, img unsafe.Pointer) {
+	syscall.Syscall(ctx.gpGetCompressedTexImage, 3, uintptr(target), uintptr(level), uintptr(img))
+	if hooked {
+		ctx.called("GetCompressedTexImage", target, level, img)
+	}
+}
+
+// return a compressed texture image
+func GetCompressedTexImage(target uint32, level int32, img unsafe.Pointer) {
+	defaultContext.GetCompressedTexImage(target, level, img)
+}
+
+// return a compressed texture image
+func (ctx *Context) GetCompressedTextureImage(texture uint32, level int32, bufSize int32, pixels unsafe.Pointer) {
+	syscall.Syscall6(ctx.gpGetCompressedTextureImage, 4, uintptr(texture), uintptr(level), uintptr(bufSize), uintptr(pixels), 0, 0)
+	if hooked {
+		ctx.called("GetCompressedTextureImage", texture, level, bufSize, pixels)
+	}
+}
+
+// return a compressed texture image
+func GetCompressedTextureImage(texture uint32, level int32, bufSize int32, pixels unsafe.Pointer) {
+	defaultContext.GetCompressedTextureImage(texture, level, bufSize, pixels)
+}
+
+// retrieve a sub-region of a compressed texture image from a     compressed texture object
+func (ctx *Context) GetCompressedTextureSubImage(texture uint32, level int32, xoffset int32, yoffset int32, zoffset int32, width int32, height int32, depth int32, bufSize int32, pixels unsafe.Pointer) {
+	syscall.Syscall12(ctx.gpGetCompressedTextureSubImage, 10, uintptr(texture), uintptr(level), uintptr(xoffset), uintptr(yoffset), uintptr(zoffset), uintptr(width), uintptr(height), uintptr(depth), uintptr(bufSize), uintptr(pixels), 0, 0)
+	if hooked {
+		ctx.called("GetCompressedTextureSubImage", texture, level, xoffset, yoffset, zoffset, width, height, depth, bufSize, pixels)
+	}
+}
+
+// retrieve a sub-region of a compressed texture image from a     compressed texture object
+func GetCompressedTextureSubImage(texture uint32, level int32, xoffset int32, yoffset int32, zoffset int32, width int32, height int32, depth int32, bufSize int32, pixels unsafe.Pointer) {
+	defaultContext.GetCompressedTextureSubImage(texture, level, xoffset, yoffset, zoffset, width, height, depth, bufSize, pixels)
+}
+
+// retrieve messages from the debug message log
+func (ctx *Context) GetDebugMessageLog(count uint32, bufSize int32, sources *uint32, types *uint32, ids *uint32, severities *uint32, lengths *int32, messageLog *uint8) uint32 {
+	ret, _, _ := syscall.Syscall9(ctx.gpGetDebugMessageLog, 8, uintptr(count), uintptr(bufSize), uintptr(unsafe.Pointer(sources)), uintptr(unsafe.Pointer(types)), uintptr(unsafe.Pointer(ids)), uintptr(unsafe.Pointer(severities)), uintptr(unsafe.Pointer(lengths)), uintptr(unsafe.Pointer(messageLog)), 0)
+	if hooked {
+		ctx.returned("GetDebugMessageLog", (uint32)(ret), count, bufSize, sources, types, ids, severities, lengths, messageLog)
+	}
+	return (uint32)(ret)
+}
+
+// retrieve messages from the debug message log
+func GetDebugMessageLog(count uint32, bufSize int32, sources *uint32, types *uint32, ids *uint32, severities *uint32, lengths *int32, messageLog *uint8) uint32 {
+	return defaultContext.GetDebugMessageLog(count, bufSize, sources, types, ids, severities, lengths, messageLog)
+}
+
+func (ctx *Context) GetDoublei_v(target uint32, index uint32, data *float64) {
+	syscall.Syscall(ctx.gpGetDoublei_v, 3, uintptr(target), uintptr(index), uintptr(unsafe.Pointer(data)))
+	if hooked {
+		ctx.called("GetDoublei_v", target, index, data)
+	}
+}
+
+func GetDoublei_v(target uint32, index uint32, data *float64) {
+	defaultContext.GetDoublei_v(target, index, data)
+}
+
+func (ctx *Context) GetDoublev(pname uint32, data *float64) {
+	syscall.Syscall(ctx.gpGetDoublev, 2, uintptr(pname), uintptr(unsafe.Pointer(data)), 0)
+	if hooked {
+		ctx.called("GetDoublev", pname, data)
+	}
+}
+
+func GetDoublev(pname uint32, data *float64) {
+	defaultContext.GetDoublev(pname, data)
+}
+
+// return error information
+func (ctx *Context) GetError() uint32 {
+	ret, _, _ := syscall.Syscall(ctx.gpGetError, 0, 0, 0, 0)
+	return (uint32)(ret)
+}
+
+// return error information
+func GetError() uint32 {
+	return defaultContext.GetError()
+}
+
+func (ctx *Context) GetFloati_v(target uint32, index uint32, data *float32) {
+	syscall.Syscall(ctx.gpGetFloati_v, 3, uintptr(target), uintptr(index), uintptr(unsafe.Pointer(data)))
+	if hooked {
+		ctx.called("GetFloati_v", target, index, data)
+	}
+}
+
+func GetFloati_v(target uint32, index uint32, data *float32) {
+	defaultContext.GetFloati_v(target, index, data)
+}
+
+func (ctx *Context) GetFloatv(pname uint32, data *float32) {
+	syscall.Syscall(ctx.gpGetFloatv, 2, uintptr(pname), uintptr(unsafe.Pointer(data)), 0)
+	if hooked {
+		ctx.called("GetFloatv", pname, data)
+	}
+}
+
+func GetFloatv(pname uint32, data *float32) {
+	defaultContext.GetFloatv(pname, data)
+}
+
+// query the bindings of color indices to user-defined varying out variables
+func (ctx *Context) GetFragDataIndex(program uint32, name *uint8) int32 {
+	ret, _, _ := syscall.Syscall(ctx.gpGetFragDataIndex, 2, uintptr(program), uintptr(unsafe.Pointer(name)), 0)
+	if hooked {
+		ctx.returned("GetFragDataIndex", (int32)(ret), program, name)
+	}
+	return (int32)(ret)
+}
+
+// query the bindings of color indices to user-defined varying out variables
+func GetFragDataIndex(program uint32, name *uint8) int32 {
+	return defaultContext.GetFragDataIndex(program, name)
+}
+
+// query the bindings of color numbers to user-defined varying out variables
+func (ctx *Context) GetFragDataLocation(program uint32, name *uint8) int32 {
+	ret, _, _ := syscall.Syscall(ctx.gpGetFragDataLocation, 2, uintptr(program), uintptr(unsafe.Pointer(name)), 0)
+	if hooked {
+		ctx.returned("GetFragDataLocation", (int32)(ret), program, name)
+	}
+	return (int32)(ret)
+}
+
+// query the bindings of color numbers to user-defined varying out variables
+func GetFragDataLocation(program uint32, name *uint8) int32 {
+	return defaultContext.GetFragDataLocation(program, name)
+}
+
+// retrieve information about attachments of a bound framebuffer object
+func (ctx *Context) GetFramebufferAttachmentParameteriv(target uint32, attachment uint32, pname uint32, params *int32) {
+	syscall.Syscall6(ctx.gpGetFramebufferAttachmentParameteriv, 4, uintptr(target), uintptr(attachment), uintptr(pname), uintptr(unsafe.Pointer(params)), 0, 0)
+	if hooked {
+		ctx.called("GetFramebufferAttachmentParameteriv", target, attachment, pname, params)
+	}
+}
+
+// retrieve information about attachments of a bound framebuffer object
+func GetFramebufferAttachmentParameteriv(target uint32, attachment uint32, pname uint32, params *int32) {
+	defaultContext.GetFramebufferAttachmentParameteriv(target, attachment, pname, params)
+}
+
+// retrieve a named parameter from a framebuffer
+func (ctx *Context) GetFramebufferParameteriv(target uint32, pname uint32, params *int32) {
+	syscall.Syscall(ctx.gpGetFramebufferParameteriv, 3, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetFramebufferParameteriv", target, pname, params)
+	}
+}
+
+// retrieve a named parameter from a framebuffer
+func GetFramebufferParameteriv(target uint32, pname uint32, params *int32) {
+	defaultContext.GetFramebufferParameteriv(target, pname, params)
+}
+
+// check if the rendering context has not been lost due to software or hardware issues
+func (ctx *Context) GetGraphicsResetStatus() uint32 {
+	ret, _, _ := syscall.Syscall(ctx.gpGetGraphicsResetStatus, 0, 0, 0, 0)
+	if hooked {
+		ctx.returned("GetGraphicsResetStatus", (uint32)(ret))
+	}
+	return (uint32)(ret)
+}
+
+// check if the rendering context has not been lost due to software or hardware issues
+func GetGraphicsResetStatus() uint32 {
+	return defaultContext.GetGraphicsResetStatus()
+}
+
+func (ctx *Context) GetInteger64i_v(target uint32, index uint32, data *int64) {
+	syscall.Syscall(ctx.gpGetInteger64i_v, 3, uintptr(target), uintptr(index), uintptr(unsafe.Pointer(data)))
+	if hooked {
+		ctx.called("GetInteger64i_v", target, index, data)
+	}
+}
+
+func GetInteger64i_v(target uint32, index uint32, data *int64) {
+	defaultContext.GetInteger64i_v(target, index, data)
+}
+
+func (ctx *Context) GetInteger64v(pname uint32, data *int64) {
+	syscall.Syscall(ctx.gpGetInteger64v, 2, uintptr(pname), uintptr(unsafe.Pointer(data)), 0)
+	if hooked {
+		ctx.called("GetInteger64v", pname, data)
+	}
+}
+
+func GetInteger64v(pname uint32, data *int64) {
+	defaultContext.GetInteger64v(pname, data)
+}
+
+func (ctx *Context) GetIntegeri_v(target uint32, index uint32, data *int32) {
+	syscall.Syscall(ctx.gpGetIntegeri_v, 3, uintptr(target), uintptr(index), uintptr(unsafe.Pointer(data)))
+	if hooked {
+		ctx.called("GetIntegeri_v", target, index, data)
+	}
+}
+
+func GetIntegeri_v(target uint32, index uint32, data *int32) {
+	defaultContext.GetIntegeri_v(target, index, data)
+}
+
+func (ctx *Context) GetIntegerv(pname uint32, data *int32) {
+	syscall.Syscall(ctx.gpGetIntegerv, 2, uintptr(pname), uintptr(unsafe.Pointer(data)), 0)
+	if hooked {
+		ctx.called("GetIntegerv", pname, data)
+	}
+}
+
+func GetIntegerv(pname uint32, data *int32) {
+	defaultContext.GetIntegerv(pname, data)
+}
+
+func (ctx *Context) GetInternalformati64v(target uint32, internalformat uint32, pname uint32, bufSize int32, params *int64) {
+	syscall.Syscall6(ctx.gpGetInternalformati64v, 5, uintptr(target), uintptr(internalformat), uintptr(pname), uintptr(bufSize), uintptr(unsafe.Pointer(params)), 0)
+	if hooked {
+		ctx.called("GetInternalformati64v", target, internalformat, pname, bufSize, params)
+	}
+}
+
+func GetInternalformati64v(target uint32, internalformat uint32, pname uint32, bufSize int32, params *int64) {
+	defaultContext.GetInternalformati64v(target, internalformat, pname, bufSize, params)
+}
+
+// retrieve information about implementation-dependent support for internal formats
+func (ctx *Context) GetInternalformativ(target uint32, internalformat uint32, pname uint32, bufSize int32, params *int32) {
+	syscall.Syscall6(ctx.gpGetInternalformativ, 5, uintptr(target), uintptr(internalformat), uintptr(pname), uintptr(bufSize), uintptr(unsafe.Pointer(params)), 0)
+	if hooked {
+		ctx.called("GetInternalformativ", target, internalformat, pname, bufSize, params)
+	}
+}
+
+// retrieve information about implementation-dependent support for internal formats
+func GetInternalformativ(target uint32, internalformat uint32, pname uint32, bufSize int32, params *int32) {
+	defaultContext.GetInternalformativ(target, internalformat, pname, bufSize, params)
+}
+
+// retrieve the location of a sample
+func (ctx *Context) GetMultisamplefv(pname uint32, index uint32, val *float32) {
+	syscall.Syscall(ctx.gpGetMultisamplefv, 3, uintptr(pname), uintptr(index), uintptr(unsafe.Pointer(val)))
+	if hooked {
+		ctx.called("GetMultisamplefv", pname, index, val)
+	}
+}
+
+// retrieve the location of a sample
+func GetMultisamplefv(pname uint32, index uint32, val *float32) {
+	defaultContext.GetMultisamplefv(pname, index, val)
+}
+
+// return parameters of a buffer object
+func (ctx *Context) GetNamedBufferParameteri64v(buffer uint32, pname uint32, params *int64) {
+	syscall.Syscall(ctx.gpGetNamedBufferParameteri64v, 3, uintptr(buffer), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetNamedBufferParameteri64v", buffer, pname, params)
+	}
+}
+
+// return parameters of a buffer object
+func GetNamedBufferParameteri64v(buffer uint32, pname uint32, params *int64) {
+	defaultContext.GetNamedBufferParameteri64v(buffer, pname, params)
+}
+
+// return parameters of a buffer object
+func (ctx *Context) GetNamedBufferParameteriv(buffer uint32, pname uint32, params *int32) {
+	syscall.Syscall(ctx.gpGetNamedBufferParameteriv, 3, uintptr(buffer), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetNamedBufferParameteriv", buffer, pname, params)
+	}
+}
+
+// return parameters of a buffer object
+func GetNamedBufferParameteriv(buffer uint32, pname uint32, params *int32) {
+	defaultContext.GetNamedBufferParameteriv(buffer, pname, params)
+}
+
+// return the pointer to a mapped buffer object's data store
+func (ctx *Context) GetNamedBufferPointerv(buffer uint32, pname uint32, params *unsafe.Pointer) {
+	syscall.Syscall(ctx.gpGetNamedBufferPointerv, 3, uintptr(buffer), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetNamedBufferPointerv", buffer, pname, params)
+	}
+}
+
+// return the pointer to a mapped buffer object's data store
+func GetNamedBufferPointerv(buffer uint32, pname uint32, params *unsafe.Pointer) {
+	defaultContext.GetNamedBufferPointerv(buffer, pname, params)
+}
+
+// returns a subset of a buffer object's data store
+func (ctx *Context) GetNamedBufferSubData(buffer uint32, offset int, size int, data unsafe.Pointer) {
+	syscall.Syscall6(ctx.gpGetNamedBufferSubData, 4, uintptr(buffer), uintptr(offset), uintptr(size), uintptr(data), 0, 0)
+	if hooked {
+		ctx.called("GetNamedBufferSubData", buffer, offset, size, data)
+	}
+}
+
+// returns a subset of a buffer object's data store
+func GetNamedBufferSubData(buffer uint32, offset int, size int, data unsafe.Pointer) {
+	defaultContext.GetNamedBufferSubData(buffer, offset, size, data)
+}
+
+// retrieve information about attachments of a framebuffer object
+func (ctx *Context) GetNamedFramebufferAttachmentParameteriv(framebuffer uint32, attachment uint32, pname uint32, params *int32) {
+	syscall.Syscall6(ctx.gpGetNamedFramebufferAttachmentParameteriv, 4, uintptr(framebuffer), uintptr(attachment), uintptr(pname), uintptr(unsafe.Pointer(params)), 0, 0)
+	if hooked {
+		ctx.called("GetNamedFramebufferAttachmentParameteriv", framebuffer, attachment, pname, params)
+	}
+}
+
+// retrieve information about attachments of a framebuffer object
+func GetNamedFramebufferAttachmentParameteriv(framebuffer uint32, attachment uint32, pname uint32, params *int32) {
+	defaultContext.GetNamedFramebufferAttachmentParameteriv(framebuffer, attachment, pname, params)
+}
+
+// query a named parameter of a framebuffer object
+func (ctx *Context) GetNamedFramebufferParameteriv(framebuffer uint32, pname uint32, param *int32) {
+	syscall.Syscall(ctx.gpGetNamedFramebufferParameteriv, 3, uintptr(framebuffer), uintptr(pname), uintptr(unsafe.Pointer(param)))
+	if hooked {
+		ctx.called("GetNamedFramebufferParameteriv", framebuffer, pname, param)
+	}
+}
+
+// query a named parameter of a framebuffer object
+func GetNamedFramebufferParameteriv(framebuffer uint32, pname uint32, param *int32) {
+	defaultContext.GetNamedFramebufferParameteriv(framebuffer, pname, param)
+}
+
+// query a named parameter of a renderbuffer object
+func (ctx *Context) GetNamedRenderbufferParameteriv(renderbuffer uint32, pname uint32, params *int32) {
+	syscall.Syscall(ctx.gpGetNamedRenderbufferParameteriv, 3, uintptr(renderbuffer), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetNamedRenderbufferParameteriv", renderbuffer, pname, params)
+	}
+}
+
+// query a named parameter of a renderbuffer object
+func GetNamedRenderbufferParameteriv(renderbuffer uint32, pname uint32, params *int32) {
+	defaultContext.GetNamedRenderbufferParameteriv(renderbuffer, pname, params)
+}
+
+// retrieve the label of a named object identified within a namespace
+func (ctx *Context) GetObjectLabel(identifier uint32, name uint32, bufSize int32, length *int32, label *uint8) {
+	syscall.Syscall6(ctx.gpGetObjectLabel, 5, uintptr(identifier), uintptr(name), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(label)), 0)
+	if hooked {
+		ctx.called("GetObjectLabel", identifier, name, bufSize, length, label)
+	}
+}
+
+// retrieve the label of a named object identified within a namespace
+func GetObjectLabel(identifier uint32, name uint32, bufSize int32, length *int32, label *uint8) {
+	defaultContext.GetObjectLabel(identifier, name, bufSize, length, label)
+}
+
+// retrieve the label of a sync object identified by a pointer
+func (ctx *Context) GetObjectPtrLabel(ptr unsafe.Pointer, bufSize int32, length *int32, label *uint8) {
+	syscall.Syscall6(ctx.gpGetObjectPtrLabel, 4, uintptr(ptr), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(label)), 0, 0)
+	if hooked {
+		ctx.called("GetObjectPtrLabel", ptr, bufSize, length, label)
+	}
+}
+
+// retrieve the label of a sync object identified by a pointer
+func GetObjectPtrLabel(ptr unsafe.Pointer, bufSize int32, length *int32, label *uint8) {
+	defaultContext.GetObjectPtrLabel(ptr, bufSize, length, label)
+}
+
+// return the address of the specified pointer
+func (ctx *Context) GetPointerv(pname uint32, params *unsafe.Pointer) {
+	syscall.Syscall(ctx.gpGetPointerv, 2, uintptr(pname), uintptr(unsafe.Pointer(params)), 0)
+	if hooked {
+		ctx.called("GetPointerv", pname, params)
+	}
+}
+
+// return the address of the specified pointer
+func GetPointerv(pname uint32, params *unsafe.Pointer) {
+	defaultContext.GetPointerv(pname, params)
+}
+
+// return a binary representation of a program object's compiled and linked executable source
+func (ctx *Context) GetProgramBinary(program uint32, bufSize int32, length *int32, binaryFormat *uint32, binary unsafe.Pointer) {
+	syscall.Syscall6(ctx.gpGetProgramBinary, 5, uintptr(program), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(binaryFormat)), uintptr(binary), 0)
+	if hooked {
+		ctx.called("GetProgramBinary", program, bufSize, length, binaryFormat, binary)
+	}
+}
+
+// return a binary representation of a program object's compiled and linked executable source
+func GetProgramBinary(program uint32, bufSize int32, length *int32, binaryFormat *uint32, binary unsafe.Pointer) {
+	defaultContext.GetProgramBinary(program, bufSize, length, binaryFormat, binary)
+}
+
+// Returns the information log for a program object
+func (ctx *Context) GetProgramInfoLog(program uint32, bufSize int32, length *int32, infoLog *uint8) {
+	syscall.Syscall6(ctx.gpGetProgramInfoLog, 4, uintptr(program), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(infoLog)), 0, 0)
+	if hooked {
+		ctx.called("GetProgramInfoLog", program, bufSize, length, infoLog)
+	}
+}
+
+// Returns the information log for a program object
+func GetProgramInfoLog(program uint32, bufSize int32, length *int32, infoLog *uint8) {
+	defaultContext.GetProgramInfoLog(program, bufSize, length, infoLog)
+}
+
+func (ctx *Context) GetProgramInterfaceiv(program uint32, programInterface uint32, pname uint32, params *int32) {
+	syscall.Syscall6(ctx.gpGetProgramInterfaceiv, 4, uintptr(program), uintptr(programInterface), uintptr(pname), uintptr(unsafe.Pointer(params)), 0, 0)
+	if hooked {
+		ctx.called("GetProgramInterfaceiv", program, programInterface, pname, params)
+	}
+}
+
+func GetProgramInterfaceiv(program uint32, programInterface uint32, pname uint32, params *int32) {
+	defaultContext.GetProgramInterfaceiv(program, programInterface, pname, params)
+}
+
+// retrieve the info log string from a program pipeline object
+func (ctx *Context) GetProgramPipelineInfoLog(pipeline uint32, bufSize int32, length *int32, infoLog *uint8) {
+	syscall.Syscall6(ctx.gpGetProgramPipelineInfoLog, 4, uintptr(pipeline), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(infoLog)), 0, 0)
+	if hooked {
+		ctx.called("GetProgramPipelineInfoLog", pipeline, bufSize, length, infoLog)
+	}
+}
+
+// retrieve the info log string from a program pipeline object
+func GetProgramPipelineInfoLog(pipeline uint32, bufSize int32, length *int32, infoLog *uint8) {
+	defaultContext.GetProgramPipelineInfoLog(pipeline, bufSize, length, infoLog)
+}
+
+func (ctx *Context) GetProgramPipelineiv(pipeline uint32, pname uint32, params *int32) {
+	syscall.Syscall(ctx.gpGetProgramPipelineiv, 3, uintptr(pipeline), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetProgramPipelineiv", pipeline, pname, params)
+	}
+}
+
+func GetProgramPipelineiv(pipeline uint32, pname uint32, params *int32) {
+	defaultContext.GetProgramPipelineiv(pipeline, pname, params)
+}
+
+// query the index of a named resource within a program
+func (ctx *Context) GetProgramResourceIndex(program uint32, programInterface uint32, name *uint8) uint32 {
+	ret, _, _ := syscall.Syscall(ctx.gpGetProgramResourceIndex, 3, uintptr(program), uintptr(programInterface), uintptr(unsafe.Pointer(name)))
+	if hooked {
+		ctx.returned("GetProgramResourceIndex", (uint32)(ret), program, programInterface, name)
+	}
+	return (uint32)(ret)
+}
+
+// query the index of a named resource within a program
+func GetProgramResourceIndex(program uint32, programInterface uint32, name *uint8) uint32 {
+	return defaultContext.GetProgramResourceIndex(program, programInterface, name)
+}
+
+// query the location of a named resource within a program
+func (ctx *Context) GetProgramResourceLocation(program uint32, programInterface uint32, name *uint8) int32 {
+	ret, _, _ := syscall.Syscall(ctx.gpGetProgramResourceLocation, 3, uintptr(program), uintptr(programInterface), uintptr(unsafe.Pointer(name)))
+	if hooked {
+		ctx.returned("GetProgramResourceLocation", (int32)(ret), program, programInterface, name)
+	}
+	return (int32)(ret)
+}
+
+// query the location of a named resource within a program
+func GetProgramResourceLocation(program uint32, programInterface uint32, name *uint8) int32 {
+	return defaultContext.GetProgramResourceLocation(program, programInterface, name)
+}
+
+// query the fragment color index of a named variable within a program
+func (ctx *Context) GetProgramResourceLocationIndex(program uint32, programInterface uint32, name *uint8) int32 {
+	ret, _, _ := syscall.Syscall(ctx.gpGetProgramResourceLocationIndex, 3, uintptr(program), uintptr(programInterface), uintptr(unsafe.Pointer(name)))
+	if hooked {
+		ctx.returned("GetProgramResourceLocationIndex", (int32)(ret), program, programInterface, name)
+	}
+	return (int32)(ret)
+}
+
+// query the fragment color index of a named variable within a program
+func GetProgramResourceLocationIndex(program uint32, programInterface uint32, name *uint8) int32 {
+	return defaultContext.GetProgramResourceLocationIndex(program, programInterface, name)
+}
+
+// query the name of an indexed resource within a program
+func (ctx *Context) GetProgramResourceName(program uint32, programInterface uint32, index uint32, bufSize int32, length *int32, name *uint8) {
+	syscall.Syscall6(ctx.gpGetProgramResourceName, 6, uintptr(program), uintptr(programInterface), uintptr(index), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(name)))
+	if hooked {
+		ctx.called("GetProgramResourceName", program, programInterface, index, bufSize, length, name)
+	}
+}
+
+// query the name of an indexed resource within a program
+func GetProgramResourceName(program uint32, programInterface uint32, index uint32, bufSize int32, length *int32, name *uint8) {
+	defaultContext.GetProgramResourceName(program, programInterface, index, bufSize, length, name)
+}
+
+func (ctx *Context) GetProgramResourceiv(program uint32, programInterface uint32, index uint32, propCount int32, props *uint32, bufSize int32, length *int32, params *int32) {
+	syscall.Syscall9(ctx.gpGetProgramResourceiv, 8, uintptr(program), uintptr(programInterface), uintptr(index), uintptr(propCount), uintptr(unsafe.Pointer(props)), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(params)), 0)
+	if hooked {
+		ctx.called("GetProgramResourceiv", program, programInterface, index, propCount, props, bufSize, length, params)
+	}
+}
+
+func GetProgramResourceiv(program uint32, programInterface uint32, index uint32, propCount int32, props *uint32, bufSize int32, length *int32, params *int32) {
+	defaultContext.GetProgramResourceiv(program, programInterface, index, propCount, props, bufSize, length, params)
+}
+
+func (ctx *Context) GetProgramStageiv(program uint32, shadertype uint32, pname uint32, values *int32) {
+	syscall.Syscall6(ctx.gpGetProgramStageiv, 4, uintptr(program), uintptr(shadertype), uintptr(pname), uintptr(unsafe.Pointer(values)), 0, 0)
+	if hooked {
+		ctx.called("GetProgramStageiv", program, shadertype, pname, values)
+	}
+}
+
+func GetProgramStageiv(program uint32, shadertype uint32, pname uint32, values *int32) {
+	defaultContext.GetProgramStageiv(program, shadertype, pname, values)
+}
+
+// Returns a parameter from a program object
+func (ctx *Context) GetProgramiv(program uint32, pname uint32, params *int32) {
+	syscall.Syscall(ctx.gpGetProgramiv, 3, uintptr(program), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetProgramiv", program, pname, params)
+	}
+}
+
+// Returns a parameter from a program object
+func GetProgramiv(program uint32, pname uint32, params *int32) {
+	defaultContext.GetProgramiv(program, pname, params)
+}
+
+func (ctx *Context) GetQueryBufferObjecti64v(id uint32, buffer uint32, pname uint32, offset int) {
+	syscall.Syscall6(ctx.gpGetQueryBufferObjecti64v, 4, uintptr(id), uintptr(buffer), uintptr(pname), uintptr(offset), 0, 0)
+	if hooked {
+		ctx.called("GetQueryBufferObjecti64v", id, buffer, pname, offset)
+	}
+}
+
+func GetQueryBufferObjecti64v(id uint32, buffer uint32, pname uint32, offset int) {
+	defaultContext.GetQueryBufferObjecti64v(id, buffer, pname, offset)
+}
+
+func (ctx *Context) GetQueryBufferObjectiv(id uint32, buffer uint32, pname uint32, offset int) {
+	syscall.Syscall6(ctx.gpGetQueryBufferObjectiv, 4, uintptr(id), uintptr(buffer), uintptr(pname), uintptr(offset), 0, 0)
+	if hooked {
+		ctx.called("GetQueryBufferObjectiv", id, buffer, pname, offset)
+	}
+}
+
+func GetQueryBufferObjectiv(id uint32, buffer uint32, pname uint32, offset int) {
+	defaultContext.GetQueryBufferObjectiv(id, buffer, pname, offset)
+}
+
+func (ctx *Context) GetQueryBufferObjectui64v(id uint32, buffer uint32, pname uint32, offset int) {
+	syscall.Syscall6(ctx.gpGetQueryBufferObjectui64v, 4, uintptr(id), uintptr(buffer), uintptr(pname), uintptr(offset), 0, 0)
+	if hooked {
+		ctx.called("GetQueryBufferObjectui64v", id, buffer, pname, offset)
+	}
+}
+
+func GetQueryBufferObjectui64v(id uint32, buffer uint32, pname uint32, offset int) {
+	defaultContext.GetQueryBufferObjectui64v(id, buffer, pname, offset)
+}
+
+func (ctx *Context) GetQueryBufferObjectuiv(id uint32, buffer uint32, pname uint32, offset int) {
+	syscall.Syscall6(ctx.gpGetQueryBufferObjectuiv, 4, uintptr(id), uintptr(buffer), uintptr(pname), uintptr(offset), 0, 0)
+	if hooked {
+		ctx.called("GetQueryBufferObjectuiv", id, buffer, pname, offset)
+	}
+}
+
+func GetQueryBufferObjectuiv(id uint32, buffer uint32, pname uint32, offset int) {
+	defaultContext.GetQueryBufferObjectuiv(id, buffer, pname, offset)
+}
+
+// return parameters of an indexed query object target
+func (ctx *Context) GetQueryIndexediv(target uint32, index uint32, pname uint32, params *int32) {
+	syscall.Syscall6(ctx.gpGetQueryIndexediv, 4, uintptr(target), uintptr(index), uintptr(pname), uintptr(unsafe.Pointer(params)), 0, 0)
+	if hooked {
+		ctx.called("GetQueryIndexediv", target, index, pname, params)
+	}
+}
+
+// return parameters of an indexed query object target
+func GetQueryIndexediv(target uint32, index uint32, pname uint32, params *int32) {
+	defaultContext.GetQueryIndexediv(target, index, pname, params)
+}
+
+func (ctx *Context) GetQueryObjecti64v(id uint32, pname uint32, params *int64) {
+	syscall.Syscall(ctx.gpGetQueryObjecti64v, 3, uintptr(id), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetQueryObjecti64v", id, pname, params)
+	}
+}
+
+func GetQueryObjecti64v(id uint32, pname uint32, params *int64) {
+	defaultContext.GetQueryObjecti64v(id, pname, params)
+}
+
+func (ctx *Context) GetQueryObjectiv(id uint32, pname uint32, params *int32) {
+	syscall.Syscall(ctx.gpGetQueryObjectiv, 3, uintptr(id), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetQueryObjectiv", id, pname, params)
+	}
+}
+
+func GetQueryObjectiv(id uint32, pname uint32, params *int32) {
+	defaultContext.GetQueryObjectiv(id, pname, params)
+}
+
+func (ctx *Context) GetQueryObjectui64v(id uint32, pname uint32, params *uint64) {
+	syscall.Syscall(ctx.gpGetQueryObjectui64v, 3, uintptr(id), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetQueryObjectui64v", id, pname, params)
+	}
+}
+
+func GetQueryObjectui64v(id uint32, pname uint32, params *uint64) {
+	defaultContext.GetQueryObjectui64v(id, pname, params)
+}
+
+// return parameters of a query object
+func (ctx *Context) GetQueryObjectuiv(id uint32, pname uint32, params *uint32) {
+	syscall.Syscall(ctx.gpGetQueryObjectuiv, 3, uintptr(id), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetQueryObjectuiv", id, pname, params)
+	}
+}
+
+// return parameters of a query object
+func GetQueryObjectuiv(id uint32, pname uint32, params *uint32) {
+	defaultContext.GetQueryObjectuiv(id, pname, params)
+}
+
+// return parameters of a query object target
+func (ctx *Context) GetQueryiv(target uint32, pname uint32, params *int32) {
+	syscall.Syscall(ctx.gpGetQueryiv, 3, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetQueryiv", target, pname, params)
+	}
+}
+
+// return parameters of a query object target
+func GetQueryiv(target uint32, pname uint32, params *int32) {
+	defaultContext.GetQueryiv(target, pname, params)
+}
+
+// retrieve information about a bound renderbuffer object
+func (ctx *Context) GetRenderbufferParameteriv(target uint32, pname uint32, params *int32) {
+	syscall.Syscall(ctx.gpGetRenderbufferParameteriv, 3, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetRenderbufferParameteriv", target, pname, params)
+	}
+}
+
+// retrieve information about a bound renderbuffer object
+func GetRenderbufferParameteriv(target uint32, pname uint32, params *int32) {
+	defaultContext.GetRenderbufferParameteriv(target, pname, params)
+}
+
+func (ctx *Context) GetSamplerParameterIiv(sampler uint32, pname uint32, params *int32) {
+	syscall.Syscall(ctx.gpGetSamplerParameterIiv, 3, uintptr(sampler), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetSamplerParameterIiv", sampler, pname, params)
+	}
+}
+
+func GetSamplerParameterIiv(sampler uint32, pname uint32, params *int32) {
+	defaultContext.GetSamplerParameterIiv(sampler, pname, params)
+}
+
+func (ctx *Context) GetSamplerParameterIuiv(sampler uint32, pname uint32, params *uint32) {
+	syscall.Syscall(ctx.gpGetSamplerParameterIuiv, 3, uintptr(sampler), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetSamplerParameterIuiv", sampler, pname, params)
+	}
+}
+
+func GetSamplerParameterIuiv(sampler uint32, pname uint32, params *uint32) {
+	defaultContext.GetSamplerParameterIuiv(sampler, pname, params)
+}
+
+func (ctx *Context) GetSamplerParameterfv(sampler uint32, pname uint32, params *float32) {
+	syscall.Syscall(ctx.gpGetSamplerParameterfv, 3, uintptr(sampler), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetSamplerParameterfv", sampler, pname, params)
+	}
+}
+
+func GetSamplerParameterfv(sampler uint32, pname uint32, params *float32) {
+	defaultContext.GetSamplerParameterfv(sampler, pname, params)
+}
+
+func (ctx *Context) GetSamplerParameteriv(sampler uint32, pname uint32, params *int32) {
+	syscall.Syscall(ctx.gpGetSamplerParameteriv, 3, uintptr(sampler), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetSamplerParameteriv", sampler, pname, params)
+	}
+}
+
+func GetSamplerParameteriv(sampler uint32, pname uint32, params *int32) {
+	defaultContext.GetSamplerParameteriv(sampler, pname, params)
+}
+
+// Returns the information log for a shader object
+func (ctx *Context) GetShaderInfoLog(shader uint32, bufSize int32, length *int32, infoLog *uint8) {
+	syscall.Syscall6(ctx.gpGetShaderInfoLog, 4, uintptr(shader), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(infoLog)), 0, 0)
+	if hooked {
+		ctx.called("GetShaderInfoLog", shader, bufSize, length, infoLog)
+	}
+}
+
+// Returns the information log for a shader object
+func GetShaderInfoLog(shader uint32, bufSize int32, length *int32, infoLog *uint8) {
+	defaultContext.GetShaderInfoLog(shader, bufSize, length, infoLog)
+}
+
+// retrieve the range and precision for numeric formats supported by the shader compiler
+func (ctx *Context) GetShaderPrecisionFormat(shadertype uint32, precisiontype uint32, xrange *int32, precision *int32) {
+	syscall.Syscall6(ctx.gpGetShaderPrecisionFormat, 4, uintptr(shadertype), uintptr(precisiontype), uintptr(unsafe.Pointer(xrange)), uintptr(unsafe.Pointer(precision)), 0, 0)
+	if hooked {
+		ctx.called("GetShaderPrecisionFormat", shadertype, precisiontype, xrange, precision)
+	}
+}
+
+// retrieve the range and precision for numeric formats supported by the shader compiler
+func GetShaderPrecisionFormat(shadertype uint32, precisiontype uint32, xrange *int32, precision *int32) {
+	defaultContext.GetShaderPrecisionFormat(shadertype, precisiontype, xrange, precision)
+}
+
+// Returns the source code string from a shader object
+func (ctx *Context) GetShaderSource(shader uint32, bufSize int32, length *int32, source *uint8) {
+	syscall.Syscall6(ctx.gpGetShaderSource, 4, uintptr(shader), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(source)), 0, 0)
+	if hooked {
+		ctx.called("GetShaderSource", shader, bufSize, length, source)
+	}
+}
+
+// Returns the source code string from a shader object
+func GetShaderSource(shader uint32, bufSize int32, length *int32, source *uint8) {
+	defaultContext.GetShaderSource(shader, bufSize, length, source)
+}
+
+// Returns a parameter from a shader object
+func (ctx *Context) GetShaderiv(shader uint32, pname uint32, params *int32) {
+	syscall.Syscall(ctx.gpGetShaderiv, 3, uintptr(shader), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetShaderiv", shader, pname, params)
+	}
+}
+
+// Returns a parameter from a shader object
+func GetShaderiv(shader uint32, pname uint32, params *int32) {
+	defaultContext.GetShaderiv(shader, pname, params)
+}
+
+// return a string describing the current GL connection
+func (ctx *Context) GetString(name uint32) *uint8 {
+	ret, _, _ := syscall.Syscall(ctx.gpGetString, 1, uintptr(name), 0, 0)
+	if hooked {
+		ctx.called("GetString", name)
+	}
+	return (*uint8)(unsafe.Pointer(ret))
+}
+
+// return a string describing the current GL connection
+func GetString(name uint32) *uint8 {
+	return defaultContext.GetString(name)
+}
+
+func (ctx *Context) GetStringi(name uint32, index uint32) *uint8 {
+	ret, _, _ := syscall.Syscall(ctx.gpGetStringi, 2, uintptr(name), uintptr(index), 0)
+	if hooked {
+		ctx.called("GetStringi", name, index)
+	}
+	return (*uint8)(unsafe.Pointer(ret))
+}
+
+func GetStringi(name uint32, index uint32) *uint8 {
+	return defaultContext.GetStringi(name, index)
+}
+
+// retrieve the index of a subroutine uniform of a given shader stage within a program
+func (ctx *Context) GetSubroutineIndex(program uint32, shadertype uint32, name *uint8) uint32 {
+	ret, _, _ := syscall.Syscall(ctx.gpGetSubroutineIndex, 3, uintptr(program), uintptr(shadertype), uintptr(unsafe.Pointer(name)))
+	if hooked {
+		ctx.returned("GetSubroutineIndex", (uint32)(ret), program, shadertype, name)
+	}
+	return (uint32)(ret)
+}
+
+// retrieve the index of a subroutine uniform of a given shader stage within a program
+func GetSubroutineIndex(program uint32, shadertype uint32, name *uint8) uint32 {
+	return defaultContext.GetSubroutineIndex(program, shadertype, name)
+}
+
+// retrieve the location of a subroutine uniform of a given shader stage within a program
+func (ctx *Context) GetSubroutineUniformLocation(program uint32, shadertype uint32, name *uint8) int32 {
+	ret, _, _ := syscall.Syscall(ctx.gpGetSubroutineUniformLocation, 3, uintptr(program), uintptr(shadertype), uintptr(unsafe.Pointer(name)))
+	if hooked {
+		ctx.returned("GetSubroutineUniformLocation", (int32)(ret), program, shadertype, name)
+	}
+	return (int32)(ret)
+}
+
+// retrieve the location of a subroutine uniform of a given shader stage within a program
+func GetSubroutineUniformLocation(program uint32, shadertype uint32, name *uint8) int32 {
+	return defaultContext.GetSubroutineUniformLocation(program, shadertype, name)
+}
+
+// query the properties of a sync object
+func (ctx *Context) GetSynciv(sync uintptr, pname uint32, bufSize int32, length *int32, values *int32) {
+	syscall.Syscall6(ctx.gpGetSynciv, 5, uintptr(sync), uintptr(pname), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(values)), 0)
+	if hooked {
+		ctx.called("GetSynciv", sync, pname, bufSize, length, values)
+	}
+}
+
+// query the properties of a sync object
+func GetSynciv(sync uintptr, pname uint32, bufSize int32, length *int32, values *int32) {
+	defaultContext.GetSynciv(sync, pname, bufSize, length, values)
+}
+
+// return a texture image
+func (ctx *Context) GetTexImage(target uint32, level int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	syscall.Syscall6(ctx.gpGetTexImage, 5, uintptr(target), uintptr(level), uintptr(format), uintptr(xtype), uintptr(pixels), 0)
+	if hooked {
+		ctx.called("GetTexImage", target, level, format, xtype, pixels)
+	}
+}
+
+// return a texture image
+func GetTexImage(target uint32, level int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	defaultContext.GetTexImage(target, level, format, xtype, pixels)
+}
+
+func (ctx *Context) GetTexLevelParameterfv(target uint32, level int32, pname uint32, params *float32) {
+	syscall.Syscall6(ctx.gpGetTexLevelParameterfv, 4, uintptr(target), uintptr(level), uintptr(pname), uintptr(unsafe.Pointer(params)), 0, 0)
+	if hooked {
+		ctx.called("GetTexLevelParameterfv", target, level, pname, params)
+	}
+}
+
+func GetTexLevelParameterfv(target uint32, level int32, pname uint32, params *float32) {
+	defaultContext.GetTexLevelParameterfv(target, level, pname, params)
+}
+
+func (ctx *Context) GetTexLevelParameteriv(target uint32, level int32, pname uint32, params *int32) {
+	syscall.Syscall6(ctx.gpGetTexLevelParameteriv, 4, uintptr(target), uintptr(level), uintptr(pname), uintptr(unsafe.Pointer(params)), 0, 0)
+	if hooked {
+		ctx.called("GetTexLevelParameteriv", target, level, pname, params)
+	}
+}
+
+func GetTexLevelParameteriv(target uint32, level int32, pname uint32, params *int32) {
+	defaultContext.GetTexLevelParameteriv(target, level, pname, params)
+}
+
+func (ctx *Context) GetTexParameterIiv(target uint32, pname uint32, params *int32) {
+	syscall.Syscall(ctx.gpGetTexParameterIiv, 3, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetTexParameterIiv", target, pname, params)
+	}
+}
+
+func GetTexParameterIiv(target uint32, pname uint32, params *int32) {
+	defaultContext.GetTexParameterIiv(target, pname, params)
+}
+
+func (ctx *Context) GetTexParameterIuiv(target uint32, pname uint32, params *uint32) {
+	syscall.Syscall(ctx.gpGetTexParameterIuiv, 3, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetTexParameterIuiv", target, pname, params)
+	}
+}
+
+func GetTexParameterIuiv(target uint32, pname uint32, params *uint32) {
+	defaultContext.GetTexParameterIuiv(target, pname, params)
+}
+
+func (ctx *Context) GetTexParameterfv(target uint32, pname uint32, params *float32) {
+	syscall.Syscall(ctx.gpGetTexParameterfv, 3, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetTexParameterfv", target, pname, params)
+	}
+}
+
+func GetTexParameterfv(target uint32, pname uint32, params *float32) {
+	defaultContext.GetTexParameterfv(target, pname, params)
+}
+
+func (ctx *Context) GetTexParameteriv(target uint32, pname uint32, params *int32) {
+	syscall.Syscall(ctx.gpGetTexParameteriv, 3, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetTexParameteriv", target, pname, params)
+	}
+}
+
+func GetTexParameteriv(target uint32, pname uint32, params *int32) {
+	defaultContext.GetTexParameteriv(target, pname, params)
+}
+
+// return a texture image
+func (ctx *Context) GetTextureImage(texture uint32, level int32, format uint32, xtype uint32, bufSize int32, pixels unsafe.Pointer) {
+	syscall.Syscall6(ctx.gpGetTextureImage, 6, uintptr(texture), uintptr(level), uintptr(format), uintptr(xtype), uintptr(bufSize), uintptr(pixels))
+	if hooked {
+		ctx.called("GetTextureImage", texture, level, format, xtype, bufSize, pixels)
+	}
+}
+
+// return a texture image
+func GetTextureImage(texture uint32, level int32, format uint32, xtype uint32, bufSize int32, pixels unsafe.Pointer) {
+	defaultContext.GetTextureImage(texture, level, format, xtype, bufSize, pixels)
+}
+
+func (ctx *Context) GetTextureLevelParameterfv(texture uint32, level int32, pname uint32, params *float32) {
+	syscall.Syscall6(ctx.gpGetTextureLevelParameterfv, 4, uintptr(texture), uintptr(level), uintptr(pname), uintptr(unsafe.Pointer(params)), 0, 0)
+	if hooked {
+		ctx.called("GetTextureLevelParameterfv", texture, level, pname, params)
+	}
+}
+
+func GetTextureLevelParameterfv(texture uint32, level int32, pname uint32, params *float32) {
+	defaultContext.GetTextureLevelParameterfv(texture, level, pname, params)
+}
+
+func (ctx *Context) GetTextureLevelParameteriv(texture uint32, level int32, pname uint32, params *int32) {
+	syscall.Syscall6(ctx.gpGetTextureLevelParameteriv, 4, uintptr(texture), uintptr(level), uintptr(pname), uintptr(unsafe.Pointer(params)), 0, 0)
+	if hooked {
+		ctx.called("GetTextureLevelParameteriv", texture, level, pname, params)
+	}
+}
+
+func GetTextureLevelParameteriv(texture uint32, level int32, pname uint32, params *int32) {
+	defaultContext.GetTextureLevelParameteriv(texture, level, pname, params)
+}
+
+func (ctx *Context) GetTextureParameterIiv(texture uint32, pname uint32, params *int32) {
+	syscall.Syscall(ctx.gpGetTextureParameterIiv, 3, uintptr(texture), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetTextureParameterIiv", texture, pname, params)
+	}
+}
+
+func GetTextureParameterIiv(texture uint32, pname uint32, params *int32) {
+	defaultContext.GetTextureParameterIiv(texture, pname, params)
+}
+
+func (ctx *Context) GetTextureParameterIuiv(texture uint32, pname uint32, params *uint32) {
+	syscall.Syscall(ctx.gpGetTextureParameterIuiv, 3, uintptr(texture), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetTextureParameterIuiv", texture, pname, params)
+	}
+}
+
+func GetTextureParameterIuiv(texture uint32, pname uint32, params *uint32) {
+	defaultContext.GetTextureParameterIuiv(texture, pname, params)
+}
+
+func (ctx *Context) GetTextureParameterfv(texture uint32, pname uint32, params *float32) {
+	syscall.Syscall(ctx.gpGetTextureParameterfv, 3, uintptr(texture), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetTextureParameterfv", texture, pname, params)
+	}
+}
+
+func GetTextureParameterfv(texture uint32, pname uint32, params *float32) {
+	defaultContext.GetTextureParameterfv(texture, pname, params)
+}
+
+func (ctx *Context) GetTextureParameteriv(texture uint32, pname uint32, params *int32) {
+	syscall.Syscall(ctx.gpGetTextureParameteriv, 3, uintptr(texture), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetTextureParameteriv", texture, pname, params)
+	}
+}
+
+func GetTextureParameteriv(texture uint32, pname uint32, params *int32) {
+	defaultContext.GetTextureParameteriv(texture, pname, params)
+}
+
+// retrieve a sub-region of a texture image from a texture     object
+func (ctx *Context) GetTextureSubImage(texture uint32, level int32, xoffset int32, yoffset int32, zoffset int32, width int32, height int32, depth int32, format uint32, xtype uint32, bufSize int32, pixels unsafe.Pointer) {
+	syscall.Syscall12(ctx.gpGetTextureSubImage, 12, uintptr(texture), uintptr(level), uintptr(xoffset), uintptr(yoffset), uintptr(zoffset), uintptr(width), uintptr(height), uintptr(depth), uintptr(format), uintptr(xtype), uintptr(bufSize), uintptr(pixels))
+	if hooked {
+		ctx.called("GetTextureSubImage", texture, level, xoffset, yoffset, zoffset, width, height, depth, format, xtype, bufSize, pixels)
+	}
+}
+
+// retrieve a sub-region of a texture image from a texture     object
+func GetTextureSubImage(texture uint32, level int32, xoffset int32, yoffset int32, zoffset int32, width int32, height int32, depth int32, format uint32, xtype uint32, bufSize int32, pixels unsafe.Pointer) {
+	defaultContext.GetTextureSubImage(texture, level, xoffset, yoffset, zoffset, width, height, depth, format, xtype, bufSize, pixels)
+}
+
+// retrieve information about varying variables selected for transform feedback
+func (ctx *Context) GetTransformFeedbackVarying(program uint32, index uint32, bufSize int32, length *int32, size *int32, xtype *uint32, name *uint8) {
+	syscall.Syscall9(ctx.gpGetTransformFeedbackVarying, 7, uintptr(program), uintptr(index), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(size)), uintptr(unsafe.Pointer(xtype)), uintptr(unsafe.Pointer(name)), 0, 0)
+	if hooked {
+		ctx.called("GetTransformFeedbackVarying", program, index, bufSize, length, size, xtype, name)
+	}
+}
+
+// retrieve information about varying variables selected for transform feedback
+func GetTransformFeedbackVarying(program uint32, index uint32, bufSize int32, length *int32, size *int32, xtype *uint32, name *uint8) {
+	defaultContext.GetTransformFeedbackVarying(program, index, bufSize, length, size, xtype, name)
+}
+
+func (ctx *Context) GetTransformFeedbacki64_v(xfb uint32, pname uint32, index uint32, param *int64) {
+	syscall.Syscall6(ctx.gpGetTransformFeedbacki64_v, 4, uintptr(xfb), uintptr(pname), uintptr(index), uintptr(unsafe.Pointer(param)), 0, 0)
+	if hooked {
+		ctx.called("GetTransformFeedbacki64_v", xfb, pname, index, param)
+	}
+}
+
+func GetTransformFeedbacki64_v(xfb uint32, pname uint32, index uint32, param *int64) {
+	defaultContext.GetTransformFeedbacki64_v(xfb, pname, index, param)
+}
+
+func (ctx *Context) GetTransformFeedbacki_v(xfb uint32, pname uint32, index uint32, param *int32) {
+	syscall.Syscall6(ctx.gpGetTransformFeedbacki_v, 4, uintptr(xfb), uintptr(pname), uintptr(index), uintptr(unsafe.Pointer(param)), 0, 0)
+	if hooked {
+		ctx.called("GetTransformFeedbacki_v", xfb, pname, index, param)
+	}
+}
+
+func GetTransformFeedbacki_v(xfb uint32, pname uint32, index uint32, param *int32) {
+	defaultContext.GetTransformFeedbacki_v(xfb, pname, index, param)
+}
+
+// query the state of a transform feedback object.
+func (ctx *Context) GetTransformFeedbackiv(xfb uint32, pname uint32, param *int32) {
+	syscall.Syscall(ctx.gpGetTransformFeedbackiv, 3, uintptr(xfb), uintptr(pname), uintptr(unsafe.Pointer(param)))
+	if hooked {
+		ctx.called("GetTransformFeedbackiv", xfb, pname, param)
+	}
+}
+
+// query the state of a transform feedback object.
+func GetTransformFeedbackiv(xfb uint32, pname uint32, param *int32) {
+	defaultContext.GetTransformFeedbackiv(xfb, pname, param)
+}
+
+// retrieve the index of a named uniform block
+func (ctx *Context) GetUniformBlockIndex(program uint32, uniformBlockName *uint8) uint32 {
+	ret, _, _ := syscall.Syscall(ctx.gpGetUniformBlockIndex, 2, uintptr(program), uintptr(unsafe.Pointer(uniformBlockName)), 0)
+	if hooked {
+		ctx.returned("GetUniformBlockIndex", (uint32)(ret), program, uniformBlockName)
+	}
+	return (uint32)(ret)
+}
+
+// retrieve the index of a named uniform block
+func GetUniformBlockIndex(program uint32, uniformBlockName *uint8) uint32 {
+	return defaultContext.GetUniformBlockIndex(program, uniformBlockName)
+}
+
+// retrieve the index of a named uniform block
+func (ctx *Context) GetUniformIndices(program uint32, uniformCount int32, uniformNames **uint8, uniformIndices *uint32) {
+	syscall.Syscall6(ctx.gpGetUniformIndices, 4, uintptr(program), uintptr(uniformCount), uintptr(unsafe.Pointer(uniformNames)), uintptr(unsafe.Pointer(uniformIndices)), 0, 0)
+	if hooked {
+		ctx.called("GetUniformIndices", program, uniformCount, uniformNames, uniformIndices)
+	}
+}
+
+// retrieve the index of a named uniform block
+func GetUniformIndices(program uint32, uniformCount int32, uniformNames **uint8, uniformIndices *uint32) {
+	defaultContext.GetUniformIndices(program, uniformCount, uniformNames, uniformIndices)
+}
+
+// Returns the location of a uniform variable
+func (ctx *Context) GetUniformLocation(program uint32, name *uint8) int32 {
+	ret, _, _ := syscall.Syscall(ctx.gpGetUniformLocation, 2, uintptr(program), uintptr(unsafe.Pointer(name)), 0)
+	if hooked {
+		ctx.returned("GetUniformLocation", (int32)(ret), program, name)
+	}
+	return (int32)(ret)
+}
+
+// Returns the location of a uniform variable
+func GetUniformLocation(program uint32, name *uint8) int32 {
+	return defaultContext.GetUniformLocation(program, name)
+}
+
+func (ctx *Context) GetUniformSubroutineuiv(shadertype uint32, location int32, params *uint32) {
+	syscall.Syscall(ctx.gpGetUniformSubroutineuiv, 3, uintptr(shadertype), uintptr(location), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetUniformSubroutineuiv", shadertype, location, params)
+	}
+}
+
+func GetUniformSubroutineuiv(shadertype uint32, location int32, params *uint32) {
+	defaultContext.GetUniformSubroutineuiv(shadertype, location, params)
+}
+
+func (ctx *Context) GetUniformdv(program uint32, location int32, params *float64) {
+	syscall.Syscall(ctx.gpGetUniformdv, 3, uintptr(program), uintptr(location), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetUniformdv", program, location, params)
+	}
+}
+
+func GetUniformdv(program uint32, location int32, params *float64) {
+	defaultContext.GetUniformdv(program, location, params)
+}
+
+// Returns the value of a uniform variable
+func (ctx *Context) GetUniformfv(program uint32, location int32, params *float32) {
+	syscall.Syscall(ctx.gpGetUniformfv, 3, uintptr(program), uintptr(location), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetUniformfv", program, location, params)
+	}
+}
+
+// Returns the value of a uniform variable
+func GetUniformfv(program uint32, location int32, params *float32) {
+	defaultContext.GetUniformfv(program, location, params)
+}
+
+// Returns the value of a uniform variable
+func (ctx *Context) GetUniformiv(program uint32, location int32, params *int32) {
+	syscall.Syscall(ctx.gpGetUniformiv, 3, uintptr(program), uintptr(location), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetUniformiv", program, location, params)
+	}
+}
+
+// Returns the value of a uniform variable
+func GetUniformiv(program uint32, location int32, params *int32) {
+	defaultContext.GetUniformiv(program, location, params)
+}
+
+func (ctx *Context) GetUniformuiv(program uint32, location int32, params *uint32) {
+	syscall.Syscall(ctx.gpGetUniformuiv, 3, uintptr(program), uintptr(location), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetUniformuiv", program, location, params)
+	}
+}
+
+func GetUniformuiv(program uint32, location int32, params *uint32) {
+	defaultContext.GetUniformuiv(program, location, params)
+}
+
+func (ctx *Context) GetVertexArrayIndexed64iv(vaobj uint32, index uint32, pname uint32, param *int64) {
+	syscall.Syscall6(ctx.gpGetVertexArrayIndexed64iv, 4, uintptr(vaobj), uintptr(index), uintptr(pname), uintptr(unsafe.Pointer(param)), 0, 0)
+	if hooked {
+		ctx.called("GetVertexArrayIndexed64iv", vaobj, index, pname, param)
+	}
+}
+
+func GetVertexArrayIndexed64iv(vaobj uint32, index uint32, pname uint32, param *int64) {
+	defaultContext.GetVertexArrayIndexed64iv(vaobj, index, pname, param)
+}
+
+func (ctx *Context) GetVertexArrayIndexediv(vaobj uint32, index uint32, pname uint32, param *int32) {
+	syscall.Syscall6(ctx.gpGetVertexArrayIndexediv, 4, uintptr(vaobj), uintptr(index), uintptr(pname), uintptr(unsafe.Pointer(param)), 0, 0)
+	if hooked {
+		ctx.called("GetVertexArrayIndexediv", vaobj, index, pname, param)
+	}
+}
+
+func GetVertexArrayIndexediv(vaobj uint32, index uint32, pname uint32, param *int32) {
+	defaultContext.GetVertexArrayIndexediv(vaobj, index, pname, param)
+}
+
+// retrieve parameters of a vertex array object
+func (ctx *Context) GetVertexArrayiv(vaobj uint32, pname uint32, param *int32) {
+	syscall.Syscall(ctx.gpGetVertexArrayiv, 3, uintptr(vaobj), uintptr(pname), uintptr(unsafe.Pointer(param)))
+	if hooked {
+		ctx.called("GetVertexArrayiv", vaobj, pname, param)
+	}
+}
+
+// retrieve parameters of a vertex array object
+func GetVertexArrayiv(vaobj uint32, pname uint32, param *int32) {
+	defaultContext.GetVertexArrayiv(vaobj, pname, param)
+}
+
+// Return a generic vertex attribute parameter
+func (ctx *Context) GetVertexAttribIiv(index uint32, pname uint32, params *int32) {
+	syscall.Syscall(ctx.gpGetVertexAttribIiv, 3, uintptr(index), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetVertexAttribIiv", index, pname, params)
+	}
+}
+
+// Return a generic vertex attribute parameter
+func GetVertexAttribIiv(index uint32, pname uint32, params *int32) {
+	defaultContext.GetVertexAttribIiv(index, pname, params)
+}
+
+// Return a generic vertex attribute parameter
+func (ctx *Context) GetVertexAttribIuiv(index uint32, pname uint32, params *uint32) {
+	syscall.Syscall(ctx.gpGetVertexAttribIuiv, 3, uintptr(index), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetVertexAttribIuiv", index, pname, params)
+	}
+}
+
+// Return a generic vertex attribute parameter
+func GetVertexAttribIuiv(index uint32, pname uint32, params *uint32) {
+	defaultContext.GetVertexAttribIuiv(index, pname, params)
+}
+
+// Return a generic vertex attribute parameter
+func (ctx *Context) GetVertexAttribLdv(index uint32, pname uint32, params *float64) {
+	syscall.Syscall(ctx.gpGetVertexAttribLdv, 3, uintptr(index), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetVertexAttribLdv", index, pname, params)
+	}
+}
+
+// Return a generic vertex attribute parameter
+func GetVertexAttribLdv(index uint32, pname uint32, params *float64) {
+	defaultContext.GetVertexAttribLdv(index, pname, params)
+}
+
+// return the address of the specified generic vertex attribute pointer
+func (ctx *Context) GetVertexAttribPointerv(index uint32, pname uint32, pointer *unsafe.Pointer) {
+	syscall.Syscall(ctx.gpGetVertexAttribPointerv, 3, uintptr(index), uintptr(pname), uintptr(unsafe.Pointer(pointer)))
+	if hooked {
+		ctx.called("GetVertexAttribPointerv", index, pname, pointer)
+	}
+}
+
+// return the address of the specified generic vertex attribute pointer
+func GetVertexAttribPointerv(index uint32, pname uint32, pointer *unsafe.Pointer) {
+	defaultContext.GetVertexAttribPointerv(index, pname, pointer)
+}
+
+func (ctx *Context) GetVertexAttribPointerWithOffsetv(index uint32, pname uint32, offset **uintptr) {
+	syscall.Syscall(ctx.gpGetVertexAttribPointerv, 3, uintptr(index), uintptr(pname), uintptr(unsafe.Pointer(offset)))
+	if hooked {
+		ctx.called("GetVertexAttribPointerWithOffsetv", index, pname, offset)
+	}
+}
+
+func GetVertexAttribPointerWithOffsetv(index uint32, pname uint32, offset **uintptr) {
+	defaultContext.GetVertexAttribPointerWithOffsetv(index, pname, offset)
+}
+
+// Return a generic vertex attribute parameter
+func (ctx *Context) GetVertexAttribdv(index uint32, pname uint32, params *float64) {
+	syscall.Syscall(ctx.gpGetVertexAttribdv, 3, uintptr(index), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetVertexAttribdv", index, pname, params)
+	}
+}
+
+// Return a generic vertex attribute parameter
+func GetVertexAttribdv(index uint32, pname uint32, params *float64) {
+	defaultContext.GetVertexAttribdv(index, pname, params)
+}
+
+// Return a generic vertex attribute parameter
+func (ctx *Context) GetVertexAttribfv(index uint32, pname uint32, params *float32) {
+	syscall.Syscall(ctx.gpGetVertexAttribfv, 3, uintptr(index), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetVertexAttribfv", index, pname, params)
+	}
+}
+
+// Return a generic vertex attribute parameter
+func GetVertexAttribfv(index uint32, pname uint32, params *float32) {
+	defaultContext.GetVertexAttribfv(index, pname, params)
+}
+
+// Return a generic vertex attribute parameter
+func (ctx *Context) GetVertexAttribiv(index uint32, pname uint32, params *int32) {
+	syscall.Syscall(ctx.gpGetVertexAttribiv, 3, uintptr(index), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("GetVertexAttribiv", index, pname, params)
+	}
+}
+
+// Return a generic vertex attribute parameter
+func GetVertexAttribiv(index uint32, pname uint32, params *int32) {
+	defaultContext.GetVertexAttribiv(index, pname, params)
+}
+
+// return a compressed texture image
+func (ctx *Context) GetnCompressedTexImage(target uint32, lod int32, bufSize int32, pixels unsafe.Pointer) {
+	syscall.Syscall6(ctx.gpGetnCompressedTexImage, 4, uintptr(target), uintptr(lod), uintptr(bufSize), uintptr(pixels), 0, 0)
+	if hooked {
+		ctx.called("GetnCompressedTexImage", target, lod, bufSize, pixels)
+	}
+}
+
+// return a compressed texture image
+func GetnCompressedTexImage(target uint32, lod int32, bufSize int32, pixels unsafe.Pointer) {
+	defaultContext.GetnCompressedTexImage(target, lod, bufSize, pixels)
+}
+
+// return a texture image
+func (ctx *Context) GetnTexImage(target uint32, level int32, format uint32, xtype uint32, bufSize int32, pixels unsafe.Pointer) {
+	syscall.Syscall6(ctx.gpGetnTexImage, 6, uintptr(target), uintptr(level), uintptr(format), uintptr(xtype), uintptr(bufSize), uintptr(pixels))
+	if hooked {
+		ctx.called("GetnTexImage", target, level, format, xtype, bufSize, pixels)
+	}
+}
+
+// return a texture image
+func GetnTexImage(target uint32, level int32, format uint32, xtype uint32, bufSize int32, pixels unsafe.Pointer) {
+	defaultContext.GetnTexImage(target, level, format, xtype, bufSize, pixels)
+}
+
+func (ctx *Context) GetnUniformdv(program uint32, location int32, bufSize int32, params *float64) {
+	syscall.Syscall6(ctx.gpGetnUniformdv, 4, uintptr(program), uintptr(location), uintptr(bufSize), uintptr(unsafe.Pointer(params)), 0, 0)
+	if hooked {
+		ctx.called("GetnUniformdv", program, location, bufSize, params)
+	}
+}
+
+func GetnUniformdv(program uint32, location int32, bufSize int32, params *float64) {
+	defaultContext.GetnUniformdv(program, location, bufSize, params)
+}
+
+func (ctx *Context) GetnUniformfv(program uint32, location int32, bufSize int32, params *float32) {
+	syscall.Syscall6(ctx.gpGetnUniformfv, 4, uintptr(program), uintptr(location), uintptr(bufSize), uintptr(unsafe.Pointer(params)), 0, 0)
+	if hooked {
+		ctx.called("GetnUniformfv", program, location, bufSize, params)
+	}
+}
+
+func GetnUniformfv(program uint32, location int32, bufSize int32, params *float32) {
+	defaultContext.GetnUniformfv(program, location, bufSize, params)
+}
+
+func (ctx *Context) GetnUniformiv(program uint32, location int32, bufSize int32, params *int32) {
+	syscall.Syscall6(ctx.gpGetnUniformiv, 4, uintptr(program), uintptr(location), uintptr(bufSize), uintptr(unsafe.Pointer(params)), 0, 0)
+	if hooked {
+		ctx.called("GetnUniformiv", program, location, bufSize, params)
+	}
+}
+
+func GetnUniformiv(program uint32, location int32, bufSize int32, params *int32) {
+	defaultContext.GetnUniformiv(program, location, bufSize, params)
+}
+
+func (ctx *Context) GetnUniformuiv(program uint32, location int32, bufSize int32, params *uint32) {
+	syscall.Syscall6(ctx.gpGetnUniformuiv, 4, uintptr(program), uintptr(location), uintptr(bufSize), uintptr(unsafe.Pointer(params)), 0, 0)
+	if hooked {
+		ctx.called("GetnUniformuiv", program, location, bufSize, params)
+	}
+}
+
+func GetnUniformuiv(program uint32, location int32, bufSize int32, params *uint32) {
+	defaultContext.GetnUniformuiv(program, location, bufSize, params)
+}
+
+// specify implementation-specific hints
+func (ctx *Context) Hint(target uint32, mode uint32) {
+	syscall.Syscall(ctx.gpHint, 2, uintptr(target), uintptr(mode), 0)
+	if hooked {
+		ctx.called("Hint", target, mode)
+	}
+}
+
+// specify implementation-specific hints
+func Hint(target uint32, mode uint32) {
+	defaultContext.Hint(target, mode)
+}
+
+// invalidate the content of a buffer object's data store
+func (ctx *Context) InvalidateBufferData(buffer uint32) {
+	syscall.Syscall(ctx.gpInvalidateBufferData, 1, uintptr(buffer), 0, 0)
+	if hooked {
+		ctx.called("InvalidateBufferData", buffer)
+	}
+}
+
+// invalidate the content of a buffer object's data store
+func InvalidateBufferData(buffer uint32) {
+	defaultContext.InvalidateBufferData(buffer)
+}
+
+// invalidate a region of a buffer object's data store
+func (ctx *Context) InvalidateBufferSubData(buffer uint32, offset int, length int) {
+	syscall.Syscall(ctx.gpInvalidateBufferSubData, 3, uintptr(buffer), uintptr(offset), uintptr(length))
+	if hooked {
+		ctx.called("InvalidateBufferSubData", buffer, offset, length)
+	}
+}
+
+// invalidate a region of a buffer object's data store
+func InvalidateBufferSubData(buffer uint32, offset int, length int) {
+	defaultContext.InvalidateBufferSubData(buffer, offset, length)
+}
+
+// invalidate the content of some or all of a framebuffer's attachments
+func (ctx *Context) InvalidateFramebuffer(target uint32, numAttachments int32, attachments *uint32) {
+	syscall.Syscall(ctx.gpInvalidateFramebuffer, 3, uintptr(target), uintptr(numAttachments), uintptr(unsafe.Pointer(attachments)))
+	if hooked {
+		ctx.called("InvalidateFramebuffer", target, numAttachments, attachments)
+	}
+}
+
+// invalidate the content of some or all of a framebuffer's attachments
+func InvalidateFramebuffer(target uint32, numAttachments int32, attachments *uint32) {
+	defaultContext.InvalidateFramebuffer(target, numAttachments, attachments)
+}
+
+// invalidate the content of some or all of a framebuffer's attachments
+func (ctx *Context) InvalidateNamedFramebufferData(framebuffer uint32, numAttachments int32, attachments *uint32) {
+	syscall.Syscall(ctx.gpInvalidateNamedFramebufferData, 3, uintptr(framebuffer), uintptr(numAttachments), uintptr(unsafe.Pointer(attachments)))
+	if hooked {
+		ctx.called("InvalidateNamedFramebufferData", framebuffer, numAttachments, attachments)
+	}
+}
+
+// invalidate the content of some or all of a framebuffer's attachments
+func InvalidateNamedFramebufferData(framebuffer uint32, numAttachments int32, attachments *uint32) {
+	defaultContext.InvalidateNamedFramebufferData(framebuffer, numAttachments, attachments)
+}
+
+// invalidate the content of a region of some or all of a framebuffer's attachments
+func (ctx *Context) InvalidateNamedFramebufferSubData(framebuffer uint32, numAttachments int32, attachments *uint32, x int32, y int32, width int32, height int32) {
+	syscall.Syscall9(ctx.gpInvalidateNamedFramebufferSubData, 7, uintptr(framebuffer), uintptr(numAttachments), uintptr(unsafe.Pointer(attachments)), uintptr(x), uintptr(y), uintptr(width), uintptr(height), 0, 0)
+	if hooked {
+		ctx.called("InvalidateNamedFramebufferSubData", framebuffer, numAttachments, attachments, x, y, width, height)
+	}
+}
+
+// invalidate the content of a region of some or all of a framebuffer's attachments
+func InvalidateNamedFramebufferSubData(framebuffer uint32, numAttachments int32, attachments *uint32, x int32, y int32, width int32, height int32) {
+	defaultContext.InvalidateNamedFramebufferSubData(framebuffer, numAttachments, attachments, x, y, width, height)
+}
+
+// invalidate the content of a region of some or all of a framebuffer's attachments
+func (ctx *Context) InvalidateSubFramebuffer(target uint32, numAttachments int32, attachments *uint32, x int32, y int32, width int32, height int32) {
+	syscall.Syscall9(ctx.gpInvalidateSubFramebuffer, 7, uintptr(target), uintptr(numAttachments), uintptr(unsafe.Pointer(attachments)), uintptr(x), uintptr(y), uintptr(width), uintptr(height), 0, 0)
+	if hooked {
+		ctx.called("InvalidateSubFramebuffer", target, numAttachments, attachments, x, y, width, height)
+	}
+}
+
+// invalidate the content of a region of some or all of a framebuffer's attachments
+func InvalidateSubFramebuffer(target uint32, numAttachments int32, attachments *uint32, x int32, y int32, width int32, height int32) {
+	defaultContext.InvalidateSubFramebuffer(target, numAttachments, attachments, x, y, width, height)
+}
+
+// invalidate the entirety a texture image
+func (ctx *Context) InvalidateTexImage(texture uint32, level int32) {
+	syscall.Syscall(ctx.gpInvalidateTexImage, 2, uintptr(texture), uintptr(level), 0)
+	if hooked {
+		ctx.called("InvalidateTexImage", texture, level)
+	}
+}
+
+// invalidate the entirety a texture image
+func InvalidateTexImage(texture uint32, level int32) {
+	defaultContext.InvalidateTexImage(texture, level)
+}
+
+// invalidate a region of a texture image
+func (ctx *Context) InvalidateTexSubImage(texture uint32, level int32, xoffset int32, yoffset int32, zoffset int32, width int32, height int32, depth int32) {
+	syscall.Syscall9(ctx.gpInvalidateTexSubImage, 8, uintptr(texture), uintptr(level), uintptr(xoffset), uintptr(yoffset), uintptr(zoffset), uintptr(width), uintptr(height), uintptr(depth), 0)
+	if hooked {
+		ctx.called("InvalidateTexSubImage", texture, level, xoffset, yoffset, zoffset, width, height, depth)
+	}
+}
+
+// invalidate a region of a texture image
+func InvalidateTexSubImage(texture uint32, level int32, xoffset int32, yoffset int32, zoffset int32, width int32, height int32, depth int32) {
+	defaultContext.InvalidateTexSubImage(texture, level, xoffset, yoffset, zoffset, width, height, depth)
+}
+
+// determine if a name corresponds to a buffer object
+func (ctx *Context) IsBuffer(buffer uint32) bool {
+	ret, _, _ := syscall.Syscall(ctx.gpIsBuffer, 1, uintptr(buffer), 0, 0)
+	if hooked {
+		ctx.called("IsBuffer", buffer)
+	}
+	return ret != 0
+}
+
+// determine if a name corresponds to a buffer object
+func IsBuffer(buffer uint32) bool {
+	return defaultContext.IsBuffer(buffer)
+}
+
+func (ctx *Context) IsEnabled(cap uint32) bool {
+	ret, _, _ := syscall.Syscall(ctx.gpIsEnabled, 1, uintptr(cap), 0, 0)
+	if hooked {
+		ctx.called("IsEnabled", cap)
+	}
+	return ret != 0
+}
+
+func IsEnabled(cap uint32) bool {
+	return defaultContext.IsEnabled(cap)
+}
+
+func (ctx *Context) IsEnabledi(target uint32, index uint32) bool {
+	ret, _, _ := syscall.Syscall(ctx.gpIsEnabledi, 2, uintptr(target), uintptr(index), 0)
+	if hooked {
+		ctx.called("IsEnabledi", target, index)
+	}
+	return ret != 0
+}
+
+func IsEnabledi(target uint32, index uint32) bool {
+	return defaultContext.IsEnabledi(target, index)
+}
+
+// determine if a name corresponds to a framebuffer object
+func (ctx *Context) IsFramebuffer(framebuffer uint32) bool {
+	ret, _, _ := syscall.Syscall(ctx.gpIsFramebuffer, 1, uintptr(framebuffer), 0, 0)
+	if hooked {
+		ctx.called("IsFramebuffer", framebuffer)
+	}
+	return ret != 0
+}
+
+// determine if a name corresponds to a framebuffer object
+func IsFramebuffer(framebuffer uint32) bool {
+	return defaultContext.IsFramebuffer(framebuffer)
+}
+
+// Determines if a name corresponds to a program object
+func (ctx *Context) IsProgram(program uint32) bool {
+	ret, _, _ := syscall.Syscall(ctx.gpIsProgram, 1, uintptr(program), 0, 0)
+	if hooked {
+		ctx.called("IsProgram", program)
+	}
+	return ret != 0
+}
+
+// Determines if a name corresponds to a program object
+func IsProgram(program uint32) bool {
+	return defaultContext.IsProgram(program)
+}
+
+// determine if a name corresponds to a program pipeline object
+func (ctx *Context) IsProgramPipeline(pipeline uint32) bool {
+	ret, _, _ := syscall.Syscall(ctx.gpIsProgramPipeline, 1, uintptr(pipeline), 0, 0)
+	if hooked {
+		ctx.called("IsProgramPipeline", pipeline)
+	}
+	return ret != 0
+}
+
+// determine if a name corresponds to a program pipeline object
+func IsProgramPipeline(pipeline uint32) bool {
+	return defaultContext.IsProgramPipeline(pipeline)
+}
+
+// determine if a name corresponds to a query object
+func (ctx *Context) IsQuery(id uint32) bool {
+	ret, _, _ := syscall.Syscall(ctx.gpIsQuery, 1, uintptr(id), 0, 0)
+	if hooked {
+		ctx.called("IsQuery", id)
+	}
+	return ret != 0
+}
+
+// determine if a name corresponds to a query object
+func IsQuery(id uint32) bool {
+	return defaultContext.IsQuery(id)
+}
+
+// determine if a name corresponds to a renderbuffer object
+func (ctx *Context) IsRenderbuffer(renderbuffer uint32) bool {
+	ret, _, _ := syscall.Syscall(ctx.gpIsRenderbuffer, 1, uintptr(renderbuffer), 0, 0)
+	if hooked {
+		ctx.called("IsRenderbuffer", renderbuffer)
+	}
+	return ret != 0
+}
+
+// determine if a name corresponds to a renderbuffer object
+func IsRenderbuffer(renderbuffer uint32) bool {
+	return defaultContext.IsRenderbuffer(renderbuffer)
+}
+
+// determine if a name corresponds to a sampler object
+func (ctx *Context) IsSampler(sampler uint32) bool {
+	ret, _, _ := syscall.Syscall(ctx.gpIsSampler, 1, uintptr(sampler), 0, 0)
+	if hooked {
+		ctx.called("IsSampler", sampler)
+	}
+	return ret != 0
+}
+
+// determine if a name corresponds to a sampler object
+func IsSampler(sampler uint32) bool {
+	return defaultContext.IsSampler(sampler)
+}
+
+// Determines if a name corresponds to a shader object
+func (ctx *Context) IsShader(shader uint32) bool {
+	ret, _, _ := syscall.Syscall(ctx.gpIsShader, 1, uintptr(shader), 0, 0)
+	if hooked {
+		ctx.called("IsShader", shader)
+	}
+	return ret != 0
+}
+
+// Determines if a name corresponds to a shader object
+func IsShader(shader uint32) bool {
+	return defaultContext.IsShader(shader)
+}
+
+// determine if a name corresponds to a sync object
+func (ctx *Context) IsSync(sync uintptr) bool {
+	ret, _, _ := syscall.Syscall(ctx.gpIsSync, 1, uintptr(sync), 0, 0)
+	if hooked {
+		ctx.called("IsSync", sync)
+	}
+	return ret != 0
+}
+
+// determine if a name corresponds to a sync object
+func IsSync(sync uintptr) bool {
+	return defaultContext.IsSync(sync)
+}
+
+// determine if a name corresponds to a texture
+func (ctx *Context) IsTexture(texture uint32) bool {
+	ret, _, _ := syscall.Syscall(ctx.gpIsTexture, 1, uintptr(texture), 0, 0)
+	if hooked {
+		ctx.called("IsTexture", texture)
+	}
+	return ret != 0
+}
+
+// determine if a name corresponds to a texture
+func IsTexture(texture uint32) bool {
+	return defaultContext.IsTexture(texture)
+}
+
+// determine if a name corresponds to a transform feedback object
+func (ctx *Context) IsTransformFeedback(id uint32) bool {
+	ret, _, _ := syscall.Syscall(ctx.gpIsTransformFeedback, 1, uintptr(id), 0, 0)
+	if hooked {
+		ctx.called("IsTransformFeedback", id)
+	}
+	return ret != 0
+}
+
+// determine if a name corresponds to a transform feedback object
+func IsTransformFeedback(id uint32) bool {
+	return defaultContext.IsTransformFeedback(id)
+}
+
+// determine if a name corresponds to a vertex array object
+func (ctx *Context) IsVertexArray(array uint32) bool {
+	ret, _, _ := syscall.Syscall(ctx.gpIsVertexArray, 1, uintptr(array), 0, 0)
+	if hooked {
+		ctx.called("IsVertexArray", array)
+	}
+	return ret != 0
+}
+
+// determine if a name corresponds to a vertex array object
+func IsVertexArray(array uint32) bool {
+	return defaultContext.IsVertexArray(array)
+}
+
+// specify the width of rasterized lines
+func (ctx *Context) LineWidth(width float32) {
+	syscall.Syscall(ctx.gpLineWidth, 1, uintptr(math.Float32bits(width)), 0, 0)
+	if hooked {
+		ctx.called("LineWidth", width)
+	}
+}
+
+// specify the width of rasterized lines
+func LineWidth(width float32) {
+	defaultContext.LineWidth(width)
+}
+
+// Links a program object
+func (ctx *Context) LinkProgram(program uint32) {
+	syscall.Syscall(ctx.gpLinkProgram, 1, uintptr(program), 0, 0)
+	if hooked {
+		ctx.called("LinkProgram", program)
+	}
+}
+
+// Links a program object
+func LinkProgram(program uint32) {
+	defaultContext.LinkProgram(program)
+}
+
+// specify a logical pixel operation for rendering
+func (ctx *Context) LogicOp(opcode uint32) {
+	syscall.Syscall(ctx.gpLogicOp, 1, uintptr(opcode), 0, 0)
+	if hooked {
+		ctx.called("LogicOp", opcode)
+	}
+}
+
+// specify a logical pixel operation for rendering
+func LogicOp(opcode uint32) {
+	defaultContext.LogicOp(opcode)
+}
+
+// map all of a buffer object's data store into the client's address space
+func (ctx *Context) MapBuffer(target uint32, access uint32) unsafe.Pointer {
+	ret, _, _ := syscall.Syscall(ctx.gpMapBuffer, 2, uintptr(target), uintptr(access), 0)
+	if hooked {
+		ctx.called("MapBuffer", target, access)
+	}
+	return (unsafe.Pointer)(ret)
+}
+
+// map all of a buffer object's data store into the client's address space
+func MapBuffer(target uint32, access uint32) unsafe.Pointer {
+	return defaultContext.MapBuffer(target, access)
+}
+
+// map all or part of a buffer object's data store into the client's address space
+func (ctx *Context) MapBufferRange(target uint32, offset int, length int, access uint32) unsafe.Pointer {
+	ret, _, _ := syscall.Syscall6(ctx.gpMapBufferRange, 4, uintptr(target), uintptr(offset), uintptr(length), uintptr(access), 0, 0)
+	if hooked {
+		ctx.called("MapBufferRange", target, offset, length, access)
+	}
+	return (unsafe.Pointer)(ret)
+}
+
+// map all or part of a buffer object's data store into the client's address space
+func MapBufferRange(target uint32, offset int, length int, access uint32) unsafe.Pointer {
+	return defaultContext.MapBufferRange(target, offset, length, access)
+}
+
+// map all of a buffer object's data store into the client's address space
+func (ctx *Context) MapNamedBuffer(buffer uint32, access uint32) unsafe.Pointer {
+	ret, _, _ := syscall.Syscall(ctx.gpMapNamedBuffer, 2, uintptr(buffer), uintptr(access), 0)
+	if hooked {
+		ctx.called("MapNamedBuffer", buffer, access)
+	}
+	return (unsafe.Pointer)(ret)
+}
+
+// map all of a buffer object's data store into the client's address space
+func MapNamedBuffer(buffer uint32, access uint32) unsafe.Pointer {
+	return defaultContext.MapNamedBuffer(buffer, access)
+}
+
+// map all or part of a buffer object's data store into the client's address space
+func (ctx *Context) MapNamedBufferRange(buffer uint32, offset int, length int, access uint32) unsafe.Pointer {
+	ret, _, _ := syscall.Syscall6(ctx.gpMapNamedBufferRange, 4, uintptr(buffer), uintptr(offset), uintptr(length), uintptr(access), 0, 0)
+	if hooked {
+		ctx.called("MapNamedBufferRange", buffer, offset, length, access)
+	}
+	return (unsafe.Pointer)(ret)
+}
+
+// map all or part of a buffer object's data store into the client's address space
+func MapNamedBufferRange(buffer uint32, offset int, length int, access uint32) unsafe.Pointer {
+	return defaultContext.MapNamedBufferRange(buffer, offset, length, access)
+}
+
+// defines a barrier ordering memory transactions
+func (ctx *Context) MemoryBarrier(barriers uint32) {
+	syscall.Syscall(ctx.gpMemoryBarrier, 1, uintptr(barriers), 0, 0)
+	if hooked {
+		ctx.called("MemoryBarrier", barriers)
+	}
+}
+
+// defines a barrier ordering memory transactions
+func MemoryBarrier(barriers uint32) {
+	defaultContext.MemoryBarrier(barriers)
+}
+
+func (ctx *Context) MemoryBarrierByRegion(barriers uint32) {
+	syscall.Syscall(ctx.gpMemoryBarrierByRegion, 1, uintptr(barriers), 0, 0)
+	if hooked {
+		ctx.called("MemoryBarrierByRegion", barriers)
+	}
+}
+
+func MemoryBarrierByRegion(barriers uint32) {
+	defaultContext.MemoryBarrierByRegion(barriers)
+}
+
+// specifies minimum rate at which sample shaing takes place
+func (ctx *Context) MinSampleShading(value float32) {
+	syscall.Syscall(ctx.gpMinSampleShading, 1, uintptr(math.Float32bits(value)), 0, 0)
+	if hooked {
+		ctx.called("MinSampleShading", value)
+	}
+}
+
+// specifies minimum rate at which sample shaing takes place
+func MinSampleShading(value float32) {
+	defaultContext.MinSampleShading(value)
+}
+
+// render multiple sets of primitives from array data
+func (ctx *Context) MultiDrawArrays(mode uint32, first *int32, count *int32, drawcount int32) {
+	syscall.Syscall6(ctx.gpMultiDrawArrays, 4, uintptr(mode), uintptr(unsafe.Pointer(first)), uintptr(unsafe.Pointer(count)), uintptr(drawcount), 0, 0)
+	if hooked {
+		ctx.called("MultiDrawArrays", mode, first, count, drawcount)
+	}
+}
+
+// render multiple sets of primitives from array data
+func MultiDrawArrays(mode uint32, first *int32, count *int32, drawcount int32) {
+	defaultContext.MultiDrawArrays(mode, first, count, drawcount)
+}
+
+// render multiple sets of primitives from array data, taking parameters from memory
+func (ctx *Context) MultiDrawArraysIndirect(mode uint32, indirect unsafe.Pointer, drawcount int32, stride int32) {
+	syscall.Syscall6(ctx.gpMultiDrawArraysIndirect, 4, uintptr(mode), uintptr(indirect), uintptr(drawcount), uintptr(stride), 0, 0)
+	if hooked {
+		ctx.called("MultiDrawArraysIndirect", mode, indirect, drawcount, stride)
+	}
+}
+
+// render multiple sets of primitives from array data, taking parameters from memory
+func MultiDrawArraysIndirect(mode uint32, indirect unsafe.Pointer, drawcount int32, stride int32) {
+	defaultContext.MultiDrawArraysIndirect(mode, indirect, drawcount, stride)
+}
+
+func (ctx *Context) MultiDrawArraysIndirectCount(mode uint32, indirect unsafe.Pointer, drawcount int, maxdrawcount int32, stride int32) {
+	syscall.Syscall6(ctx.gpMultiDrawArraysIndirectCount, 5, uintptr(mode), uintptr(indirect), uintptr(drawcount), uintptr(maxdrawcount), uintptr(stride), 0)
+	if hooked {
+		ctx.called("MultiDrawArraysIndirectCount", mode, indirect, drawcount, maxdrawcount, stride)
+	}
+}
+
+func MultiDrawArraysIndirectCount(mode uint32, indirect unsafe.Pointer, drawcount int, maxdrawcount int32, stride int32) {
+	defaultContext.MultiDrawArraysIndirectCount(mode, indirect, drawcount, maxdrawcount, stride)
+}
+
+// render multiple sets of primitives by specifying indices of array data elements
+func (ctx *Context) MultiDrawElements(mode uint32, count *int32, xtype uint32, indices *unsafe.Pointer, drawcount int32) {
+	syscall.Syscall6(ctx.gpMultiDrawElements, 5, uintptr(mode), uintptr(unsafe.Pointer(count)), uintptr(xtype), uintptr(unsafe.Pointer(indices)), uintptr(drawcount), 0)
+	if hooked {
+		ctx.called("MultiDrawElements", mode, count, xtype, indices, drawcount)
+	}
+}
+
+// render multiple sets of primitives by specifying indices of array data elements
+func MultiDrawElements(mode uint32, count *int32, xtype uint32, indices *unsafe.Pointer, drawcount int32) {
+	defaultContext.MultiDrawElements(mode, count, xtype, indices, drawcount)
+}
+
+// render multiple sets of primitives by specifying indices of array data elements and an index to apply to each index
+func (ctx *Context) MultiDrawElementsBaseVertex(mode uint32, count *int32, xtype uint32, indices *unsafe.Pointer, drawcount int32, basevertex *int32) {
+	syscall.Syscall6(ctx.gpMultiDrawElementsBaseVertex, 6, uintptr(mode), uintptr(unsafe.Pointer(count)), uintptr(xtype), uintptr(unsafe.Pointer(indices)), uintptr(drawcount), uintptr(unsafe.Pointer(basevertex)))
+	if hooked {
+		ctx.called("MultiDrawElementsBaseVertex", mode, count, xtype, indices, drawcount, basevertex)
+	}
+}
+
+// render multiple sets of primitives by specifying indices of array data elements and an index to apply to each index
+func MultiDrawElementsBaseVertex(mode uint32, count *int32, xtype uint32, indices *unsafe.Pointer, drawcount int32, basevertex *int32) {
+	defaultContext.MultiDrawElementsBaseVertex(mode, count, xtype, indices, drawcount, basevertex)
+}
+
+// render indexed primitives from array data, taking parameters from memory
+func (ctx *Context) MultiDrawElementsIndirect(mode uint32, xtype uint32, indirect unsafe.Pointer, drawcount int32, stride int32) {
+	syscall.Syscall6(ctx.gpMultiDrawElementsIndirect, 5, uintptr(mode), uintptr(xtype), uintptr(indirect), uintptr(drawcount), uintptr(stride), 0)
+	if hooked {
+		ctx.called("MultiDrawElementsIndirect", mode, xtype, indirect, drawcount, stride)
+	}
+}
+
+// render indexed primitives from array data, taking parameters from memory
+func MultiDrawElementsIndirect(mode uint32, xtype uint32, indirect unsafe.Pointer, drawcount int32, stride int32) {
+	defaultContext.MultiDrawElementsIndirect(mode, xtype, indirect, drawcount, stride)
+}
+
+func (ctx *Context) MultiDrawElementsIndirectCount(mode uint32, xtype uint32, indirect unsafe.Pointer, drawcount int, maxdrawcount int32, stride int32) {
+	syscall.Syscall6(ctx.gpMultiDrawElementsIndirectCount, 6, uintptr(mode), uintptr(xtype), uintptr(indirect), uintptr(drawcount), uintptr(maxdrawcount), uintptr(stride))
+	if hooked {
+		ctx.called("MultiDrawElementsIndirectCount", mode, xtype, indirect, drawcount, maxdrawcount, stride)
+	}
+}
+
+func MultiDrawElementsIndirectCount(mode uint32, xtype uint32, indirect unsafe.Pointer, drawcount int, maxdrawcount int32, stride int32) {
+	defaultContext.MultiDrawElementsIndirectCount(mode, xtype, indirect, drawcount, maxdrawcount, stride)
+}
+
+// creates and initializes a buffer object's data     store
+func (ctx *Context) NamedBufferData(buffer uint32, size int, data unsafe.Pointer, usage uint32) {
+	syscall.Syscall6(ctx.gpNamedBufferData, 4, uintptr(buffer), uintptr(size), uintptr(data), uintptr(usage), 0, 0)
+	if hooked {
+		ctx.called("NamedBufferData", buffer, size, data, usage)
+	}
+}
+
+// creates and initializes a buffer object's data     store
+func NamedBufferData(buffer uint32, size int, data unsafe.Pointer, usage uint32) {
+	defaultContext.NamedBufferData(buffer, size, data, usage)
+}
+
+// creates and initializes a buffer object's immutable data     store
+func (ctx *Context) NamedBufferStorage(buffer uint32, size int, data unsafe.Pointer, flags uint32) {
+	syscall.Syscall6(ctx.gpNamedBufferStorage, 4, uintptr(buffer), uintptr(size), uintptr(data), uintptr(flags), 0, 0)
+	if hooked {
+		ctx.called("NamedBufferStorage", buffer, size, data, flags)
+	}
+}
+
+// creates and initializes a buffer object's immutable data     store
+func NamedBufferStorage(buffer uint32, size int, data unsafe.Pointer, flags uint32) {
+	defaultContext.NamedBufferStorage(buffer, size, data, flags)
+}
+
+// updates a subset of a buffer object's data store
+func (ctx *Context) NamedBufferSubData(buffer uint32, offset int, size int, data unsafe.Pointer) {
+	syscall.Syscall6(ctx.gpNamedBufferSubData, 4, uintptr(buffer), uintptr(offset), uintptr(size), uintptr(data), 0, 0)
+	if hooked {
+		ctx.called("NamedBufferSubData", buffer, offset, size, data)
+	}
+}
+
+// updates a subset of a buffer object's data store
+func NamedBufferSubData(buffer uint32, offset int, size int, data unsafe.Pointer) {
+	defaultContext.NamedBufferSubData(buffer, offset, size, data)
+}
+
+// specify which color buffers are to be drawn into
+func (ctx *Context) NamedFramebufferDrawBuffer(framebuffer uint32, buf uint32) {
+	syscall.Syscall(ctx.gpNamedFramebufferDrawBuffer, 2, uintptr(framebuffer), uintptr(buf), 0)
+	if hooked {
+		ctx.called("NamedFramebufferDrawBuffer", framebuffer, buf)
+	}
+}
+
+// specify which color buffers are to be drawn into
+func NamedFramebufferDrawBuffer(framebuffer uint32, buf uint32) {
+	defaultContext.NamedFramebufferDrawBuffer(framebuffer, buf)
+}
+
+// Specifies a list of color buffers to be drawn     into
+func (ctx *Context) NamedFramebufferDrawBuffers(framebuffer uint32, n int32, bufs *uint32) {
+	syscall.Syscall(ctx.gpNamedFramebufferDrawBuffers, 3, uintptr(framebuffer), uintptr(n), uintptr(unsafe.Pointer(bufs)))
+	if hooked {
+		ctx.called("NamedFramebufferDrawBuffers", framebuffer, n, bufs)
+	}
+}
+
+// Specifies a list of color buffers to be drawn     into
+func NamedFramebufferDrawBuffers(framebuffer uint32, n int32, bufs *uint32) {
+	defaultContext.NamedFramebufferDrawBuffers(framebuffer, n, bufs)
+}
+
+// set a named parameter of a framebuffer object
+func (ctx *Context) NamedFramebufferParameteri(framebuffer uint32, pname uint32, param int32) {
+	syscall.Syscall(ctx.gpNamedFramebufferParameteri, 3, uintptr(framebuffer), uintptr(pname), uintptr(param))
+	if hooked {
+		ctx.called("NamedFramebufferParameteri", framebuffer, pname, param)
+	}
+}
+
+// set a named parameter of a framebuffer object
+func NamedFramebufferParameteri(framebuffer uint32, pname uint32, param int32) {
+	defaultContext.NamedFramebufferParameteri(framebuffer, pname, param)
+}
+
+// select a color buffer source for pixels
+func (ctx *Context) NamedFramebufferReadBuffer(framebuffer uint32, src uint32) {
+	syscall.Syscall(ctx.gpNamedFramebufferReadBuffer, 2, uintptr(framebuffer), uintptr(src), 0)
+	if hooked {
+		ctx.called("NamedFramebufferReadBuffer", framebuffer, src)
+	}
+}
+
+// select a color buffer source for pixels
+func NamedFramebufferReadBuffer(framebuffer uint32, src uint32) {
+	defaultContext.NamedFramebufferReadBuffer(framebuffer, src)
+}
+
+// attach a renderbuffer as a logical buffer of a framebuffer object
+func (ctx *Context) NamedFramebufferRenderbuffer(framebuffer uint32, attachment uint32, renderbuffertarget uint32, renderbuffer uint32) {
+	syscall.Syscall6(ctx.gpNamedFramebufferRenderbuffer, 4, uintptr(framebuffer), uintptr(attachment), uintptr(renderbuffertarget), uintptr(renderbuffer), 0, 0)
+	if hooked {
+		ctx.called("NamedFramebufferRenderbuffer", framebuffer, attachment, renderbuffertarget, renderbuffer)
+	}
+}
+
+// attach a renderbuffer as a logical buffer of a framebuffer object
+func NamedFramebufferRenderbuffer(framebuffer uint32, attachment uint32, renderbuffertarget uint32, renderbuffer uint32) {
+	defaultContext.NamedFramebufferRenderbuffer(framebuffer, attachment, renderbuffertarget, renderbuffer)
+}
+
+func (ctx *Context) NamedFramebufferTexture(framebuffer uint32, attachment uint32, texture uint32, level int32) {
+	syscall.Syscall6(ctx.gpNamedFramebufferTexture, 4, uintptr(framebuffer), uintptr(attachment), uintptr(texture), uintptr(level), 0, 0)
+	if hooked {
+		ctx.called("NamedFramebufferTexture", framebuffer, attachment, texture, level)
+	}
+}
+
+func NamedFramebufferTexture(framebuffer uint32, attachment uint32, texture uint32, level int32) {
+	defaultContext.NamedFramebufferTexture(framebuffer, attachment, texture, level)
+}
+
+// attach a single layer of a texture object as a logical buffer of a framebuffer object
+func (ctx *Context) NamedFramebufferTextureLayer(framebuffer uint32, attachment uint32, texture uint32, level int32, layer int32) {
+	syscall.Syscall6(ctx.gpNamedFramebufferTextureLayer, 5, uintptr(framebuffer), uintptr(attachment), uintptr(texture), uintptr(level), uintptr(layer), 0)
+	if hooked {
+		ctx.called("NamedFramebufferTextureLayer", framebuffer, attachment, texture, level, layer)
+	}
+}
+
+// attach a single layer of a texture object as a logical buffer of a framebuffer object
+func NamedFramebufferTextureLayer(framebuffer uint32, attachment uint32, texture uint32, level int32, layer int32) {
+	defaultContext.NamedFramebufferTextureLayer(framebuffer, attachment, texture, level, layer)
+}
+
+// establish data storage, format and dimensions of a     renderbuffer object's image
+func (ctx *Context) NamedRenderbufferStorage(renderbuffer uint32, internalformat uint32, width int32, height int32) {
+	syscall.Syscall6(ctx.gpNamedRenderbufferStorage, 4, uintptr(renderbuffer), uintptr(internalformat), uintptr(width), uintptr(height), 0, 0)
+	if hooked {
+		ctx.called("NamedRenderbufferStorage", renderbuffer, internalformat, width, height)
+	}
+}
+
+// establish data storage, format and dimensions of a     renderbuffer object's image
+func NamedRenderbufferStorage(renderbuffer uint32, internalformat uint32, width int32, height int32) {
+	defaultContext.NamedRenderbufferStorage(renderbuffer, internalformat, width, height)
+}
+
+// establish data storage, format, dimensions and sample count of     a renderbuffer object's image
+func (ctx *Context) NamedRenderbufferStorageMultisample(renderbuffer uint32, samples int32, internalformat uint32, width int32, height int32) {
+	syscall.Syscall6(ctx.gpNamedRenderbufferStorageMultisample, 5, uintptr(renderbuffer), uintptr(samples), uintptr(internalformat), uintptr(width), uintptr(height), 0)
+	if hooked {
+		ctx.called("NamedRenderbufferStorageMultisample", renderbuffer, samples, internalformat, width, height)
+	}
+}
+
+// establish data storage, format, dimensions and sample count of     a renderbuffer object's image
+func NamedRenderbufferStorageMultisample(renderbuffer uint32, samples int32, internalformat uint32, width int32, height int32) {
+	defaultContext.NamedRenderbufferStorageMultisample(renderbuffer, samples, internalformat, width, height)
+}
+
+// label a named object identified within a namespace
+func (ctx *Context) ObjectLabel(identifier uint32, name uint32, length int32, label *uint8) {
+	syscall.Syscall6(ctx.gpObjectLabel, 4, uintptr(identifier), uintptr(name), uintptr(length), uintptr(unsafe.Pointer(label)), 0, 0)
+	if hooked {
+		ctx.called("ObjectLabel", identifier, name, length, label)
+	}
+}
+
+// label a named object identified within a namespace
+func ObjectLabel(identifier uint32, name uint32, length int32, label *uint8) {
+	defaultContext.ObjectLabel(identifier, name, length, label)
+}
+
+// label a sync object identified by a pointer
+func (ctx *Context) ObjectPtrLabel(ptr unsafe.Pointer, length int32, label *uint8) {
+	syscall.Syscall(ctx.gpObjectPtrLabel, 3, uintptr(ptr), uintptr(length), uintptr(unsafe.Pointer(label)))
+	if hooked {
+		ctx.called("ObjectPtrLabel", ptr, length, label)
+	}
+}
+
+// label a sync object identified by a pointer
+func ObjectPtrLabel(ptr unsafe.Pointer, length int32, label *uint8) {
+	defaultContext.ObjectPtrLabel(ptr, length, label)
+}
+
+func (ctx *Context) PatchParameterfv(pname uint32, values *float32) {
+	syscall.Syscall(ctx.gpPatchParameterfv, 2, uintptr(pname), uintptr(unsafe.Pointer(values)), 0)
+	if hooked {
+		ctx.called("PatchParameterfv", pname, values)
+	}
+}
+
+func PatchParameterfv(pname uint32, values *float32) {
+	defaultContext.PatchParameterfv(pname, values)
+}
+
+// specifies the parameters for patch primitives
+func (ctx *Context) PatchParameteri(pname uint32, value int32) {
+	syscall.Syscall(ctx.gpPatchParameteri, 2, uintptr(pname), uintptr(value), 0)
+	if hooked {
+		ctx.called("PatchParameteri", pname, value)
+	}
+}
+
+// specifies the parameters for patch primitives
+func PatchParameteri(pname uint32, value int32) {
+	defaultContext.PatchParameteri(pname, value)
+}
+
+// pause transform feedback operations
+func (ctx *Context) PauseTransformFeedback() {
+	syscall.Syscall(ctx.gpPauseTransformFeedback, 0, 0, 0, 0)
+	if hooked {
+		ctx.called("PauseTransformFeedback")
+	}
+}
+
+// pause transform feedback operations
+func PauseTransformFeedback() {
+	defaultContext.PauseTransformFeedback()
+}
+
+func (ctx *Context) PixelStoref(pname uint32, param float32) {
+	syscall.Syscall(ctx.gpPixelStoref, 2, uintptr(pname), uintptr(math.Float32bits(param)), 0)
+	if hooked {
+		ctx.called("PixelStoref", pname, param)
+	}
+}
+
+func PixelStoref(pname uint32, param float32) {
+	defaultContext.PixelStoref(pname, param)
+}
+
+// set pixel storage modes
+func (ctx *Context) PixelStorei(pname uint32, param int32) {
+	syscall.Syscall(ctx.gpPixelStorei, 2, uintptr(pname), uintptr(param), 0)
+	if hooked {
+		ctx.called("PixelStorei", pname, param)
+	}
+}
+
+// set pixel storage modes
+func PixelStorei(pname uint32, param int32) {
+	defaultContext.PixelStorei(pname, param)
+}
+
+func (ctx *Context) PointParameterf(pname uint32, param float32) {
+	syscall.Syscall(ctx.gpPointParameterf, 2, uintptr(pname), uintptr(math.Float32bits(param)), 0)
+	if hooked {
+		ctx.called("PointParameterf", pname, param)
+	}
+}
+
+func PointParameterf(pname uint32, param float32) {
+	defaultContext.PointParameterf(pname, param)
+}
+
+func (ctx *Context) PointParameterfv(pname uint32, params *float32) {
+	syscall.Syscall(ctx.gpPointParameterfv, 2, uintptr(pname), uintptr(unsafe.Pointer(params)), 0)
+	if hooked {
+		ctx.called("PointParameterfv", pname, params)
+	}
+}
+
+func PointParameterfv(pname uint32, params *float32) {
+	defaultContext.PointParameterfv(pname, params)
+}
+
+func (ctx *Context) PointParameteri(pname uint32, param int32) {
+	syscall.Syscall(ctx.gpPointParameteri, 2, uintptr(pname), uintptr(param), 0)
+	if hooked {
+		ctx.called("PointParameteri", pname, param)
+	}
+}
+
+func PointParameteri(pname uint32, param int32) {
+	defaultContext.PointParameteri(pname, param)
+}
+
+func (ctx *Context) PointParameteriv(pname uint32, params *int32) {
+	syscall.Syscall(ctx.gpPointParameteriv, 2, uintptr(pname), uintptr(unsafe.Pointer(params)), 0)
+	if hooked {
+		ctx.called("PointParameteriv", pname, params)
+	}
+}
+
+func PointParameteriv(pname uint32, params *int32) {
+	defaultContext.PointParameteriv(pname, params)
+}
+
+// specify the diameter of rasterized points
+func (ctx *Context) PointSize(size float32) {
+	syscall.Syscall(ctx.gpPointSize, 1, uintptr(math.Float32bits(size)), 0, 0)
+	if hooked {
+		ctx.called("PointSize", size)
+	}
+}
+
+// specify the diameter of rasterized points
+func PointSize(size float32) {
+	defaultContext.PointSize(size)
+}
+
+// select a polygon rasterization mode
+func (ctx *Context) PolygonMode(face uint32, mode uint32) {
+	syscall.Syscall(ctx.gpPolygonMode, 2, uintptr(face), uintptr(mode), 0)
+	if hooked {
+		ctx.called("PolygonMode", face, mode)
+	}
+}
+
+// select a polygon rasterization mode
+func PolygonMode(face uint32, mode uint32) {
+	defaultContext.PolygonMode(face, mode)
+}
+
+// set the scale and units used to calculate depth values
+func (ctx *Context) PolygonOffset(factor float32, units float32) {
+	syscall.Syscall(ctx.gpPolygonOffset, 2, uintptr(math.Float32bits(factor)), uintptr(math.Float32bits(units)), 0)
+	if hooked {
+		ctx.called("PolygonOffset", factor, units)
+	}
+}
+
+// set the scale and units used to calculate depth values
+func PolygonOffset(factor float32, units float32) {
+	defaultContext.PolygonOffset(factor, units)
+}
+
+func (ctx *Context) PolygonOffsetClamp(factor float32, units float32, clamp float32) {
+	syscall.Syscall(ctx.gpPolygonOffsetClamp, 3, uintptr(math.Float32bits(factor)), uintptr(math.Float32bits(units)), uintptr(math.Float32bits(clamp)))
+	if hooked {
+		ctx.called("PolygonOffsetClamp", factor, units, clamp)
+	}
+}
+
+func PolygonOffsetClamp(factor float32, units float32, clamp float32) {
+	defaultContext.PolygonOffsetClamp(factor, units, clamp)
+}
+
+// pop the active debug group
+func (ctx *Context) PopDebugGroup() {
+	syscall.Syscall(ctx.gpPopDebugGroup, 0, 0, 0, 0)
+	if hooked {
+		ctx.called("PopDebugGroup")
+	}
+}
+
+// pop the active debug group
+func PopDebugGroup() {
+	defaultContext.PopDebugGroup()
+}
+
+// specify the primitive restart index
+func (ctx *Context) PrimitiveRestartIndex(index uint32) {
+	syscall.Syscall(ctx.gpPrimitiveRestartIndex, 1, uintptr(index), 0, 0)
+	if hooked {
+		ctx.called("PrimitiveRestartIndex", index)
+	}
+}
+
+// specify the primitive restart index
+func PrimitiveRestartIndex(index uint32) {
+	defaultContext.PrimitiveRestartIndex(index)
+}
+
+// load a program object with a program binary
+func (ctx *Context) ProgramBinary(program uint32, binaryFormat uint32, binary unsafe.Pointer, length int32) {
+	syscall.Syscall6(ctx.gpProgramBinary, 4, uintptr(program), uintptr(binaryFormat), uintptr(binary), uintptr(length), 0, 0)
+	if hooked {
+		ctx.called("ProgramBinary", program, binaryFormat, binary, length)
+	}
+}
+
+// load a program object with a program binary
+func ProgramBinary(program uint32, binaryFormat uint32, binary unsafe.Pointer, length int32) {
+	defaultContext.ProgramBinary(program, binaryFormat, binary, length)
+}
+
+// specify a parameter for a program object
+func (ctx *Context) ProgramParameteri(program uint32, pname uint32, value int32) {
+	syscall.Syscall(ctx.gpProgramParameteri, 3, uintptr(program), uintptr(pname), uintptr(value))
+	if hooked {
+		ctx.called("ProgramParameteri", program, pname, value)
+	}
+}
+
+// specify a parameter for a program object
+func ProgramParameteri(program uint32, pname uint32, value int32) {
+	defaultContext.ProgramParameteri(program, pname, value)
+}
+
+func (ctx *Context) ProgramUniform1d(program uint32, location int32, v0 float64) {
+	syscall.Syscall(ctx.gpProgramUniform1d, 3, uintptr(program), uintptr(location), uintptr(math.Float64bits(v0)))
+	if hooked {
+		ctx.called("ProgramUniform1d", program, location, v0)
+	}
+}
+
+func ProgramUniform1d(program uint32, location int32, v0 float64) {
+	defaultContext.ProgramUniform1d(program, location, v0)
+}
+
+func (ctx *Context) ProgramUniform1dv(program uint32, location int32, count int32, value *float64) {
+	syscall.Syscall6(ctx.gpProgramUniform1dv, 4, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("ProgramUniform1dv", program, location, count, value)
+	}
+}
+
+func ProgramUniform1dv(program uint32, location int32, count int32, value *float64) {
+	defaultContext.ProgramUniform1dv(program, location, count, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform1f(program uint32, location int32, v0 float32) {
+	syscall.Syscall(ctx.gpProgramUniform1f, 3, uintptr(program), uintptr(location), uintptr(math.Float32bits(v0)))
+	if hooked {
+		ctx.called("ProgramUniform1f", program, location, v0)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform1f(program uint32, location int32, v0 float32) {
+	defaultContext.ProgramUniform1f(program, location, v0)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform1fv(program uint32, location int32, count int32, value *float32) {
+	syscall.Syscall6(ctx.gpProgramUniform1fv, 4, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("ProgramUniform1fv", program, location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform1fv(program uint32, location int32, count int32, value *float32) {
+	defaultContext.ProgramUniform1fv(program, location, count, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform1i(program uint32, location int32, v0 int32) {
+	syscall.Syscall(ctx.gpProgramUniform1i, 3, uintptr(program), uintptr(location), uintptr(v0))
+	if hooked {
+		ctx.called("ProgramUniform1i", program, location, v0)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform1i(program uint32, location int32, v0 int32) {
+	defaultContext.ProgramUniform1i(program, location, v0)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform1iv(program uint32, location int32, count int32, value *int32) {
+	syscall.Syscall6(ctx.gpProgramUniform1iv, 4, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("ProgramUniform1iv", program, location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform1iv(program uint32, location int32, count int32, value *int32) {
+	defaultContext.ProgramUniform1iv(program, location, count, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform1ui(program uint32, location int32, v0 uint32) {
+	syscall.Syscall(ctx.gpProgramUniform1ui, 3, uintptr(program), uintptr(location), uintptr(v0))
+	if hooked {
+		ctx.called("ProgramUniform1ui", program, location, v0)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform1ui(program uint32, location int32, v0 uint32) {
+	defaultContext.ProgramUniform1ui(program, location, v0)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform1uiv(program uint32, location int32, count int32, value *uint32) {
+	syscall.Syscall6(ctx.gpProgramUniform1uiv, 4, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("ProgramUniform1uiv", program, location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform1uiv(program uint32, location int32, count int32, value *uint32) {
+	defaultContext.ProgramUniform1uiv(program, location, count, value)
+}
+
+func (ctx *Context) ProgramUniform2d(program uint32, location int32, v0 float64, v1 float64) {
+	syscall.Syscall6(ctx.gpProgramUniform2d, 4, uintptr(program), uintptr(location), uintptr(math.Float64bits(v0)), uintptr(math.Float64bits(v1)), 0, 0)
+	if hooked {
+		ctx.called("ProgramUniform2d", program, location, v0, v1)
+	}
+}
+
+func ProgramUniform2d(program uint32, location int32, v0 float64, v1 float64) {
+	defaultContext.ProgramUniform2d(program, location, v0, v1)
+}
+
+func (ctx *Context) ProgramUniform2dv(program uint32, location int32, count int32, value *float64) {
+	syscall.Syscall6(ctx.gpProgramUniform2dv, 4, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("ProgramUniform2dv", program, location, count, value)
+	}
+}
+
+func ProgramUniform2dv(program uint32, location int32, count int32, value *float64) {
+	defaultContext.ProgramUniform2dv(program, location, count, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform2f(program uint32, location int32, v0 float32, v1 float32) {
+	syscall.Syscall6(ctx.gpProgramUniform2f, 4, uintptr(program), uintptr(location), uintptr(math.Float32bits(v0)), uintptr(math.Float32bits(v1)), 0, 0)
+	if hooked {
+		ctx.called("ProgramUniform2f", program, location, v0, v1)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform2f(program uint32, location int32, v0 float32, v1 float32) {
+	defaultContext.ProgramUniform2f(program, location, v0, v1)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform2fv(program uint32, location int32, count int32, value *float32) {
+	syscall.Syscall6(ctx.gpProgramUniform2fv, 4, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("ProgramUniform2fv", program, location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform2fv(program uint32, location int32, count int32, value *float32) {
+	defaultContext.ProgramUniform2fv(program, location, count, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform2i(program uint32, location int32, v0 int32, v1 int32) {
+	syscall.Syscall6(ctx.gpProgramUniform2i, 4, uintptr(program), uintptr(location), uintptr(v0), uintptr(v1), 0, 0)
+	if hooked {
+		ctx.called("ProgramUniform2i", program, location, v0, v1)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform2i(program uint32, location int32, v0 int32, v1 int32) {
+	defaultContext.ProgramUniform2i(program, location, v0, v1)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform2iv(program uint32, location int32, count int32, value *int32) {
+	syscall.Syscall6(ctx.gpProgramUniform2iv, 4, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("ProgramUniform2iv", program, location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform2iv(program uint32, location int32, count int32, value *int32) {
+	defaultContext.ProgramUniform2iv(program, location, count, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform2ui(program uint32, location int32, v0 uint32, v1 uint32) {
+	syscall.Syscall6(ctx.gpProgramUniform2ui, 4, uintptr(program), uintptr(location), uintptr(v0), uintptr(v1), 0, 0)
+	if hooked {
+		ctx.called("ProgramUniform2ui", program, location, v0, v1)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform2ui(program uint32, location int32, v0 uint32, v1 uint32) {
+	defaultContext.ProgramUniform2ui(program, location, v0, v1)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform2uiv(program uint32, location int32, count int32, value *uint32) {
+	syscall.Syscall6(ctx.gpProgramUniform2uiv, 4, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("ProgramUniform2uiv", program, location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform2uiv(program uint32, location int32, count int32, value *uint32) {
+	defaultContext.ProgramUniform2uiv(program, location, count, value)
+}
+
+func (ctx *Context) ProgramUniform3d(program uint32, location int32, v0 float64, v1 float64, v2 float64) {
+	syscall.Syscall6(ctx.gpProgramUniform3d, 5, uintptr(program), uintptr(location), uintptr(math.Float64bits(v0)), uintptr(math.Float64bits(v1)), uintptr(math.Float64bits(v2)), 0)
+	if hooked {
+		ctx.called("ProgramUniform3d", program, location, v0, v1, v2)
+	}
+}
+
+func ProgramUniform3d(program uint32, location int32, v0 float64, v1 float64, v2 float64) {
+	defaultContext.ProgramUniform3d(program, location, v0, v1, v2)
+}
+
+func (ctx *Context) ProgramUniform3dv(program uint32, location int32, count int32, value *float64) {
+	syscall.Syscall6(ctx.gpProgramUniform3dv, 4, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("ProgramUniform3dv", program, location, count, value)
+	}
+}
+
+func ProgramUniform3dv(program uint32, location int32, count int32, value *float64) {
+	defaultContext.ProgramUniform3dv(program, location, count, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform3f(program uint32, location int32, v0 float32, v1 float32, v2 float32) {
+	syscall.Syscall6(ctx.gpProgramUniform3f, 5, uintptr(program), uintptr(location), uintptr(math.Float32bits(v0)), uintptr(math.Float32bits(v1)), uintptr(math.Float32bits(v2)), 0)
+	if hooked {
+		ctx.called("ProgramUniform3f", program, location, v0, v1, v2)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform3f(program uint32, location int32, v0 float32, v1 float32, v2 float32) {
+	defaultContext.ProgramUniform3f(program, location, v0, v1, v2)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform3fv(program uint32, location int32, count int32, value *float32) {
+	syscall.Syscall6(ctx.gpProgramUniform3fv, 4, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("ProgramUniform3fv", program, location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform3fv(program uint32, location int32, count int32, value *float32) {
+	defaultContext.ProgramUniform3fv(program, location, count, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform3i(program uint32, location int32, v0 int32, v1 int32, v2 int32) {
+	syscall.Syscall6(ctx.gpProgramUniform3i, 5, uintptr(program), uintptr(location), uintptr(v0), uintptr(v1), uintptr(v2), 0)
+	if hooked {
+		ctx.called("ProgramUniform3i", program, location, v0, v1, v2)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform3i(program uint32, location int32, v0 int32, v1 int32, v2 int32) {
+	defaultContext.ProgramUniform3i(program, location, v0, v1, v2)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform3iv(program uint32, location int32, count int32, value *int32) {
+	syscall.Syscall6(ctx.gpProgramUniform3iv, 4, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("ProgramUniform3iv", program, location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform3iv(program uint32, location int32, count int32, value *int32) {
+	defaultContext.ProgramUniform3iv(program, location, count, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform3ui(program uint32, location int32, v0 uint32, v1 uint32, v2 uint32) {
+	syscall.Syscall6(ctx.gpProgramUniform3ui, 5, uintptr(program), uintptr(location), uintptr(v0), uintptr(v1), uintptr(v2), 0)
+	if hooked {
+		ctx.called("ProgramUniform3ui", program, location, v0, v1, v2)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform3ui(program uint32, location int32, v0 uint32, v1 uint32, v2 uint32) {
+	defaultContext.ProgramUniform3ui(program, location, v0, v1, v2)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform3uiv(program uint32, location int32, count int32, value *uint32) {
+	syscall.Syscall6(ctx.gpProgramUniform3uiv, 4, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("ProgramUniform3uiv", program, location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform3uiv(program uint32, location int32, count int32, value *uint32) {
+	defaultContext.ProgramUniform3uiv(program, location, count, value)
+}
+
+func (ctx *Context) ProgramUniform4d(program uint32, location int32, v0 float64, v1 float64, v2 float64, v3 float64) {
+	syscall.Syscall6(ctx.gpProgramUniform4d, 6, uintptr(program), uintptr(location), uintptr(math.Float64bits(v0)), uintptr(math.Float64bits(v1)), uintptr(math.Float64bits(v2)), uintptr(math.Float64bits(v3)))
+	if hooked {
+		ctx.called("ProgramUniform4d", program, location, v0, v1, v2, v3)
+	}
+}
+
+func ProgramUniform4d(program uint32, location int32, v0 float64, v1 float64, v2 float64, v3 float64) {
+	defaultContext.ProgramUniform4d(program, location, v0, v1, v2, v3)
+}
+
+func (ctx *Context) ProgramUniform4dv(program uint32, location int32, count int32, value *float64) {
+	syscall.Syscall6(ctx.gpProgramUniform4dv, 4, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("ProgramUniform4dv", program, location, count, value)
+	}
+}
+
+func ProgramUniform4dv(program uint32, location int32, count int32, value *float64) {
+	defaultContext.ProgramUniform4dv(program, location, count, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform4f(program uint32, location int32, v0 float32, v1 float32, v2 float32, v3 float32) {
+	syscall.Syscall6(ctx.gpProgramUniform4f, 6, uintptr(program), uintptr(location), uintptr(math.Float32bits(v0)), uintptr(math.Float32bits(v1)), uintptr(math.Float32bits(v2)), uintptr(math.Float32bits(v3)))
+	if hooked {
+		ctx.called("ProgramUniform4f", program, location, v0, v1, v2, v3)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform4f(program uint32, location int32, v0 float32, v1 float32, v2 float32, v3 float32) {
+	defaultContext.ProgramUniform4f(program, location, v0, v1, v2, v3)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform4fv(program uint32, location int32, count int32, value *float32) {
+	syscall.Syscall6(ctx.gpProgramUniform4fv, 4, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("ProgramUniform4fv", program, location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform4fv(program uint32, location int32, count int32, value *float32) {
+	defaultContext.ProgramUniform4fv(program, location, count, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform4i(program uint32, location int32, v0 int32, v1 int32, v2 int32, v3 int32) {
+	syscall.Syscall6(ctx.gpProgramUniform4i, 6, uintptr(program), uintptr(location), uintptr(v0), uintptr(v1), uintptr(v2), uintptr(v3))
+	if hooked {
+		ctx.called("ProgramUniform4i", program, location, v0, v1, v2, v3)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform4i(program uint32, location int32, v0 int32, v1 int32, v2 int32, v3 int32) {
+	defaultContext.ProgramUniform4i(program, location, v0, v1, v2, v3)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform4iv(program uint32, location int32, count int32, value *int32) {
+	syscall.Syscall6(ctx.gpProgramUniform4iv, 4, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("ProgramUniform4iv", program, location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform4iv(program uint32, location int32, count int32, value *int32) {
+	defaultContext.ProgramUniform4iv(program, location, count, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform4ui(program uint32, location int32, v0 uint32, v1 uint32, v2 uint32, v3 uint32) {
+	syscall.Syscall6(ctx.gpProgramUniform4ui, 6, uintptr(program), uintptr(location), uintptr(v0), uintptr(v1), uintptr(v2), uintptr(v3))
+	if hooked {
+		ctx.called("ProgramUniform4ui", program, location, v0, v1, v2, v3)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform4ui(program uint32, location int32, v0 uint32, v1 uint32, v2 uint32, v3 uint32) {
+	defaultContext.ProgramUniform4ui(program, location, v0, v1, v2, v3)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniform4uiv(program uint32, location int32, count int32, value *uint32) {
+	syscall.Syscall6(ctx.gpProgramUniform4uiv, 4, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("ProgramUniform4uiv", program, location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniform4uiv(program uint32, location int32, count int32, value *uint32) {
+	defaultContext.ProgramUniform4uiv(program, location, count, value)
+}
+
+func (ctx *Context) ProgramUniformMatrix2dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	syscall.Syscall6(ctx.gpProgramUniformMatrix2dv, 5, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0)
+	if hooked {
+		ctx.called("ProgramUniformMatrix2dv", program, location, count, transpose, value)
+	}
+}
+
+func ProgramUniformMatrix2dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	defaultContext.ProgramUniformMatrix2dv(program, location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniformMatrix2fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(ctx.gpProgramUniformMatrix2fv, 5, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0)
+	if hooked {
+		ctx.called("ProgramUniformMatrix2fv", program, location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniformMatrix2fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	defaultContext.ProgramUniformMatrix2fv(program, location, count, transpose, value)
+}
+
+func (ctx *Context) ProgramUniformMatrix2x3dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	syscall.Syscall6(ctx.gpProgramUniformMatrix2x3dv, 5, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0)
+	if hooked {
+		ctx.called("ProgramUniformMatrix2x3dv", program, location, count, transpose, value)
+	}
+}
+
+func ProgramUniformMatrix2x3dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	defaultContext.ProgramUniformMatrix2x3dv(program, location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniformMatrix2x3fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(ctx.gpProgramUniformMatrix2x3fv, 5, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0)
+	if hooked {
+		ctx.called("ProgramUniformMatrix2x3fv", program, location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniformMatrix2x3fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	defaultContext.ProgramUniformMatrix2x3fv(program, location, count, transpose, value)
+}
+
+func (ctx *Context) ProgramUniformMatrix2x4dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	syscall.Syscall6(ctx.gpProgramUniformMatrix2x4dv, 5, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0)
+	if hooked {
+		ctx.called("ProgramUniformMatrix2x4dv", program, location, count, transpose, value)
+	}
+}
+
+func ProgramUniformMatrix2x4dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	defaultContext.ProgramUniformMatrix2x4dv(program, location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniformMatrix2x4fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(ctx.gpProgramUniformMatrix2x4fv, 5, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0)
+	if hooked {
+		ctx.called("ProgramUniformMatrix2x4fv", program, location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniformMatrix2x4fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	defaultContext.ProgramUniformMatrix2x4fv(program, location, count, transpose, value)
+}
+
+func (ctx *Context) ProgramUniformMatrix3dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	syscall.Syscall6(ctx.gpProgramUniformMatrix3dv, 5, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0)
+	if hooked {
+		ctx.called("ProgramUniformMatrix3dv", program, location, count, transpose, value)
+	}
+}
+
+func ProgramUniformMatrix3dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	defaultContext.ProgramUniformMatrix3dv(program, location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniformMatrix3fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(ctx.gpProgramUniformMatrix3fv, 5, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0)
+	if hooked {
+		ctx.called("ProgramUniformMatrix3fv", program, location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniformMatrix3fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	defaultContext.ProgramUniformMatrix3fv(program, location, count, transpose, value)
+}
+
+func (ctx *Context) ProgramUniformMatrix3x2dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	syscall.Syscall6(ctx.gpProgramUniformMatrix3x2dv, 5, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0)
+	if hooked {
+		ctx.called("ProgramUniformMatrix3x2dv", program, location, count, transpose, value)
+	}
+}
+
+func ProgramUniformMatrix3x2dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	defaultContext.ProgramUniformMatrix3x2dv(program, location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniformMatrix3x2fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(ctx.gpProgramUniformMatrix3x2fv, 5, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0)
+	if hooked {
+		ctx.called("ProgramUniformMatrix3x2fv", program, location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniformMatrix3x2fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	defaultContext.ProgramUniformMatrix3x2fv(program, location, count, transpose, value)
+}
+
+func (ctx *Context) ProgramUniformMatrix3x4dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	syscall.Syscall6(ctx.gpProgramUniformMatrix3x4dv, 5, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0)
+	if hooked {
+		ctx.called("ProgramUniformMatrix3x4dv", program, location, count, transpose, value)
+	}
+}
+
+func ProgramUniformMatrix3x4dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	defaultContext.ProgramUniformMatrix3x4dv(program, location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniformMatrix3x4fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(ctx.gpProgramUniformMatrix3x4fv, 5, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0)
+	if hooked {
+		ctx.called("ProgramUniformMatrix3x4fv", program, location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniformMatrix3x4fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	defaultContext.ProgramUniformMatrix3x4fv(program, location, count, transpose, value)
+}
+
+func (ctx *Context) ProgramUniformMatrix4dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	syscall.Syscall6(ctx.gpProgramUniformMatrix4dv, 5, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0)
+	if hooked {
+		ctx.called("ProgramUniformMatrix4dv", program, location, count, transpose, value)
+	}
+}
+
+func ProgramUniformMatrix4dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	defaultContext.ProgramUniformMatrix4dv(program, location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniformMatrix4fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(ctx.gpProgramUniformMatrix4fv, 5, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0)
+	if hooked {
+		ctx.called("ProgramUniformMatrix4fv", program, location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniformMatrix4fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	defaultContext.ProgramUniformMatrix4fv(program, location, count, transpose, value)
+}
+
+func (ctx *Context) ProgramUniformMatrix4x2dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	syscall.Syscall6(ctx.gpProgramUniformMatrix4x2dv, 5, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0)
+	if hooked {
+		ctx.called("ProgramUniformMatrix4x2dv", program, location, count, transpose, value)
+	}
+}
+
+func ProgramUniformMatrix4x2dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	defaultContext.ProgramUniformMatrix4x2dv(program, location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniformMatrix4x2fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(ctx.gpProgramUniformMatrix4x2fv, 5, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0)
+	if hooked {
+		ctx.called("ProgramUniformMatrix4x2fv", program, location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniformMatrix4x2fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	defaultContext.ProgramUniformMatrix4x2fv(program, location, count, transpose, value)
+}
+
+func (ctx *Context) ProgramUniformMatrix4x3dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	syscall.Syscall6(ctx.gpProgramUniformMatrix4x3dv, 5, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0)
+	if hooked {
+		ctx.called("ProgramUniformMatrix4x3dv", program, location, count, transpose, value)
+	}
+}
+
+func ProgramUniformMatrix4x3dv(program uint32, location int32, count int32, transpose bool, value *float64) {
+	defaultContext.ProgramUniformMatrix4x3dv(program, location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for a specified program object
+func (ctx *Context) ProgramUniformMatrix4x3fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(ctx.gpProgramUniformMatrix4x3fv, 5, uintptr(program), uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0)
+	if hooked {
+		ctx.called("ProgramUniformMatrix4x3fv", program, location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for a specified program object
+func ProgramUniformMatrix4x3fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	defaultContext.ProgramUniformMatrix4x3fv(program, location, count, transpose, value)
+}
+
+// specifiy the vertex to be used as the source of data for flat shaded varyings
+func (ctx *Context) ProvokingVertex(mode uint32) {
+	syscall.Syscall(ctx.gpProvokingVertex, 1, uintptr(mode), 0, 0)
+	if hooked {
+		ctx.called("ProvokingVertex", mode)
+	}
+}
+
+// specifiy the vertex to be used as the source of data for flat shaded varyings
+func ProvokingVertex(mode uint32) {
+	defaultContext.ProvokingVertex(mode)
+}
+
+// push a named debug group into the command stream
+func (ctx *Context) PushDebugGroup(source uint32, id uint32, length int32, message *uint8) {
+	syscall.Syscall6(ctx.gpPushDebugGroup, 4, uintptr(source), uintptr(id), uintptr(length), uintptr(unsafe.Pointer(message)), 0, 0)
+	if hooked {
+		ctx.called("PushDebugGroup", source, id, length, message)
+	}
+}
+
+// push a named debug group into the command stream
+func PushDebugGroup(source uint32, id uint32, length int32, message *uint8) {
+	defaultContext.PushDebugGroup(source, id, length, message)
+}
+
+// record the GL time into a query object after all previous commands have reached the GL server but have not yet necessarily executed.
+func (ctx *Context) QueryCounter(id uint32, target uint32) {
+	syscall.Syscall(ctx.gpQueryCounter, 2, uintptr(id), uintptr(target), 0)
+	if hooked {
+		ctx.called("QueryCounter", id, target)
+	}
+}
+
+// record the GL time into a query object after all previous commands have reached the GL server but have not yet necessarily executed.
+func QueryCounter(id uint32, target uint32) {
+	defaultContext.QueryCounter(id, target)
+}
+
+// select a color buffer source for pixels
+func (ctx *Context) ReadBuffer(src uint32) {
+	syscall.Syscall(ctx.gpReadBuffer, 1, uintptr(src), 0, 0)
+	if hooked {
+		ctx.called("ReadBuffer", src)
+	}
+}
+
+// select a color buffer source for pixels
+func ReadBuffer(src uint32) {
+	defaultContext.ReadBuffer(src)
+}
+
+// read a block of pixels from the frame buffer
+func (ctx *Context) ReadPixels(x int32, y int32, width int32, height int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	syscall.Syscall9(ctx.gpReadPixels, 7, uintptr(x), uintptr(y), uintptr(width), uintptr(height), uintptr(format), uintptr(xtype), uintptr(pixels), 0, 0)
+	if hooked {
+		ctx.called("ReadPixels", x, y, width, height, format, xtype, pixels)
+	}
+}
+
+// read a block of pixels from the frame buffer
+func ReadPixels(x int32, y int32, width int32, height int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	defaultContext.ReadPixels(x, y, width, height, format, xtype, pixels)
+}
+
+// read a block of pixels from the frame buffer
+func (ctx *Context) ReadnPixels(x int32, y int32, width int32, height int32, format uint32, xtype uint32, bufSize int32, data unsafe.Pointer) {
+	syscall.Syscall9(ctx.gpReadnPixels, 8, uintptr(x), uintptr(y), uintptr(width), uintptr(height), uintptr(format), uintptr(xtype), uintptr(bufSize), uintptr(data), 0)
+	if hooked {
+		ctx.called("ReadnPixels", x, y, width, height, format, xtype, bufSize, data)
+	}
+}
+
+// read a block of pixels from the frame buffer
+func ReadnPixels(x int32, y int32, width int32, height int32, format uint32, xtype uint32, bufSize int32, data unsafe.Pointer) {
+	defaultContext.ReadnPixels(x, y, width, height, format, xtype, bufSize, data)
+}
+
+// release resources consumed by the implementation's shader compiler
+func (ctx *Context) ReleaseShaderCompiler() {
+	syscall.Syscall(ctx.gpReleaseShaderCompiler, 0, 0, 0, 0)
+	if hooked {
+		ctx.called("ReleaseShaderCompiler")
+	}
+}
+
+// release resources consumed by the implementation's shader compiler
+func ReleaseShaderCompiler() {
+	defaultContext.ReleaseShaderCompiler()
+}
+
+// establish data storage, format and dimensions of a     renderbuffer object's image
+func (ctx *Context) RenderbufferStorage(target uint32, internalformat uint32, width int32, height int32) {
+	syscall.Syscall6(ctx.gpRenderbufferStorage, 4, uintptr(target), uintptr(internalformat), uintptr(width), uintptr(height), 0, 0)
+	if hooked {
+		ctx.called("RenderbufferStorage", target, internalformat, width, height)
+	}
+}
+
+// establish data storage, format and dimensions of a     renderbuffer object's image
+func RenderbufferStorage(target uint32, internalformat uint32, width int32, height int32) {
+	defaultContext.RenderbufferStorage(target, internalformat, width, height)
+}
+
+// establish data storage, format, dimensions and sample count of     a renderbuffer object's image
+func (ctx *Context) RenderbufferStorageMultisample(target uint32, samples int32, internalformat uint32, width int32, height int32) {
+	syscall.Syscall6(ctx.gpRenderbufferStorageMultisample, 5, uintptr(target), uintptr(samples), uintptr(internalformat), uintptr(width), uintptr(height), 0)
+	if hooked {
+		ctx.called("RenderbufferStorageMultisample", target, samples, internalformat, width, height)
+	}
+}
+
+// establish data storage, format, dimensions and sample count of     a renderbuffer object's image
+func RenderbufferStorageMultisample(target uint32, samples int32, internalformat uint32, width int32, height int32) {
+	defaultContext.RenderbufferStorageMultisample(target, samples, internalformat, width, height)
+}
+
+// resume transform feedback operations
+func (ctx *Context) ResumeTransformFeedback() {
+	syscall.Syscall(ctx.gpResumeTransformFeedback, 0, 0, 0, 0)
+	if hooked {
+		ctx.called("ResumeTransformFeedback")
+	}
+}
+
+// resume transform feedback operations
+func ResumeTransformFeedback() {
+	defaultContext.ResumeTransformFeedback()
+}
+
+// specify multisample coverage parameters
+func (ctx *Context) SampleCoverage(value float32, invert bool) {
+	syscall.Syscall(ctx.gpSampleCoverage, 2, uintptr(math.Float32bits(value)), boolToUintptr(invert), 0)
+	if hooked {
+		ctx.called("SampleCoverage", value, invert)
+	}
+}
+
+// specify multisample coverage parameters
+func SampleCoverage(value float32, invert bool) {
+	defaultContext.SampleCoverage(value, invert)
+}
+
+// set the value of a sub-word of the sample mask
+func (ctx *Context) SampleMaski(maskNumber uint32, mask uint32) {
+	syscall.Syscall(ctx.gpSampleMaski, 2, uintptr(maskNumber), uintptr(mask), 0)
+	if hooked {
+		ctx.called("SampleMaski", maskNumber, mask)
+	}
+}
+
+// set the value of a sub-word of the sample mask
+func SampleMaski(maskNumber uint32, mask uint32) {
+	defaultContext.SampleMaski(maskNumber, mask)
+}
+
+func (ctx *Context) SamplerParameterIiv(sampler uint32, pname uint32, param *int32) {
+	syscall.Syscall(ctx.gpSamplerParameterIiv, 3, uintptr(sampler), uintptr(pname), uintptr(unsafe.Pointer(param)))
+	if hooked {
+		ctx.called("SamplerParameterIiv", sampler, pname, param)
+	}
+}
+
+func SamplerParameterIiv(sampler uint32, pname uint32, param *int32) {
+	defaultContext.SamplerParameterIiv(sampler, pname, param)
+}
+
+func (ctx *Context) SamplerParameterIuiv(sampler uint32, pname uint32, param *uint32) {
+	syscall.Syscall(ctx.gpSamplerParameterIuiv, 3, uintptr(sampler), uintptr(pname), uintptr(unsafe.Pointer(param)))
+	if hooked {
+		ctx.called("SamplerParameterIuiv", sampler, pname, param)
+	}
+}
+
+func SamplerParameterIuiv(sampler uint32, pname uint32, param *uint32) {
+	defaultContext.SamplerParameterIuiv(sampler, pname, param)
+}
+
+func (ctx *Context) SamplerParameterf(sampler uint32, pname uint32, param float32) {
+	syscall.Syscall(ctx.gpSamplerParameterf, 3, uintptr(sampler), uintptr(pname), uintptr(math.Float32bits(param)))
+	if hooked {
+		ctx.called("SamplerParameterf", sampler, pname, param)
+	}
+}
+
+func SamplerParameterf(sampler uint32, pname uint32, param float32) {
+	defaultContext.SamplerParameterf(sampler, pname, param)
+}
+
+func (ctx *Context) SamplerParameterfv(sampler uint32, pname uint32, param *float32) {
+	syscall.Syscall(ctx.gpSamplerParameterfv, 3, uintptr(sampler), uintptr(pname), uintptr(unsafe.Pointer(param)))
+	if hooked {
+		ctx.called("SamplerParameterfv", sampler, pname, param)
+	}
+}
+
+func SamplerParameterfv(sampler uint32, pname uint32, param *float32) {
+	defaultContext.SamplerParameterfv(sampler, pname, param)
+}
+
+func (ctx *Context) SamplerParameteri(sampler uint32, pname uint32, param int32) {
+	syscall.Syscall(ctx.gpSamplerParameteri, 3, uintptr(sampler), uintptr(pname), uintptr(param))
+	if hooked {
+		ctx.called("SamplerParameteri", sampler, pname, param)
+	}
+}
+
+func SamplerParameteri(sampler uint32, pname uint32, param int32) {
+	defaultContext.SamplerParameteri(sampler, pname, param)
+}
+
+func (ctx *Context) SamplerParameteriv(sampler uint32, pname uint32, param *int32) {
+	syscall.Syscall(ctx.gpSamplerParameteriv, 3, uintptr(sampler), uintptr(pname), uintptr(unsafe.Pointer(param)))
+	if hooked {
+		ctx.called("SamplerParameteriv", sampler, pname, param)
+	}
+}
+
+func SamplerParameteriv(sampler uint32, pname uint32, param *int32) {
+	defaultContext.SamplerParameteriv(sampler, pname, param)
+}
+
+// define the scissor box
+func (ctx *Context) Scissor(x int32, y int32, width int32, height int32) {
+	syscall.Syscall6(ctx.gpScissor, 4, uintptr(x), uintptr(y), uintptr(width), uintptr(height), 0, 0)
+	if hooked {
+		ctx.called("Scissor", x, y, width, height)
+	}
+}
+
+// define the scissor box
+func Scissor(x int32, y int32, width int32, height int32) {
+	defaultContext.Scissor(x, y, width, height)
+}
+
+func (ctx *Context) ScissorArrayv(first uint32, count int32, v *int32) {
+	syscall.Syscall(ctx.gpScissorArrayv, 3, uintptr(first), uintptr(count), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("ScissorArrayv", first, count, v)
+	}
+}
+
+func ScissorArrayv(first uint32, count int32, v *int32) {
+	defaultContext.ScissorArrayv(first, count, v)
+}
+
+// define the scissor box for a specific viewport
+func (ctx *Context) ScissorIndexed(index uint32, left int32, bottom int32, width int32, height int32) {
+	syscall.Syscall6(ctx.gpScissorIndexed, 5, uintptr(index), uintptr(left), uintptr(bottom), uintptr(width), uintptr(height), 0)
+	if hooked {
+		ctx.called("ScissorIndexed", index, left, bottom, width, height)
+	}
+}
+
+// define the scissor box for a specific viewport
+func ScissorIndexed(index uint32, left int32, bottom int32, width int32, height int32) {
+	defaultContext.ScissorIndexed(index, left, bottom, width, height)
+}
+
+func (ctx *Context) ScissorIndexedv(index uint32, v *int32) {
+	syscall.Syscall(ctx.gpScissorIndexedv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("ScissorIndexedv", index, v)
+	}
+}
+
+func ScissorIndexedv(index uint32, v *int32) {
+	defaultContext.ScissorIndexedv(index, v)
+}
+
+// load pre-compiled shader binaries
+func (ctx *Context) ShaderBinary(count int32, shaders *uint32, binaryformat uint32, binary unsafe.Pointer, length int32) {
+	syscall.Syscall6(ctx.gpShaderBinary, 5, uintptr(count), uintptr(unsafe.Pointer(shaders)), uintptr(binaryformat), uintptr(binary), uintptr(length), 0)
+	if hooked {
+		ctx.called("ShaderBinary", count, shaders, binaryformat, binary, length)
+	}
+}
+
+// load pre-compiled shader binaries
+func ShaderBinary(count int32, shaders *uint32, binaryformat uint32, binary unsafe.Pointer, length int32) {
+	defaultContext.ShaderBinary(count, shaders, binaryformat, binary, length)
+}
+
+// Replaces the source code in a shader object
+func (ctx *Context) ShaderSource(shader uint32, count int32, xstring **uint8, length *int32) {
+	syscall.Syscall6(ctx.gpShaderSource, 4, uintptr(shader), uintptr(count), uintptr(unsafe.Pointer(xstring)), uintptr(unsafe.Pointer(length)), 0, 0)
+	if hooked {
+		ctx.called("ShaderSource", shader, count, xstring, length)
+	}
+}
+
+// Replaces the source code in a shader object
+func ShaderSource(shader uint32, count int32, xstring **uint8, length *int32) {
+	defaultContext.ShaderSource(shader, count, xstring, length)
+}
+
+// change an active shader storage block binding
+func (ctx *Context) ShaderStorageBlockBinding(program uint32, storageBlockIndex uint32, storageBlockBinding uint32) {
+	syscall.Syscall(ctx.gpShaderStorageBlockBinding, 3, uintptr(program), uintptr(storageBlockIndex), uintptr(storageBlockBinding))
+	if hooked {
+		ctx.called("ShaderStorageBlockBinding", program, storageBlockIndex, storageBlockBinding)
+	}
+}
+
+// change an active shader storage block binding
+func ShaderStorageBlockBinding(program uint32, storageBlockIndex uint32, storageBlockBinding uint32) {
+	defaultContext.ShaderStorageBlockBinding(program, storageBlockIndex, storageBlockBinding)
+}
+
+func (ctx *Context) SpecializeShader(shader uint32, pEntryPoint *uint8, numSpecializationConstants uint32, pConstantIndex *uint32, pConstantValue *uint32) {
+	syscall.Syscall6(ctx.gpSpecializeShader, 5, uintptr(shader), uintptr(unsafe.Pointer(pEntryPoint)), uintptr(numSpecializationConstants), uintptr(unsafe.Pointer(pConstantIndex)), uintptr(unsafe.Pointer(pConstantValue)), 0)
+	if hooked {
+		ctx.called("SpecializeShader", shader, pEntryPoint, numSpecializationConstants, pConstantIndex, pConstantValue)
+	}
+}
+
+func SpecializeShader(shader uint32, pEntryPoint *uint8, numSpecializationConstants uint32, pConstantIndex *uint32, pConstantValue *uint32) {
+	defaultContext.SpecializeShader(shader, pEntryPoint, numSpecializationConstants, pConstantIndex, pConstantValue)
+}
+
+// set front and back function and reference value for stencil testing
+func (ctx *Context) StencilFunc(xfunc uint32, ref int32, mask uint32) {
+	syscall.Syscall(ctx.gpStencilFunc, 3, uintptr(xfunc), uintptr(ref), uintptr(mask))
+	if hooked {
+		ctx.called("StencilFunc", xfunc, ref, mask)
+	}
+}
+
+// set front and back function and reference value for stencil testing
+func StencilFunc(xfunc uint32, ref int32, mask uint32) {
+	defaultContext.StencilFunc(xfunc, ref, mask)
+}
+
+// set front and/or back function and reference value for stencil testing
+func (ctx *Context) StencilFuncSeparate(face uint32, xfunc uint32, ref int32, mask uint32) {
+	syscall.Syscall6(ctx.gpStencilFuncSeparate, 4, uintptr(face), uintptr(xfunc), uintptr(ref), uintptr(mask), 0, 0)
+	if hooked {
+		ctx.called("StencilFuncSeparate", face, xfunc, ref, mask)
+	}
+}
+
+// set front and/or back function and reference value for stencil testing
+func StencilFuncSeparate(face uint32, xfunc uint32, ref int32, mask uint32) {
+	defaultContext.StencilFuncSeparate(face, xfunc, ref, mask)
+}
+
+// control the front and back writing of individual bits in the stencil planes
+func (ctx *Context) StencilMask(mask uint32) {
+	syscall.Syscall(ctx.gpStencilMask, 1, uintptr(mask), 0, 0)
+	if hooked {
+		ctx.called("StencilMask", mask)
+	}
+}
+
+// control the front and back writing of individual bits in the stencil planes
+func StencilMask(mask uint32) {
+	defaultContext.StencilMask(mask)
+}
+
+// control the front and/or back writing of individual bits in the stencil planes
+func (ctx *Context) StencilMaskSeparate(face uint32, mask uint32) {
+	syscall.Syscall(ctx.gpStencilMaskSeparate, 2, uintptr(face), uintptr(mask), 0)
+	if hooked {
+		ctx.called("StencilMaskSeparate", face, mask)
+	}
+}
+
+// control the front and/or back writing of individual bits in the stencil planes
+func StencilMaskSeparate(face uint32, mask uint32) {
+	defaultContext.StencilMaskSeparate(face, mask)
+}
+
+// set front and back stencil test actions
+func (ctx *Context) StencilOp(fail uint32, zfail uint32, zpass uint32) {
+	syscall.Syscall(ctx.gpStencilOp, 3, uintptr(fail), uintptr(zfail), uintptr(zpass))
+	if hooked {
+		ctx.called("StencilOp", fail, zfail, zpass)
+	}
+}
+
+// set front and back stencil test actions
+func StencilOp(fail uint32, zfail uint32, zpass uint32) {
+	defaultContext.StencilOp(fail, zfail, zpass)
+}
+
+// set front and/or back stencil test actions
+func (ctx *Context) StencilOpSeparate(face uint32, sfail uint32, dpfail uint32, dppass uint32) {
+	syscall.Syscall6(ctx.gpStencilOpSeparate, 4, uintptr(face), uintptr(sfail), uintptr(dpfail), uintptr(dppass), 0, 0)
+	if hooked {
+		ctx.called("StencilOpSeparate", face, sfail, dpfail, dppass)
+	}
+}
+
+// set front and/or back stencil test actions
+func StencilOpSeparate(face uint32, sfail uint32, dpfail uint32, dppass uint32) {
+	defaultContext.StencilOpSeparate(face, sfail, dpfail, dppass)
+}
+
+// attach a buffer object's data store to a buffer texture object
+func (ctx *Context) TexBuffer(target uint32, internalformat uint32, buffer uint32) {
+	syscall.Syscall(ctx.gpTexBuffer, 3, uintptr(target), uintptr(internalformat), uintptr(buffer))
+	if hooked {
+		ctx.called("TexBuffer", target, internalformat, buffer)
+	}
+}
+
+// attach a buffer object's data store to a buffer texture object
+func TexBuffer(target uint32, internalformat uint32, buffer uint32) {
+	defaultContext.TexBuffer(target, internalformat, buffer)
+}
+
+// attach a range of a buffer object's data store to a buffer texture object
+func (ctx *Context) TexBufferRange(target uint32, internalformat uint32, buffer uint32, offset int, size int) {
+	syscall.Syscall6(ctx.gpTexBufferRange, 5, uintptr(target), uintptr(internalformat), uintptr(buffer), uintptr(offset), uintptr(size), 0)
+	if hooked {
+		ctx.called("TexBufferRange", target, internalformat, buffer, offset, size)
+	}
+}
+
+// attach a range of a buffer object's data store to a buffer texture object
+func TexBufferRange(target uint32, internalformat uint32, buffer uint32, offset int, size int) {
+	defaultContext.TexBufferRange(target, internalformat, buffer, offset, size)
+}
+
+// specify a one-dimensional texture image
+func (ctx *Context) TexImage1D(target uint32, level int32, internalformat int32, width int32, border int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	syscall.Syscall9(ctx.gpTexImage1D, 8, uintptr(target), uintptr(level), uintptr(internalformat), uintptr(width), uintptr(border), uintptr(format), uintptr(xtype), uintptr(pixels), 0)
+	if hooked {
+		ctx.called("TexImage1D", target, level, internalformat, width, border, format, xtype, pixels)
+	}
+}
+
+// specify a one-dimensional texture image
+func TexImage1D(target uint32, level int32, internalformat int32, width int32, border int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	defaultContext.TexImage1D(target, level, internalformat, width, border, format, xtype, pixels)
+}
+
+// specify a two-dimensional texture image
+func (ctx *Context) TexImage2D(target uint32, level int32, internalformat int32, width int32, height int32, border int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	syscall.Syscall9(ctx.gpTexImage2D, 9, uintptr(target), uintptr(level), uintptr(internalformat), uintptr(width), uintptr(height), uintptr(border), uintptr(format), uintptr(xtype), uintptr(pixels))
+	if hooked {
+		ctx.called("TexImage2D", target, level, internalformat, width, height, border, format, xtype, pixels)
+	}
+}
+
+// specify a two-dimensional texture image
+func TexImage2D(target uint32, level int32, internalformat int32, width int32, height int32, border int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	defaultContext.TexImage2D(target, level, internalformat, width, height, border, format, xtype, pixels)
+}
+
+// establish the data storage, format, dimensions, and number of samples of a multisample texture's image
+func (ctx *Context) TexImage2DMultisample(target uint32, samples int32, internalformat uint32, width int32, height int32, fixedsamplelocations bool) {
+	syscall.Syscall6(ctx.gpTexImage2DMultisample, 6, uintptr(target), uintptr(samples), uintptr(internalformat), uintptr(width), uintptr(height), boolToUintptr(fixedsamplelocations))
+	if hooked {
+		ctx.called("TexImage2DMultisample", target, samples, internalformat, width, height, fixedsamplelocations)
+	}
+}
+
+// establish the data storage, format, dimensions, and number of samples of a multisample texture's image
+func TexImage2DMultisample(target uint32, samples int32, internalformat uint32, width int32, height int32, fixedsamplelocations bool) {
+	defaultContext.TexImage2DMultisample(target, samples, internalformat, width, height, fixedsamplelocations)
+}
+
+// specify a three-dimensional texture image
+func (ctx *Context) TexImage3D(target uint32, level int32, internalformat int32, width int32, height int32, depth int32, border int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	syscall.Syscall12(ctx.gpTexImage3D, 10, uintptr(target), uintptr(level), uintptr(internalformat), uintptr(width), uintptr(height), uintptr(depth), uintptr(border), uintptr(format), uintptr(xtype), uintptr(pixels), 0, 0)
+	if hooked {
+		ctx.called("TexImage3D", target, level, internalformat, width, height, depth, border, format, xtype, pixels)
+	}
+}
+
+// specify a three-dimensional texture image
+func TexImage3D(target uint32, level int32, internalformat int32, width int32, height int32, depth int32, border int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	defaultContext.TexImage3D(target, level, internalformat, width, height, depth, border, format, xtype, pixels)
+}
+
+// establish the data storage, format, dimensions, and number of samples of a multisample texture's image
+func (ctx *Context) TexImage3DMultisample(target uint32, samples int32, internalformat uint32, width int32, height int32, depth int32, fixedsamplelocations bool) {
+	syscall.Syscall9(ctx.gpTexImage3DMultisample, 7, uintptr(target), uintptr(samples), uintptr(internalformat), uintptr(width), uintptr(height), uintptr(depth), boolToUintptr(fixedsamplelocations), 0, 0)
+	if hooked {
+		ctx.called("TexImage3DMultisample", target, samples, internalformat, width, height, depth, fixedsamplelocations)
+	}
+}
+
+// establish the data storage, format, dimensions, and number of samples of a multisample texture's image
+func TexImage3DMultisample(target uint32, samples int32, internalformat uint32, width int32, height int32, depth int32, fixedsamplelocations bool) {
+	defaultContext.TexImage3DMultisample(target, samples, internalformat, width, height, depth, fixedsamplelocations)
+}
+
+func (ctx *Context) TexParameterIiv(target uint32, pname uint32, params *int32) {
+	syscall.Syscall(ctx.gpTexParameterIiv, 3, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("TexParameterIiv", target, pname, params)
+	}
+}
+
+func TexParameterIiv(target uint32, pname uint32, params *int32) {
+	defaultContext.TexParameterIiv(target, pname, params)
+}
+
+func (ctx *Context) TexParameterIuiv(target uint32, pname uint32, params *uint32) {
+	syscall.Syscall(ctx.gpTexParameterIuiv, 3, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("TexParameterIuiv", target, pname, params)
+	}
+}
+
+func TexParameterIuiv(target uint32, pname uint32, params *uint32) {
+	defaultContext.TexParameterIuiv(target, pname, params)
+}
+
+func (ctx *Context) TexParameterf(target uint32, pname uint32, param float32) {
+	syscall.Syscall(ctx.gpTexParameterf, 3, uintptr(target), uintptr(pname), uintptr(math.Float32bits(param)))
+	if hooked {
+		ctx.called("TexParameterf", target, pname, param)
+	}
+}
+
+func TexParameterf(target uint32, pname uint32, param float32) {
+	defaultContext.TexParameterf(target, pname, param)
+}
+
+func (ctx *Context) TexParameterfv(target uint32, pname uint32, params *float32) {
+	syscall.Syscall(ctx.gpTexParameterfv, 3, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("TexParameterfv", target, pname, params)
+	}
+}
+
+func TexParameterfv(target uint32, pname uint32, params *float32) {
+	defaultContext.TexParameterfv(target, pname, params)
+}
+
+func (ctx *Context) TexParameteri(target uint32, pname uint32, param int32) {
+	syscall.Syscall(ctx.gpTexParameteri, 3, uintptr(target), uintptr(pname), uintptr(param))
+	if hooked {
+		ctx.called("TexParameteri", target, pname, param)
+	}
+}
+
+func TexParameteri(target uint32, pname uint32, param int32) {
+	defaultContext.TexParameteri(target, pname, param)
+}
+
+func (ctx *Context) TexParameteriv(target uint32, pname uint32, params *int32) {
+	syscall.Syscall(ctx.gpTexParameteriv, 3, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("TexParameteriv", target, pname, params)
+	}
+}
+
+func TexParameteriv(target uint32, pname uint32, params *int32) {
+	defaultContext.TexParameteriv(target, pname, params)
+}
+
+// simultaneously specify storage for all levels of a one-dimensional texture
+func (ctx *Context) TexStorage1D(target uint32, levels int32, internalformat uint32, width int32) {
+	syscall.Syscall6(ctx.gpTexStorage1D, 4, uintptr(target), uintptr(levels), uintptr(internalformat), uintptr(width), 0, 0)
+	if hooked {
+		ctx.called("TexStorage1D", target, levels, internalformat, width)
+	}
+}
+
+// simultaneously specify storage for all levels of a one-dimensional texture
+func TexStorage1D(target uint32, levels int32, internalformat uint32, width int32) {
+	defaultContext.TexStorage1D(target, levels, internalformat, width)
+}
+
+// simultaneously specify storage for all levels of a two-dimensional or one-dimensional array texture
+func (ctx *Context) TexStorage2D(target uint32, levels int32, internalformat uint32, width int32, height int32) {
+	syscall.Syscall6(ctx.gpTexStorage2D, 5, uintptr(target), uintptr(levels), uintptr(internalformat), uintptr(width), uintptr(height), 0)
+	if hooked {
+		ctx.called("TexStorage2D", target, levels, internalformat, width, height)
+	}
+}
+
+// simultaneously specify storage for all levels of a two-dimensional or one-dimensional array texture
+func TexStorage2D(target uint32, levels int32, internalformat uint32, width int32, height int32) {
+	defaultContext.TexStorage2D(target, levels, internalformat, width, height)
+}
+
+// specify storage for a two-dimensional multisample texture
+func (ctx *Context) TexStorage2DMultisample(target uint32, samples int32, internalformat uint32, width int32, height int32, fixedsamplelocations bool) {
+	syscall.Syscall6(ctx.gpTexStorage2DMultisample, 6, uintptr(target), uintptr(samples), uintptr(internalformat), uintptr(width), uintptr(height), boolToUintptr(fixedsamplelocations))
+	if hooked {
+		ctx.called("TexStorage2DMultisample", target, samples, internalformat, width, height, fixedsamplelocations)
+	}
+}
+
+// specify storage for a two-dimensional multisample texture
+func TexStorage2DMultisample(target uint32, samples int32, internalformat uint32, width int32, height int32, fixedsamplelocations bool) {
+	defaultContext.TexStorage2DMultisample(target, samples, internalformat, width, height, fixedsamplelocations)
+}
+
+// simultaneously specify storage for all levels of a three-dimensional, two-dimensional array or cube-map array texture
+func (ctx *Context) TexStorage3D(target uint32, levels int32, internalformat uint32, width int32, height int32, depth int32) {
+	syscall.Syscall6(ctx.gpTexStorage3D, 6, uintptr(target), uintptr(levels), uintptr(internalformat), uintptr(width), uintptr(height), uintptr(depth))
+	if hooked {
+		ctx.called("TexStorage3D", target, levels, internalformat, width, height, depth)
+	}
+}
+
+// simultaneously specify storage for all levels of a three-dimensional, two-dimensional array or cube-map array texture
+func TexStorage3D(target uint32, levels int32, internalformat uint32, width int32, height int32, depth int32) {
+	defaultContext.TexStorage3D(target, levels, internalformat, width, height, depth)
+}
+
+// specify storage for a two-dimensional multisample array texture
+func (ctx *Context) TexStorage3DMultisample(target uint32, samples int32, internalformat uint32, width int32, height int32, depth int32, fixedsamplelocations bool) {
+	syscall.Syscall9(ctx.gpTexStorage3DMultisample, 7, uintptr(target), uintptr(samples), uintptr(internalformat), uintptr(width), uintptr(height), uintptr(depth), boolToUintptr(fixedsamplelocations), 0, 0)
+	if hooked {
+		ctx.called("TexStorage3DMultisample", target, samples, internalformat, width, height, depth, fixedsamplelocations)
+	}
+}
+
+// specify storage for a two-dimensional multisample array texture
+func TexStorage3DMultisample(target uint32, samples int32, internalformat uint32, width int32, height int32, depth int32, fixedsamplelocations bool) {
+	defaultContext.TexStorage3DMultisample(target, samples, internalformat, width, height, depth, fixedsamplelocations)
+}
+
+// specify a one-dimensional texture subimage
+func (ctx *Context) TexSubImage1D(target uint32, level int32, xoffset int32, width int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	syscall.Syscall9(ctx.gpTexSubImage1D, 7, uintptr(target), uintptr(level), uintptr(xoffset), uintptr(width), uintptr(format), uintptr(xtype), uintptr(pixels), 0, 0)
+	if hooked {
+		ctx.called("TexSubImage1D", target, level, xoffset, width, format, xtype, pixels)
+	}
+}
+
+// specify a one-dimensional texture subimage
+func TexSubImage1D(target uint32, level int32, xoffset int32, width int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	defaultContext.TexSubImage1D(target, level, xoffset, width, format, xtype, pixels)
+}
+
+// specify a two-dimensional texture subimage
+func (ctx *Context) TexSubImage2D(target uint32, level int32, xoffset int32, yoffset int32, width int32, height int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	syscall.Syscall9(ctx.gpTexSubImage2D, 9, uintptr(target), uintptr(level), uintptr(xoffset), uintptr(yoffset), uintptr(width), uintptr(height), uintptr(format), uintptr(xtype), uintptr(pixels))
+	if hooked {
+		ctx.called("TexSubImage2D", target, level, xoffset, yoffset, width, height, format, xtype, pixels)
+	}
+}
+
+// specify a two-dimensional texture subimage
+func TexSubImage2D(target uint32, level int32, xoffset int32, yoffset int32, width int32, height int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	defaultContext.TexSubImage2D(target, level, xoffset, yoffset, width, height, format, xtype, pixels)
+}
+
+// specify a three-dimensional texture subimage
+func (ctx *Context) TexSubImage3D(target uint32, level int32, xoffset int32, yoffset int32, zoffset int32, width int32, height int32, depth int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	syscall.Syscall12(ctx.gpTexSubImage3D, 11, uintptr(target), uintptr(level), uintptr(xoffset), uintptr(yoffset), uintptr(zoffset), uintptr(width), uintptr(height), uintptr(depth), uintptr(format), uintptr(xtype), uintptr(pixels), 0)
+	if hooked {
+		ctx.called("TexSubImage3D", target, level, xoffset, yoffset, zoffset, width, height, depth, format, xtype, pixels)
+	}
+}
+
+// specify a three-dimensional texture subimage
+func TexSubImage3D(target uint32, level int32, xoffset int32, yoffset int32, zoffset int32, width int32, height int32, depth int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	defaultContext.TexSubImage3D(target, level, xoffset, yoffset, zoffset, width, height, depth, format, xtype, pixels)
+}
+
+// controls the ordering of reads and writes to rendered fragments across drawing commands
+func (ctx *Context) TextureBarrier() {
+	syscall.Syscall(ctx.gpTextureBarrier, 0, 0, 0, 0)
+	if hooked {
+		ctx.called("TextureBarrier")
+	}
+}
+
+// controls the ordering of reads and writes to rendered fragments across drawing commands
+func TextureBarrier() {
+	defaultContext.TextureBarrier()
+}
+
+// attach a buffer object's data store to a buffer texture object
+func (ctx *Context) TextureBuffer(texture uint32, internalformat uint32, buffer uint32) {
+	syscall.Syscall(ctx.gpTextureBuffer, 3, uintptr(texture), uintptr(internalformat), uintptr(buffer))
+	if hooked {
+		ctx.called("TextureBuffer", texture, internalformat, buffer)
+	}
+}
+
+// attach a buffer object's data store to a buffer texture object
+func TextureBuffer(texture uint32, internalformat uint32, buffer uint32) {
+	defaultContext.TextureBuffer(texture, internalformat, buffer)
+}
+
+// attach a range of a buffer object's data store to a buffer texture object
+func (ctx *Context) TextureBufferRange(texture uint32, internalformat uint32, buffer uint32, offset int, size int) {
+	syscall.Syscall6(ctx.gpTextureBufferRange, 5, uintptr(texture), uintptr(internalformat), uintptr(buffer), uintptr(offset), uintptr(size), 0)
+	if hooked {
+		ctx.called("TextureBufferRange", texture, internalformat, buffer, offset, size)
+	}
+}
+
+// attach a range of a buffer object's data store to a buffer texture object
+func TextureBufferRange(texture uint32, internalformat uint32, buffer uint32, offset int, size int) {
+	defaultContext.TextureBufferRange(texture, internalformat, buffer, offset, size)
+}
+
+func (ctx *Context) TextureParameterIiv(texture uint32, pname uint32, params *int32) {
+	syscall.Syscall(ctx.gpTextureParameterIiv, 3, uintptr(texture), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("TextureParameterIiv", texture, pname, params)
+	}
+}
+
+func TextureParameterIiv(texture uint32, pname uint32, params *int32) {
+	defaultContext.TextureParameterIiv(texture, pname, params)
+}
+
+func (ctx *Context) TextureParameterIuiv(texture uint32, pname uint32, params *uint32) {
+	syscall.Syscall(ctx.gpTextureParameterIuiv, 3, uintptr(texture), uintptr(pname), uintptr(unsafe.Pointer(params)))
+	if hooked {
+		ctx.called("TextureParameterIuiv", texture, pname, params)
+	}
+}
+
+func TextureParameterIuiv(texture uint32, pname uint32, params *uint32) {
+	defaultContext.TextureParameterIuiv(texture, pname, params)
+}
+
+func (ctx *Context) TextureParameterf(texture uint32, pname uint32, param float32) {
+	syscall.Syscall(ctx.gpTextureParameterf, 3, uintptr(texture), uintptr(pname), uintptr(math.Float32bits(param)))
+	if hooked {
+		ctx.called("TextureParameterf", texture, pname, param)
+	}
+}
+
+func TextureParameterf(texture uint32, pname uint32, param float32) {
+	defaultContext.TextureParameterf(texture, pname, param)
+}
+
+func (ctx *Context) TextureParameterfv(texture uint32, pname uint32, param *float32) {
+	syscall.Syscall(ctx.gpTextureParameterfv, 3, uintptr(texture), uintptr(pname), uintptr(unsafe.Pointer(param)))
+	if hooked {
+		ctx.called("TextureParameterfv", texture, pname, param)
+	}
+}
+
+func TextureParameterfv(texture uint32, pname uint32, param *float32) {
+	defaultContext.TextureParameterfv(texture, pname, param)
+}
+
+func (ctx *Context) TextureParameteri(texture uint32, pname uint32, param int32) {
+	syscall.Syscall(ctx.gpTextureParameteri, 3, uintptr(texture), uintptr(pname), uintptr(param))
+	if hooked {
+		ctx.called("TextureParameteri", texture, pname, param)
+	}
+}
+
+func TextureParameteri(texture uint32, pname uint32, param int32) {
+	defaultContext.TextureParameteri(texture, pname, param)
+}
+
+func (ctx *Context) TextureParameteriv(texture uint32, pname uint32, param *int32) {
+	syscall.Syscall(ctx.gpTextureParameteriv, 3, uintptr(texture), uintptr(pname), uintptr(unsafe.Pointer(param)))
+	if hooked {
+		ctx.called("TextureParameteriv", texture, pname, param)
+	}
+}
+
+func TextureParameteriv(texture uint32, pname uint32, param *int32) {
+	defaultContext.TextureParameteriv(texture, pname, param)
+}
+
+// simultaneously specify storage for all levels of a one-dimensional texture
+func (ctx *Context) TextureStorage1D(texture uint32, levels int32, internalformat uint32, width int32) {
+	syscall.Syscall6(ctx.gpTextureStorage1D, 4, uintptr(texture), uintptr(levels), uintptr(internalformat), uintptr(width), 0, 0)
+	if hooked {
+		ctx.called("TextureStorage1D", texture, levels, internalformat, width)
+	}
+}
+
+// simultaneously specify storage for all levels of a one-dimensional texture
+func TextureStorage1D(texture uint32, levels int32, internalformat uint32, width int32) {
+	defaultContext.TextureStorage1D(texture, levels, internalformat, width)
+}
+
+// simultaneously specify storage for all levels of a two-dimensional or one-dimensional array texture
+func (ctx *Context) TextureStorage2D(texture uint32, levels int32, internalformat uint32, width int32, height int32) {
+	syscall.Syscall6(ctx.gpTextureStorage2D, 5, uintptr(texture), uintptr(levels), uintptr(internalformat), uintptr(width), uintptr(height), 0)
+	if hooked {
+		ctx.called("TextureStorage2D", texture, levels, internalformat, width, height)
+	}
+}
+
+// simultaneously specify storage for all levels of a two-dimensional or one-dimensional array texture
+func TextureStorage2D(texture uint32, levels int32, internalformat uint32, width int32, height int32) {
+	defaultContext.TextureStorage2D(texture, levels, internalformat, width, height)
+}
+
+// specify storage for a two-dimensional multisample texture
+func (ctx *Context) TextureStorage2DMultisample(texture uint32, samples int32, internalformat uint32, width int32, height int32, fixedsamplelocations bool) {
+	syscall.Syscall6(ctx.gpTextureStorage2DMultisample, 6, uintptr(texture), uintptr(samples), uintptr(internalformat), uintptr(width), uintptr(height), boolToUintptr(fixedsamplelocations))
+	if hooked {
+		ctx.called("TextureStorage2DMultisample", texture, samples, internalformat, width, height, fixedsamplelocations)
+	}
+}
+
+// specify storage for a two-dimensional multisample texture
+func TextureStorage2DMultisample(texture uint32, samples int32, internalformat uint32, width int32, height int32, fixedsamplelocations bool) {
+	defaultContext.TextureStorage2DMultisample(texture, samples, internalformat, width, height, fixedsamplelocations)
+}
+
+// simultaneously specify storage for all levels of a three-dimensional, two-dimensional array or cube-map array texture
+func (ctx *Context) TextureStorage3D(texture uint32, levels int32, internalformat uint32, width int32, height int32, depth int32) {
+	syscall.Syscall6(ctx.gpTextureStorage3D, 6, uintptr(texture), uintptr(levels), uintptr(internalformat), uintptr(width), uintptr(height), uintptr(depth))
+	if hooked {
+		ctx.called("TextureStorage3D", texture, levels, internalformat, width, height, depth)
+	}
+}
+
+// simultaneously specify storage for all levels of a three-dimensional, two-dimensional array or cube-map array texture
+func TextureStorage3D(texture uint32, levels int32, internalformat uint32, width int32, height int32, depth int32) {
+	defaultContext.TextureStorage3D(texture, levels, internalformat, width, height, depth)
+}
+
+// specify storage for a two-dimensional multisample array texture
+func (ctx *Context) TextureStorage3DMultisample(texture uint32, samples int32, internalformat uint32, width int32, height int32, depth int32, fixedsamplelocations bool) {
+	syscall.Syscall9(ctx.gpTextureStorage3DMultisample, 7, uintptr(texture), uintptr(samples), uintptr(internalformat), uintptr(width), uintptr(height), uintptr(depth), boolToUintptr(fixedsamplelocations), 0, 0)
+	if hooked {
+		ctx.called("TextureStorage3DMultisample", texture, samples, internalformat, width, height, depth, fixedsamplelocations)
+	}
+}
+
+// specify storage for a two-dimensional multisample array texture
+func TextureStorage3DMultisample(texture uint32, samples int32, internalformat uint32, width int32, height int32, depth int32, fixedsamplelocations bool) {
+	defaultContext.TextureStorage3DMultisample(texture, samples, internalformat, width, height, depth, fixedsamplelocations)
+}
+
+// specify a one-dimensional texture subimage
+func (ctx *Context) TextureSubImage1D(texture uint32, level int32, xoffset int32, width int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	syscall.Syscall9(ctx.gpTextureSubImage1D, 7, uintptr(texture), uintptr(level), uintptr(xoffset), uintptr(width), uintptr(format), uintptr(xtype), uintptr(pixels), 0, 0)
+	if hooked {
+		ctx.called("TextureSubImage1D", texture, level, xoffset, width, format, xtype, pixels)
+	}
+}
+
+// specify a one-dimensional texture subimage
+func TextureSubImage1D(texture uint32, level int32, xoffset int32, width int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	defaultContext.TextureSubImage1D(texture, level, xoffset, width, format, xtype, pixels)
+}
+
+// specify a two-dimensional texture subimage
+func (ctx *Context) TextureSubImage2D(texture uint32, level int32, xoffset int32, yoffset int32, width int32, height int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	syscall.Syscall9(ctx.gpTextureSubImage2D, 9, uintptr(texture), uintptr(level), uintptr(xoffset), uintptr(yoffset), uintptr(width), uintptr(height), uintptr(format), uintptr(xtype), uintptr(pixels))
+	if hooked {
+		ctx.called("TextureSubImage2D", texture, level, xoffset, yoffset, width, height, format, xtype, pixels)
+	}
+}
+
+// specify a two-dimensional texture subimage
+func TextureSubImage2D(texture uint32, level int32, xoffset int32, yoffset int32, width int32, height int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	defaultContext.TextureSubImage2D(texture, level, xoffset, yoffset, width, height, format, xtype, pixels)
+}
+
+// specify a three-dimensional texture subimage
+func (ctx *Context) TextureSubImage3D(texture uint32, level int32, xoffset int32, yoffset int32, zoffset int32, width int32, height int32, depth int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	syscall.Syscall12(ctx.gpTextureSubImage3D, 11, uintptr(texture), uintptr(level), uintptr(xoffset), uintptr(yoffset), uintptr(zoffset), uintptr(width), uintptr(height), uintptr(depth), uintptr(format), uintptr(xtype), uintptr(pixels), 0)
+	if hooked {
+		ctx.called("TextureSubImage3D", texture, level, xoffset, yoffset, zoffset, width, height, depth, format, xtype, pixels)
+	}
+}
+
+// specify a three-dimensional texture subimage
+func TextureSubImage3D(texture uint32, level int32, xoffset int32, yoffset int32, zoffset int32, width int32, height int32, depth int32, format uint32, xtype uint32, pixels unsafe.Pointer) {
+	defaultContext.TextureSubImage3D(texture, level, xoffset, yoffset, zoffset, width, height, depth, format, xtype, pixels)
+}
+
+// initialize a texture as a data alias of another texture's data store
+func (ctx *Context) TextureView(texture uint32, target uint32, origtexture uint32, internalformat uint32, minlevel uint32, numlevels uint32, minlayer uint32, numlayers uint32) {
+	syscall.Syscall9(ctx.gpTextureView, 8, uintptr(texture), uintptr(target), uintptr(origtexture), uintptr(internalformat), uintptr(minlevel), uintptr(numlevels), uintptr(minlayer), uintptr(numlayers), 0)
+	if hooked {
+		ctx.called("TextureView", texture, target, origtexture, internalformat, minlevel, numlevels, minlayer, numlayers)
+	}
+}
+
+// initialize a texture as a data alias of another texture's data store
+func TextureView(texture uint32, target uint32, origtexture uint32, internalformat uint32, minlevel uint32, numlevels uint32, minlayer uint32, numlayers uint32) {
+	defaultContext.TextureView(texture, target, origtexture, internalformat, minlevel, numlevels, minlayer, numlayers)
+}
+
+// bind a buffer object to a transform feedback buffer object
+func (ctx *Context) TransformFeedbackBufferBase(xfb uint32, index uint32, buffer uint32) {
+	syscall.Syscall(ctx.gpTransformFeedbackBufferBase, 3, uintptr(xfb), uintptr(index), uintptr(buffer))
+	if hooked {
+		ctx.called("TransformFeedbackBufferBase", xfb, index, buffer)
+	}
+}
+
+// bind a buffer object to a transform feedback buffer object
+func TransformFeedbackBufferBase(xfb uint32, index uint32, buffer uint32) {
+	defaultContext.TransformFeedbackBufferBase(xfb, index, buffer)
+}
+
+// bind a range within a buffer object to a transform feedback buffer object
+func (ctx *Context) TransformFeedbackBufferRange(xfb uint32, index uint32, buffer uint32, offset int, size int) {
+	syscall.Syscall6(ctx.gpTransformFeedbackBufferRange, 5, uintptr(xfb), uintptr(index), uintptr(buffer), uintptr(offset), uintptr(size), 0)
+	if hooked {
+		ctx.called("TransformFeedbackBufferRange", xfb, index, buffer, offset, size)
+	}
+}
+
+// bind a range within a buffer object to a transform feedback buffer object
+func TransformFeedbackBufferRange(xfb uint32, index uint32, buffer uint32, offset int, size int) {
+	defaultContext.TransformFeedbackBufferRange(xfb, index, buffer, offset, size)
+}
+
+// specify values to record in transform feedback buffers
+func (ctx *Context) TransformFeedbackVaryings(program uint32, count int32, varyings **uint8, bufferMode uint32) {
+	syscall.Syscall6(ctx.gpTransformFeedbackVaryings, 4, uintptr(program), uintptr(count), uintptr(unsafe.Pointer(varyings)), uintptr(bufferMode), 0, 0)
+	if hooked {
+		ctx.called("TransformFeedbackVaryings", program, count, varyings, bufferMode)
+	}
+}
+
+// specify values to record in transform feedback buffers
+func TransformFeedbackVaryings(program uint32, count int32, varyings **uint8, bufferMode uint32) {
+	defaultContext.TransformFeedbackVaryings(program, count, varyings, bufferMode)
+}
+
+func (ctx *Context) Uniform1d(location int32, x float64) {
+	syscall.Syscall(ctx.gpUniform1d, 2, uintptr(location), uintptr(math.Float64bits(x)), 0)
+	if hooked {
+		ctx.called("Uniform1d", location, x)
+	}
+}
+
+func Uniform1d(location int32, x float64) {
+	defaultContext.Uniform1d(location, x)
+}
+
+func (ctx *Context) Uniform1dv(location int32, count int32, value *float64) {
+	syscall.Syscall(ctx.gpUniform1dv, 3, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("Uniform1dv", location, count, value)
+	}
+}
+
+func Uniform1dv(location int32, count int32, value *float64) {
+	defaultContext.Uniform1dv(location, count, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform1f(location int32, v0 float32) {
+	syscall.Syscall(ctx.gpUniform1f, 2, uintptr(location), uintptr(math.Float32bits(v0)), 0)
+	if hooked {
+		ctx.called("Uniform1f", location, v0)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform1f(location int32, v0 float32) {
+	defaultContext.Uniform1f(location, v0)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform1fv(location int32, count int32, value *float32) {
+	syscall.Syscall(ctx.gpUniform1fv, 3, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("Uniform1fv", location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform1fv(location int32, count int32, value *float32) {
+	defaultContext.Uniform1fv(location, count, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform1i(location int32, v0 int32) {
+	syscall.Syscall(ctx.gpUniform1i, 2, uintptr(location), uintptr(v0), 0)
+	if hooked {
+		ctx.called("Uniform1i", location, v0)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform1i(location int32, v0 int32) {
+	defaultContext.Uniform1i(location, v0)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform1iv(location int32, count int32, value *int32) {
+	syscall.Syscall(ctx.gpUniform1iv, 3, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("Uniform1iv", location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform1iv(location int32, count int32, value *int32) {
+	defaultContext.Uniform1iv(location, count, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform1ui(location int32, v0 uint32) {
+	syscall.Syscall(ctx.gpUniform1ui, 2, uintptr(location), uintptr(v0), 0)
+	if hooked {
+		ctx.called("Uniform1ui", location, v0)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform1ui(location int32, v0 uint32) {
+	defaultContext.Uniform1ui(location, v0)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform1uiv(location int32, count int32, value *uint32) {
+	syscall.Syscall(ctx.gpUniform1uiv, 3, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("Uniform1uiv", location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform1uiv(location int32, count int32, value *uint32) {
+	defaultContext.Uniform1uiv(location, count, value)
+}
+
+func (ctx *Context) Uniform2d(location int32, x float64, y float64) {
+	syscall.Syscall(ctx.gpUniform2d, 3, uintptr(location), uintptr(math.Float64bits(x)), uintptr(math.Float64bits(y)))
+	if hooked {
+		ctx.called("Uniform2d", location, x, y)
+	}
+}
+
+func Uniform2d(location int32, x float64, y float64) {
+	defaultContext.Uniform2d(location, x, y)
+}
+
+func (ctx *Context) Uniform2dv(location int32, count int32, value *float64) {
+	syscall.Syscall(ctx.gpUniform2dv, 3, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("Uniform2dv", location, count, value)
+	}
+}
+
+func Uniform2dv(location int32, count int32, value *float64) {
+	defaultContext.Uniform2dv(location, count, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform2f(location int32, v0 float32, v1 float32) {
+	syscall.Syscall(ctx.gpUniform2f, 3, uintptr(location), uintptr(math.Float32bits(v0)), uintptr(math.Float32bits(v1)))
+	if hooked {
+		ctx.called("Uniform2f", location, v0, v1)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform2f(location int32, v0 float32, v1 float32) {
+	defaultContext.Uniform2f(location, v0, v1)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform2fv(location int32, count int32, value *float32) {
+	syscall.Syscall(ctx.gpUniform2fv, 3, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("Uniform2fv", location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform2fv(location int32, count int32, value *float32) {
+	defaultContext.Uniform2fv(location, count, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform2i(location int32, v0 int32, v1 int32) {
+	syscall.Syscall(ctx.gpUniform2i, 3, uintptr(location), uintptr(v0), uintptr(v1))
+	if hooked {
+		ctx.called("Uniform2i", location, v0, v1)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform2i(location int32, v0 int32, v1 int32) {
+	defaultContext.Uniform2i(location, v0, v1)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform2iv(location int32, count int32, value *int32) {
+	syscall.Syscall(ctx.gpUniform2iv, 3, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("Uniform2iv", location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform2iv(location int32, count int32, value *int32) {
+	defaultContext.Uniform2iv(location, count, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform2ui(location int32, v0 uint32, v1 uint32) {
+	syscall.Syscall(ctx.gpUniform2ui, 3, uintptr(location), uintptr(v0), uintptr(v1))
+	if hooked {
+		ctx.called("Uniform2ui", location, v0, v1)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform2ui(location int32, v0 uint32, v1 uint32) {
+	defaultContext.Uniform2ui(location, v0, v1)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform2uiv(location int32, count int32, value *uint32) {
+	syscall.Syscall(ctx.gpUniform2uiv, 3, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("Uniform2uiv", location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform2uiv(location int32, count int32, value *uint32) {
+	defaultContext.Uniform2uiv(location, count, value)
+}
+
+func (ctx *Context) Uniform3d(location int32, x float64, y float64, z float64) {
+	syscall.Syscall6(ctx.gpUniform3d, 4, uintptr(location), uintptr(math.Float64bits(x)), uintptr(math.Float64bits(y)), uintptr(math.Float64bits(z)), 0, 0)
+	if hooked {
+		ctx.called("Uniform3d", location, x, y, z)
+	}
+}
+
+func Uniform3d(location int32, x float64, y float64, z float64) {
+	defaultContext.Uniform3d(location, x, y, z)
+}
+
+func (ctx *Context) Uniform3dv(location int32, count int32, value *float64) {
+	syscall.Syscall(ctx.gpUniform3dv, 3, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("Uniform3dv", location, count, value)
+	}
+}
+
+func Uniform3dv(location int32, count int32, value *float64) {
+	defaultContext.Uniform3dv(location, count, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform3f(location int32, v0 float32, v1 float32, v2 float32) {
+	syscall.Syscall6(ctx.gpUniform3f, 4, uintptr(location), uintptr(math.Float32bits(v0)), uintptr(math.Float32bits(v1)), uintptr(math.Float32bits(v2)), 0, 0)
+	if hooked {
+		ctx.called("Uniform3f", location, v0, v1, v2)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform3f(location int32, v0 float32, v1 float32, v2 float32) {
+	defaultContext.Uniform3f(location, v0, v1, v2)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform3fv(location int32, count int32, value *float32) {
+	syscall.Syscall(ctx.gpUniform3fv, 3, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("Uniform3fv", location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform3fv(location int32, count int32, value *float32) {
+	defaultContext.Uniform3fv(location, count, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform3i(location int32, v0 int32, v1 int32, v2 int32) {
+	syscall.Syscall6(ctx.gpUniform3i, 4, uintptr(location), uintptr(v0), uintptr(v1), uintptr(v2), 0, 0)
+	if hooked {
+		ctx.called("Uniform3i", location, v0, v1, v2)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform3i(location int32, v0 int32, v1 int32, v2 int32) {
+	defaultContext.Uniform3i(location, v0, v1, v2)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform3iv(location int32, count int32, value *int32) {
+	syscall.Syscall(ctx.gpUniform3iv, 3, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("Uniform3iv", location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform3iv(location int32, count int32, value *int32) {
+	defaultContext.Uniform3iv(location, count, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform3ui(location int32, v0 uint32, v1 uint32, v2 uint32) {
+	syscall.Syscall6(ctx.gpUniform3ui, 4, uintptr(location), uintptr(v0), uintptr(v1), uintptr(v2), 0, 0)
+	if hooked {
+		ctx.called("Uniform3ui", location, v0, v1, v2)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform3ui(location int32, v0 uint32, v1 uint32, v2 uint32) {
+	defaultContext.Uniform3ui(location, v0, v1, v2)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform3uiv(location int32, count int32, value *uint32) {
+	syscall.Syscall(ctx.gpUniform3uiv, 3, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("Uniform3uiv", location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform3uiv(location int32, count int32, value *uint32) {
+	defaultContext.Uniform3uiv(location, count, value)
+}
+
+func (ctx *Context) Uniform4d(location int32, x float64, y float64, z float64, w float64) {
+	syscall.Syscall6(ctx.gpUniform4d, 5, uintptr(location), uintptr(math.Float64bits(x)), uintptr(math.Float64bits(y)), uintptr(math.Float64bits(z)), uintptr(math.Float64bits(w)), 0)
+	if hooked {
+		ctx.called("Uniform4d", location, x, y, z, w)
+	}
+}
+
+func Uniform4d(location int32, x float64, y float64, z float64, w float64) {
+	defaultContext.Uniform4d(location, x, y, z, w)
+}
+
+func (ctx *Context) Uniform4dv(location int32, count int32, value *float64) {
+	syscall.Syscall(ctx.gpUniform4dv, 3, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("Uniform4dv", location, count, value)
+	}
+}
+
+func Uniform4dv(location int32, count int32, value *float64) {
+	defaultContext.Uniform4dv(location, count, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform4f(location int32, v0 float32, v1 float32, v2 float32, v3 float32) {
+	syscall.Syscall6(ctx.gpUniform4f, 5, uintptr(location), uintptr(math.Float32bits(v0)), uintptr(math.Float32bits(v1)), uintptr(math.Float32bits(v2)), uintptr(math.Float32bits(v3)), 0)
+	if hooked {
+		ctx.called("Uniform4f", location, v0, v1, v2, v3)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform4f(location int32, v0 float32, v1 float32, v2 float32, v3 float32) {
+	defaultContext.Uniform4f(location, v0, v1, v2, v3)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform4fv(location int32, count int32, value *float32) {
+	syscall.Syscall(ctx.gpUniform4fv, 3, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("Uniform4fv", location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform4fv(location int32, count int32, value *float32) {
+	defaultContext.Uniform4fv(location, count, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform4i(location int32, v0 int32, v1 int32, v2 int32, v3 int32) {
+	syscall.Syscall6(ctx.gpUniform4i, 5, uintptr(location), uintptr(v0), uintptr(v1), uintptr(v2), uintptr(v3), 0)
+	if hooked {
+		ctx.called("Uniform4i", location, v0, v1, v2, v3)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform4i(location int32, v0 int32, v1 int32, v2 int32, v3 int32) {
+	defaultContext.Uniform4i(location, v0, v1, v2, v3)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform4iv(location int32, count int32, value *int32) {
+	syscall.Syscall(ctx.gpUniform4iv, 3, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("Uniform4iv", location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform4iv(location int32, count int32, value *int32) {
+	defaultContext.Uniform4iv(location, count, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform4ui(location int32, v0 uint32, v1 uint32, v2 uint32, v3 uint32) {
+	syscall.Syscall6(ctx.gpUniform4ui, 5, uintptr(location), uintptr(v0), uintptr(v1), uintptr(v2), uintptr(v3), 0)
+	if hooked {
+		ctx.called("Uniform4ui", location, v0, v1, v2, v3)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform4ui(location int32, v0 uint32, v1 uint32, v2 uint32, v3 uint32) {
+	defaultContext.Uniform4ui(location, v0, v1, v2, v3)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) Uniform4uiv(location int32, count int32, value *uint32) {
+	syscall.Syscall(ctx.gpUniform4uiv, 3, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+	if hooked {
+		ctx.called("Uniform4uiv", location, count, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func Uniform4uiv(location int32, count int32, value *uint32) {
+	defaultContext.Uniform4uiv(location, count, value)
+}
+
+// assign a binding point to an active uniform block
+func (ctx *Context) UniformBlockBinding(program uint32, uniformBlockIndex uint32, uniformBlockBinding uint32) {
+	syscall.Syscall(ctx.gpUniformBlockBinding, 3, uintptr(program), uintptr(uniformBlockIndex), uintptr(uniformBlockBinding))
+	if hooked {
+		ctx.called("UniformBlockBinding", program, uniformBlockIndex, uniformBlockBinding)
+	}
+}
+
+// assign a binding point to an active uniform block
+func UniformBlockBinding(program uint32, uniformBlockIndex uint32, uniformBlockBinding uint32) {
+	defaultContext.UniformBlockBinding(program, uniformBlockIndex, uniformBlockBinding)
+}
+
+func (ctx *Context) UniformMatrix2dv(location int32, count int32, transpose bool, value *float64) {
+	syscall.Syscall6(ctx.gpUniformMatrix2dv, 4, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("UniformMatrix2dv", location, count, transpose, value)
+	}
+}
+
+func UniformMatrix2dv(location int32, count int32, transpose bool, value *float64) {
+	defaultContext.UniformMatrix2dv(location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) UniformMatrix2fv(location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(ctx.gpUniformMatrix2fv, 4, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("UniformMatrix2fv", location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func UniformMatrix2fv(location int32, count int32, transpose bool, value *float32) {
+	defaultContext.UniformMatrix2fv(location, count, transpose, value)
+}
+
+func (ctx *Context) UniformMatrix2x3dv(location int32, count int32, transpose bool, value *float64) {
+	syscall.Syscall6(ctx.gpUniformMatrix2x3dv, 4, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("UniformMatrix2x3dv", location, count, transpose, value)
+	}
+}
+
+func UniformMatrix2x3dv(location int32, count int32, transpose bool, value *float64) {
+	defaultContext.UniformMatrix2x3dv(location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) UniformMatrix2x3fv(location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(ctx.gpUniformMatrix2x3fv, 4, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("UniformMatrix2x3fv", location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func UniformMatrix2x3fv(location int32, count int32, transpose bool, value *float32) {
+	defaultContext.UniformMatrix2x3fv(location, count, transpose, value)
+}
+
+func (ctx *Context) UniformMatrix2x4dv(location int32, count int32, transpose bool, value *float64) {
+	syscall.Syscall6(ctx.gpUniformMatrix2x4dv, 4, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("UniformMatrix2x4dv", location, count, transpose, value)
+	}
+}
+
+func UniformMatrix2x4dv(location int32, count int32, transpose bool, value *float64) {
+	defaultContext.UniformMatrix2x4dv(location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) UniformMatrix2x4fv(location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(ctx.gpUniformMatrix2x4fv, 4, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("UniformMatrix2x4fv", location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func UniformMatrix2x4fv(location int32, count int32, transpose bool, value *float32) {
+	defaultContext.UniformMatrix2x4fv(location, count, transpose, value)
+}
+
+func (ctx *Context) UniformMatrix3dv(location int32, count int32, transpose bool, value *float64) {
+	syscall.Syscall6(ctx.gpUniformMatrix3dv, 4, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("UniformMatrix3dv", location, count, transpose, value)
+	}
+}
+
+func UniformMatrix3dv(location int32, count int32, transpose bool, value *float64) {
+	defaultContext.UniformMatrix3dv(location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) UniformMatrix3fv(location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(ctx.gpUniformMatrix3fv, 4, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("UniformMatrix3fv", location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func UniformMatrix3fv(location int32, count int32, transpose bool, value *float32) {
+	defaultContext.UniformMatrix3fv(location, count, transpose, value)
+}
+
+func (ctx *Context) UniformMatrix3x2dv(location int32, count int32, transpose bool, value *float64) {
+	syscall.Syscall6(ctx.gpUniformMatrix3x2dv, 4, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("UniformMatrix3x2dv", location, count, transpose, value)
+	}
+}
+
+func UniformMatrix3x2dv(location int32, count int32, transpose bool, value *float64) {
+	defaultContext.UniformMatrix3x2dv(location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) UniformMatrix3x2fv(location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(ctx.gpUniformMatrix3x2fv, 4, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("UniformMatrix3x2fv", location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func UniformMatrix3x2fv(location int32, count int32, transpose bool, value *float32) {
+	defaultContext.UniformMatrix3x2fv(location, count, transpose, value)
+}
+
+func (ctx *Context) UniformMatrix3x4dv(location int32, count int32, transpose bool, value *float64) {
+	syscall.Syscall6(ctx.gpUniformMatrix3x4dv, 4, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("UniformMatrix3x4dv", location, count, transpose, value)
+	}
+}
+
+func UniformMatrix3x4dv(location int32, count int32, transpose bool, value *float64) {
+	defaultContext.UniformMatrix3x4dv(location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) UniformMatrix3x4fv(location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(ctx.gpUniformMatrix3x4fv, 4, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("UniformMatrix3x4fv", location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func UniformMatrix3x4fv(location int32, count int32, transpose bool, value *float32) {
+	defaultContext.UniformMatrix3x4fv(location, count, transpose, value)
+}
+
+func (ctx *Context) UniformMatrix4dv(location int32, count int32, transpose bool, value *float64) {
+	syscall.Syscall6(ctx.gpUniformMatrix4dv, 4, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("UniformMatrix4dv", location, count, transpose, value)
+	}
+}
+
+func UniformMatrix4dv(location int32, count int32, transpose bool, value *float64) {
+	defaultContext.UniformMatrix4dv(location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) UniformMatrix4fv(location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(ctx.gpUniformMatrix4fv, 4, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("UniformMatrix4fv", location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func UniformMatrix4fv(location int32, count int32, transpose bool, value *float32) {
+	defaultContext.UniformMatrix4fv(location, count, transpose, value)
+}
+
+func (ctx *Context) UniformMatrix4x2dv(location int32, count int32, transpose bool, value *float64) {
+	syscall.Syscall6(ctx.gpUniformMatrix4x2dv, 4, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("UniformMatrix4x2dv", location, count, transpose, value)
+	}
+}
+
+func UniformMatrix4x2dv(location int32, count int32, transpose bool, value *float64) {
+	defaultContext.UniformMatrix4x2dv(location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) UniformMatrix4x2fv(location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(ctx.gpUniformMatrix4x2fv, 4, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("UniformMatrix4x2fv", location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func UniformMatrix4x2fv(location int32, count int32, transpose bool, value *float32) {
+	defaultContext.UniformMatrix4x2fv(location, count, transpose, value)
+}
+
+func (ctx *Context) UniformMatrix4x3dv(location int32, count int32, transpose bool, value *float64) {
+	syscall.Syscall6(ctx.gpUniformMatrix4x3dv, 4, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("UniformMatrix4x3dv", location, count, transpose, value)
+	}
+}
+
+func UniformMatrix4x3dv(location int32, count int32, transpose bool, value *float64) {
+	defaultContext.UniformMatrix4x3dv(location, count, transpose, value)
+}
+
+// Specify the value of a uniform variable for the current program object
+func (ctx *Context) UniformMatrix4x3fv(location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(ctx.gpUniformMatrix4x3fv, 4, uintptr(location), uintptr(count), boolToUintptr(transpose), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("UniformMatrix4x3fv", location, count, transpose, value)
+	}
+}
+
+// Specify the value of a uniform variable for the current program object
+func UniformMatrix4x3fv(location int32, count int32, transpose bool, value *float32) {
+	defaultContext.UniformMatrix4x3fv(location, count, transpose, value)
+}
+
+func (ctx *Context) UniformSubroutinesuiv(shadertype uint32, count int32, indices *uint32) {
+	syscall.Syscall(ctx.gpUniformSubroutinesuiv, 3, uintptr(shadertype), uintptr(count), uintptr(unsafe.Pointer(indices)))
+	if hooked {
+		ctx.called("UniformSubroutinesuiv", shadertype, count, indices)
+	}
+}
+
+func UniformSubroutinesuiv(shadertype uint32, count int32, indices *uint32) {
+	defaultContext.UniformSubroutinesuiv(shadertype, count, indices)
+}
+
+// release the mapping of a buffer object's data store into the client's address space
+func (ctx *Context) UnmapBuffer(target uint32) bool {
+	ret, _, _ := syscall.Syscall(ctx.gpUnmapBuffer, 1, uintptr(target), 0, 0)
+	if hooked {
+		ctx.called("UnmapBuffer", target)
+	}
+	return ret != 0
+}
+
+// release the mapping of a buffer object's data store into the client's address space
+func UnmapBuffer(target uint32) bool {
+	return defaultContext.UnmapBuffer(target)
+}
+
+// release the mapping of a buffer object's data store into the client's address space
+func (ctx *Context) UnmapNamedBuffer(buffer uint32) bool {
+	ret, _, _ := syscall.Syscall(ctx.gpUnmapNamedBuffer, 1, uintptr(buffer), 0, 0)
+	if hooked {
+		ctx.called("UnmapNamedBuffer", buffer)
+	}
+	return ret != 0
+}
+
+// release the mapping of a buffer object's data store into the client's address space
+func UnmapNamedBuffer(buffer uint32) bool {
+	return defaultContext.UnmapNamedBuffer(buffer)
+}
+
+// Installs a program object as part of current rendering state
+func (ctx *Context) UseProgram(program uint32) {
+	syscall.Syscall(ctx.gpUseProgram, 1, uintptr(program), 0, 0)
+	if hooked {
+		ctx.called("UseProgram", program)
+	}
+}
+
+// Installs a program object as part of current rendering state
+func UseProgram(program uint32) {
+	defaultContext.UseProgram(program)
+}
+
+// bind stages of a program object to a program pipeline
+func (ctx *Context) UseProgramStages(pipeline uint32, stages uint32, program uint32) {
+	syscall.Syscall(ctx.gpUseProgramStages, 3, uintptr(pipeline), uintptr(stages), uintptr(program))
+	if hooked {
+		ctx.called("UseProgramStages", pipeline, stages, program)
+	}
+}
+
+// bind stages of a program object to a program pipeline
+func UseProgramStages(pipeline uint32, stages uint32, program uint32) {
+	defaultContext.UseProgramStages(pipeline, stages, program)
+}
+
+// Validates a program object
+func (ctx *Context) ValidateProgram(program uint32) {
+	syscall.Syscall(ctx.gpValidateProgram, 1, uintptr(program), 0, 0)
+	if hooked {
+		ctx.called("ValidateProgram", program)
+	}
+}
+
+// Validates a program object
+func ValidateProgram(program uint32) {
+	defaultContext.ValidateProgram(program)
+}
+
+// validate a program pipeline object against current GL state
+func (ctx *Context) ValidateProgramPipeline(pipeline uint32) {
+	syscall.Syscall(ctx.gpValidateProgramPipeline, 1, uintptr(pipeline), 0, 0)
+	if hooked {
+		ctx.called("ValidateProgramPipeline", pipeline)
+	}
+}
+
+// validate a program pipeline object against current GL state
+func ValidateProgramPipeline(pipeline uint32) {
+	defaultContext.ValidateProgramPipeline(pipeline)
+}
+
+func (ctx *Context) VertexArrayAttribBinding(vaobj uint32, attribindex uint32, bindingindex uint32) {
+	syscall.Syscall(ctx.gpVertexArrayAttribBinding, 3, uintptr(vaobj), uintptr(attribindex), uintptr(bindingindex))
+	if hooked {
+		ctx.called("VertexArrayAttribBinding", vaobj, attribindex, bindingindex)
+	}
+}
+
+func VertexArrayAttribBinding(vaobj uint32, attribindex uint32, bindingindex uint32) {
+	defaultContext.VertexArrayAttribBinding(vaobj, attribindex, bindingindex)
+}
+
+// specify the organization of vertex arrays
+func (ctx *Context) VertexArrayAttribFormat(vaobj uint32, attribindex uint32, size int32, xtype uint32, normalized bool, relativeoffset uint32) {
+	syscall.Syscall6(ctx.gpVertexArrayAttribFormat, 6, uintptr(vaobj), uintptr(attribindex), uintptr(size), uintptr(xtype), boolToUintptr(normalized), uintptr(relativeoffset))
+	if hooked {
+		ctx.called("VertexArrayAttribFormat", vaobj, attribindex, size, xtype, normalized, relativeoffset)
+	}
+}
+
+// specify the organization of vertex arrays
+func VertexArrayAttribFormat(vaobj uint32, attribindex uint32, size int32, xtype uint32, normalized bool, relativeoffset uint32) {
+	defaultContext.VertexArrayAttribFormat(vaobj, attribindex, size, xtype, normalized, relativeoffset)
+}
+
+func (ctx *Context) VertexArrayAttribIFormat(vaobj uint32, attribindex uint32, size int32, xtype uint32, relativeoffset uint32) {
+	syscall.Syscall6(ctx.gpVertexArrayAttribIFormat, 5, uintptr(vaobj), uintptr(attribindex), uintptr(size), uintptr(xtype), uintptr(relativeoffset), 0)
+	if hooked {
+		ctx.called("VertexArrayAttribIFormat", vaobj, attribindex, size, xtype, relativeoffset)
+	}
+}
+
+func VertexArrayAttribIFormat(vaobj uint32, attribindex uint32, size int32, xtype uint32, relativeoffset uint32) {
+	defaultContext.VertexArrayAttribIFormat(vaobj, attribindex, size, xtype, relativeoffset)
+}
+
+func (ctx *Context) VertexArrayAttribLFormat(vaobj uint32, attribindex uint32, size int32, xtype uint32, relativeoffset uint32) {
+	syscall.Syscall6(ctx.gpVertexArrayAttribLFormat, 5, uintptr(vaobj), uintptr(attribindex), uintptr(size), uintptr(xtype), uintptr(relativeoffset), 0)
+	if hooked {
+		ctx.called("VertexArrayAttribLFormat", vaobj, attribindex, size, xtype, relativeoffset)
+	}
+}
+
+func VertexArrayAttribLFormat(vaobj uint32, attribindex uint32, size int32, xtype uint32, relativeoffset uint32) {
+	defaultContext.VertexArrayAttribLFormat(vaobj, attribindex, size, xtype, relativeoffset)
+}
+
+// modify the rate at which generic vertex attributes     advance
+func (ctx *Context) VertexArrayBindingDivisor(vaobj uint32, bindingindex uint32, divisor uint32) {
+	syscall.Syscall(ctx.gpVertexArrayBindingDivisor, 3, uintptr(vaobj), uintptr(bindingindex), uintptr(divisor))
+	if hooked {
+		ctx.called("VertexArrayBindingDivisor", vaobj, bindingindex, divisor)
+	}
+}
+
+// modify the rate at which generic vertex attributes     advance
+func VertexArrayBindingDivisor(vaobj uint32, bindingindex uint32, divisor uint32) {
+	defaultContext.VertexArrayBindingDivisor(vaobj, bindingindex, divisor)
+}
+
+// configures element array buffer binding of a vertex array object
+func (ctx *Context) VertexArrayElementBuffer(vaobj uint32, buffer uint32) {
+	syscall.Syscall(ctx.gpVertexArrayElementBuffer, 2, uintptr(vaobj), uintptr(buffer), 0)
+	if hooked {
+		ctx.called("VertexArrayElementBuffer", vaobj, buffer)
+	}
+}
+
+// configures element array buffer binding of a vertex array object
+func VertexArrayElementBuffer(vaobj uint32, buffer uint32) {
+	defaultContext.VertexArrayElementBuffer(vaobj, buffer)
+}
+
+// bind a buffer to a vertex buffer bind point
+func (ctx *Context) VertexArrayVertexBuffer(vaobj uint32, bindingindex uint32, buffer uint32, offset int, stride int32) {
+	syscall.Syscall6(ctx.gpVertexArrayVertexBuffer, 5, uintptr(vaobj), uintptr(bindingindex), uintptr(buffer), uintptr(offset), uintptr(stride), 0)
+	if hooked {
+		ctx.called("VertexArrayVertexBuffer", vaobj, bindingindex, buffer, offset, stride)
+	}
+}
+
+// bind a buffer to a vertex buffer bind point
+func VertexArrayVertexBuffer(vaobj uint32, bindingindex uint32, buffer uint32, offset int, stride int32) {
+	defaultContext.VertexArrayVertexBuffer(vaobj, bindingindex, buffer, offset, stride)
+}
+
+// attach multiple buffer objects to a vertex array object
+func (ctx *Context) VertexArrayVertexBuffers(vaobj uint32, first uint32, count int32, buffers *uint32, offsets *int, strides *int32) {
+	syscall.Syscall6(ctx.gpVertexArrayVertexBuffers, 6, uintptr(vaobj), uintptr(first), uintptr(count), uintptr(unsafe.Pointer(buffers)), uintptr(unsafe.Pointer(offsets)), uintptr(unsafe.Pointer(strides)))
+	if hooked {
+		ctx.called("VertexArrayVertexBuffers", vaobj, first, count, buffers, offsets, strides)
+	}
+}
+
+// attach multiple buffer objects to a vertex array object
+func VertexArrayVertexBuffers(vaobj uint32, first uint32, count int32, buffers *uint32, offsets *int, strides *int32) {
+	defaultContext.VertexArrayVertexBuffers(vaobj, first, count, buffers, offsets, strides)
+}
+
+func (ctx *Context) VertexAttrib1d(index uint32, x float64) {
+	syscall.Syscall(ctx.gpVertexAttrib1d, 2, uintptr(index), uintptr(math.Float64bits(x)), 0)
+	if hooked {
+		ctx.called("VertexAttrib1d", index, x)
+	}
+}
+
+func VertexAttrib1d(index uint32, x float64) {
+	defaultContext.VertexAttrib1d(index, x)
+}
+
+func (ctx *Context) VertexAttrib1dv(index uint32, v *float64) {
+	syscall.Syscall(ctx.gpVertexAttrib1dv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttrib1dv", index, v)
+	}
+}
+
+func VertexAttrib1dv(index uint32, v *float64) {
+	defaultContext.VertexAttrib1dv(index, v)
+}
+
+func (ctx *Context) VertexAttrib1f(index uint32, x float32) {
+	syscall.Syscall(ctx.gpVertexAttrib1f, 2, uintptr(index), uintptr(math.Float32bits(x)), 0)
+	if hooked {
+		ctx.called("VertexAttrib1f", index, x)
+	}
+}
+
+func VertexAttrib1f(index uint32, x float32) {
+	defaultContext.VertexAttrib1f(index, x)
+}
+
+func (ctx *Context) VertexAttrib1fv(index uint32, v *float32) {
+	syscall.Syscall(ctx.gpVertexAttrib1fv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttrib1fv", index, v)
+	}
+}
+
+func VertexAttrib1fv(index uint32, v *float32) {
+	defaultContext.VertexAttrib1fv(index, v)
+}
+
+func (ctx *Context) VertexAttrib1s(index uint32, x int16) {
+	syscall.Syscall(ctx.gpVertexAttrib1s, 2, uintptr(index), uintptr(x), 0)
+	if hooked {
+		ctx.called("VertexAttrib1s", index, x)
+	}
+}
+
+func VertexAttrib1s(index uint32, x int16) {
+	defaultContext.VertexAttrib1s(index, x)
+}
+
+func (ctx *Context) VertexAttrib1sv(index uint32, v *int16) {
+	syscall.Syscall(ctx.gpVertexAttrib1sv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttrib1sv", index, v)
+	}
+}
+
+func VertexAttrib1sv(index uint32, v *int16) {
+	defaultContext.VertexAttrib1sv(index, v)
+}
+
+func (ctx *Context) VertexAttrib2d(index uint32, x float64, y float64) {
+	syscall.Syscall(ctx.gpVertexAttrib2d, 3, uintptr(index), uintptr(math.Float64bits(x)), uintptr(math.Float64bits(y)))
+	if hooked {
+		ctx.called("VertexAttrib2d", index, x, y)
+	}
+}
+
+func VertexAttrib2d(index uint32, x float64, y float64) {
+	defaultContext.VertexAttrib2d(index, x, y)
+}
+
+func (ctx *Context) VertexAttrib2dv(index uint32, v *float64) {
+	syscall.Syscall(ctx.gpVertexAttrib2dv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttrib2dv", index, v)
+	}
+}
+
+func VertexAttrib2dv(index uint32, v *float64) {
+	defaultContext.VertexAttrib2dv(index, v)
+}
+
+func (ctx *Context) VertexAttrib2f(index uint32, x float32, y float32) {
+	syscall.Syscall(ctx.gpVertexAttrib2f, 3, uintptr(index), uintptr(math.Float32bits(x)), uintptr(math.Float32bits(y)))
+	if hooked {
+		ctx.called("VertexAttrib2f", index, x, y)
+	}
+}
+
+func VertexAttrib2f(index uint32, x float32, y float32) {
+	defaultContext.VertexAttrib2f(index, x, y)
+}
+
+func (ctx *Context) VertexAttrib2fv(index uint32, v *float32) {
+	syscall.Syscall(ctx.gpVertexAttrib2fv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttrib2fv", index, v)
+	}
+}
+
+func VertexAttrib2fv(index uint32, v *float32) {
+	defaultContext.VertexAttrib2fv(index, v)
+}
+
+func (ctx *Context) VertexAttrib2s(index uint32, x int16, y int16) {
+	syscall.Syscall(ctx.gpVertexAttrib2s, 3, uintptr(index), uintptr(x), uintptr(y))
+	if hooked {
+		ctx.called("VertexAttrib2s", index, x, y)
+	}
+}
+
+func VertexAttrib2s(index uint32, x int16, y int16) {
+	defaultContext.VertexAttrib2s(index, x, y)
+}
+
+func (ctx *Context) VertexAttrib2sv(index uint32, v *int16) {
+	syscall.Syscall(ctx.gpVertexAttrib2sv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttrib2sv", index, v)
+	}
+}
+
+func VertexAttrib2sv(index uint32, v *int16) {
+	defaultContext.VertexAttrib2sv(index, v)
+}
+
+func (ctx *Context) VertexAttrib3d(index uint32, x float64, y float64, z float64) {
+	syscall.Syscall6(ctx.gpVertexAttrib3d, 4, uintptr(index), uintptr(math.Float64bits(x)), uintptr(math.Float64bits(y)), uintptr(math.Float64bits(z)), 0, 0)
+	if hooked {
+		ctx.called("VertexAttrib3d", index, x, y, z)
+	}
+}
+
+func VertexAttrib3d(index uint32, x float64, y float64, z float64) {
+	defaultContext.VertexAttrib3d(index, x, y, z)
+}
+
+func (ctx *Context) VertexAttrib3dv(index uint32, v *float64) {
+	syscall.Syscall(ctx.gpVertexAttrib3dv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttrib3dv", index, v)
+	}
+}
+
+func VertexAttrib3dv(index uint32, v *float64) {
+	defaultContext.VertexAttrib3dv(index, v)
+}
+
+func (ctx *Context) VertexAttrib3f(index uint32, x float32, y float32, z float32) {
+	syscall.Syscall6(ctx.gpVertexAttrib3f, 4, uintptr(index), uintptr(math.Float32bits(x)), uintptr(math.Float32bits(y)), uintptr(math.Float32bits(z)), 0, 0)
+	if hooked {
+		ctx.called("VertexAttrib3f", index, x, y, z)
+	}
+}
+
+func VertexAttrib3f(index uint32, x float32, y float32, z float32) {
+	defaultContext.VertexAttrib3f(index, x, y, z)
+}
+
+func (ctx *Context) VertexAttrib3fv(index uint32, v *float32) {
+	syscall.Syscall(ctx.gpVertexAttrib3fv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttrib3fv", index, v)
+	}
+}
+
+func VertexAttrib3fv(index uint32, v *float32) {
+	defaultContext.VertexAttrib3fv(index, v)
+}
+
+func (ctx *Context) VertexAttrib3s(index uint32, x int16, y int16, z int16) {
+	syscall.Syscall6(ctx.gpVertexAttrib3s, 4, uintptr(index), uintptr(x), uintptr(y), uintptr(z), 0, 0)
+	if hooked {
+		ctx.called("VertexAttrib3s", index, x, y, z)
+	}
+}
+
+func VertexAttrib3s(index uint32, x int16, y int16, z int16) {
+	defaultContext.VertexAttrib3s(index, x, y, z)
+}
+
+func (ctx *Context) VertexAttrib3sv(index uint32, v *int16) {
+	syscall.Syscall(ctx.gpVertexAttrib3sv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttrib3sv", index, v)
+	}
+}
+
+func VertexAttrib3sv(index uint32, v *int16) {
+	defaultContext.VertexAttrib3sv(index, v)
+}
+
+func (ctx *Context) VertexAttrib4Nbv(index uint32, v *int8) {
+	syscall.Syscall(ctx.gpVertexAttrib4Nbv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttrib4Nbv", index, v)
+	}
+}
+
+func VertexAttrib4Nbv(index uint32, v *int8) {
+	defaultContext.VertexAttrib4Nbv(index, v)
+}
+
+func (ctx *Context) VertexAttrib4Niv(index uint32, v *int32) {
+	syscall.Syscall(ctx.gpVertexAttrib4Niv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttrib4Niv", index, v)
+	}
+}
+
+func VertexAttrib4Niv(index uint32, v *int32) {
+	defaultContext.VertexAttrib4Niv(index, v)
+}
+
+func (ctx *Context) VertexAttrib4Nsv(index uint32, v *int16) {
+	syscall.Syscall(ctx.gpVertexAttrib4Nsv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttrib4Nsv", index, v)
+	}
+}
+
+func VertexAttrib4Nsv(index uint32, v *int16) {
+	defaultContext.VertexAttrib4Nsv(index, v)
+}
+
+func (ctx *Context) VertexAttrib4Nub(index uint32, x uint8, y uint8, z uint8, w uint8) {
+	syscall.Syscall6(ctx.gpVertexAttrib4Nub, 5, uintptr(index), uintptr(x), uintptr(y), uintptr(z), uintptr(w), 0)
+	if hooked {
+		ctx.called("VertexAttrib4Nub", index, x, y, z, w)
+	}
+}
+
+func VertexAttrib4Nub(index uint32, x uint8, y uint8, z uint8, w uint8) {
+	defaultContext.VertexAttrib4Nub(index, x, y, z, w)
+}
+
+func (ctx *Context) VertexAttrib4Nubv(index uint32, v *uint8) {
+	syscall.Syscall(ctx.gpVertexAttrib4Nubv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttrib4Nubv", index, v)
+	}
+}
+
+func VertexAttrib4Nubv(index uint32, v *uint8) {
+	defaultContext.VertexAttrib4Nubv(index, v)
+}
+
+func (ctx *Context) VertexAttrib4Nuiv(index uint32, v *uint32) {
+	syscall.Syscall(ctx.gpVertexAttrib4Nuiv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttrib4Nuiv", index, v)
+	}
+}
+
+func VertexAttrib4Nuiv(index uint32, v *uint32) {
+	defaultContext.VertexAttrib4Nuiv(index, v)
+}
+
+func (ctx *Context) VertexAttrib4Nusv(index uint32, v *uint16) {
+	syscall.Syscall(ctx.gpVertexAttrib4Nusv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttrib4Nusv", index, v)
+	}
+}
+
+func VertexAttrib4Nusv(index uint32, v *uint16) {
+	defaultContext.VertexAttrib4Nusv(index, v)
+}
+
+func (ctx *Context) VertexAttrib4bv(index uint32, v *int8) {
+	syscall.Syscall(ctx.gpVertexAttrib4bv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttrib4bv", index, v)
+	}
+}
+
+func VertexAttrib4bv(index uint32, v *int8) {
+	defaultContext.VertexAttrib4bv(index, v)
+}
+
+func (ctx *Context) VertexAttrib4d(index uint32, x float64, y float64, z float64, w float64) {
+	syscall.Syscall6(ctx.gpVertexAttrib4d, 5, uintptr(index), uintptr(math.Float64bits(x)), uintptr(math.Float64bits(y)), uintptr(math.Float64bits(z)), uintptr(math.Float64bits(w)), 0)
+	if hooked {
+		ctx.called("VertexAttrib4d", index, x, y, z, w)
+	}
+}
+
+func VertexAttrib4d(index uint32, x float64, y float64, z float64, w float64) {
+	defaultContext.VertexAttrib4d(index, x, y, z, w)
+}
+
+func (ctx *Context) VertexAttrib4dv(index uint32, v *float64) {
+	syscall.Syscall(ctx.gpVertexAttrib4dv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttrib4dv", index, v)
+	}
+}
+
+func VertexAttrib4dv(index uint32, v *float64) {
+	defaultContext.VertexAttrib4dv(index, v)
+}
+
+func (ctx *Context) VertexAttrib4f(index uint32, x float32, y float32, z float32, w float32) {
+	syscall.Syscall6(ctx.gpVertexAttrib4f, 5, uintptr(index), uintptr(math.Float32bits(x)), uintptr(math.Float32bits(y)), uintptr(math.Float32bits(z)), uintptr(math.Float32bits(w)), 0)
+	if hooked {
+		ctx.called("VertexAttrib4f", index, x, y, z, w)
+	}
+}
+
+func VertexAttrib4f(index uint32, x float32, y float32, z float32, w float32) {
+	defaultContext.VertexAttrib4f(index, x, y, z, w)
+}
+
+func (ctx *Context) VertexAttrib4fv(index uint32, v *float32) {
+	syscall.Syscall(ctx.gpVertexAttrib4fv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttrib4fv", index, v)
+	}
+}
+
+func VertexAttrib4fv(index uint32, v *float32) {
+	defaultContext.VertexAttrib4fv(index, v)
+}
+
+func (ctx *Context) VertexAttrib4iv(index uint32, v *int32) {
+	syscall.Syscall(ctx.gpVertexAttrib4iv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttrib4iv", index, v)
+	}
+}
+
+func VertexAttrib4iv(index uint32, v *int32) {
+	defaultContext.VertexAttrib4iv(index, v)
+}
+
+func (ctx *Context) VertexAttrib4s(index uint32, x int16, y int16, z int16, w int16) {
+	syscall.Syscall6(ctx.gpVertexAttrib4s, 5, uintptr(index), uintptr(x), uintptr(y), uintptr(z), uintptr(w), 0)
+	if hooked {
+		ctx.called("VertexAttrib4s", index, x, y, z, w)
+	}
+}
+
+func VertexAttrib4s(index uint32, x int16, y int16, z int16, w int16) {
+	defaultContext.VertexAttrib4s(index, x, y, z, w)
+}
+
+func (ctx *Context) VertexAttrib4sv(index uint32, v *int16) {
+	syscall.Syscall(ctx.gpVertexAttrib4sv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttrib4sv", index, v)
+	}
+}
+
+func VertexAttrib4sv(index uint32, v *int16) {
+	defaultContext.VertexAttrib4sv(index, v)
+}
+
+func (ctx *Context) VertexAttrib4ubv(index uint32, v *uint8) {
+	syscall.Syscall(ctx.gpVertexAttrib4ubv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttrib4ubv", index, v)
+	}
+}
+
+func VertexAttrib4ubv(index uint32, v *uint8) {
+	defaultContext.VertexAttrib4ubv(index, v)
+}
+
+func (ctx *Context) VertexAttrib4uiv(index uint32, v *uint32) {
+	syscall.Syscall(ctx.gpVertexAttrib4uiv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttrib4uiv", index, v)
+	}
+}
+
+func VertexAttrib4uiv(index uint32, v *uint32) {
+	defaultContext.VertexAttrib4uiv(index, v)
+}
+
+func (ctx *Context) VertexAttrib4usv(index uint32, v *uint16) {
+	syscall.Syscall(ctx.gpVertexAttrib4usv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttrib4usv", index, v)
+	}
+}
+
+func VertexAttrib4usv(index uint32, v *uint16) {
+	defaultContext.VertexAttrib4usv(index, v)
+}
+
+// associate a vertex attribute and a vertex buffer binding for a vertex array object
+func (ctx *Context) VertexAttribBinding(attribindex uint32, bindingindex uint32) {
+	syscall.Syscall(ctx.gpVertexAttribBinding, 2, uintptr(attribindex), uintptr(bindingindex), 0)
+	if hooked {
+		ctx.called("VertexAttribBinding", attribindex, bindingindex)
+	}
+}
+
+// associate a vertex attribute and a vertex buffer binding for a vertex array object
+func VertexAttribBinding(attribindex uint32, bindingindex uint32) {
+	defaultContext.VertexAttribBinding(attribindex, bindingindex)
+}
+
+// modify the rate at which generic vertex attributes advance during instanced rendering
+func (ctx *Context) VertexAttribDivisor(index uint32, divisor uint32) {
+	syscall.Syscall(ctx.gpVertexAttribDivisor, 2, uintptr(index), uintptr(divisor), 0)
+	if hooked {
+		ctx.called("VertexAttribDivisor", index, divisor)
+	}
+}
+
+// modify the rate at which generic vertex attributes advance during instanced rendering
+func VertexAttribDivisor(index uint32, divisor uint32) {
+	defaultContext.VertexAttribDivisor(index, divisor)
+}
+
+// specify the organization of vertex arrays
+func (ctx *Context) VertexAttribFormat(attribindex uint32, size int32, xtype uint32, normalized bool, relativeoffset uint32) {
+	syscall.Syscall6(ctx.gpVertexAttribFormat, 5, uintptr(attribindex), uintptr(size), uintptr(xtype), boolToUintptr(normalized), uintptr(relativeoffset), 0)
+	if hooked {
+		ctx.called("VertexAttribFormat", attribindex, size, xtype, normalized, relativeoffset)
+	}
+}
+
+// specify the organization of vertex arrays
+func VertexAttribFormat(attribindex uint32, size int32, xtype uint32, normalized bool, relativeoffset uint32) {
+	defaultContext.VertexAttribFormat(attribindex, size, xtype, normalized, relativeoffset)
+}
+
+func (ctx *Context) VertexAttribI1i(index uint32, x int32) {
+	syscall.Syscall(ctx.gpVertexAttribI1i, 2, uintptr(index), uintptr(x), 0)
+	if hooked {
+		ctx.called("VertexAttribI1i", index, x)
+	}
+}
+
+func VertexAttribI1i(index uint32, x int32) {
+	defaultContext.VertexAttribI1i(index, x)
+}
+
+func (ctx *Context) VertexAttribI1iv(index uint32, v *int32) {
+	syscall.Syscall(ctx.gpVertexAttribI1iv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttribI1iv", index, v)
+	}
+}
+
+func VertexAttribI1iv(index uint32, v *int32) {
+	defaultContext.VertexAttribI1iv(index, v)
+}
+
+func (ctx *Context) VertexAttribI1ui(index uint32, x uint32) {
+	syscall.Syscall(ctx.gpVertexAttribI1ui, 2, uintptr(index), uintptr(x), 0)
+	if hooked {
+		ctx.called("VertexAttribI1ui", index, x)
+	}
+}
+
+func VertexAttribI1ui(index uint32, x uint32) {
+	defaultContext.VertexAttribI1ui(index, x)
+}
+
+func (ctx *Context) VertexAttribI1uiv(index uint32, v *uint32) {
+	syscall.Syscall(ctx.gpVertexAttribI1uiv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttribI1uiv", index, v)
+	}
+}
+
+func VertexAttribI1uiv(index uint32, v *uint32) {
+	defaultContext.VertexAttribI1uiv(index, v)
+}
+
+func (ctx *Context) VertexAttribI2i(index uint32, x int32, y int32) {
+	syscall.Syscall(ctx.gpVertexAttribI2i, 3, uintptr(index), uintptr(x), uintptr(y))
+	if hooked {
+		ctx.called("VertexAttribI2i", index, x, y)
+	}
+}
+
+func VertexAttribI2i(index uint32, x int32, y int32) {
+	defaultContext.VertexAttribI2i(index, x, y)
+}
+
+func (ctx *Context) VertexAttribI2iv(index uint32, v *int32) {
+	syscall.Syscall(ctx.gpVertexAttribI2iv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttribI2iv", index, v)
+	}
+}
+
+func VertexAttribI2iv(index uint32, v *int32) {
+	defaultContext.VertexAttribI2iv(index, v)
+}
+
+func (ctx *Context) VertexAttribI2ui(index uint32, x uint32, y uint32) {
+	syscall.Syscall(ctx.gpVertexAttribI2ui, 3, uintptr(index), uintptr(x), uintptr(y))
+	if hooked {
+		ctx.called("VertexAttribI2ui", index, x, y)
+	}
+}
+
+func VertexAttribI2ui(index uint32, x uint32, y uint32) {
+	defaultContext.VertexAttribI2ui(index, x, y)
+}
+
+func (ctx *Context) VertexAttribI2uiv(index uint32, v *uint32) {
+	syscall.Syscall(ctx.gpVertexAttribI2uiv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttribI2uiv", index, v)
+	}
+}
+
+func VertexAttribI2uiv(index uint32, v *uint32) {
+	defaultContext.VertexAttribI2uiv(index, v)
+}
+
+func (ctx *Context) VertexAttribI3i(index uint32, x int32, y int32, z int32) {
+	syscall.Syscall6(ctx.gpVertexAttribI3i, 4, uintptr(index), uintptr(x), uintptr(y), uintptr(z), 0, 0)
+	if hooked {
+		ctx.called("VertexAttribI3i", index, x, y, z)
+	}
+}
+
+func VertexAttribI3i(index uint32, x int32, y int32, z int32) {
+	defaultContext.VertexAttribI3i(index, x, y, z)
+}
+
+func (ctx *Context) VertexAttribI3iv(index uint32, v *int32) {
+	syscall.Syscall(ctx.gpVertexAttribI3iv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttribI3iv", index, v)
+	}
+}
+
+func VertexAttribI3iv(index uint32, v *int32) {
+	defaultContext.VertexAttribI3iv(index, v)
+}
+
+func (ctx *Context) VertexAttribI3ui(index uint32, x uint32, y uint32, z uint32) {
+	syscall.Syscall6(ctx.gpVertexAttribI3ui, 4, uintptr(index), uintptr(x), uintptr(y), uintptr(z), 0, 0)
+	if hooked {
+		ctx.called("VertexAttribI3ui", index, x, y, z)
+	}
+}
+
+func VertexAttribI3ui(index uint32, x uint32, y uint32, z uint32) {
+	defaultContext.VertexAttribI3ui(index, x, y, z)
+}
+
+func (ctx *Context) VertexAttribI3uiv(index uint32, v *uint32) {
+	syscall.Syscall(ctx.gpVertexAttribI3uiv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttribI3uiv", index, v)
+	}
+}
+
+func VertexAttribI3uiv(index uint32, v *uint32) {
+	defaultContext.VertexAttribI3uiv(index, v)
+}
+
+func (ctx *Context) VertexAttribI4bv(index uint32, v *int8) {
+	syscall.Syscall(ctx.gpVertexAttribI4bv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttribI4bv", index, v)
+	}
+}
+
+func VertexAttribI4bv(index uint32, v *int8) {
+	defaultContext.VertexAttribI4bv(index, v)
+}
+
+func (ctx *Context) VertexAttribI4i(index uint32, x int32, y int32, z int32, w int32) {
+	syscall.Syscall6(ctx.gpVertexAttribI4i, 5, uintptr(index), uintptr(x), uintptr(y), uintptr(z), uintptr(w), 0)
+	if hooked {
+		ctx.called("VertexAttribI4i", index, x, y, z, w)
+	}
+}
+
+func VertexAttribI4i(index uint32, x int32, y int32, z int32, w int32) {
+	defaultContext.VertexAttribI4i(index, x, y, z, w)
+}
+
+func (ctx *Context) VertexAttribI4iv(index uint32, v *int32) {
+	syscall.Syscall(ctx.gpVertexAttribI4iv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttribI4iv", index, v)
+	}
+}
+
+func VertexAttribI4iv(index uint32, v *int32) {
+	defaultContext.VertexAttribI4iv(index, v)
+}
+
+func (ctx *Context) VertexAttribI4sv(index uint32, v *int16) {
+	syscall.Syscall(ctx.gpVertexAttribI4sv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttribI4sv", index, v)
+	}
+}
+
+func VertexAttribI4sv(index uint32, v *int16) {
+	defaultContext.VertexAttribI4sv(index, v)
+}
+
+func (ctx *Context) VertexAttribI4ubv(index uint32, v *uint8) {
+	syscall.Syscall(ctx.gpVertexAttribI4ubv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttribI4ubv", index, v)
+	}
+}
+
+func VertexAttribI4ubv(index uint32, v *uint8) {
+	defaultContext.VertexAttribI4ubv(index, v)
+}
+
+func (ctx *Context) VertexAttribI4ui(index uint32, x uint32, y uint32, z uint32, w uint32) {
+	syscall.Syscall6(ctx.gpVertexAttribI4ui, 5, uintptr(index), uintptr(x), uintptr(y), uintptr(z), uintptr(w), 0)
+	if hooked {
+		ctx.called("VertexAttribI4ui", index, x, y, z, w)
+	}
+}
+
+func VertexAttribI4ui(index uint32, x uint32, y uint32, z uint32, w uint32) {
+	defaultContext.VertexAttribI4ui(index, x, y, z, w)
+}
+
+func (ctx *Context) VertexAttribI4uiv(index uint32, v *uint32) {
+	syscall.Syscall(ctx.gpVertexAttribI4uiv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttribI4uiv", index, v)
+	}
+}
+
+func VertexAttribI4uiv(index uint32, v *uint32) {
+	defaultContext.VertexAttribI4uiv(index, v)
+}
+
+func (ctx *Context) VertexAttribI4usv(index uint32, v *uint16) {
+	syscall.Syscall(ctx.gpVertexAttribI4usv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttribI4usv", index, v)
+	}
+}
+
+func VertexAttribI4usv(index uint32, v *uint16) {
+	defaultContext.VertexAttribI4usv(index, v)
+}
+
+func (ctx *Context) VertexAttribIFormat(attribindex uint32, size int32, xtype uint32, relativeoffset uint32) {
+	syscall.Syscall6(ctx.gpVertexAttribIFormat, 4, uintptr(attribindex), uintptr(size), uintptr(xtype), uintptr(relativeoffset), 0, 0)
+	if hooked {
+		ctx.called("VertexAttribIFormat", attribindex, size, xtype, relativeoffset)
+	}
+}
+
+func VertexAttribIFormat(attribindex uint32, size int32, xtype uint32, relativeoffset uint32) {
+	defaultContext.VertexAttribIFormat(attribindex, size, xtype, relativeoffset)
+}
+
+func (ctx *Context) VertexAttribIPointer(index uint32, size int32, xtype uint32, stride int32, pointer unsafe.Pointer) {
+	syscall.Syscall6(ctx.gpVertexAttribIPointer, 5, uintptr(index), uintptr(size), uintptr(xtype), uintptr(stride), uintptr(pointer), 0)
+	if hooked {
+		ctx.called("VertexAttribIPointer", index, size, xtype, stride, pointer)
+	}
+}
+
+func VertexAttribIPointer(index uint32, size int32, xtype uint32, stride int32, pointer unsafe.Pointer) {
+	defaultContext.VertexAttribIPointer(index, size, xtype, stride, pointer)
+}
+
+func (ctx *Context) VertexAttribL1d(index uint32, x float64) {
+	syscall.Syscall(ctx.gpVertexAttribL1d, 2, uintptr(index), uintptr(math.Float64bits(x)), 0)
+	if hooked {
+		ctx.called("VertexAttribL1d", index, x)
+	}
+}
+
+func VertexAttribL1d(index uint32, x float64) {
+	defaultContext.VertexAttribL1d(index, x)
+}
+
+func (ctx *Context) VertexAttribL1dv(index uint32, v *float64) {
+	syscall.Syscall(ctx.gpVertexAttribL1dv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttribL1dv", index, v)
+	}
+}
+
+func VertexAttribL1dv(index uint32, v *float64) {
+	defaultContext.VertexAttribL1dv(index, v)
+}
+
+func (ctx *Context) VertexAttribL2d(index uint32, x float64, y float64) {
+	syscall.Syscall(ctx.gpVertexAttribL2d, 3, uintptr(index), uintptr(math.Float64bits(x)), uintptr(math.Float64bits(y)))
+	if hooked {
+		ctx.called("VertexAttribL2d", index, x, y)
+	}
+}
+
+func VertexAttribL2d(index uint32, x float64, y float64) {
+	defaultContext.VertexAttribL2d(index, x, y)
+}
+
+func (ctx *Context) VertexAttribL2dv(index uint32, v *float64) {
+	syscall.Syscall(ctx.gpVertexAttribL2dv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttribL2dv", index, v)
+	}
+}
+
+func VertexAttribL2dv(index uint32, v *float64) {
+	defaultContext.VertexAttribL2dv(index, v)
+}
+
+func (ctx *Context) VertexAttribL3d(index uint32, x float64, y float64, z float64) {
+	syscall.Syscall6(ctx.gpVertexAttribL3d, 4, uintptr(index), uintptr(math.Float64bits(x)), uintptr(math.Float64bits(y)), uintptr(math.Float64bits(z)), 0, 0)
+	if hooked {
+		ctx.called("VertexAttribL3d", index, x, y, z)
+	}
+}
+
+func VertexAttribL3d(index uint32, x float64, y float64, z float64) {
+	defaultContext.VertexAttribL3d(index, x, y, z)
+}
+
+func (ctx *Context) VertexAttribL3dv(index uint32, v *float64) {
+	syscall.Syscall(ctx.gpVertexAttribL3dv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttribL3dv", index, v)
+	}
+}
+
+func VertexAttribL3dv(index uint32, v *float64) {
+	defaultContext.VertexAttribL3dv(index, v)
+}
+
+func (ctx *Context) VertexAttribL4d(index uint32, x float64, y float64, z float64, w float64) {
+	syscall.Syscall6(ctx.gpVertexAttribL4d, 5, uintptr(index), uintptr(math.Float64bits(x)), uintptr(math.Float64bits(y)), uintptr(math.Float64bits(z)), uintptr(math.Float64bits(w)), 0)
+	if hooked {
+		ctx.called("VertexAttribL4d", index, x, y, z, w)
+	}
+}
+
+func VertexAttribL4d(index uint32, x float64, y float64, z float64, w float64) {
+	defaultContext.VertexAttribL4d(index, x, y, z, w)
+}
+
+func (ctx *Context) VertexAttribL4dv(index uint32, v *float64) {
+	syscall.Syscall(ctx.gpVertexAttribL4dv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("VertexAttribL4dv", index, v)
+	}
+}
+
+func VertexAttribL4dv(index uint32, v *float64) {
+	defaultContext.VertexAttribL4dv(index, v)
+}
+
+func (ctx *Context) VertexAttribLFormat(attribindex uint32, size int32, xtype uint32, relativeoffset uint32) {
+	syscall.Syscall6(ctx.gpVertexAttribLFormat, 4, uintptr(attribindex), uintptr(size), uintptr(xtype), uintptr(relativeoffset), 0, 0)
+	if hooked {
+		ctx.called("VertexAttribLFormat", attribindex, size, xtype, relativeoffset)
+	}
+}
+
+func VertexAttribLFormat(attribindex uint32, size int32, xtype uint32, relativeoffset uint32) {
+	defaultContext.VertexAttribLFormat(attribindex, size, xtype, relativeoffset)
+}
+
+func (ctx *Context) VertexAttribLPointer(index uint32, size int32, xtype uint32, stride int32, pointer unsafe.Pointer) {
+	syscall.Syscall6(ctx.gpVertexAttribLPointer, 5, uintptr(index), uintptr(size), uintptr(xtype), uintptr(stride), uintptr(pointer), 0)
+	if hooked {
+		ctx.called("VertexAttribLPointer", index, size, xtype, stride, pointer)
+	}
+}
+
+func VertexAttribLPointer(index uint32, size int32, xtype uint32, stride int32, pointer unsafe.Pointer) {
+	defaultContext.VertexAttribLPointer(index, size, xtype, stride, pointer)
+}
+
+func (ctx *Context) VertexAttribP1ui(index uint32, xtype uint32, normalized bool, value uint32) {
+	syscall.Syscall6(ctx.gpVertexAttribP1ui, 4, uintptr(index), uintptr(xtype), boolToUintptr(normalized), uintptr(value), 0, 0)
+	if hooked {
+		ctx.called("VertexAttribP1ui", index, xtype, normalized, value)
+	}
+}
+
+func VertexAttribP1ui(index uint32, xtype uint32, normalized bool, value uint32) {
+	defaultContext.VertexAttribP1ui(index, xtype, normalized, value)
+}
+
+func (ctx *Context) VertexAttribP1uiv(index uint32, xtype uint32, normalized bool, value *uint32) {
+	syscall.Syscall6(ctx.gpVertexAttribP1uiv, 4, uintptr(index), uintptr(xtype), boolToUintptr(normalized), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("VertexAttribP1uiv", index, xtype, normalized, value)
+	}
+}
+
+func VertexAttribP1uiv(index uint32, xtype uint32, normalized bool, value *uint32) {
+	defaultContext.VertexAttribP1uiv(index, xtype, normalized, value)
+}
+
+func (ctx *Context) VertexAttribP2ui(index uint32, xtype uint32, normalized bool, value uint32) {
+	syscall.Syscall6(ctx.gpVertexAttribP2ui, 4, uintptr(index), uintptr(xtype), boolToUintptr(normalized), uintptr(value), 0, 0)
+	if hooked {
+		ctx.called("VertexAttribP2ui", index, xtype, normalized, value)
+	}
+}
+
+func VertexAttribP2ui(index uint32, xtype uint32, normalized bool, value uint32) {
+	defaultContext.VertexAttribP2ui(index, xtype, normalized, value)
+}
+
+func (ctx *Context) VertexAttribP2uiv(index uint32, xtype uint32, normalized bool, value *uint32) {
+	syscall.Syscall6(ctx.gpVertexAttribP2uiv, 4, uintptr(index), uintptr(xtype), boolToUintptr(normalized), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("VertexAttribP2uiv", index, xtype, normalized, value)
+	}
+}
+
+func VertexAttribP2uiv(index uint32, xtype uint32, normalized bool, value *uint32) {
+	defaultContext.VertexAttribP2uiv(index, xtype, normalized, value)
+}
+
+func (ctx *Context) VertexAttribP3ui(index uint32, xtype uint32, normalized bool, value uint32) {
+	syscall.Syscall6(ctx.gpVertexAttribP3ui, 4, uintptr(index), uintptr(xtype), boolToUintptr(normalized), uintptr(value), 0, 0)
+	if hooked {
+		ctx.called("VertexAttribP3ui", index, xtype, normalized, value)
+	}
+}
+
+func VertexAttribP3ui(index uint32, xtype uint32, normalized bool, value uint32) {
+	defaultContext.VertexAttribP3ui(index, xtype, normalized, value)
+}
+
+func (ctx *Context) VertexAttribP3uiv(index uint32, xtype uint32, normalized bool, value *uint32) {
+	syscall.Syscall6(ctx.gpVertexAttribP3uiv, 4, uintptr(index), uintptr(xtype), boolToUintptr(normalized), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("VertexAttribP3uiv", index, xtype, normalized, value)
+	}
+}
+
+func VertexAttribP3uiv(index uint32, xtype uint32, normalized bool, value *uint32) {
+	defaultContext.VertexAttribP3uiv(index, xtype, normalized, value)
+}
+
+func (ctx *Context) VertexAttribP4ui(index uint32, xtype uint32, normalized bool, value uint32) {
+	syscall.Syscall6(ctx.gpVertexAttribP4ui, 4, uintptr(index), uintptr(xtype), boolToUintptr(normalized), uintptr(value), 0, 0)
+	if hooked {
+		ctx.called("VertexAttribP4ui", index, xtype, normalized, value)
+	}
+}
+
+func VertexAttribP4ui(index uint32, xtype uint32, normalized bool, value uint32) {
+	defaultContext.VertexAttribP4ui(index, xtype, normalized, value)
+}
+
+func (ctx *Context) VertexAttribP4uiv(index uint32, xtype uint32, normalized bool, value *uint32) {
+	syscall.Syscall6(ctx.gpVertexAttribP4uiv, 4, uintptr(index), uintptr(xtype), boolToUintptr(normalized), uintptr(unsafe.Pointer(value)), 0, 0)
+	if hooked {
+		ctx.called("VertexAttribP4uiv", index, xtype, normalized, value)
+	}
+}
+
+func VertexAttribP4uiv(index uint32, xtype uint32, normalized bool, value *uint32) {
+	defaultContext.VertexAttribP4uiv(index, xtype, normalized, value)
+}
+
+// define an array of generic vertex attribute data
+func (ctx *Context) VertexAttribPointer(index uint32, size int32, xtype uint32, normalized bool, stride int32, pointer unsafe.Pointer) {
+	syscall.Syscall6(ctx.gpVertexAttribPointer, 6, uintptr(index), uintptr(size), uintptr(xtype), boolToUintptr(normalized), uintptr(stride), uintptr(pointer))
+	if hooked {
+		ctx.called("VertexAttribPointer", index, size, xtype, normalized, stride, pointer)
+	}
+}
+
+// define an array of generic vertex attribute data
+func VertexAttribPointer(index uint32, size int32, xtype uint32, normalized bool, stride int32, pointer unsafe.Pointer) {
+	defaultContext.VertexAttribPointer(index, size, xtype, normalized, stride, pointer)
+}
+
+func (ctx *Context) VertexAttribPointerWithOffset(index uint32, size int32, xtype uint32, normalized bool, stride int32, offset uintptr) {
+	syscall.Syscall6(ctx.gpVertexAttribPointer, 6, uintptr(index), uintptr(size), uintptr(xtype), boolToUintptr(normalized), uintptr(stride), uintptr(offset))
+	if hooked {
+		ctx.called("VertexAttribPointerWithOffset", index, size, xtype, normalized, stride, offset)
+	}
+}
+
+func VertexAttribPointerWithOffset(index uint32, size int32, xtype uint32, normalized bool, stride int32, offset uintptr) {
+	defaultContext.VertexAttribPointerWithOffset(index, size, xtype, normalized, stride, offset)
+}
+
+// modify the rate at which generic vertex attributes     advance
+func (ctx *Context) VertexBindingDivisor(bindingindex uint32, divisor uint32) {
+	syscall.Syscall(ctx.gpVertexBindingDivisor, 2, uintptr(bindingindex), uintptr(divisor), 0)
+	if hooked {
+		ctx.called("VertexBindingDivisor", bindingindex, divisor)
+	}
+}
+
+// modify the rate at which generic vertex attributes     advance
+func VertexBindingDivisor(bindingindex uint32, divisor uint32) {
+	defaultContext.VertexBindingDivisor(bindingindex, divisor)
+}
+
+// set the viewport
+func (ctx *Context) Viewport(x int32, y int32, width int32, height int32) {
+	syscall.Syscall6(ctx.gpViewport, 4, uintptr(x), uintptr(y), uintptr(width), uintptr(height), 0, 0)
+	if hooked {
+		ctx.called("Viewport", x, y, width, height)
+	}
+}
+
+// set the viewport
+func Viewport(x int32, y int32, width int32, height int32) {
+	defaultContext.Viewport(x, y, width, height)
+}
+
+func (ctx *Context) ViewportArrayv(first uint32, count int32, v *float32) {
+	syscall.Syscall(ctx.gpViewportArrayv, 3, uintptr(first), uintptr(count), uintptr(unsafe.Pointer(v)))
+	if hooked {
+		ctx.called("ViewportArrayv", first, count, v)
+	}
+}
+
+func ViewportArrayv(first uint32, count int32, v *float32) {
+	defaultContext.ViewportArrayv(first, count, v)
+}
+
+func (ctx *Context) ViewportIndexedf(index uint32, x float32, y float32, w float32, h float32) {
+	syscall.Syscall6(ctx.gpViewportIndexedf, 5, uintptr(index), uintptr(math.Float32bits(x)), uintptr(math.Float32bits(y)), uintptr(math.Float32bits(w)), uintptr(math.Float32bits(h)), 0)
+	if hooked {
+		ctx.called("ViewportIndexedf", index, x, y, w, h)
+	}
+}
+
+func ViewportIndexedf(index uint32, x float32, y float32, w float32, h float32) {
+	defaultContext.ViewportIndexedf(index, x, y, w, h)
+}
+
+func (ctx *Context) ViewportIndexedfv(index uint32, v *float32) {
+	syscall.Syscall(ctx.gpViewportIndexedfv, 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+	if hooked {
+		ctx.called("ViewportIndexedfv", index, v)
+	}
+}
+
+func ViewportIndexedfv(index uint32, v *float32) {
+	defaultContext.ViewportIndexedfv(index, v)
+}
+
+// instruct the GL server to block until the specified sync object becomes signaled
+func (ctx *Context) WaitSync(sync uintptr, flags uint32, timeout uint64) {
+	syscall.Syscall(ctx.gpWaitSync, 3, uintptr(sync), uintptr(flags), uintptr(timeout))
+	if hooked {
+		ctx.called("WaitSync", sync, flags, timeout)
+	}
+}
+
+// instruct the GL server to block until the specified sync object becomes signaled
+func WaitSync(sync uintptr, flags uint32, timeout uint64) {
+	defaultContext.WaitSync(sync, flags, timeout)
+}
+
+// load loads the entry points of the OpenGL 4.6 core profile. It returns an error listing the
+// entry points that could not be found, which means that the current context
+// does not support the OpenGL 4.6 core profile.
+func (ctx *Context) load(getProcAddr func(name string) unsafe.Pointer) error {
+	var missing []string
+	load := func(name string) uintptr {
+		p := uintptr(getProcAddr(name))
+		if p == 0 {
+			missing = append(missing, name)
+		}
+		return p
+	}
+	ctx.gpActiveShaderProgram = load("glActiveShaderProgram")
+	ctx.gpActiveTexture = load("glActiveTexture")
+	ctx.gpAttachShader = load("glAttachShader")
+	ctx.gpBeginConditionalRender = load("glBeginConditionalRender")
+	ctx.gpBeginQuery = load("glBeginQuery")
+	ctx.gpBeginQueryIndexed = load("glBeginQueryIndexed")
+	ctx.gpBeginTransformFeedback = load("glBeginTransformFeedback")
+	ctx.gpBindAttribLocation = load("glBindAttribLocation")
+	ctx.gpBindBuffer = load("glBindBuffer")
+	ctx.gpBindBufferBase = load("glBindBufferBase")
+	ctx.gpBindBufferRange = load("glBindBufferRange")
+	ctx.gpBindBuffersBase = load("glBindBuffersBase")
+	ctx.gpBindBuffersRange = load("glBindBuffersRange")
+	ctx.gpBindFragDataLocation = load("glBindFragDataLocation")
+	ctx.gpBindFragDataLocationIndexed = load("glBindFragDataLocationIndexed")
+	ctx.gpBindFramebuffer = load("glBindFramebuffer")
+	ctx.gpBindImageTexture = load("glBindImageTexture")
+	ctx.gpBindImageTextures = load("glBindImageTextures")
+	ctx.gpBindProgramPipeline = load("glBindProgramPipeline")
+	ctx.gpBindRenderbuffer = load("glBindRenderbuffer")
+	ctx.gpBindSampler = load("glBindSampler")
+	ctx.gpBindSamplers = load("glBindSamplers")
+	ctx.gpBindTexture = load("glBindTexture")
+	ctx.gpBindTextureUnit = load("glBindTextureUnit")
+	ctx.gpBindTextures = load("glBindTextures")
+	ctx.gpBindTransformFeedback = load("glBindTransformFeedback")
+	ctx.gpBindVertexArray = load("glBindVertexArray")
+	ctx.gpBindVertexBuffer = load("glBindVertexBuffer")
+	ctx.gpBindVertexBuffers = load("glBindVertexBuffers")
+	ctx.gpBlendColor = load("glBlendColor")
+	ctx.gpBlendEquation = load("glBlendEquation")
+	ctx.gpBlendEquationSeparate = load("glBlendEquationSeparate")
+	ctx.gpBlendEquationSeparatei = load("glBlendEquationSeparatei")
+	ctx.gpBlendEquationi = load("glBlendEquationi")
+	ctx.gpBlendFunc = load("glBlendFunc")
+	ctx.gpBlendFuncSeparate = load("glBlendFuncSeparate")
+	ctx.gpBlendFuncSeparatei = load("glBlendFuncSeparatei")
+	ctx.gpBlendFunci = load("glBlendFunci")
+	ctx.gpBlitFramebuffer = load("glBlitFramebuffer")
+	ctx.gpBlitNamedFramebuffer = load("glBlitNamedFramebuffer")
+	ctx.gpBufferData = load("glBufferData")
+	ctx.gpBufferStorage = load("glBufferStorage")
+	ctx.gpBufferSubData = load("glBufferSubData")
+	ctx.gpCheckFramebufferStatus = load("glCheckFramebufferStatus")
+	ctx.gpCheckNamedFramebufferStatus = load("glCheckNamedFramebufferStatus")
+	ctx.gpClampColor = load("glClampColor")
+	ctx.gpClear = load("glClear")
+	ctx.gpClearBufferData = load("glClearBufferData")
+	ctx.gpClearBufferSubData = load("glClearBufferSubData")
+	ctx.gpClearBufferfi = load("glClearBufferfi")
+	ctx.gpClearBufferfv = load("glClearBufferfv")
+	ctx.gpClearBufferiv = load("glClearBufferiv")
+	ctx.gpClearBufferuiv = load("glClearBufferuiv")
+	ctx.gpClearColor = load("glClearColor")
+	ctx.gpClearDepth = load("glClearDepth")
+	ctx.gpClearDepthf = load("glClearDepthf")
+	ctx.gpClearNamedBufferData = load("glClearNamedBufferData")
+	ctx.gpClearNamedBufferSubData = load("glClearNamedBufferSubData")
+	ctx.gpClearNamedFramebufferfi = load("glClearNamedFramebufferfi")
+	ctx.gpClearNamedFramebufferfv = load("glClearNamedFramebufferfv")
+	ctx.gpClearNamedFramebufferiv = load("glClearNamedFramebufferiv")
+	ctx.gpClearNamedFramebufferuiv = load("glClearNamedFramebufferuiv")
+	ctx.gpClearStencil = load("glClearStencil")
+	ctx.gpClearTexImage = load("glClearTexImage")
+	ctx.gpClearTexSubImage = load("glClearTexSubImage")
+	ctx.gpClientWaitSync = load("glClientWaitSync")
+	ctx.gpClipControl = load("glClipControl")
+	ctx.gpColorMask = load("glColorMask")
+	ctx.gpColorMaski = load("glColorMaski")
+	ctx.gpCompileShader = load("glCompileShader")
+	ctx.gpCompressedTexImage1D = load("glCompressedTexImage1D")
+	ctx.gpCompressedTexImage2D = load("glCompressedTexImage2D")
+	ctx.gpCompressedTexImage3D = load("glCompressedTexImage3D")
+	ctx.gpCompressedTexSubImage1D = load("glCompressedTexSubImage1D")
+	ctx.gpCompressedTexSubImage2D = load("glCompressedTexSubImage2D")
+	ctx.gpCompressedTexSubImage3D = load("glCompressedTexSubImage3D")
+	ctx.gpCompressedTextureSubImage1D = load("glCompressedTextureSubImage1D")
+	ctx.gpCompressedTextureSubImage2D = load("glCompressedTextureSubImage2D")
+	ctx.gpCompressedTextureSubImage3D = load("glCompressedTextureSubImage3D")
+	ctx.gpCopyBufferSubData = load("glCopyBufferSubData")
+	ctx.gpCopyImageSubData = load("glCopyImageSubData")
+	ctx.gpCopyNamedBufferSubData = load("glCopyNamedBufferSubData")
+	ctx.gpCopyTexImage1D = load("glCopyTexImage1D")
+	ctx.gpCopyTexImage2D = load("glCopyTexImage2D")
+	ctx.gpCopyTexSubImage1D = load("glCopyTexSubImage1D")
+	ctx.gpCopyTexSubImage2D = load("glCopyTexSubImage2D")
+	ctx.gpCopyTexSubImage3D = load("glCopyTexSubImage3D")
+	ctx.gpCopyTextureSubImage1D = load("glCopyTextureSubImage1D")
+	ctx.gpCopyTextureSubImage2D = load("glCopyTextureSubImage2D")
+	ctx.gpCopyTextureSubImage3D = load("glCopyTextureSubImage3D")
+	ctx.gpCreateBuffers = load("glCreateBuffers")
+	ctx.gpCreateFramebuffers = load("glCreateFramebuffers")
+	ctx.gpCreateProgram = load("glCreateProgram")
+	ctx.gpCreateProgramPipelines = load("glCreateProgramPipelines")
+	ctx.gpCreateQueries = load("glCreateQueries")
+	ctx.gpCreateRenderbuffers = load("glCreateRenderbuffers")
+	ctx.gpCreateSamplers = load("glCreateSamplers")
+	ctx.gpCreateShader = load("glCreateShader")
+	ctx.gpCreateShaderProgramv = load("glCreateShaderProgramv")
+	ctx.gpCreateTextures = load("glCreateTextures")
+	ctx.gpCreateTransformFeedbacks = load("glCreateTransformFeedbacks")
+	ctx.gpCreateVertexArrays = load("glCreateVertexArrays")
+	ctx.gpCullFace = load("glCullFace")
+	ctx.gpDebugMessageCallback = load("glDebugMessageCallback")
+	ctx.gpDebugMessageControl = load("glDebugMessageControl")
+	ctx.gpDebugMessageInsert = load("glDebugMessageInsert")
+	ctx.gpDeleteBuffers = load("glDeleteBuffers")
+	ctx.gpDeleteFramebuffers = load("glDeleteFramebuffers")
+	ctx.gpDeleteProgram = load("glDeleteProgram")
+	ctx.gpDeleteProgramPipelines = load("glDeleteProgramPipelines")
+	ctx.gpDeleteQueries = load("glDeleteQueries")
+	ctx.gpDeleteRenderbuffers = load("glDeleteRenderbuffers")
+	ctx.gpDeleteSamplers = load("glDeleteSamplers")
+	ctx.gpDeleteShader = load("glDeleteShader")
+	ctx.gpDeleteSync = load("glDeleteSync")
+	ctx.gpDeleteTextures = load("glDeleteTextures")
+	ctx.gpDeleteTransformFeedbacks = load("glDeleteTransformFeedbacks")
+	ctx.gpDeleteVertexArrays = load("glDeleteVertexArrays")
+	ctx.gpDepthFunc = load("glDepthFunc")
+	ctx.gpDepthMask = load("glDepthMask")
+	ctx.gpDepthRange = load("glDepthRange")
+	ctx.gpDepthRangeArrayv = load("glDepthRangeArrayv")
+	ctx.gpDepthRangeIndexed = load("glDepthRangeIndexed")
+	ctx.gpDepthRangef = load("glDepthRangef")
+	ctx.gpDetachShader = load("glDetachShader")
+	ctx.gpDisable = load("glDisable")
+	ctx.gpDisableVertexArrayAttrib = load("glDisableVertexArrayAttrib")
+	ctx.gpDisableVertexAttribArray = load("glDisableVertexAttribArray")
+	ctx.gpDisablei = load("glDisablei")
+	ctx.gpDispatchCompute = load("glDispatchCompute")
+	ctx.gpDispatchComputeIndirect = load("glDispatchComputeIndirect")
+	ctx.gpDrawArrays = load("glDrawArrays")
+	ctx.gpDrawArraysIndirect = load("glDrawArraysIndirect")
+	ctx.gpDrawArraysInstanced = load("glDrawArraysInstanced")
+	ctx.gpDrawArraysInstancedBaseInstance = load("glDrawArraysInstancedBaseInstance")
+	ctx.gpDrawBuffer = load("glDrawBuffer")
+	ctx.gpDrawBuffers = load("glDrawBuffers")
+	ctx.gpDrawElements = load("glDrawElements")
+	ctx.gpDrawElementsBaseVertex = load("glDrawElementsBaseVertex")
+	ctx.gpDrawElementsIndirect = load("glDrawElementsIndirect")
+	ctx.gpDrawElementsInstanced = load("glDrawElementsInstanced")
+	ctx.gpDrawElementsInstancedBaseInstance = load("glDrawElementsInstancedBaseInstance")
+	ctx.gpDrawElementsInstancedBaseVertex = load("glDrawElementsInstancedBaseVertex")
+	ctx.gpDrawElementsInstancedBaseVertexBaseInstance = load("glDrawElementsInstancedBaseVertexBaseInstance")
+	ctx.gpDrawRangeElements = load("glDrawRangeElements")
+	ctx.gpDrawRangeElementsBaseVertex = load("glDrawRangeElementsBaseVertex")
+	ctx.gpDrawTransformFeedback = load("glDrawTransformFeedback")
+	ctx.gpDrawTransformFeedbackInstanced = load("glDrawTransformFeedbackInstanced")
+	ctx.gpDrawTransformFeedbackStream = load("glDrawTransformFeedbackStream")
+	ctx.gpDrawTransformFeedbackStreamInstanced = load("glDrawTransformFeedbackStreamInstanced")
+	ctx.gpEnable = load("glEnable")
+	ctx.gpEnableVertexArrayAttrib = load("glEnableVertexArrayAttrib")
+	ctx.gpEnableVertexAttribArray = load("glEnableVertexAttribArray")
+	ctx.gpEnablei = load("glEnablei")
+	ctx.gpEndConditionalRender = load("glEndConditionalRender")
+	ctx.gpEndQuery = load("glEndQuery")
+	ctx.gpEndQueryIndexed = load("glEndQueryIndexed")
+	ctx.gpEndTransformFeedback = load("glEndTransformFeedback")
+	ctx.gpFenceSync = load("glFenceSync")
+	ctx.gpFinish = load("glFinish")
+	ctx.gpFlush = load("glFlush")
+	ctx.gpFlushMappedBufferRange = load("glFlushMappedBufferRange")
+	ctx.gpFlushMappedNamedBufferRange = load("glFlushMappedNamedBufferRange")
+	ctx.gpFramebufferParameteri = load("glFramebufferParameteri")
+	ctx.gpFramebufferRenderbuffer = load("glFramebufferRenderbuffer")
+	ctx.gpFramebufferTexture = load("glFramebufferTexture")
+	ctx.gpFramebufferTexture1D = load("glFramebufferTexture1D")
+	ctx.gpFramebufferTexture2D = load("glFramebufferTexture2D")
+	ctx.gpFramebufferTexture3D = load("glFramebufferTexture3D")
+	ctx.gpFramebufferTextureLayer = load("glFramebufferTextureLayer")
+	ctx.gpFrontFace = load("glFrontFace")
+	ctx.gpGenBuffers = load("glGenBuffers")
+	ctx.gpGenFramebuffers = load("glGenFramebuffers")
+	ctx.gpGenProgramPipelines = load("glGenProgramPipelines")
+	ctx.gpGenQueries = load("glGenQueries")
+	ctx.gpGenRenderbuffers = load("glGenRenderbuffers")
+	ctx.gpGenSamplers = load("glGenSamplers")
+	ctx.gpGenTextures = load("glGenTextures")
+	ctx.gpGenTransformFeedbacks = load("glGenTransformFeedbacks")
+	ctx.gpGenVertexArrays = load("glGenVertexArrays")
+	ctx.gpGenerateMipmap = load("glGenerateMipmap")
+	ctx.gpGenerateTextureMipmap = load("glGenerateTextureMipmap")
+	ctx.gpGetActiveAtomicCounterBufferiv = load("glGetActiveAtomicCounterBufferiv")
+	ctx.gpGetActiveAttrib = load("glGetActiveAttrib")
+	ctx.gpGetActiveSubroutineName = load("glGetActiveSubroutineName")
+	ctx.gpGetActiveSubroutineUniformName = load("glGetActiveSubroutineUniformName")
+	ctx.gpGetActiveSubroutineUniformiv = load("glGetActiveSubroutineUniformiv")
+	ctx.gpGetActiveUniform = load("glGetActiveUniform")
+	ctx.gpGetActiveUniformBlockName = load("glGetActiveUniformBlockName")
+	ctx.gpGetActiveUniformBlockiv = load("glGetActiveUniformBlockiv")
+	ctx.gpGetActiveUniformName = load("glGetActiveUniformName")
+	ctx.gpGetActiveUniformsiv = load("glGetActiveUniformsiv")
+	ctx.gpGetAttachedShaders = load("glGetAttachedShaders")
+	ctx.gpGetAttribLocation = load("glGetAttribLocation")
+	ctx.gpGetBooleani_v = load("glGetBooleani_v")
+	ctx.gpGetBooleanv = load("glGetBooleanv")
+	ctx.gpGetBufferParameteri64v = load("glGetBufferParameteri64v")
+	ctx.gpGetBufferParameteriv = load("glGetBufferParameteriv")
+	ctx.gpGetBufferPointerv = load("glGetBufferPointerv")
+	ctx.gpGetBufferSubData = load("glGetBufferSubData")
+	ctx.gpGetCompressedTexImage = load("glGetCompressedTexImage")
+	ctx.gpGetCompressedTextureImage = load("glGetCompressedTextureImage")
+	ctx.gpGetCompressedTextureSubImage = load("glGetCompressedTextureSubImage")
+	ctx.gpGetDebugMessageLog = load("glGetDebugMessageLog")
+	ctx.gpGetDoublei_v = load("glGetDoublei_v")
+	ctx.gpGetDoublev = load("glGetDoublev")
+	ctx.gpGetError = load("glGetError")
+	ctx.gpGetFloati_v = load("glGetFloati_v")
+	ctx.gpGetFloatv = load("glGetFloatv")
+	ctx.gpGetFragDataIndex = load("glGetFragDataIndex")
+	ctx.gpGetFragDataLocation = load("glGetFragDataLocation")
+	ctx.gpGetFramebufferAttachmentParameteriv = load("glGetFramebufferAttachmentParameteriv")
+	ctx.gpGetFramebufferParameteriv = load("glGetFramebufferParameteriv")
+	ctx.gpGetGraphicsResetStatus = load("glGetGraphicsResetStatus")
+	ctx.gpGetInteger64i_v = load("glGetInteger64i_v")
+	ctx.gpGetInteger64v = load("glGetInteger64v")
+	ctx.gpGetIntegeri_v = load("glGetIntegeri_v")
+	ctx.gpGetIntegerv = load("glGetIntegerv")
+	ctx.gpGetInternalformati64v = load("glGetInternalformati64v")
+	ctx.gpGetInternalformativ = load("glGetInternalformativ")
+	ctx.gpGetMultisamplefv = load("glGetMultisamplefv")
+	ctx.gpGetNamedBufferParameteri64v = load("glGetNamedBufferParameteri64v")
+	ctx.gpGetNamedBufferParameteriv = load("glGetNamedBufferParameteriv")
+	ctx.gpGetNamedBufferPointerv = load("glGetNamedBufferPointerv")
+	ctx.gpGetNamedBufferSubData = load("glGetNamedBufferSubData")
+	ctx.gpGetNamedFramebufferAttachmentParameteriv = load("glGetNamedFramebufferAttachmentParameteriv")
+	ctx.gpGetNamedFramebufferParameteriv = load("glGetNamedFramebufferParameteriv")
+	ctx.gpGetNamedRenderbufferParameteriv = load("glGetNamedRenderbufferParameteriv")
+	ctx.gpGetObjectLabel = load("glGetObjectLabel")
+	ctx.gpGetObjectPtrLabel = load("glGetObjectPtrLabel")
+	ctx.gpGetPointerv = load("glGetPointerv")
+	ctx.gpGetProgramBinary = load("glGetProgramBinary")
+	ctx.gpGetProgramInfoLog = load("glGetProgramInfoLog")
+	ctx.gpGetProgramInterfaceiv = load("glGetProgramInterfaceiv")
+	ctx.gpGetProgramPipelineInfoLog = load("glGetProgramPipelineInfoLog")
+	ctx.gpGetProgramPipelineiv = load("glGetProgramPipelineiv")
+	ctx.gpGetProgramResourceIndex = load("glGetProgramResourceIndex")
+	ctx.gpGetProgramResourceLocation = load("glGetProgramResourceLocation")
+	ctx.gpGetProgramResourceLocationIndex = load("glGetProgramResourceLocationIndex")
+	ctx.gpGetProgramResourceName = load("glGetProgramResourceName")
+	ctx.gpGetProgramResourceiv = load("glGetProgramResourceiv")
+	ctx.gpGetProgramStageiv = load("glGetProgramStageiv")
+	ctx.gpGetProgramiv = load("glGetProgramiv")
+	ctx.gpGetQueryBufferObjecti64v = load("glGetQueryBufferObjecti64v")
+	ctx.gpGetQueryBufferObjectiv = load("glGetQueryBufferObjectiv")
+	ctx.gpGetQueryBufferObjectui64v = load("glGetQueryBufferObjectui64v")
+	ctx.gpGetQueryBufferObjectuiv = load("glGetQueryBufferObjectuiv")
+	ctx.gpGetQueryIndexediv = load("glGetQueryIndexediv")
+	ctx.gpGetQueryObjecti64v = load("glGetQueryObjecti64v")
+	ctx.gpGetQueryObjectiv = load("glGetQueryObjectiv")
+	ctx.gpGetQueryObjectui64v = load("glGetQueryObjectui64v")
+	ctx.gpGetQueryObjectuiv = load("glGetQueryObjectuiv")
+	ctx.gpGetQueryiv = load("glGetQueryiv")
+	ctx.gpGetRenderbufferParameteriv = load("glGetRenderbufferParameteriv")
+	ctx.gpGetSamplerParameterIiv = load("glGetSamplerParameterIiv")
+	ctx.gpGetSamplerParameterIuiv = load("glGetSamplerParameterIuiv")
+	ctx.gpGetSamplerParameterfv = load("glGetSamplerParameterfv")
+	ctx.gpGetSamplerParameteriv = load("glGetSamplerParameteriv")
+	ctx.gpGetShaderInfoLog = load("glGetShaderInfoLog")
+	ctx.gpGetShaderPrecisionFormat = load("glGetShaderPrecisionFormat")
+	ctx.gpGetShaderSource = load("glGetShaderSource")
+	ctx.gpGetShaderiv = load("glGetShaderiv")
+	ctx.gpGetString = load("glGetString")
+	ctx.gpGetStringi = load("glGetStringi")
+	ctx.gpGetSubroutineIndex = load("glGetSubroutineIndex")
+	ctx.gpGetSubroutineUniformLocation = load("glGetSubroutineUniformLocation")
+	ctx.gpGetSynciv = load("glGetSynciv")
+	ctx.gpGetTexImage = load("glGetTexImage")
+	ctx.gpGetTexLevelParameterfv = load("glGetTexLevelParameterfv")
+	ctx.gpGetTexLevelParameteriv = load("glGetTexLevelParameteriv")
+	ctx.gpGetTexParameterIiv = load("glGetTexParameterIiv")
+	ctx.gpGetTexParameterIuiv = load("glGetTexParameterIuiv")
+	ctx.gpGetTexParameterfv = load("glGetTexParameterfv")
+	ctx.gpGetTexParameteriv = load("glGetTexParameteriv")
+	ctx.gpGetTextureImage = load("glGetTextureImage")
+	ctx.gpGetTextureLevelParameterfv = load("glGetTextureLevelParameterfv")
+	ctx.gpGetTextureLevelParameteriv = load("glGetTextureLevelParameteriv")
+	ctx.gpGetTextureParameterIiv = load("glGetTextureParameterIiv")
+	ctx.gpGetTextureParameterIuiv = load("glGetTextureParameterIuiv")
+	ctx.gpGetTextureParameterfv = load("glGetTextureParameterfv")
+	ctx.gpGetTextureParameteriv = load("glGetTextureParameteriv")
+	ctx.gpGetTextureSubImage = load("glGetTextureSubImage")
+	ctx.gpGetTransformFeedbackVarying = load("glGetTransformFeedbackVarying")
+	ctx.gpGetTransformFeedbacki64_v = load("glGetTransformFeedbacki64_v")
+	ctx.gpGetTransformFeedbacki_v = load("glGetTransformFeedbacki_v")
+	ctx.gpGetTransformFeedbackiv = load("glGetTransformFeedbackiv")
+	ctx.gpGetUniformBlockIndex = load("glGetUniformBlockIndex")
+	ctx.gpGetUniformIndices = load("glGetUniformIndices")
+	ctx.gpGetUniformLocation = load("glGetUniformLocation")
+	ctx.gpGetUniformSubroutineuiv = load("glGetUniformSubroutineuiv")
+	ctx.gpGetUniformdv = load("glGetUniformdv")
+	ctx.gpGetUniformfv = load("glGetUniformfv")
+	ctx.gpGetUniformiv = load("glGetUniformiv")
+	ctx.gpGetUniformuiv = load("glGetUniformuiv")
+	ctx.gpGetVertexArrayIndexed64iv = load("glGetVertexArrayIndexed64iv")
+	ctx.gpGetVertexArrayIndexediv = load("glGetVertexArrayIndexediv")
+	ctx.gpGetVertexArrayiv = load("glGetVertexArrayiv")
+	ctx.gpGetVertexAttribIiv = load("glGetVertexAttribIiv")
+	ctx.gpGetVertexAttribIuiv = load("glGetVertexAttribIuiv")
+	ctx.gpGetVertexAttribLdv = load("glGetVertexAttribLdv")
+	ctx.gpGetVertexAttribPointerv = load("glGetVertexAttribPointerv")
+	ctx.gpGetVertexAttribdv = load("glGetVertexAttribdv")
+	ctx.gpGetVertexAttribfv = load("glGetVertexAttribfv")
+	ctx.gpGetVertexAttribiv = load("glGetVertexAttribiv")
+	ctx.gpGetnCompressedTexImage = load("glGetnCompressedTexImage")
+	ctx.gpGetnTexImage = load("glGetnTexImage")
+	ctx.gpGetnUniformdv = load("glGetnUniformdv")
+	ctx.gpGetnUniformfv = load("glGetnUniformfv")
+	ctx.gpGetnUniformiv = load("glGetnUniformiv")
+	ctx.gpGetnUniformuiv = load("glGetnUniformuiv")
+	ctx.gpHint = load("glHint")
+	ctx.gpInvalidateBufferData = load("glInvalidateBufferData")
+	ctx.gpInvalidateBufferSubData = load("glInvalidateBufferSubData")
+	ctx.gpInvalidateFramebuffer = load("glInvalidateFramebuffer")
+	ctx.gpInvalidateNamedFramebufferData = load("glInvalidateNamedFramebufferData")
+	ctx.gpInvalidateNamedFramebufferSubData = load("glInvalidateNamedFramebufferSubData")
+	ctx.gpInvalidateSubFramebuffer = load("glInvalidateSubFramebuffer")
+	ctx.gpInvalidateTexImage = load("glInvalidateTexImage")
+	ctx.gpInvalidateTexSubImage = load("glInvalidateTexSubImage")
+	ctx.gpIsBuffer = load("glIsBuffer")
+	ctx.gpIsEnabled = load("glIsEnabled")
+	ctx.gpIsEnabledi = load("glIsEnabledi")
+	ctx.gpIsFramebuffer = load("glIsFramebuffer")
+	ctx.gpIsProgram = load("glIsProgram")
+	ctx.gpIsProgramPipeline = load("glIsProgramPipeline")
+	ctx.gpIsQuery = load("glIsQuery")
+	ctx.gpIsRenderbuffer = load("glIsRenderbuffer")
+	ctx.gpIsSampler = load("glIsSampler")
+	ctx.gpIsShader = load("glIsShader")
+	ctx.gpIsSync = load("glIsSync")
+	ctx.gpIsTexture = load("glIsTexture")
+	ctx.gpIsTransformFeedback = load("glIsTransformFeedback")
+	ctx.gpIsVertexArray = load("glIsVertexArray")
+	ctx.gpLineWidth = load("glLineWidth")
+	ctx.gpLinkProgram = load("glLinkProgram")
+	ctx.gpLogicOp = load("glLogicOp")
+	ctx.gpMapBuffer = load("glMapBuffer")
+	ctx.gpMapBufferRange = load("glMapBufferRange")
+	ctx.gpMapNamedBuffer = load("glMapNamedBuffer")
+	ctx.gpMapNamedBufferRange = load("glMapNamedBufferRange")
+	ctx.gpMemoryBarrier = load("glMemoryBarrier")
+	ctx.gpMemoryBarrierByRegion = load("glMemoryBarrierByRegion")
+	ctx.gpMinSampleShading = load("glMinSampleShading")
+	ctx.gpMultiDrawArrays = load("glMultiDrawArrays")
+	ctx.gpMultiDrawArraysIndirect = load("glMultiDrawArraysIndirect")
+	ctx.gpMultiDrawArraysIndirectCount = load("glMultiDrawArraysIndirectCount")
+	ctx.gpMultiDrawElements = load("glMultiDrawElements")
+	ctx.gpMultiDrawElementsBaseVertex = load("glMultiDrawElementsBaseVertex")
+	ctx.gpMultiDrawElementsIndirect = load("glMultiDrawElementsIndirect")
+	ctx.gpMultiDrawElementsIndirectCount = load("glMultiDrawElementsIndirectCount")
+	ctx.gpNamedBufferData = load("glNamedBufferData")
+	ctx.gpNamedBufferStorage = load("glNamedBufferStorage")
+	ctx.gpNamedBufferSubData = load("glNamedBufferSubData")
+	ctx.gpNamedFramebufferDrawBuffer = load("glNamedFramebufferDrawBuffer")
+	ctx.gpNamedFramebufferDrawBuffers = load("glNamedFramebufferDrawBuffers")
+	ctx.gpNamedFramebufferParameteri = load("glNamedFramebufferParameteri")
+	ctx.gpNamedFramebufferReadBuffer = load("glNamedFramebufferReadBuffer")
+	ctx.gpNamedFramebufferRenderbuffer = load("glNamedFramebufferRenderbuffer")
+	ctx.gpNamedFramebufferTexture = load("glNamedFramebufferTexture")
+	ctx.gpNamedFramebufferTextureLayer = load("glNamedFramebufferTextureLayer")
+	ctx.gpNamedRenderbufferStorage = load("glNamedRenderbufferStorage")
+	ctx.gpNamedRenderbufferStorageMultisample = load("glNamedRenderbufferStorageMultisample")
+	ctx.gpObjectLabel = load("glObjectLabel")
+	ctx.gpObjectPtrLabel = load("glObjectPtrLabel")
+	ctx.gpPatchParameterfv = load("glPatchParameterfv")
+	ctx.gpPatchParameteri = load("glPatchParameteri")
+	ctx.gpPauseTransformFeedback = load("glPauseTransformFeedback")
+	ctx.gpPixelStoref = load("glPixelStoref")
+	ctx.gpPixelStorei = load("glPixelStorei")
+	ctx.gpPointParameterf = load("glPointParameterf")
+	ctx.gpPointParameterfv = load("glPointParameterfv")
+	ctx.gpPointParameteri = load("glPointParameteri")
+	ctx.gpPointParameteriv = load("glPointParameteriv")
+	ctx.gpPointSize = load("glPointSize")
+	ctx.gpPolygonMode = load("glPolygonMode")
+	ctx.gpPolygonOffset = load("glPolygonOffset")
+	ctx.gpPolygonOffsetClamp = load("glPolygonOffsetClamp")
+	ctx.gpPopDebugGroup = load("glPopDebugGroup")
+	ctx.gpPrimitiveRestartIndex = load("glPrimitiveRestartIndex")
+	ctx.gpProgramBinary = load("glProgramBinary")
+	ctx.gpProgramParameteri = load("glProgramParameteri")
+	ctx.gpProgramUniform1d = load("glProgramUniform1d")
+	ctx.gpProgramUniform1dv = load("glProgramUniform1dv")
+	ctx.gpProgramUniform1f = load("glProgramUniform1f")
+	ctx.gpProgramUniform1fv = load("glProgramUniform1fv")
+	ctx.gpProgramUniform1i = load("glProgramUniform1i")
+	ctx.gpProgramUniform1iv = load("glProgramUniform1iv")
+	ctx.gpProgramUniform1ui = load("glProgramUniform1ui")
+	ctx.gpProgramUniform1uiv = load("glProgramUniform1uiv")
+	ctx.gpProgramUniform2d = load("glProgramUniform2d")
+	ctx.gpProgramUniform2dv = load("glProgramUniform2dv")
+	ctx.gpProgramUniform2f = load("glProgramUniform2f")
+	ctx.gpProgramUniform2fv = load("glProgramUniform2fv")
+	ctx.gpProgramUniform2i = load("glProgramUniform2i")
+	ctx.gpProgramUniform2iv = load("glProgramUniform2iv")
+	ctx.gpProgramUniform2ui = load("glProgramUniform2ui")
+	ctx.gpProgramUniform2uiv = load("glProgramUniform2uiv")
+	ctx.gpProgramUniform3d = load("glProgramUniform3d")
+	ctx.gpProgramUniform3dv = load("glProgramUniform3dv")
+	ctx.gpProgramUniform3f = load("glProgramUniform3f")
+	ctx.gpProgramUniform3fv = load("glProgramUniform3fv")
+	ctx.gpProgramUniform3i = load("glProgramUniform3i")
+	ctx.gpProgramUniform3iv = load("glProgramUniform3iv")
+	ctx.gpProgramUniform3ui = load("glProgramUniform3ui")
+	ctx.gpProgramUniform3uiv = load("glProgramUniform3uiv")
+	ctx.gpProgramUniform4d = load("glProgramUniform4d")
+	ctx.gpProgramUniform4dv = load("glProgramUniform4dv")
+	ctx.gpProgramUniform4f = load("glProgramUniform4f")
+	ctx.gpProgramUniform4fv = load("glProgramUniform4fv")
+	ctx.gpProgramUniform4i = load("glProgramUniform4i")
+	ctx.gpProgramUniform4iv = load("glProgramUniform4iv")
+	ctx.gpProgramUniform4ui = load("glProgramUniform4ui")
+	ctx.gpProgramUniform4uiv = load("glProgramUniform4uiv")
+	ctx.gpProgramUniformMatrix2dv = load("glProgramUniformMatrix2dv")
+	ctx.gpProgramUniformMatrix2fv = load("glProgramUniformMatrix2fv")
+	ctx.gpProgramUniformMatrix2x3dv = load("glProgramUniformMatrix2x3dv")
+	ctx.gpProgramUniformMatrix2x3fv = load("glProgramUniformMatrix2x3fv")
+	ctx.gpProgramUniformMatrix2x4dv = load("glProgramUniformMatrix2x4dv")
+	ctx.gpProgramUniformMatrix2x4fv = load("glProgramUniformMatrix2x4fv")
+	ctx.gpProgramUniformMatrix3dv = load("glProgramUniformMatrix3dv")
+	ctx.gpProgramUniformMatrix3fv = load("glProgramUniformMatrix3fv")
+	ctx.gpProgramUniformMatrix3x2dv = load("glProgramUniformMatrix3x2dv")
+	ctx.gpProgramUniformMatrix3x2fv = load("glProgramUniformMatrix3x2fv")
+	ctx.gpProgramUniformMatrix3x4dv = load("glProgramUniformMatrix3x4dv")
+	ctx.gpProgramUniformMatrix3x4fv = load("glProgramUniformMatrix3x4fv")
+	ctx.gpProgramUniformMatrix4dv = load("glProgramUniformMatrix4dv")
+	ctx.gpProgramUniformMatrix4fv = load("glProgramUniformMatrix4fv")
+	ctx.gpProgramUniformMatrix4x2dv = load("glProgramUniformMatrix4x2dv")
+	ctx.gpProgramUniformMatrix4x2fv = load("glProgramUniformMatrix4x2fv")
+	ctx.gpProgramUniformMatrix4x3dv = load("glProgramUniformMatrix4x3dv")
+	ctx.gpProgramUniformMatrix4x3fv = load("glProgramUniformMatrix4x3fv")
+	ctx.gpProvokingVertex = load("glProvokingVertex")
+	ctx.gpPushDebugGroup = load("glPushDebugGroup")
+	ctx.gpQueryCounter = load("glQueryCounter")
+	ctx.gpReadBuffer = load("glReadBuffer")
+	ctx.gpReadPixels = load("glReadPixels")
+	ctx.gpReadnPixels = load("glReadnPixels")
+	ctx.gpReleaseShaderCompiler = load("glReleaseShaderCompiler")
+	ctx.gpRenderbufferStorage = load("glRenderbufferStorage")
+	ctx.gpRenderbufferStorageMultisample = load("glRenderbufferStorageMultisample")
+	ctx.gpResumeTransformFeedback = load("glResumeTransformFeedback")
+	ctx.gpSampleCoverage = load("glSampleCoverage")
+	ctx.gpSampleMaski = load("glSampleMaski")
+	ctx.gpSamplerParameterIiv = load("glSamplerParameterIiv")
+	ctx.gpSamplerParameterIuiv = load("glSamplerParameterIuiv")
+	ctx.gpSamplerParameterf = load("glSamplerParameterf")
+	ctx.gpSamplerParameterfv = load("glSamplerParameterfv")
+	ctx.gpSamplerParameteri = load("glSamplerParameteri")
+	ctx.gpSamplerParameteriv = load("glSamplerParameteriv")
+	ctx.gpScissor = load("glScissor")
+	ctx.gpScissorArrayv = load("glScissorArrayv")
+	ctx.gpScissorIndexed = load("glScissorIndexed")
+	ctx.gpScissorIndexedv = load("glScissorIndexedv")
+	ctx.gpShaderBinary = load("glShaderBinary")
+	ctx.gpShaderSource = load("glShaderSource")
+	ctx.gpShaderStorageBlockBinding = load("glShaderStorageBlockBinding")
+	ctx.gpSpecializeShader = load("glSpecializeShader")
+	ctx.gpStencilFunc = load("glStencilFunc")
+	ctx.gpStencilFuncSeparate = load("glStencilFuncSeparate")
+	ctx.gpStencilMask = load("glStencilMask")
+	ctx.gpStencilMaskSeparate = load("glStencilMaskSeparate")
+	ctx.gpStencilOp = load("glStencilOp")
+	ctx.gpStencilOpSeparate = load("glStencilOpSeparate")
+	ctx.gpTexBuffer = load("glTexBuffer")
+	ctx.gpTexBufferRange = load("glTexBufferRange")
+	ctx.gpTexImage1D = load("glTexImage1D")
+	ctx.gpTexImage2D = load("glTexImage2D")
+	ctx.gpTexImage2DMultisample = load("glTexImage2DMultisample")
+	ctx.gpTexImage3D = load("glTexImage3D")
+	ctx.gpTexImage3DMultisample = load("glTexImage3DMultisample")
+	ctx.gpTexParameterIiv = load("glTexParameterIiv")
+	ctx.gpTexParameterIuiv = load("glTexParameterIuiv")
+	ctx.gpTexParameterf = load("glTexParameterf")
+	ctx.gpTexParameterfv = load("glTexParameterfv")
+	ctx.gpTexParameteri = load("glTexParameteri")
+	ctx.gpTexParameteriv = load("glTexParameteriv")
+	ctx.gpTexStorage1D = load("glTexStorage1D")
+	ctx.gpTexStorage2D = load("glTexStorage2D")
+	ctx.gpTexStorage2DMultisample = load("glTexStorage2DMultisample")
+	ctx.gpTexStorage3D = load("glTexStorage3D")
+	ctx.gpTexStorage3DMultisample = load("glTexStorage3DMultisample")
+	ctx.gpTexSubImage1D = load("glTexSubImage1D")
+	ctx.gpTexSubImage2D = load("glTexSubImage2D")
+	ctx.gpTexSubImage3D = load("glTexSubImage3D")
+	ctx.gpTextureBarrier = load("glTextureBarrier")
+	ctx.gpTextureBuffer = load("glTextureBuffer")
+	ctx.gpTextureBufferRange = load("glTextureBufferRange")
+	ctx.gpTextureParameterIiv = load("glTextureParameterIiv")
+	ctx.gpTextureParameterIuiv = load("glTextureParameterIuiv")
+	ctx.gpTextureParameterf = load("glTextureParameterf")
+	ctx.gpTextureParameterfv = load("glTextureParameterfv")
+	ctx.gpTextureParameteri = load("glTextureParameteri")
+	ctx.gpTextureParameteriv = load("glTextureParameteriv")
+	ctx.gpTextureStorage1D = load("glTextureStorage1D")
+	ctx.gpTextureStorage2D = load("glTextureStorage2D")
+	ctx.gpTextureStorage2DMultisample = load("glTextureStorage2DMultisample")
+	ctx.gpTextureStorage3D = load("glTextureStorage3D")
+	ctx.gpTextureStorage3DMultisample = load("glTextureStorage3DMultisample")
+	ctx.gpTextureSubImage1D = load("glTextureSubImage1D")
+	ctx.gpTextureSubImage2D = load("glTextureSubImage2D")
+	ctx.gpTextureSubImage3D = load("glTextureSubImage3D")
+	ctx.gpTextureView = load("glTextureView")
+	ctx.gpTransformFeedbackBufferBase = load("glTransformFeedbackBufferBase")
+	ctx.gpTransformFeedbackBufferRange = load("glTransformFeedbackBufferRange")
+	ctx.gpTransformFeedbackVaryings = load("glTransformFeedbackVaryings")
+	ctx.gpUniform1d = load("glUniform1d")
+	ctx.gpUniform1dv = load("glUniform1dv")
+	ctx.gpUniform1f = load("glUniform1f")
+	ctx.gpUniform1fv = load("glUniform1fv")
+	ctx.gpUniform1i = load("glUniform1i")
+	ctx.gpUniform1iv = load("glUniform1iv")
+	ctx.gpUniform1ui = load("glUniform1ui")
+	ctx.gpUniform1uiv = load("glUniform1uiv")
+	ctx.gpUniform2d = load("glUniform2d")
+	ctx.gpUniform2dv = load("glUniform2dv")
+	ctx.gpUniform2f = load("glUniform2f")
+	ctx.gpUniform2fv = load("glUniform2fv")
+	ctx.gpUniform2i = load("glUniform2i")
+	ctx.gpUniform2iv = load("glUniform2iv")
+	ctx.gpUniform2ui = load("glUniform2ui")
+	ctx.gpUniform2uiv = load("glUniform2uiv")
+	ctx.gpUniform3d = load("glUniform3d")
+	ctx.gpUniform3dv = load("glUniform3dv")
+	ctx.gpUniform3f = load("glUniform3f")
+	ctx.gpUniform3fv = load("glUniform3fv")
+	ctx.gpUniform3i = load("glUniform3i")
+	ctx.gpUniform3iv = load("glUniform3iv")
+	ctx.gpUniform3ui = load("glUniform3ui")
+	ctx.gpUniform3uiv = load("glUniform3uiv")
+	ctx.gpUniform4d = load("glUniform4d")
+	ctx.gpUniform4dv = load("glUniform4dv")
+	ctx.gpUniform4f = load("glUniform4f")
+	ctx.gpUniform4fv = load("glUniform4fv")
+	ctx.gpUniform4i = load("glUniform4i")
+	ctx.gpUniform4iv = load("glUniform4iv")
+	ctx.gpUniform4ui = load("glUniform4ui")
+	ctx.gpUniform4uiv = load("glUniform4uiv")
+	ctx.gpUniformBlockBinding = load("glUniformBlockBinding")
+	ctx.gpUniformMatrix2dv = load("glUniformMatrix2dv")
+	ctx.gpUniformMatrix2fv = load("glUniformMatrix2fv")
+	ctx.gpUniformMatrix2x3dv = load("glUniformMatrix2x3dv")
+	ctx.gpUniformMatrix2x3fv = load("glUniformMatrix2x3fv")
+	ctx.gpUniformMatrix2x4dv = load("glUniformMatrix2x4dv")
+	ctx.gpUniformMatrix2x4fv = load("glUniformMatrix2x4fv")
+	ctx.gpUniformMatrix3dv = load("glUniformMatrix3dv")
+	ctx.gpUniformMatrix3fv = load("glUniformMatrix3fv")
+	ctx.gpUniformMatrix3x2dv = load("glUniformMatrix3x2dv")
+	ctx.gpUniformMatrix3x2fv = load("glUniformMatrix3x2fv")
+	ctx.gpUniformMatrix3x4dv = load("glUniformMatrix3x4dv")
+	ctx.gpUniformMatrix3x4fv = load("glUniformMatrix3x4fv")
+	ctx.gpUniformMatrix4dv = load("glUniformMatrix4dv")
+	ctx.gpUniformMatrix4fv = load("glUniformMatrix4fv")
+	ctx.gpUniformMatrix4x2dv = load("glUniformMatrix4x2dv")
+	ctx.gpUniformMatrix4x2fv = load("glUniformMatrix4x2fv")
+	ctx.gpUniformMatrix4x3dv = load("glUniformMatrix4x3dv")
+	ctx.gpUniformMatrix4x3fv = load("glUniformMatrix4x3fv")
+	ctx.gpUniformSubroutinesuiv = load("glUniformSubroutinesuiv")
+	ctx.gpUnmapBuffer = load("glUnmapBuffer")
+	ctx.gpUnmapNamedBuffer = load("glUnmapNamedBuffer")
+	ctx.gpUseProgram = load("glUseProgram")
+	ctx.gpUseProgramStages = load("glUseProgramStages")
+	ctx.gpValidateProgram = load("glValidateProgram")
+	ctx.gpValidateProgramPipeline = load("glValidateProgramPipeline")
+	ctx.gpVertexArrayAttribBinding = load("glVertexArrayAttribBinding")
+	ctx.gpVertexArrayAttribFormat = load("glVertexArrayAttribFormat")
+	ctx.gpVertexArrayAttribIFormat = load("glVertexArrayAttribIFormat")
+	ctx.gpVertexArrayAttribLFormat = load("glVertexArrayAttribLFormat")
+	ctx.gpVertexArrayBindingDivisor = load("glVertexArrayBindingDivisor")
+	ctx.gpVertexArrayElementBuffer = load("glVertexArrayElementBuffer")
+	ctx.gpVertexArrayVertexBuffer = load("glVertexArrayVertexBuffer")
+	ctx.gpVertexArrayVertexBuffers = load("glVertexArrayVertexBuffers")
+	ctx.gpVertexAttrib1d = load("glVertexAttrib1d")
+	ctx.gpVertexAttrib1dv = load("glVertexAttrib1dv")
+	ctx.gpVertexAttrib1f = load("glVertexAttrib1f")
+	ctx.gpVertexAttrib1fv = load("glVertexAttrib1fv")
+	ctx.gpVertexAttrib1s = load("glVertexAttrib1s")
+	ctx.gpVertexAttrib1sv = load("glVertexAttrib1sv")
+	ctx.gpVertexAttrib2d = load("glVertexAttrib2d")
+	ctx.gpVertexAttrib2dv = load("glVertexAttrib2dv")
+	ctx.gpVertexAttrib2f = load("glVertexAttrib2f")
+	ctx.gpVertexAttrib2fv = load("glVertexAttrib2fv")
+	ctx.gpVertexAttrib2s = load("glVertexAttrib2s")
+	ctx.gpVertexAttrib2sv = load("glVertexAttrib2sv")
+	ctx.gpVertexAttrib3d = load("glVertexAttrib3d")
+	ctx.gpVertexAttrib3dv = load("glVertexAttrib3dv")
+	ctx.gpVertexAttrib3f = load("glVertexAttrib3f")
+	ctx.gpVertexAttrib3fv = load("glVertexAttrib3fv")
+	ctx.gpVertexAttrib3s = load("glVertexAttrib3s")
+	ctx.gpVertexAttrib3sv = load("glVertexAttrib3sv")
+	ctx.gpVertexAttrib4Nbv = load("glVertexAttrib4Nbv")
+	ctx.gpVertexAttrib4Niv = load("glVertexAttrib4Niv")
+	ctx.gpVertexAttrib4Nsv = load("glVertexAttrib4Nsv")
+	ctx.gpVertexAttrib4Nub = load("glVertexAttrib4Nub")
+	ctx.gpVertexAttrib4Nubv = load("glVertexAttrib4Nubv")
+	ctx.gpVertexAttrib4Nuiv = load("glVertexAttrib4Nuiv")
+	ctx.gpVertexAttrib4Nusv = load("glVertexAttrib4Nusv")
+	ctx.gpVertexAttrib4bv = load("glVertexAttrib4bv")
+	ctx.gpVertexAttrib4d = load("glVertexAttrib4d")
+	ctx.gpVertexAttrib4dv = load("glVertexAttrib4dv")
+	ctx.gpVertexAttrib4f = load("glVertexAttrib4f")
+	ctx.gpVertexAttrib4fv = load("glVertexAttrib4fv")
+	ctx.gpVertexAttrib4iv = load("glVertexAttrib4iv")
+	ctx.gpVertexAttrib4s = load("glVertexAttrib4s")
+	ctx.gpVertexAttrib4sv = load("glVertexAttrib4sv")
+	ctx.gpVertexAttrib4ubv = load("glVertexAttrib4ubv")
+	ctx.gpVertexAttrib4uiv = load("glVertexAttrib4uiv")
+	ctx.gpVertexAttrib4usv = load("glVertexAttrib4usv")
+	ctx.gpVertexAttribBinding = load("glVertexAttribBinding")
+	ctx.gpVertexAttribDivisor = load("glVertexAttribDivisor")
+	ctx.gpVertexAttribFormat = load("glVertexAttribFormat")
+	ctx.gpVertexAttribI1i = load("glVertexAttribI1i")
+	ctx.gpVertexAttribI1iv = load("glVertexAttribI1iv")
+	ctx.gpVertexAttribI1ui = load("glVertexAttribI1ui")
+	ctx.gpVertexAttribI1uiv = load("glVertexAttribI1uiv")
+	ctx.gpVertexAttribI2i = load("glVertexAttribI2i")
+	ctx.gpVertexAttribI2iv = load("glVertexAttribI2iv")
+	ctx.gpVertexAttribI2ui = load("glVertexAttribI2ui")
+	ctx.gpVertexAttribI2uiv = load("glVertexAttribI2uiv")
+	ctx.gpVertexAttribI3i = load("glVertexAttribI3i")
+	ctx.gpVertexAttribI3iv = load("glVertexAttribI3iv")
+	ctx.gpVertexAttribI3ui = load("glVertexAttribI3ui")
+	ctx.gpVertexAttribI3uiv = load("glVertexAttribI3uiv")
+	ctx.gpVertexAttribI4bv = load("glVertexAttribI4bv")
+	ctx.gpVertexAttribI4i = load("glVertexAttribI4i")
+	ctx.gpVertexAttribI4iv = load("glVertexAttribI4iv")
+	ctx.gpVertexAttribI4sv = load("glVertexAttribI4sv")
+	ctx.gpVertexAttribI4ubv = load("glVertexAttribI4ubv")
+	ctx.gpVertexAttribI4ui = load("glVertexAttribI4ui")
+	ctx.gpVertexAttribI4uiv = load("glVertexAttribI4uiv")
+	ctx.gpVertexAttribI4usv = load("glVertexAttribI4usv")
+	ctx.gpVertexAttribIFormat = load("glVertexAttribIFormat")
+	ctx.gpVertexAttribIPointer = load("glVertexAttribIPointer")
+	ctx.gpVertexAttribL1d = load("glVertexAttribL1d")
+	ctx.gpVertexAttribL1dv = load("glVertexAttribL1dv")
+	ctx.gpVertexAttribL2d = load("glVertexAttribL2d")
+	ctx.gpVertexAttribL2dv = load("glVertexAttribL2dv")
+	ctx.gpVertexAttribL3d = load("glVertexAttribL3d")
+	ctx.gpVertexAttribL3dv = load("glVertexAttribL3dv")
+	ctx.gpVertexAttribL4d = load("glVertexAttribL4d")
+	ctx.gpVertexAttribL4dv = load("glVertexAttribL4dv")
+	ctx.gpVertexAttribLFormat = load("glVertexAttribLFormat")
+	ctx.gpVertexAttribLPointer = load("glVertexAttribLPointer")
+	ctx.gpVertexAttribP1ui = load("glVertexAttribP1ui")
+	ctx.gpVertexAttribP1uiv = load("glVertexAttribP1uiv")
+	ctx.gpVertexAttribP2ui = load("glVertexAttribP2ui")
+	ctx.gpVertexAttribP2uiv = load("glVertexAttribP2uiv")
+	ctx.gpVertexAttribP3ui = load("glVertexAttribP3ui")
+	ctx.gpVertexAttribP3uiv = load("glVertexAttribP3uiv")
+	ctx.gpVertexAttribP4ui = load("glVertexAttribP4ui")
+	ctx.gpVertexAttribP4uiv = load("glVertexAttribP4uiv")
+	ctx.gpVertexAttribPointer = load("glVertexAttribPointer")
+	ctx.gpVertexBindingDivisor = load("glVertexBindingDivisor")
+	ctx.gpViewport = load("glViewport")
+	ctx.gpViewportArrayv = load("glViewportArrayv")
+	ctx.gpViewportIndexedf = load("glViewportIndexedf")
+	ctx.gpViewportIndexedfv = load("glViewportIndexedfv")
+	ctx.gpWaitSync = load("glWaitSync")
+	if len(missing) > 0 {
+		return fmt.Errorf("gl: %d entry points are missing: %s", len(missing), strings.Join(missing, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,498 @@
+//go:build windows || (linux && (amd64 || arm64))
+
+package gl
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strings"
+	"unsafe"
+)
+
+// traceArg is an argument read from a trace
+type traceArg struct {
+	kind byte
+	i    int64
+	u    uint64
+	f    float64
+	b    []byte
+	s    []string
+}
+
+// traceReader reads the calls of a trace written by StartCapture
+type traceReader struct {
+	r     *bufio.Reader
+	names []string
+	args  []traceArg
+}
+
+func newTraceReader(r io.Reader) (*traceReader, error) {
+	t := &traceReader{r: bufio.NewReader(r)}
+	magic := make([]byte, len(traceMagic))
+	if _, err := io.ReadFull(t.r, magic); err != nil || string(magic) != traceMagic {
+		return nil, errors.New("gl: not a trace")
+	}
+	return t, nil
+}
+
+// traceCall is a call read from a trace
+type traceCall struct {
+	name   string
+	args   []traceArg
+	result *traceArg // The result of the functions returning an integer, or nil
+}
+
+// next returns the next call, or io.EOF at the end of the trace. The
+// arguments are valid until the next call.
+func (t *traceReader) next() (traceCall, error) {
+	for {
+		op, err := t.r.ReadByte()
+		if err != nil {
+			return traceCall{}, err
+		}
+		switch op {
+		case opName:
+			name, err := t.readString()
+			if err != nil {
+				return traceCall{}, t.corrupt(err)
+			}
+			t.names = append(t.names, name)
+		case opCall, opReturn:
+			id, err := binary.ReadUvarint(t.r)
+			if err != nil || id >= uint64(len(t.names)) {
+				return traceCall{}, t.corrupt(err)
+			}
+			n, err := binary.ReadUvarint(t.r)
+			if err != nil {
+				return traceCall{}, t.corrupt(err)
+			}
+			t.args = t.args[:0]
+			for range n {
+				a, err := t.readArg()
+				if err != nil {
+					return traceCall{}, t.corrupt(err)
+				}
+				t.args = append(t.args, a)
+			}
+			c := traceCall{name: t.names[id], args: t.args}
+			if op == opReturn {
+				result, err := t.readArg()
+				if err != nil {
+					return traceCall{}, t.corrupt(err)
+				}
+				c.result = &result
+			}
+			return c, nil
+		default:
+			return traceCall{}, t.corrupt(nil)
+		}
+	}
+}
+
+func (t *traceReader) corrupt(err error) error {
+	if err == nil || err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return fmt.Errorf("gl: corrupt trace: %w", err)
+}
+
+func (t *traceReader) readBytes() ([]byte, error) {
+	n, err := binary.ReadUvarint(t.r)
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, n)
+	_, err = io.ReadFull(t.r, b)
+	return b, err
+}
+
+func (t *traceReader) readString() (string, error) {
+	b, err := t.readBytes()
+	return string(b), err
+}
+
+func (t *traceReader) readArg() (a traceArg, err error) {
+	if a.kind, err = t.r.ReadByte(); err != nil {
+		return a, err
+	}
+	switch a.kind {
+	case argInt:
+		a.i, err = binary.ReadVarint(t.r)
+	case argUint, argAddr, argOffset:
+		a.u, err = binary.ReadUvarint(t.r)
+	case argFloat32:
+		var b [4]byte
+		_, err = io.ReadFull(t.r, b[:])
+		a.f = float64(math.Float32frombits(binary.LittleEndian.Uint32(b[:])))
+	case argFloat64:
+		var b [8]byte
+		_, err = io.ReadFull(t.r, b[:])
+		a.f = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	case argBool:
+		var b byte
+		b, err = t.r.ReadByte()
+		a.u = uint64(b)
+	case argBytes, argCString:
+		a.b, err = t.readBytes()
+	case argStrings:
+		var n uint64
+		if n, err = binary.ReadUvarint(t.r); err != nil {
+			return a, err
+		}
+		for range n {
+			var s string
+			if s, err = t.readString(); err != nil {
+				return a, err
+			}
+			a.s = append(a.s, s)
+		}
+	case argNil, argFunc:
+	default:
+		err = fmt.Errorf("unknown argument kind %d", a.kind)
+	}
+	return a, err
+}
+
+func (a traceArg) String() string {
+	switch a.kind {
+	case argInt:
+		return fmt.Sprint(a.i)
+	case argUint:
+		return fmt.Sprint(a.u)
+	case argFloat32, argFloat64:
+		return fmt.Sprint(a.f)
+	case argBool:
+		return fmt.Sprint(a.u != 0)
+	case argNil:
+		return "nil"
+	case argAddr:
+		return fmt.Sprintf("0x%x", a.u)
+	case argOffset:
+		return fmt.Sprintf("offset %d", a.u)
+	case argBytes:
+		return fmt.Sprintf("<%d bytes>", len(a.b))
+	case argCString:
+		return fmt.Sprintf("%q", a.b)
+	case argStrings:
+		return fmt.Sprintf("%q", a.s)
+	case argFunc:
+		return "func"
+	}
+	return "?"
+}
+
+// DumpTrace prints the calls of a trace written by StartCapture as text, one
+// call per line, numbered from 1.
+func DumpTrace(w io.Writer, r io.Reader) error {
+	t, err := newTraceReader(r)
+	if err != nil {
+		return err
+	}
+	for n := 1; ; n++ {
+		c, err := t.next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		s := make([]string, len(c.args))
+		for i, a := range c.args {
+			s[i] = a.String()
+		}
+		result := ""
+		if c.result != nil {
+			result = " = " + c.result.String()
+		}
+		if _, err := fmt.Fprintf(w, "%d gl%s(%s)%s\n", n, c.name, strings.Join(s, ", "), result); err != nil {
+			return err
+		}
+	}
+}
+
+// Replay issues the calls of a trace written by StartCapture on a context,
+// which must be current. A nil context is the default context. It returns
+// the number of calls issued.
+//
+// The names of the objects, and the uniform and attribute locations, are
+// translated from those of the capture to those given by the replay, as
+// apitrace does. GetUniformLocation, GetAttribLocation and
+// GetUniformBlockIndex are issued for this, while the other calls returning
+// values only, the Get, Is and ReadPixels functions, and the calls with
+// callbacks are skipped. A call with a pointer to client memory whose
+// content was not recorded, such as a client side vertex array, stops the
+// replay with an error.
+func Replay(r io.Reader, ctx *Context) (int, error) {
+	if ctx == nil {
+		ctx = &defaultContext
+	}
+	t, err := newTraceReader(r)
+	if err != nil {
+		return 0, err
+	}
+	rp := &replayer{ctx: ctx, methods: map[string]reflect.Value{}, names: map[objectKind]map[uint64]uint64{}}
+	n := 0
+	for {
+		c, err := t.next()
+		if err == io.EOF {
+			return n, nil
+		}
+		if err != nil {
+			return n, err
+		}
+		issued, err := rp.call(c)
+		if err != nil {
+			return n, fmt.Errorf("gl: replay: gl%s: %w", c.name, err)
+		}
+		if issued {
+			n++
+		}
+	}
+}
+
+// objectKind is the kind of the names, or locations, translated by Replay
+type objectKind uint8
+
+const (
+	objBuffer objectKind = iota + 1
+	objTexture
+	objFramebuffer
+	objRenderbuffer
+	objVertexArray
+	objProgram
+	objShader
+	objSampler
+	objPipeline
+	objQuery
+	objTransformFeedback
+	objSync
+	objUniform      // Uniform location, in a program
+	objUniformBlock // Uniform block index, in a program
+	objAttrib       // Vertex attribute location
+)
+
+// objectParam is a parameter naming an object, listed in objectParams
+type objectParam struct {
+	index int
+	kind  objectKind
+}
+
+// resultKinds is the kind of the names and locations returned by functions
+var resultKinds = map[string]objectKind{
+	"CreateProgram":        objProgram,
+	"CreateShader":         objShader,
+	"CreateShaderProgramv": objProgram,
+	"FenceSync":            objSync,
+	"GetUniformLocation":   objUniform,
+	"GetAttribLocation":    objAttrib,
+	"GetUniformBlockIndex": objUniformBlock,
+}
+
+// replayer is the state of a replay
+type replayer struct {
+	ctx     *Context
+	methods map[string]reflect.Value
+	names   map[objectKind]map[uint64]uint64 // The names of the replay, by kind and name in the capture
+	program uint64                           // The current program, named as in the capture
+}
+
+// call issues a call, and returns false if it is skipped
+func (r *replayer) call(c traceCall) (bool, error) {
+	if _, ok := resultKinds[c.name]; !ok && (strings.HasPrefix(c.name, "Get") || strings.HasPrefix(c.name, "Is") ||
+		(strings.HasPrefix(c.name, "Read") && strings.HasSuffix(c.name, "Pixels"))) {
+		return false, nil
+	}
+	m, ok := r.methods[c.name]
+	if !ok {
+		m = reflect.ValueOf(r.ctx).MethodByName(c.name)
+		if !m.IsValid() || m.Type().NumIn() != len(c.args) {
+			return false, errors.New("not in this package")
+		}
+		r.methods[c.name] = m
+	}
+	// The names created by glGen* and glCreate*, as given in the capture
+	var created []uint32
+	if objectsRe.MatchString(c.name) && !strings.HasPrefix(c.name, "Delete") {
+		created = bytesToUint32(c.args[len(c.args)-1].b)
+	}
+	program := r.translate(c)
+	in, free, err := replayArgs(m.Type(), c.args)
+	defer func() {
+		for _, f := range free {
+			f()
+		}
+	}()
+	if in == nil || err != nil {
+		return false, err
+	}
+	out := m.Call(in)
+	if created != nil {
+		kind := r.paramKind(c.name, len(c.args)-1)
+		names := unsafe.Slice((*uint32)(in[len(in)-1].UnsafePointer()), len(created))
+		for i, name := range created {
+			r.add(kind, program, uint64(name), uint64(names[i]))
+		}
+	}
+	if kind, ok := resultKinds[c.name]; ok && c.result != nil && len(out) == 1 {
+		var result uint64
+		if out[0].CanInt() {
+			result = uint64(uint32(out[0].Int()))
+		} else {
+			result = out[0].Uint()
+		}
+		r.add(kind, program, c.result.name(), result)
+	}
+	return true, nil
+}
+
+// translate replaces the names and locations of the capture in the
+// arguments of a call by those of the replay. The names without a
+// translation, such as 0 and the names not created in the trace, are kept.
+// It returns the program of the call, named as in the capture, which is the
+// current program for the Uniform functions.
+func (r *replayer) translate(c traceCall) uint64 {
+	params := objectParams[c.name]
+	program := r.program
+	for _, p := range params {
+		if p.kind == objProgram {
+			program = c.args[p.index].u
+		}
+	}
+	if c.name == "UseProgram" {
+		r.program = program
+	}
+	for _, p := range params {
+		a := &c.args[p.index]
+		switch a.kind {
+		case argUint:
+			a.u = r.lookup(p.kind, program, a.u)
+		case argInt:
+			a.i = int64(int32(r.lookup(p.kind, program, a.name())))
+		case argBytes:
+			// An array of names
+			for i := 0; i+4 <= len(a.b); i += 4 {
+				name := r.lookup(p.kind, program, uint64(binary.NativeEndian.Uint32(a.b[i:])))
+				binary.NativeEndian.PutUint32(a.b[i:], uint32(name))
+			}
+		}
+	}
+	return program
+}
+
+// paramKind returns the kind of object named by parameter i of a function
+func (r *replayer) paramKind(name string, i int) objectKind {
+	for _, p := range objectParams[name] {
+		if p.index == i {
+			return p.kind
+		}
+	}
+	return 0
+}
+
+// key returns the key of a name of the capture. The uniform locations and
+// block indices are those of a program.
+func (r *replayer) key(kind objectKind, program, name uint64) uint64 {
+	if kind == objUniform || kind == objUniformBlock {
+		return program<<32 | name
+	}
+	return name
+}
+
+// add records the name of the replay for a name of the capture
+func (r *replayer) add(kind objectKind, program, name, replayed uint64) {
+	if r.names[kind] == nil {
+		r.names[kind] = map[uint64]uint64{}
+	}
+	r.names[kind][r.key(kind, program, name)] = replayed
+}
+
+func (r *replayer) lookup(kind objectKind, program, name uint64) uint64 {
+	if replayed, ok := r.names[kind][r.key(kind, program, name)]; ok {
+		return replayed
+	}
+	return name
+}
+
+// name returns an argument naming an object, or a location, as an uint64
+func (a traceArg) name() uint64 {
+	if a.kind == argInt {
+		return uint64(uint32(a.i))
+	}
+	return a.u
+}
+
+// bytesToUint32 returns the names of an array recorded as bytes
+func bytesToUint32(b []byte) []uint32 {
+	names := make([]uint32, len(b)/4)
+	for i := range names {
+		names[i] = binary.NativeEndian.Uint32(b[4*i:])
+	}
+	return names
+}
+
+// replayArgs converts the arguments of a call to the parameter types of a
+// method. It returns nil arguments for calls that must be skipped.
+func replayArgs(ft reflect.Type, args []traceArg) (in []reflect.Value, free []func(), err error) {
+	in = make([]reflect.Value, len(args))
+	for i, a := range args {
+		pt := ft.In(i)
+		var p unsafe.Pointer
+		switch a.kind {
+		case argInt:
+			in[i] = reflect.ValueOf(a.i)
+		case argUint:
+			in[i] = reflect.ValueOf(a.u)
+		case argFloat32, argFloat64:
+			in[i] = reflect.ValueOf(a.f)
+		case argBool:
+			in[i] = reflect.ValueOf(a.u != 0)
+		case argFunc:
+			return nil, free, nil
+		case argNil:
+			in[i] = reflect.Zero(pt)
+			continue
+		case argAddr:
+			return nil, free, fmt.Errorf("argument %d points to client memory that was not recorded", i+1)
+		case argOffset:
+			if a.u == 0 {
+				in[i] = reflect.Zero(pt)
+				continue
+			}
+			p = unsafe.Pointer(uintptr(a.u))
+		case argBytes, argCString:
+			// Aligned for the arrays of integers and floats
+			mem := make([]uint64, (len(a.b)+8)/8)
+			copy(unsafe.Slice((*byte)(unsafe.Pointer(&mem[0])), len(a.b)), a.b)
+			p = unsafe.Pointer(&mem[0])
+		case argStrings:
+			if len(a.s) == 0 {
+				in[i] = reflect.Zero(pt)
+				continue
+			}
+			strs, f := Strs(a.s...)
+			free = append(free, f)
+			p = unsafe.Pointer(strs)
+		}
+		if p != nil {
+			switch pt.Kind() {
+			case reflect.UnsafePointer:
+				in[i] = reflect.ValueOf(p)
+			case reflect.Pointer:
+				in[i] = reflect.NewAt(pt.Elem(), p)
+			default:
+				return nil, free, fmt.Errorf("argument %d is a pointer, not %s", i+1, pt)
+			}
+			continue
+		}
+		if !in[i].CanConvert(pt) || in[i].Kind() == reflect.Bool != (pt.Kind() == reflect.Bool) {
+			return nil, free, fmt.Errorf("argument %d is %s, not %s", i+1, in[i].Type(), pt)
+		}
+		in[i] = in[i].Convert(pt)
+	}
+	return in, free, nil
+}
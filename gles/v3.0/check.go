@@ -0,0 +1,123 @@
+//go:build windows || (linux && (amd64 || arm64))
+
+package gles
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+// Error is an error reported by glGetError after a call, in builds with the
+// gldebug tag.
+type Error struct {
+	Code uint32 // The value returned by glGetError
+	Func string // The function called, without the gl prefix
+	Args []any  // The arguments of the call
+}
+
+func (e *Error) Error() string {
+	args := make([]string, len(e.Args))
+	for i, a := range e.Args {
+		args[i] = fmt.Sprint(a)
+	}
+	return fmt.Sprintf("gl: %s(%s): %s", e.Func, strings.Join(args, ", "), errorName(e.Code))
+}
+
+var errorNames = map[uint32]string{
+	0x0500: "GL_INVALID_ENUM",
+	0x0501: "GL_INVALID_VALUE",
+	0x0502: "GL_INVALID_OPERATION",
+	0x0503: "GL_STACK_OVERFLOW",
+	0x0504: "GL_STACK_UNDERFLOW",
+	0x0505: "GL_OUT_OF_MEMORY",
+	0x0506: "GL_INVALID_FRAMEBUFFER_OPERATION",
+	0x0507: "GL_CONTEXT_LOST",
+}
+
+func errorName(code uint32) string {
+	if name, ok := errorNames[code]; ok {
+		return name
+	}
+	return fmt.Sprintf("0x%04X", code)
+}
+
+var (
+	logger       = slog.Default()
+	errorHandler = func(err *Error) { panic(err) }
+)
+
+// SetLogger sets the logger used in builds with the gldebug tag. Every call
+// is logged at the debug level, GL errors at the error level, and the
+// KHR_debug messages of debug contexts at the level of their severity. The
+// default is slog.Default(), which does not log the debug level.
+func SetLogger(l *slog.Logger) {
+	logger = l
+}
+
+// SetErrorHandler sets the function called with the errors reported by
+// glGetError in builds with the gldebug tag, after they are logged. The
+// default handler panics, giving the stack of the failing call. A nil handler
+// only logs the errors.
+func SetErrorHandler(handler func(err *Error)) {
+	errorHandler = handler
+}
+
+// hooked is true when every call is followed by called, in builds with the
+// gldebug or gltrace tags. The hooks are compiled out of other builds. They
+// are added to the methods by gencontext.go, and copied from there by
+// genlinux.go and genversions.go.
+const hooked = checkErrors || capture
+
+// called is called after each call, with the name of the function without
+// the gl prefix, and the arguments
+func (ctx *Context) called(name string, args ...any) {
+	if capture {
+		ctx.record(name, nil, args)
+	}
+	if checkErrors {
+		ctx.check(name, args)
+	}
+}
+
+// returned is called instead of called after the functions returning an
+// integer, such as a name or a location, with their result
+func (ctx *Context) returned(name string, result any, args ...any) {
+	if capture {
+		ctx.record(name, result, args)
+	}
+	if checkErrors {
+		ctx.check(name, args)
+	}
+}
+
+// check traces a call, and reports the errors it caused
+func (ctx *Context) check(name string, args []any) {
+	if logger.Enabled(context.Background(), slog.LevelDebug) {
+		logger.Debug("gl"+name, "args", args)
+	}
+	switch name {
+	case "Begin":
+		ctx.inBegin = true
+		return
+	case "End":
+		ctx.inBegin = false
+	}
+	if ctx.inBegin || ctx.gpGetError == 0 {
+		return
+	}
+	// Several errors can be recorded, glGetError returns one at a time. The
+	// loop is bounded, as some drivers return an error without a context.
+	for range 8 {
+		code := ctx.GetError()
+		if code == NO_ERROR {
+			return
+		}
+		err := &Error{Code: code, Func: name, Args: args}
+		logger.Error(err.Error())
+		if errorHandler != nil {
+			errorHandler(err)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+//go:build windows || (linux && (amd64 || arm64))
+
+package gles
+
+import "unsafe"
+
+// defaultContext is used by the package-level functions
+var defaultContext Context
+
+// NewContext returns a context with the entry points returned by getProcAddr,
+// typically glfw.GetProcAddress with the context of a window current. The
+// entry points are only valid for that context, or for contexts of the same
+// driver, so each window can keep its own Context instead of calling
+// InitWithProcAddrFunc after every switch.
+func NewContext(getProcAddr func(name string) unsafe.Pointer) (*Context, error) {
+	c := &Context{}
+	if err := c.init(getProcAddr); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// DefaultContext returns the context used by the package-level functions.
+func DefaultContext() *Context {
+	return &defaultContext
+}
+
+// InitWithProcAddrFunc intializes the package using the specified OpenGL
+// function pointer loading function. For more cases Init should be used
+// instead.
+func InitWithProcAddrFunc(getProcAddr func(name string) unsafe.Pointer) error {
+	return defaultContext.init(getProcAddr)
+}
+
+// init loads the entry points, and routes the debug output to the logger in
+// builds with the gldebug tag
+func (ctx *Context) init(getProcAddr func(name string) unsafe.Pointer) error {
+	if err := ctx.load(getProcAddr); err != nil {
+		return err
+	}
+	if checkErrors {
+		ctx.logDebugOutput()
+	}
+	return nil
+}
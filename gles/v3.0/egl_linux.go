@@ -0,0 +1,7 @@
+//go:build egl && (amd64 || arm64)
+
+package gles
+
+func init() {
+	useEGL = true
+}
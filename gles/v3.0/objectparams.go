@@ -0,0 +1,788 @@
+// Code generated by gencontext.go. DO NOT EDIT.
+
+//go:build windows || (linux && (amd64 || arm64))
+
+package gles
+
+// objectParams lists the parameters of the functions naming objects, or
+// locations, which Replay translates from the capture to the replay
+var objectParams = map[string][]objectParam{
+	"ActiveProgramEXT":                               {{0, objProgram}},
+	"ActiveShaderProgram":                            {{0, objPipeline}, {1, objProgram}},
+	"ActiveShaderProgramEXT":                         {{0, objPipeline}, {1, objProgram}},
+	"AreTexturesResident":                            {{1, objTexture}},
+	"AttachShader":                                   {{0, objProgram}, {1, objShader}},
+	"BeginConditionalRender":                         {{0, objQuery}},
+	"BeginConditionalRenderNV":                       {{0, objQuery}},
+	"BeginQuery":                                     {{1, objQuery}},
+	"BeginQueryIndexed":                              {{2, objQuery}},
+	"BindAttribLocation":                             {{0, objProgram}},
+	"BindBuffer":                                     {{1, objBuffer}},
+	"BindBufferBase":                                 {{2, objBuffer}},
+	"BindBufferRange":                                {{2, objBuffer}},
+	"BindBuffersBase":                                {{3, objBuffer}},
+	"BindBuffersRange":                               {{3, objBuffer}},
+	"BindFragDataLocation":                           {{0, objProgram}},
+	"BindFragDataLocationIndexed":                    {{0, objProgram}},
+	"BindFramebuffer":                                {{1, objFramebuffer}},
+	"BindImageTexture":                               {{1, objTexture}},
+	"BindImageTextures":                              {{2, objTexture}},
+	"BindMultiTextureEXT":                            {{2, objTexture}},
+	"BindProgramPipeline":                            {{0, objPipeline}},
+	"BindProgramPipelineEXT":                         {{0, objPipeline}},
+	"BindRenderbuffer":                               {{1, objRenderbuffer}},
+	"BindSampler":                                    {{1, objSampler}},
+	"BindSamplers":                                   {{2, objSampler}},
+	"BindShadingRateImageNV":                         {{0, objTexture}},
+	"BindTexture":                                    {{1, objTexture}},
+	"BindTextureUnit":                                {{1, objTexture}},
+	"BindTextures":                                   {{2, objTexture}},
+	"BindTransformFeedback":                          {{1, objTransformFeedback}},
+	"BindVertexArray":                                {{0, objVertexArray}},
+	"BindVertexBuffer":                               {{1, objBuffer}},
+	"BindVertexBuffers":                              {{2, objBuffer}},
+	"BlitNamedFramebuffer":                           {{0, objFramebuffer}, {1, objFramebuffer}},
+	"CheckNamedFramebufferStatus":                    {{0, objFramebuffer}},
+	"CheckNamedFramebufferStatusEXT":                 {{0, objFramebuffer}},
+	"ClearNamedBufferData":                           {{0, objBuffer}},
+	"ClearNamedBufferDataEXT":                        {{0, objBuffer}},
+	"ClearNamedBufferSubData":                        {{0, objBuffer}},
+	"ClearNamedBufferSubDataEXT":                     {{0, objBuffer}},
+	"ClearNamedFramebufferfi":                        {{0, objFramebuffer}},
+	"ClearNamedFramebufferfv":                        {{0, objFramebuffer}},
+	"ClearNamedFramebufferiv":                        {{0, objFramebuffer}},
+	"ClearNamedFramebufferuiv":                       {{0, objFramebuffer}},
+	"ClearTexImage":                                  {{0, objTexture}},
+	"ClearTexSubImage":                               {{0, objTexture}},
+	"ClientWaitSync":                                 {{0, objSync}},
+	"CompileShader":                                  {{0, objShader}},
+	"CompileShaderIncludeARB":                        {{0, objShader}},
+	"CompressedTextureImage1DEXT":                    {{0, objTexture}},
+	"CompressedTextureImage2DEXT":                    {{0, objTexture}},
+	"CompressedTextureImage3DEXT":                    {{0, objTexture}},
+	"CompressedTextureSubImage1D":                    {{0, objTexture}},
+	"CompressedTextureSubImage1DEXT":                 {{0, objTexture}},
+	"CompressedTextureSubImage2D":                    {{0, objTexture}},
+	"CompressedTextureSubImage2DEXT":                 {{0, objTexture}},
+	"CompressedTextureSubImage3D":                    {{0, objTexture}},
+	"CompressedTextureSubImage3DEXT":                 {{0, objTexture}},
+	"CopyNamedBufferSubData":                         {{0, objBuffer}, {1, objBuffer}},
+	"CopyTextureImage1DEXT":                          {{0, objTexture}},
+	"CopyTextureImage2DEXT":                          {{0, objTexture}},
+	"CopyTextureSubImage1D":                          {{0, objTexture}},
+	"CopyTextureSubImage1DEXT":                       {{0, objTexture}},
+	"CopyTextureSubImage2D":                          {{0, objTexture}},
+	"CopyTextureSubImage2DEXT":                       {{0, objTexture}},
+	"CopyTextureSubImage3D":                          {{0, objTexture}},
+	"CopyTextureSubImage3DEXT":                       {{0, objTexture}},
+	"CreateBuffers":                                  {{1, objBuffer}},
+	"CreateFramebuffers":                             {{1, objFramebuffer}},
+	"CreateProgramPipelines":                         {{1, objPipeline}},
+	"CreateQueries":                                  {{2, objQuery}},
+	"CreateRenderbuffers":                            {{1, objRenderbuffer}},
+	"CreateSamplers":                                 {{1, objSampler}},
+	"CreateTextures":                                 {{2, objTexture}},
+	"CreateTransformFeedbacks":                       {{1, objTransformFeedback}},
+	"CreateVertexArrays":                             {{1, objVertexArray}},
+	"DeleteBuffers":                                  {{1, objBuffer}},
+	"DeleteFramebuffers":                             {{1, objFramebuffer}},
+	"DeleteProgram":                                  {{0, objProgram}},
+	"DeleteProgramPipelines":                         {{1, objPipeline}},
+	"DeleteProgramPipelinesEXT":                      {{1, objPipeline}},
+	"DeleteQueries":                                  {{1, objQuery}},
+	"DeleteRenderbuffers":                            {{1, objRenderbuffer}},
+	"DeleteSamplers":                                 {{1, objSampler}},
+	"DeleteShader":                                   {{0, objShader}},
+	"DeleteSync":                                     {{0, objSync}},
+	"DeleteTextures":                                 {{1, objTexture}},
+	"DeleteTransformFeedbacks":                       {{1, objTransformFeedback}},
+	"DeleteVertexArrays":                             {{1, objVertexArray}},
+	"DetachShader":                                   {{0, objProgram}, {1, objShader}},
+	"DisableVertexArrayAttrib":                       {{0, objVertexArray}, {1, objAttrib}},
+	"DisableVertexArrayAttribEXT":                    {{0, objVertexArray}, {1, objAttrib}},
+	"DisableVertexArrayEXT":                          {{0, objVertexArray}},
+	"DisableVertexAttribArray":                       {{0, objAttrib}},
+	"DrawCommandsNV":                                 {{1, objBuffer}},
+	"DrawCommandsStatesNV":                           {{0, objBuffer}},
+	"DrawTransformFeedback":                          {{1, objTransformFeedback}},
+	"DrawTransformFeedbackInstanced":                 {{1, objTransformFeedback}},
+	"DrawTransformFeedbackStream":                    {{1, objTransformFeedback}},
+	"DrawTransformFeedbackStreamInstanced":           {{1, objTransformFeedback}},
+	"DrawVkImageNV":                                  {{1, objSampler}},
+	"EGLImageTargetTextureStorageEXT":                {{0, objTexture}},
+	"EnableVertexArrayAttrib":                        {{0, objVertexArray}, {1, objAttrib}},
+	"EnableVertexArrayAttribEXT":                     {{0, objVertexArray}, {1, objAttrib}},
+	"EnableVertexArrayEXT":                           {{0, objVertexArray}},
+	"EnableVertexAttribArray":                        {{0, objAttrib}},
+	"FlushMappedNamedBufferRange":                    {{0, objBuffer}},
+	"FlushMappedNamedBufferRangeEXT":                 {{0, objBuffer}},
+	"FramebufferDrawBufferEXT":                       {{0, objFramebuffer}},
+	"FramebufferDrawBuffersEXT":                      {{0, objFramebuffer}},
+	"FramebufferReadBufferEXT":                       {{0, objFramebuffer}},
+	"FramebufferRenderbuffer":                        {{3, objRenderbuffer}},
+	"FramebufferTexture":                             {{2, objTexture}},
+	"FramebufferTexture1D":                           {{3, objTexture}},
+	"FramebufferTexture2D":                           {{3, objTexture}},
+	"FramebufferTexture3D":                           {{3, objTexture}},
+	"FramebufferTextureARB":                          {{2, objTexture}},
+	"FramebufferTextureFaceARB":                      {{2, objTexture}},
+	"FramebufferTextureLayer":                        {{2, objTexture}},
+	"FramebufferTextureLayerARB":                     {{2, objTexture}},
+	"FramebufferTextureMultiviewOVR":                 {{2, objTexture}},
+	"GenBuffers":                                     {{1, objBuffer}},
+	"GenFramebuffers":                                {{1, objFramebuffer}},
+	"GenProgramPipelines":                            {{1, objPipeline}},
+	"GenProgramPipelinesEXT":                         {{1, objPipeline}},
+	"GenQueries":                                     {{1, objQuery}},
+	"GenRenderbuffers":                               {{1, objRenderbuffer}},
+	"GenSamplers":                                    {{1, objSampler}},
+	"GenTextures":                                    {{1, objTexture}},
+	"GenTransformFeedbacks":                          {{1, objTransformFeedback}},
+	"GenVertexArrays":                                {{1, objVertexArray}},
+	"GenerateTextureMipmap":                          {{0, objTexture}},
+	"GenerateTextureMipmapEXT":                       {{0, objTexture}},
+	"GetActiveAtomicCounterBufferiv":                 {{0, objProgram}},
+	"GetActiveAttrib":                                {{0, objProgram}},
+	"GetActiveSubroutineName":                        {{0, objProgram}},
+	"GetActiveSubroutineUniformName":                 {{0, objProgram}},
+	"GetActiveSubroutineUniformiv":                   {{0, objProgram}},
+	"GetActiveUniform":                               {{0, objProgram}},
+	"GetActiveUniformBlockName":                      {{0, objProgram}, {1, objUniformBlock}},
+	"GetActiveUniformBlockiv":                        {{0, objProgram}, {1, objUniformBlock}},
+	"GetActiveUniformName":                           {{0, objProgram}},
+	"GetActiveUniformsiv":                            {{0, objProgram}},
+	"GetAttachedShaders":                             {{0, objProgram}, {3, objShader}},
+	"GetAttribLocation":                              {{0, objProgram}},
+	"GetCompressedTextureImage":                      {{0, objTexture}},
+	"GetCompressedTextureImageEXT":                   {{0, objTexture}},
+	"GetCompressedTextureSubImage":                   {{0, objTexture}},
+	"GetFragDataIndex":                               {{0, objProgram}},
+	"GetFragDataLocation":                            {{0, objProgram}},
+	"GetFramebufferParameterivEXT":                   {{0, objFramebuffer}},
+	"GetImageHandleARB":                              {{0, objTexture}},
+	"GetImageHandleNV":                               {{0, objTexture}},
+	"GetNamedBufferParameteri64v":                    {{0, objBuffer}},
+	"GetNamedBufferParameteriv":                      {{0, objBuffer}},
+	"GetNamedBufferParameterivEXT":                   {{0, objBuffer}},
+	"GetNamedBufferParameterui64vNV":                 {{0, objBuffer}},
+	"GetNamedBufferPointerv":                         {{0, objBuffer}},
+	"GetNamedBufferPointervEXT":                      {{0, objBuffer}},
+	"GetNamedBufferSubData":                          {{0, objBuffer}},
+	"GetNamedBufferSubDataEXT":                       {{0, objBuffer}},
+	"GetNamedFramebufferAttachmentParameteriv":       {{0, objFramebuffer}},
+	"GetNamedFramebufferAttachmentParameterivEXT":    {{0, objFramebuffer}},
+	"GetNamedFramebufferParameteriv":                 {{0, objFramebuffer}},
+	"GetNamedFramebufferParameterivEXT":              {{0, objFramebuffer}},
+	"GetNamedProgramLocalParameterIivEXT":            {{0, objProgram}},
+	"GetNamedProgramLocalParameterIuivEXT":           {{0, objProgram}},
+	"GetNamedProgramLocalParameterdvEXT":             {{0, objProgram}},
+	"GetNamedProgramLocalParameterfvEXT":             {{0, objProgram}},
+	"GetNamedProgramStringEXT":                       {{0, objProgram}},
+	"GetNamedProgramivEXT":                           {{0, objProgram}},
+	"GetNamedRenderbufferParameteriv":                {{0, objRenderbuffer}},
+	"GetNamedRenderbufferParameterivEXT":             {{0, objRenderbuffer}},
+	"GetProgramBinary":                               {{0, objProgram}},
+	"GetProgramInfoLog":                              {{0, objProgram}},
+	"GetProgramInterfaceiv":                          {{0, objProgram}},
+	"GetProgramPipelineInfoLog":                      {{0, objPipeline}},
+	"GetProgramPipelineInfoLogEXT":                   {{0, objPipeline}},
+	"GetProgramPipelineiv":                           {{0, objPipeline}},
+	"GetProgramPipelineivEXT":                        {{0, objPipeline}},
+	"GetProgramResourceIndex":                        {{0, objProgram}},
+	"GetProgramResourceLocation":                     {{0, objProgram}},
+	"GetProgramResourceLocationIndex":                {{0, objProgram}},
+	"GetProgramResourceName":                         {{0, objProgram}},
+	"GetProgramResourcefvNV":                         {{0, objProgram}},
+	"GetProgramResourceiv":                           {{0, objProgram}},
+	"GetProgramStageiv":                              {{0, objProgram}},
+	"GetProgramiv":                                   {{0, objProgram}},
+	"GetQueryBufferObjecti64v":                       {{0, objQuery}, {1, objBuffer}},
+	"GetQueryBufferObjectiv":                         {{0, objQuery}, {1, objBuffer}},
+	"GetQueryBufferObjectui64v":                      {{0, objQuery}, {1, objBuffer}},
+	"GetQueryBufferObjectuiv":                        {{0, objQuery}, {1, objBuffer}},
+	"GetQueryObjecti64v":                             {{0, objQuery}},
+	"GetQueryObjectiv":                               {{0, objQuery}},
+	"GetQueryObjectui64v":                            {{0, objQuery}},
+	"GetQueryObjectuiv":                              {{0, objQuery}},
+	"GetSamplerParameterIiv":                         {{0, objSampler}},
+	"GetSamplerParameterIuiv":                        {{0, objSampler}},
+	"GetSamplerParameterfv":                          {{0, objSampler}},
+	"GetSamplerParameteriv":                          {{0, objSampler}},
+	"GetShaderInfoLog":                               {{0, objShader}},
+	"GetShaderSource":                                {{0, objShader}},
+	"GetShaderiv":                                    {{0, objShader}},
+	"GetSubroutineIndex":                             {{0, objProgram}},
+	"GetSubroutineUniformLocation":                   {{0, objProgram}},
+	"GetSynciv":                                      {{0, objSync}},
+	"GetTextureHandleARB":                            {{0, objTexture}},
+	"GetTextureHandleNV":                             {{0, objTexture}},
+	"GetTextureImage":                                {{0, objTexture}},
+	"GetTextureImageEXT":                             {{0, objTexture}},
+	"GetTextureLevelParameterfv":                     {{0, objTexture}},
+	"GetTextureLevelParameterfvEXT":                  {{0, objTexture}},
+	"GetTextureLevelParameteriv":                     {{0, objTexture}},
+	"GetTextureLevelParameterivEXT":                  {{0, objTexture}},
+	"GetTextureParameterIiv":                         {{0, objTexture}},
+	"GetTextureParameterIivEXT":                      {{0, objTexture}},
+	"GetTextureParameterIuiv":                        {{0, objTexture}},
+	"GetTextureParameterIuivEXT":                     {{0, objTexture}},
+	"GetTextureParameterfv":                          {{0, objTexture}},
+	"GetTextureParameterfvEXT":                       {{0, objTexture}},
+	"GetTextureParameteriv":                          {{0, objTexture}},
+	"GetTextureParameterivEXT":                       {{0, objTexture}},
+	"GetTextureSamplerHandleARB":                     {{0, objTexture}, {1, objSampler}},
+	"GetTextureSamplerHandleNV":                      {{0, objTexture}, {1, objSampler}},
+	"GetTextureSubImage":                             {{0, objTexture}},
+	"GetTransformFeedbackVarying":                    {{0, objProgram}},
+	"GetTransformFeedbacki64_v":                      {{0, objTransformFeedback}},
+	"GetTransformFeedbacki_v":                        {{0, objTransformFeedback}},
+	"GetTransformFeedbackiv":                         {{0, objTransformFeedback}},
+	"GetUniformBlockIndex":                           {{0, objProgram}},
+	"GetUniformIndices":                              {{0, objProgram}},
+	"GetUniformLocation":                             {{0, objProgram}},
+	"GetUniformSubroutineuiv":                        {{1, objUniform}},
+	"GetUniformdv":                                   {{0, objProgram}, {1, objUniform}},
+	"GetUniformfv":                                   {{0, objProgram}, {1, objUniform}},
+	"GetUniformi64vARB":                              {{0, objProgram}, {1, objUniform}},
+	"GetUniformi64vNV":                               {{0, objProgram}, {1, objUniform}},
+	"GetUniformiv":                                   {{0, objProgram}, {1, objUniform}},
+	"GetUniformui64vARB":                             {{0, objProgram}, {1, objUniform}},
+	"GetUniformui64vNV":                              {{0, objProgram}, {1, objUniform}},
+	"GetUniformuiv":                                  {{0, objProgram}, {1, objUniform}},
+	"GetVertexArrayIndexed64iv":                      {{0, objVertexArray}},
+	"GetVertexArrayIndexediv":                        {{0, objVertexArray}},
+	"GetVertexArrayIntegeri_vEXT":                    {{0, objVertexArray}},
+	"GetVertexArrayIntegervEXT":                      {{0, objVertexArray}},
+	"GetVertexArrayPointeri_vEXT":                    {{0, objVertexArray}},
+	"GetVertexArrayPointervEXT":                      {{0, objVertexArray}},
+	"GetVertexArrayiv":                               {{0, objVertexArray}},
+	"GetVertexAttribIiv":                             {{0, objAttrib}},
+	"GetVertexAttribIuiv":                            {{0, objAttrib}},
+	"GetVertexAttribLdv":                             {{0, objAttrib}},
+	"GetVertexAttribLi64vNV":                         {{0, objAttrib}},
+	"GetVertexAttribLui64vARB":                       {{0, objAttrib}},
+	"GetVertexAttribLui64vNV":                        {{0, objAttrib}},
+	"GetVertexAttribPointerv":                        {{0, objAttrib}},
+	"GetVertexAttribPointerWithOffsetv":              {{0, objAttrib}},
+	"GetVertexAttribdv":                              {{0, objAttrib}},
+	"GetVertexAttribfv":                              {{0, objAttrib}},
+	"GetVertexAttribiv":                              {{0, objAttrib}},
+	"GetnUniformdv":                                  {{0, objProgram}, {1, objUniform}},
+	"GetnUniformdvARB":                               {{0, objProgram}, {1, objUniform}},
+	"GetnUniformfv":                                  {{0, objProgram}, {1, objUniform}},
+	"GetnUniformfvARB":                               {{0, objProgram}, {1, objUniform}},
+	"GetnUniformfvKHR":                               {{0, objProgram}, {1, objUniform}},
+	"GetnUniformi64vARB":                             {{0, objProgram}, {1, objUniform}},
+	"GetnUniformiv":                                  {{0, objProgram}, {1, objUniform}},
+	"GetnUniformivARB":                               {{0, objProgram}, {1, objUniform}},
+	"GetnUniformivKHR":                               {{0, objProgram}, {1, objUniform}},
+	"GetnUniformui64vARB":                            {{0, objProgram}, {1, objUniform}},
+	"GetnUniformuiv":                                 {{0, objProgram}, {1, objUniform}},
+	"GetnUniformuivARB":                              {{0, objProgram}, {1, objUniform}},
+	"GetnUniformuivKHR":                              {{0, objProgram}, {1, objUniform}},
+	"InvalidateBufferData":                           {{0, objBuffer}},
+	"InvalidateBufferSubData":                        {{0, objBuffer}},
+	"InvalidateNamedFramebufferData":                 {{0, objFramebuffer}},
+	"InvalidateNamedFramebufferSubData":              {{0, objFramebuffer}},
+	"InvalidateTexImage":                             {{0, objTexture}},
+	"InvalidateTexSubImage":                          {{0, objTexture}},
+	"IsBuffer":                                       {{0, objBuffer}},
+	"IsFramebuffer":                                  {{0, objFramebuffer}},
+	"IsNamedBufferResidentNV":                        {{0, objBuffer}},
+	"IsProgram":                                      {{0, objProgram}},
+	"IsProgramPipeline":                              {{0, objPipeline}},
+	"IsProgramPipelineEXT":                           {{0, objPipeline}},
+	"IsQuery":                                        {{0, objQuery}},
+	"IsRenderbuffer":                                 {{0, objRenderbuffer}},
+	"IsSampler":                                      {{0, objSampler}},
+	"IsShader":                                       {{0, objShader}},
+	"IsSync":                                         {{0, objSync}},
+	"IsTexture":                                      {{0, objTexture}},
+	"IsTransformFeedback":                            {{0, objTransformFeedback}},
+	"IsVertexArray":                                  {{0, objVertexArray}},
+	"LinkProgram":                                    {{0, objProgram}},
+	"MakeNamedBufferNonResidentNV":                   {{0, objBuffer}},
+	"MakeNamedBufferResidentNV":                      {{0, objBuffer}},
+	"MapNamedBuffer":                                 {{0, objBuffer}},
+	"MapNamedBufferEXT":                              {{0, objBuffer}},
+	"MapNamedBufferRange":                            {{0, objBuffer}},
+	"MapNamedBufferRangeEXT":                         {{0, objBuffer}},
+	"MultiTexBufferEXT":                              {{3, objBuffer}},
+	"MultiTexRenderbufferEXT":                        {{2, objRenderbuffer}},
+	"NamedBufferAttachMemoryNV":                      {{0, objBuffer}},
+	"NamedBufferData":                                {{0, objBuffer}},
+	"NamedBufferDataEXT":                             {{0, objBuffer}},
+	"NamedBufferPageCommitmentARB":                   {{0, objBuffer}},
+	"NamedBufferPageCommitmentEXT":                   {{0, objBuffer}},
+	"NamedBufferStorage":                             {{0, objBuffer}},
+	"NamedBufferStorageEXT":                          {{0, objBuffer}},
+	"NamedBufferSubData":                             {{0, objBuffer}},
+	"NamedBufferSubDataEXT":                          {{0, objBuffer}},
+	"NamedCopyBufferSubDataEXT":                      {{0, objBuffer}, {1, objBuffer}},
+	"NamedFramebufferDrawBuffer":                     {{0, objFramebuffer}},
+	"NamedFramebufferDrawBuffers":                    {{0, objFramebuffer}},
+	"NamedFramebufferParameteri":                     {{0, objFramebuffer}},
+	"NamedFramebufferParameteriEXT":                  {{0, objFramebuffer}},
+	"NamedFramebufferReadBuffer":                     {{0, objFramebuffer}},
+	"NamedFramebufferRenderbuffer":                   {{0, objFramebuffer}, {3, objRenderbuffer}},
+	"NamedFramebufferRenderbufferEXT":                {{0, objFramebuffer}, {3, objRenderbuffer}},
+	"NamedFramebufferSampleLocationsfvARB":           {{0, objFramebuffer}},
+	"NamedFramebufferSampleLocationsfvNV":            {{0, objFramebuffer}},
+	"NamedFramebufferTexture":                        {{0, objFramebuffer}, {2, objTexture}},
+	"NamedFramebufferTexture1DEXT":                   {{0, objFramebuffer}, {3, objTexture}},
+	"NamedFramebufferTexture2DEXT":                   {{0, objFramebuffer}, {3, objTexture}},
+	"NamedFramebufferTexture3DEXT":                   {{0, objFramebuffer}, {3, objTexture}},
+	"NamedFramebufferTextureEXT":                     {{0, objFramebuffer}, {2, objTexture}},
+	"NamedFramebufferTextureFaceEXT":                 {{0, objFramebuffer}, {2, objTexture}},
+	"NamedFramebufferTextureLayer":                   {{0, objFramebuffer}, {2, objTexture}},
+	"NamedFramebufferTextureLayerEXT":                {{0, objFramebuffer}, {2, objTexture}},
+	"NamedProgramLocalParameter4dEXT":                {{0, objProgram}},
+	"NamedProgramLocalParameter4dvEXT":               {{0, objProgram}},
+	"NamedProgramLocalParameter4fEXT":                {{0, objProgram}},
+	"NamedProgramLocalParameter4fvEXT":               {{0, objProgram}},
+	"NamedProgramLocalParameterI4iEXT":               {{0, objProgram}},
+	"NamedProgramLocalParameterI4ivEXT":              {{0, objProgram}},
+	"NamedProgramLocalParameterI4uiEXT":              {{0, objProgram}},
+	"NamedProgramLocalParameterI4uivEXT":             {{0, objProgram}},
+	"NamedProgramLocalParameters4fvEXT":              {{0, objProgram}},
+	"NamedProgramLocalParametersI4ivEXT":             {{0, objProgram}},
+	"NamedProgramLocalParametersI4uivEXT":            {{0, objProgram}},
+	"NamedProgramStringEXT":                          {{0, objProgram}},
+	"NamedRenderbufferStorage":                       {{0, objRenderbuffer}},
+	"NamedRenderbufferStorageEXT":                    {{0, objRenderbuffer}},
+	"NamedRenderbufferStorageMultisample":            {{0, objRenderbuffer}},
+	"NamedRenderbufferStorageMultisampleAdvancedAMD": {{0, objRenderbuffer}},
+	"NamedRenderbufferStorageMultisampleCoverageEXT": {{0, objRenderbuffer}},
+	"NamedRenderbufferStorageMultisampleEXT":         {{0, objRenderbuffer}},
+	"PrioritizeTextures":                             {{1, objTexture}},
+	"ProgramBinary":                                  {{0, objProgram}},
+	"ProgramParameteri":                              {{0, objProgram}},
+	"ProgramParameteriARB":                           {{0, objProgram}},
+	"ProgramParameteriEXT":                           {{0, objProgram}},
+	"ProgramPathFragmentInputGenNV":                  {{0, objProgram}},
+	"ProgramUniform1d":                               {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform1dEXT":                            {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform1dv":                              {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform1dvEXT":                           {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform1f":                               {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform1fEXT":                            {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform1fv":                              {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform1fvEXT":                           {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform1i":                               {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform1i64ARB":                          {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform1i64NV":                           {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform1i64vARB":                         {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform1i64vNV":                          {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform1iEXT":                            {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform1iv":                              {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform1ivEXT":                           {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform1ui":                              {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform1ui64ARB":                         {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform1ui64NV":                          {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform1ui64vARB":                        {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform1ui64vNV":                         {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform1uiEXT":                           {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform1uiv":                             {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform1uivEXT":                          {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform2d":                               {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform2dEXT":                            {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform2dv":                              {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform2dvEXT":                           {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform2f":                               {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform2fEXT":                            {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform2fv":                              {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform2fvEXT":                           {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform2i":                               {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform2i64ARB":                          {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform2i64NV":                           {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform2i64vARB":                         {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform2i64vNV":                          {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform2iEXT":                            {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform2iv":                              {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform2ivEXT":                           {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform2ui":                              {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform2ui64ARB":                         {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform2ui64NV":                          {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform2ui64vARB":                        {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform2ui64vNV":                         {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform2uiEXT":                           {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform2uiv":                             {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform2uivEXT":                          {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform3d":                               {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform3dEXT":                            {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform3dv":                              {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform3dvEXT":                           {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform3f":                               {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform3fEXT":                            {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform3fv":                              {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform3fvEXT":                           {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform3i":                               {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform3i64ARB":                          {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform3i64NV":                           {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform3i64vARB":                         {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform3i64vNV":                          {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform3iEXT":                            {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform3iv":                              {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform3ivEXT":                           {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform3ui":                              {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform3ui64ARB":                         {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform3ui64NV":                          {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform3ui64vARB":                        {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform3ui64vNV":                         {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform3uiEXT":                           {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform3uiv":                             {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform3uivEXT":                          {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform4d":                               {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform4dEXT":                            {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform4dv":                              {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform4dvEXT":                           {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform4f":                               {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform4fEXT":                            {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform4fv":                              {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform4fvEXT":                           {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform4i":                               {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform4i64ARB":                          {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform4i64NV":                           {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform4i64vARB":                         {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform4i64vNV":                          {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform4iEXT":                            {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform4iv":                              {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform4ivEXT":                           {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform4ui":                              {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform4ui64ARB":                         {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform4ui64NV":                          {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform4ui64vARB":                        {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform4ui64vNV":                         {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform4uiEXT":                           {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform4uiv":                             {{0, objProgram}, {1, objUniform}},
+	"ProgramUniform4uivEXT":                          {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformHandleui64ARB":                    {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformHandleui64NV":                     {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformHandleui64vARB":                   {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformHandleui64vNV":                    {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix2dv":                        {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix2dvEXT":                     {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix2fv":                        {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix2fvEXT":                     {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix2x3dv":                      {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix2x3dvEXT":                   {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix2x3fv":                      {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix2x3fvEXT":                   {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix2x4dv":                      {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix2x4dvEXT":                   {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix2x4fv":                      {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix2x4fvEXT":                   {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix3dv":                        {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix3dvEXT":                     {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix3fv":                        {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix3fvEXT":                     {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix3x2dv":                      {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix3x2dvEXT":                   {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix3x2fv":                      {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix3x2fvEXT":                   {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix3x4dv":                      {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix3x4dvEXT":                   {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix3x4fv":                      {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix3x4fvEXT":                   {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix4dv":                        {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix4dvEXT":                     {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix4fv":                        {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix4fvEXT":                     {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix4x2dv":                      {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix4x2dvEXT":                   {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix4x2fv":                      {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix4x2fvEXT":                   {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix4x3dv":                      {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix4x3dvEXT":                   {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix4x3fv":                      {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformMatrix4x3fvEXT":                   {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformui64NV":                           {{0, objProgram}, {1, objUniform}},
+	"ProgramUniformui64vNV":                          {{0, objProgram}, {1, objUniform}},
+	"QueryCounter":                                   {{0, objQuery}},
+	"SamplerParameterIiv":                            {{0, objSampler}},
+	"SamplerParameterIuiv":                           {{0, objSampler}},
+	"SamplerParameterf":                              {{0, objSampler}},
+	"SamplerParameterfv":                             {{0, objSampler}},
+	"SamplerParameteri":                              {{0, objSampler}},
+	"SamplerParameteriv":                             {{0, objSampler}},
+	"SelectBuffer":                                   {{1, objBuffer}},
+	"ShaderBinary":                                   {{1, objShader}},
+	"ShaderSource":                                   {{0, objShader}},
+	"ShaderStorageBlockBinding":                      {{0, objProgram}},
+	"SpecializeShader":                               {{0, objShader}},
+	"SpecializeShaderARB":                            {{0, objShader}},
+	"TexBuffer":                                      {{2, objBuffer}},
+	"TexBufferARB":                                   {{2, objBuffer}},
+	"TexBufferRange":                                 {{2, objBuffer}},
+	"TextureAttachMemoryNV":                          {{0, objTexture}},
+	"TextureBuffer":                                  {{0, objTexture}, {2, objBuffer}},
+	"TextureBufferEXT":                               {{0, objTexture}, {3, objBuffer}},
+	"TextureBufferRange":                             {{0, objTexture}, {2, objBuffer}},
+	"TextureBufferRangeEXT":                          {{0, objTexture}, {3, objBuffer}},
+	"TextureImage1DEXT":                              {{0, objTexture}},
+	"TextureImage2DEXT":                              {{0, objTexture}},
+	"TextureImage3DEXT":                              {{0, objTexture}},
+	"TexturePageCommitmentEXT":                       {{0, objTexture}},
+	"TextureParameterIiv":                            {{0, objTexture}},
+	"TextureParameterIivEXT":                         {{0, objTexture}},
+	"TextureParameterIuiv":                           {{0, objTexture}},
+	"TextureParameterIuivEXT":                        {{0, objTexture}},
+	"TextureParameterf":                              {{0, objTexture}},
+	"TextureParameterfEXT":                           {{0, objTexture}},
+	"TextureParameterfv":                             {{0, objTexture}},
+	"TextureParameterfvEXT":                          {{0, objTexture}},
+	"TextureParameteri":                              {{0, objTexture}},
+	"TextureParameteriEXT":                           {{0, objTexture}},
+	"TextureParameteriv":                             {{0, objTexture}},
+	"TextureParameterivEXT":                          {{0, objTexture}},
+	"TextureRenderbufferEXT":                         {{0, objTexture}, {2, objRenderbuffer}},
+	"TextureStorage1D":                               {{0, objTexture}},
+	"TextureStorage1DEXT":                            {{0, objTexture}},
+	"TextureStorage2D":                               {{0, objTexture}},
+	"TextureStorage2DEXT":                            {{0, objTexture}},
+	"TextureStorage2DMultisample":                    {{0, objTexture}},
+	"TextureStorage2DMultisampleEXT":                 {{0, objTexture}},
+	"TextureStorage3D":                               {{0, objTexture}},
+	"TextureStorage3DEXT":                            {{0, objTexture}},
+	"TextureStorage3DMultisample":                    {{0, objTexture}},
+	"TextureStorage3DMultisampleEXT":                 {{0, objTexture}},
+	"TextureSubImage1D":                              {{0, objTexture}},
+	"TextureSubImage1DEXT":                           {{0, objTexture}},
+	"TextureSubImage2D":                              {{0, objTexture}},
+	"TextureSubImage2DEXT":                           {{0, objTexture}},
+	"TextureSubImage3D":                              {{0, objTexture}},
+	"TextureSubImage3DEXT":                           {{0, objTexture}},
+	"TextureView":                                    {{0, objTexture}, {2, objTexture}},
+	"TransformFeedbackBufferBase":                    {{0, objTransformFeedback}, {2, objBuffer}},
+	"TransformFeedbackBufferRange":                   {{0, objTransformFeedback}, {2, objBuffer}},
+	"TransformFeedbackVaryings":                      {{0, objProgram}},
+	"Uniform1d":                                      {{0, objUniform}},
+	"Uniform1dv":                                     {{0, objUniform}},
+	"Uniform1f":                                      {{0, objUniform}},
+	"Uniform1fv":                                     {{0, objUniform}},
+	"Uniform1i":                                      {{0, objUniform}},
+	"Uniform1i64ARB":                                 {{0, objUniform}},
+	"Uniform1i64NV":                                  {{0, objUniform}},
+	"Uniform1i64vARB":                                {{0, objUniform}},
+	"Uniform1i64vNV":                                 {{0, objUniform}},
+	"Uniform1iv":                                     {{0, objUniform}},
+	"Uniform1ui":                                     {{0, objUniform}},
+	"Uniform1ui64ARB":                                {{0, objUniform}},
+	"Uniform1ui64NV":                                 {{0, objUniform}},
+	"Uniform1ui64vARB":                               {{0, objUniform}},
+	"Uniform1ui64vNV":                                {{0, objUniform}},
+	"Uniform1uiv":                                    {{0, objUniform}},
+	"Uniform2d":                                      {{0, objUniform}},
+	"Uniform2dv":                                     {{0, objUniform}},
+	"Uniform2f":                                      {{0, objUniform}},
+	"Uniform2fv":                                     {{0, objUniform}},
+	"Uniform2i":                                      {{0, objUniform}},
+	"Uniform2i64ARB":                                 {{0, objUniform}},
+	"Uniform2i64NV":                                  {{0, objUniform}},
+	"Uniform2i64vARB":                                {{0, objUniform}},
+	"Uniform2i64vNV":                                 {{0, objUniform}},
+	"Uniform2iv":                                     {{0, objUniform}},
+	"Uniform2ui":                                     {{0, objUniform}},
+	"Uniform2ui64ARB":                                {{0, objUniform}},
+	"Uniform2ui64NV":                                 {{0, objUniform}},
+	"Uniform2ui64vARB":                               {{0, objUniform}},
+	"Uniform2ui64vNV":                                {{0, objUniform}},
+	"Uniform2uiv":                                    {{0, objUniform}},
+	"Uniform3d":                                      {{0, objUniform}},
+	"Uniform3dv":                                     {{0, objUniform}},
+	"Uniform3f":                                      {{0, objUniform}},
+	"Uniform3fv":                                     {{0, objUniform}},
+	"Uniform3i":                                      {{0, objUniform}},
+	"Uniform3i64ARB":                                 {{0, objUniform}},
+	"Uniform3i64NV":                                  {{0, objUniform}},
+	"Uniform3i64vARB":                                {{0, objUniform}},
+	"Uniform3i64vNV":                                 {{0, objUniform}},
+	"Uniform3iv":                                     {{0, objUniform}},
+	"Uniform3ui":                                     {{0, objUniform}},
+	"Uniform3ui64ARB":                                {{0, objUniform}},
+	"Uniform3ui64NV":                                 {{0, objUniform}},
+	"Uniform3ui64vARB":                               {{0, objUniform}},
+	"Uniform3ui64vNV":                                {{0, objUniform}},
+	"Uniform3uiv":                                    {{0, objUniform}},
+	"Uniform4d":                                      {{0, objUniform}},
+	"Uniform4dv":                                     {{0, objUniform}},
+	"Uniform4f":                                      {{0, objUniform}},
+	"Uniform4fv":                                     {{0, objUniform}},
+	"Uniform4i":                                      {{0, objUniform}},
+	"Uniform4i64ARB":                                 {{0, objUniform}},
+	"Uniform4i64NV":                                  {{0, objUniform}},
+	"Uniform4i64vARB":                                {{0, objUniform}},
+	"Uniform4i64vNV":                                 {{0, objUniform}},
+	"Uniform4iv":                                     {{0, objUniform}},
+	"Uniform4ui":                                     {{0, objUniform}},
+	"Uniform4ui64ARB":                                {{0, objUniform}},
+	"Uniform4ui64NV":                                 {{0, objUniform}},
+	"Uniform4ui64vARB":                               {{0, objUniform}},
+	"Uniform4ui64vNV":                                {{0, objUniform}},
+	"Uniform4uiv":                                    {{0, objUniform}},
+	"UniformBlockBinding":                            {{0, objProgram}, {1, objUniformBlock}},
+	"UniformHandleui64ARB":                           {{0, objUniform}},
+	"UniformHandleui64NV":                            {{0, objUniform}},
+	"UniformHandleui64vARB":                          {{0, objUniform}},
+	"UniformHandleui64vNV":                           {{0, objUniform}},
+	"UniformMatrix2dv":                               {{0, objUniform}},
+	"UniformMatrix2fv":                               {{0, objUniform}},
+	"UniformMatrix2x3dv":                             {{0, objUniform}},
+	"UniformMatrix2x3fv":                             {{0, objUniform}},
+	"UniformMatrix2x4dv":                             {{0, objUniform}},
+	"UniformMatrix2x4fv":                             {{0, objUniform}},
+	"UniformMatrix3dv":                               {{0, objUniform}},
+	"UniformMatrix3fv":                               {{0, objUniform}},
+	"UniformMatrix3x2dv":                             {{0, objUniform}},
+	"UniformMatrix3x2fv":                             {{0, objUniform}},
+	"UniformMatrix3x4dv":                             {{0, objUniform}},
+	"UniformMatrix3x4fv":                             {{0, objUniform}},
+	"UniformMatrix4dv":                               {{0, objUniform}},
+	"UniformMatrix4fv":                               {{0, objUniform}},
+	"UniformMatrix4x2dv":                             {{0, objUniform}},
+	"UniformMatrix4x2fv":                             {{0, objUniform}},
+	"UniformMatrix4x3dv":                             {{0, objUniform}},
+	"UniformMatrix4x3fv":                             {{0, objUniform}},
+	"Uniformui64NV":                                  {{0, objUniform}},
+	"Uniformui64vNV":                                 {{0, objUniform}},
+	"UnmapNamedBuffer":                               {{0, objBuffer}},
+	"UnmapNamedBufferEXT":                            {{0, objBuffer}},
+	"UseProgram":                                     {{0, objProgram}},
+	"UseProgramStages":                               {{0, objPipeline}, {2, objProgram}},
+	"UseProgramStagesEXT":                            {{0, objPipeline}, {2, objProgram}},
+	"UseShaderProgramEXT":                            {{1, objProgram}},
+	"ValidateProgram":                                {{0, objProgram}},
+	"ValidateProgramPipeline":                        {{0, objPipeline}},
+	"ValidateProgramPipelineEXT":                     {{0, objPipeline}},
+	"VertexArrayAttribBinding":                       {{0, objVertexArray}, {1, objAttrib}},
+	"VertexArrayAttribFormat":                        {{0, objVertexArray}, {1, objAttrib}},
+	"VertexArrayAttribIFormat":                       {{0, objVertexArray}, {1, objAttrib}},
+	"VertexArrayAttribLFormat":                       {{0, objVertexArray}, {1, objAttrib}},
+	"VertexArrayBindVertexBufferEXT":                 {{0, objVertexArray}, {2, objBuffer}},
+	"VertexArrayBindingDivisor":                      {{0, objVertexArray}},
+	"VertexArrayColorOffsetEXT":                      {{0, objVertexArray}, {1, objBuffer}},
+	"VertexArrayEdgeFlagOffsetEXT":                   {{0, objVertexArray}, {1, objBuffer}},
+	"VertexArrayElementBuffer":                       {{0, objVertexArray}, {1, objBuffer}},
+	"VertexArrayFogCoordOffsetEXT":                   {{0, objVertexArray}, {1, objBuffer}},
+	"VertexArrayIndexOffsetEXT":                      {{0, objVertexArray}, {1, objBuffer}},
+	"VertexArrayMultiTexCoordOffsetEXT":              {{0, objVertexArray}, {1, objBuffer}},
+	"VertexArrayNormalOffsetEXT":                     {{0, objVertexArray}, {1, objBuffer}},
+	"VertexArraySecondaryColorOffsetEXT":             {{0, objVertexArray}, {1, objBuffer}},
+	"VertexArrayTexCoordOffsetEXT":                   {{0, objVertexArray}, {1, objBuffer}},
+	"VertexArrayVertexAttribBindingEXT":              {{0, objVertexArray}, {1, objAttrib}},
+	"VertexArrayVertexAttribDivisorEXT":              {{0, objVertexArray}, {1, objAttrib}},
+	"VertexArrayVertexAttribFormatEXT":               {{0, objVertexArray}, {1, objAttrib}},
+	"VertexArrayVertexAttribIFormatEXT":              {{0, objVertexArray}, {1, objAttrib}},
+	"VertexArrayVertexAttribIOffsetEXT":              {{0, objVertexArray}, {1, objBuffer}, {2, objAttrib}},
+	"VertexArrayVertexAttribLFormatEXT":              {{0, objVertexArray}, {1, objAttrib}},
+	"VertexArrayVertexAttribLOffsetEXT":              {{0, objVertexArray}, {1, objBuffer}, {2, objAttrib}},
+	"VertexArrayVertexAttribOffsetEXT":               {{0, objVertexArray}, {1, objBuffer}, {2, objAttrib}},
+	"VertexArrayVertexBindingDivisorEXT":             {{0, objVertexArray}},
+	"VertexArrayVertexBuffer":                        {{0, objVertexArray}, {2, objBuffer}},
+	"VertexArrayVertexBuffers":                       {{0, objVertexArray}, {3, objBuffer}},
+	"VertexArrayVertexOffsetEXT":                     {{0, objVertexArray}, {1, objBuffer}},
+	"VertexAttrib1d":                                 {{0, objAttrib}},
+	"VertexAttrib1dv":                                {{0, objAttrib}},
+	"VertexAttrib1f":                                 {{0, objAttrib}},
+	"VertexAttrib1fv":                                {{0, objAttrib}},
+	"VertexAttrib1s":                                 {{0, objAttrib}},
+	"VertexAttrib1sv":                                {{0, objAttrib}},
+	"VertexAttrib2d":                                 {{0, objAttrib}},
+	"VertexAttrib2dv":                                {{0, objAttrib}},
+	"VertexAttrib2f":                                 {{0, objAttrib}},
+	"VertexAttrib2fv":                                {{0, objAttrib}},
+	"VertexAttrib2s":                                 {{0, objAttrib}},
+	"VertexAttrib2sv":                                {{0, objAttrib}},
+	"VertexAttrib3d":                                 {{0, objAttrib}},
+	"VertexAttrib3dv":                                {{0, objAttrib}},
+	"VertexAttrib3f":                                 {{0, objAttrib}},
+	"VertexAttrib3fv":                                {{0, objAttrib}},
+	"VertexAttrib3s":                                 {{0, objAttrib}},
+	"VertexAttrib3sv":                                {{0, objAttrib}},
+	"VertexAttrib4Nbv":                               {{0, objAttrib}},
+	"VertexAttrib4Niv":                               {{0, objAttrib}},
+	"VertexAttrib4Nsv":                               {{0, objAttrib}},
+	"VertexAttrib4Nub":                               {{0, objAttrib}},
+	"VertexAttrib4Nubv":                              {{0, objAttrib}},
+	"VertexAttrib4Nuiv":                              {{0, objAttrib}},
+	"VertexAttrib4Nusv":                              {{0, objAttrib}},
+	"VertexAttrib4bv":                                {{0, objAttrib}},
+	"VertexAttrib4d":                                 {{0, objAttrib}},
+	"VertexAttrib4dv":                                {{0, objAttrib}},
+	"VertexAttrib4f":                                 {{0, objAttrib}},
+	"VertexAttrib4fv":                                {{0, objAttrib}},
+	"VertexAttrib4iv":                                {{0, objAttrib}},
+	"VertexAttrib4s":                                 {{0, objAttrib}},
+	"VertexAttrib4sv":                                {{0, objAttrib}},
+	"VertexAttrib4ubv":                               {{0, objAttrib}},
+	"VertexAttrib4uiv":                               {{0, objAttrib}},
+	"VertexAttrib4usv":                               {{0, objAttrib}},
+	"VertexAttribBinding":                            {{0, objAttrib}},
+	"VertexAttribDivisor":                            {{0, objAttrib}},
+	"VertexAttribDivisorARB":                         {{0, objAttrib}},
+	"VertexAttribFormat":                             {{0, objAttrib}},
+	"VertexAttribFormatNV":                           {{0, objAttrib}},
+	"VertexAttribI1i":                                {{0, objAttrib}},
+	"VertexAttribI1iv":                               {{0, objAttrib}},
+	"VertexAttribI1ui":                               {{0, objAttrib}},
+	"VertexAttribI1uiv":                              {{0, objAttrib}},
+	"VertexAttribI2i":                                {{0, objAttrib}},
+	"VertexAttribI2iv":                               {{0, objAttrib}},
+	"VertexAttribI2ui":                               {{0, objAttrib}},
+	"VertexAttribI2uiv":                              {{0, objAttrib}},
+	"VertexAttribI3i":                                {{0, objAttrib}},
+	"VertexAttribI3iv":                               {{0, objAttrib}},
+	"VertexAttribI3ui":                               {{0, objAttrib}},
+	"VertexAttribI3uiv":                              {{0, objAttrib}},
+	"VertexAttribI4bv":                               {{0, objAttrib}},
+	"VertexAttribI4i":                                {{0, objAttrib}},
+	"VertexAttribI4iv":                               {{0, objAttrib}},
+	"VertexAttribI4sv":                               {{0, objAttrib}},
+	"VertexAttribI4ubv":                              {{0, objAttrib}},
+	"VertexAttribI4ui":                               {{0, objAttrib}},
+	"VertexAttribI4uiv":                              {{0, objAttrib}},
+	"VertexAttribI4usv":                              {{0, objAttrib}},
+	"VertexAttribIFormat":                            {{0, objAttrib}},
+	"VertexAttribIFormatNV":                          {{0, objAttrib}},
+	"VertexAttribIPointer":                           {{0, objAttrib}},
+	"VertexAttribL1d":                                {{0, objAttrib}},
+	"VertexAttribL1dv":                               {{0, objAttrib}},
+	"VertexAttribL1i64NV":                            {{0, objAttrib}},
+	"VertexAttribL1i64vNV":                           {{0, objAttrib}},
+	"VertexAttribL1ui64ARB":                          {{0, objAttrib}},
+	"VertexAttribL1ui64NV":                           {{0, objAttrib}},
+	"VertexAttribL1ui64vARB":                         {{0, objAttrib}},
+	"VertexAttribL1ui64vNV":                          {{0, objAttrib}},
+	"VertexAttribL2d":                                {{0, objAttrib}},
+	"VertexAttribL2dv":                               {{0, objAttrib}},
+	"VertexAttribL2i64NV":                            {{0, objAttrib}},
+	"VertexAttribL2i64vNV":                           {{0, objAttrib}},
+	"VertexAttribL2ui64NV":                           {{0, objAttrib}},
+	"VertexAttribL2ui64vNV":                          {{0, objAttrib}},
+	"VertexAttribL3d":                                {{0, objAttrib}},
+	"VertexAttribL3dv":                               {{0, objAttrib}},
+	"VertexAttribL3i64NV":                            {{0, objAttrib}},
+	"VertexAttribL3i64vNV":                           {{0, objAttrib}},
+	"VertexAttribL3ui64NV":                           {{0, objAttrib}},
+	"VertexAttribL3ui64vNV":                          {{0, objAttrib}},
+	"VertexAttribL4d":                                {{0, objAttrib}},
+	"VertexAttribL4dv":                               {{0, objAttrib}},
+	"VertexAttribL4i64NV":                            {{0, objAttrib}},
+	"VertexAttribL4i64vNV":                           {{0, objAttrib}},
+	"VertexAttribL4ui64NV":                           {{0, objAttrib}},
+	"VertexAttribL4ui64vNV":                          {{0, objAttrib}},
+	"VertexAttribLFormat":                            {{0, objAttrib}},
+	"VertexAttribLFormatNV":                          {{0, objAttrib}},
+	"VertexAttribLPointer":                           {{0, objAttrib}},
+	"VertexAttribP1ui":                               {{0, objAttrib}},
+	"VertexAttribP1uiv":                              {{0, objAttrib}},
+	"VertexAttribP2ui":                               {{0, objAttrib}},
+	"VertexAttribP2uiv":                              {{0, objAttrib}},
+	"VertexAttribP3ui":                               {{0, objAttrib}},
+	"VertexAttribP3uiv":                              {{0, objAttrib}},
+	"VertexAttribP4ui":                               {{0, objAttrib}},
+	"VertexAttribP4uiv":                              {{0, objAttrib}},
+	"VertexAttribPointer":                            {{0, objAttrib}},
+	"VertexAttribPointerWithOffset":                  {{0, objAttrib}},
+	"WaitSync":                                       {{0, objSync}},
+}
@@ -0,0 +1,66 @@
+//go:build amd64 || arm64
+
+// This file implements getProcAddress on Linux without cgo. The OpenGL
+// library is loaded with dlopen when the first function is retrieved, and
+// the functions are called through purego.
+//
+// GLX is used by default, and EGL with the "egl" build tag, as with the cgo
+// bindings on the other platforms.
+
+package gles
+
+import (
+	"runtime"
+	"sync"
+	"unsafe"
+
+	"github.com/ebitengine/purego"
+)
+
+var (
+	loadOnce sync.Once
+	// Set by the egl build tag
+	useEGL bool
+	// The function returning the entry points of the current context
+	getProcAddressProc uintptr
+	// Library searched for core functions not returned by eglGetProcAddress
+	coreLibrary uintptr
+)
+
+// dlopenFirst returns a handle to the first library that can be loaded
+func dlopenFirst(names ...string) uintptr {
+	for _, name := range names {
+		if h, err := purego.Dlopen(name, purego.RTLD_NOW|purego.RTLD_GLOBAL); err == nil {
+			return h
+		}
+	}
+	return 0
+}
+
+func loadLibrary() {
+	if useEGL {
+		if egl := dlopenFirst("libEGL.so.1", "libEGL.so"); egl != 0 {
+			getProcAddressProc, _ = purego.Dlsym(egl, "eglGetProcAddress")
+		}
+		// eglGetProcAddress only returns core functions from EGL 1.5
+		coreLibrary = dlopenFirst("libOpenGL.so.0", "libGLESv2.so.2", "libGL.so.1")
+		return
+	}
+	if glx := dlopenFirst("libGL.so.1", "libGL.so"); glx != 0 {
+		getProcAddressProc, _ = purego.Dlsym(glx, "glXGetProcAddressARB")
+	}
+}
+
+func getProcAddress(namea string) unsafe.Pointer {
+	loadOnce.Do(loadLibrary)
+	if getProcAddressProc == 0 {
+		return nil
+	}
+	cname := append([]byte(namea), 0)
+	r, _, _ := purego.SyscallN(getProcAddressProc, uintptr(unsafe.Pointer(&cname[0])))
+	runtime.KeepAlive(cname)
+	if r == 0 && coreLibrary != 0 {
+		r, _ = purego.Dlsym(coreLibrary, namea)
+	}
+	return unsafe.Pointer(r)
+}
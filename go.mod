@@ -2,4 +2,7 @@ module github.com/jkvatne/purego-glfw
 
 go 1.24.0
 
-require golang.org/x/sys v0.37.0
+require (
+	github.com/ebitengine/purego v0.9.1
+	golang.org/x/sys v0.37.0
+)
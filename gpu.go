@@ -0,0 +1,208 @@
+package glfw
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+	"golang.org/x/sys/windows/registry"
+)
+
+// Windows has no API for choosing the GPU of an OpenGL context. Hybrid
+// graphics drivers pick the GPU when the driver is loaded into the process,
+// using the per application preference that is also set in the Windows
+// graphics settings. The NvOptimusEnablement and AmdPowerXpressRequestHighPerformance
+// exports used by C programs cannot be made from Go without cgo, and
+// WGL_NV_gpu_affinity is only available on professional NVIDIA cards.
+
+const (
+	gpuPreferenceKey = `Software\Microsoft\DirectX\UserGpuPreferences`
+
+	_DXGI_ADAPTER_FLAG_SOFTWARE               = 2
+	_DXGI_GPU_PREFERENCE_MINIMUM_POWER        = 1
+	_DXGI_GPU_PREFERENCE_HIGH_PERFORMANCE     = 2
+	_IDXGIAdapter1_GetDesc1                   = 10
+	_IDXGIFactory6_EnumAdapterByGpuPreference = 29
+)
+
+var iidIDXGIFactory6 = GUID{0xc1b6694f, 0xff09, 0x44a9, [8]uint8{0xb0, 0x3c, 0x77, 0x90, 0x0a, 0x0a, 0x1d, 0x17}}
+var iidIDXGIAdapter1 = GUID{0x29038f61, 0x3839, 0x4626, [8]uint8{0x91, 0xfd, 0x08, 0x68, 0x79, 0x01, 0x1a, 0x05}}
+
+type dxgiAdapterDesc1 struct {
+	Description           [128]uint16
+	VendorId              uint32
+	DeviceId              uint32
+	SubSysId              uint32
+	Revision              uint32
+	DedicatedVideoMemory  uintptr
+	DedicatedSystemMemory uintptr
+	SharedSystemMemory    uintptr
+	AdapterLuid           uint64
+	Flags                 uint32
+}
+
+// Adapter describes a display adapter (GPU). Memory sizes are in bytes.
+type Adapter struct {
+	Name                  string
+	VendorID              uint32
+	DeviceID              uint32
+	DedicatedVideoMemory  uint64 // VRAM not shared with the CPU
+	DedicatedSystemMemory uint64
+	SharedSystemMemory    uint64
+	LUID                  uint64 // Locally unique identifier, valid until reboot
+	Software              bool   // A software renderer such as the Microsoft Basic Render Driver
+	HighPerformance       bool   // The adapter Windows selects for HighPerformanceGPU
+	LowPower              bool   // The adapter Windows selects for LowPowerGPU
+}
+
+// GetAdapters returns the display adapters of the system, in the order
+// reported by DXGI, where the first adapter drives the primary monitor.
+// The HighPerformance and LowPower flags need Windows 10 version 1803 or later.
+func GetAdapters() ([]Adapter, error) {
+	var adapters []Adapter
+	var err error
+	e := dxgiEnumAdapters(func(adapter *comObject) bool {
+		var a Adapter
+		if a, err = getAdapterDesc(adapter); err != nil {
+			return false
+		}
+		adapters = append(adapters, a)
+		return true
+	})
+	if e != nil {
+		return nil, e
+	}
+	if err != nil {
+		return nil, err
+	}
+	if luid, ok := preferredAdapter(_DXGI_GPU_PREFERENCE_HIGH_PERFORMANCE); ok {
+		for i := range adapters {
+			adapters[i].HighPerformance = adapters[i].LUID == luid
+		}
+	}
+	if luid, ok := preferredAdapter(_DXGI_GPU_PREFERENCE_MINIMUM_POWER); ok {
+		for i := range adapters {
+			adapters[i].LowPower = adapters[i].LUID == luid
+		}
+	}
+	return adapters, nil
+}
+
+func getAdapterDesc(adapter *comObject) (Adapter, error) {
+	var desc dxgiAdapterDesc1
+	if r := adapter.call(_IDXGIAdapter1_GetDesc1, uintptr(unsafe.Pointer(&desc))); r != 0 {
+		return Adapter{}, fmt.Errorf("DXGI: GetDesc1 failed, 0x%08X", r)
+	}
+	return Adapter{
+		Name:                  windows.UTF16ToString(desc.Description[:]),
+		VendorID:              desc.VendorId,
+		DeviceID:              desc.DeviceId,
+		DedicatedVideoMemory:  uint64(desc.DedicatedVideoMemory),
+		DedicatedSystemMemory: uint64(desc.DedicatedSystemMemory),
+		SharedSystemMemory:    uint64(desc.SharedSystemMemory),
+		LUID:                  desc.AdapterLuid,
+		Software:              desc.Flags&_DXGI_ADAPTER_FLAG_SOFTWARE != 0,
+	}, nil
+}
+
+// preferredAdapter returns the LUID of the first adapter for a DXGI GPU preference.
+// It fails before Windows 10 version 1803, where IDXGIFactory6 is missing.
+func preferredAdapter(preference uintptr) (uint64, bool) {
+	if dxgi.Load() != nil || _CreateDXGIFactory1.Find() != nil {
+		return 0, false
+	}
+	var factory *comObject
+	if r, _, _ := _CreateDXGIFactory1.Call(uintptr(unsafe.Pointer(&iidIDXGIFactory6)), uintptr(unsafe.Pointer(&factory))); r != 0 || factory == nil {
+		return 0, false
+	}
+	defer factory.release()
+	var adapter *comObject
+	if factory.call(_IDXGIFactory6_EnumAdapterByGpuPreference, 0, preference, uintptr(unsafe.Pointer(&iidIDXGIAdapter1)), uintptr(unsafe.Pointer(&adapter))) != 0 {
+		return 0, false
+	}
+	defer adapter.release()
+	a, err := getAdapterDesc(adapter)
+	return a.LUID, err == nil
+}
+
+// gpuPreferenceValue is the previous registry value of the executable.
+type gpuPreferenceValue struct {
+	name   string
+	value  string
+	exists bool
+}
+
+// applyGPUPreference stores the GPUPreference init hint as the preference
+// of the executable, where the graphics driver reads it when it is loaded.
+// This changes a setting of the user, so it is only done when allowed by the
+// Win32GPUPreferenceRegistry init hint, and never replaces a preference the
+// user has chosen. It only has an effect before the first context of the
+// process is created. The previous value is restored by Terminate.
+func applyGPUPreference() {
+	var n int
+	switch _glfw.hints.init.gpuPreference {
+	case AnyGPU:
+		return
+	case LowPowerGPU:
+		n = 1
+	case HighPerformanceGPU:
+		n = 2
+	default:
+		glfwInputError(InvalidEnum, fmt.Sprintf("Invalid GPU preference 0x%08X", _glfw.hints.init.gpuPreference))
+		return
+	}
+	if !_glfw.hints.init.win32.gpuPreferenceRegistry {
+		glfwInputError(FeatureUnavailable, "Win32: The GPU preference is only set when allowed by the Win32GPUPreferenceRegistry init hint")
+		return
+	}
+	name, err := os.Executable()
+	if err != nil {
+		glfwInputError(PlatformError, "Win32: Failed to get the executable path, "+err.Error())
+		return
+	}
+	key, _, err := registry.CreateKey(registry.CURRENT_USER, gpuPreferenceKey, registry.QUERY_VALUE|registry.SET_VALUE)
+	if err != nil {
+		glfwInputError(PlatformError, "Win32: Failed to open the GPU preference key, "+err.Error())
+		return
+	}
+	defer key.Close()
+	old, _, err := key.GetStringValue(name)
+	previous := &gpuPreferenceValue{name: name, value: old, exists: err == nil}
+	// Keep the other settings, such as those set by the graphics settings page
+	settings := []string{fmt.Sprintf("GpuPreference=%d", n)}
+	for _, s := range strings.Split(old, ";") {
+		if strings.HasPrefix(s, "GpuPreference=") {
+			// The user has chosen a GPU for the executable
+			return
+		}
+		if s != "" {
+			settings = append(settings, s)
+		}
+	}
+	if err = key.SetStringValue(name, strings.Join(settings, ";")+";"); err != nil {
+		glfwInputError(PlatformError, "Win32: Failed to set the GPU preference, "+err.Error())
+		return
+	}
+	_glfw.win32.gpuPreference = previous
+}
+
+// restoreGPUPreference restores the registry value changed by applyGPUPreference
+func restoreGPUPreference() {
+	previous := _glfw.win32.gpuPreference
+	if previous == nil {
+		return
+	}
+	_glfw.win32.gpuPreference = nil
+	key, err := registry.OpenKey(registry.CURRENT_USER, gpuPreferenceKey, registry.SET_VALUE)
+	if err != nil {
+		return
+	}
+	defer key.Close()
+	if previous.exists {
+		_ = key.SetStringValue(previous.name, previous.value)
+	} else {
+		_ = key.DeleteValue(previous.name)
+	}
+}
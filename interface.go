@@ -4,9 +4,7 @@ import (
 	"fmt"
 	"image"
 	"image/draw"
-	"sync/atomic"
 	"syscall"
-	"time"
 	"unsafe"
 )
 
@@ -30,6 +28,7 @@ const (
 	PositionY              Hint = 0x0002000F
 	ScaleFramebuffer       Hint = 0x0002200D
 	CocoaRetinaFramebuffer Hint = 0x00023001
+	Offscreen              Hint = 0x00027001 // Specifies whether the window renders to a framebuffer object instead of a visible window. The window is never shown.
 )
 
 // Context related hints.
@@ -65,6 +64,26 @@ const (
 	SRGBCapable     Hint = 0x0002100E // Specifies whether the framebuffer should be sRGB capable.
 	RefreshRate     Hint = 0x0002100F // Specifies the desired refresh rate for full screen windows. If set to zero, the highest available refresh rate will be used. This hint is ignored for windowed mode windows.
 	DoubleBuffer    Hint = 0x00021010 // Specifies whether the framebuffer should be double buffered. You nearly always want to use double buffering. This is a hard constraint.
+	ComponentType   Hint = 0x00021011 // Specifies whether the colour components are fixed point or floating point. Requires WGL_ARB_pixel_format_float for FloatComponents.
+	ColorSpace      Hint = 0x00021012 // Specifies the colour space of the framebuffer, or DontCare.
+)
+
+// Values for the ComponentType hint.
+const (
+	FixedComponents = 0x00036001
+	FloatComponents = 0x00036002
+)
+
+// Values for the ColorSpace hint.
+// ScRGBColorSpace selects a linear 16-bit floating point framebuffer, which the
+// compositor shows as scRGB on monitors in HDR mode. HDR10ColorSpace selects a
+// 10-bit per channel framebuffer. The application must then write PQ encoded
+// BT.2020 values, as WGL has no way to tag the framebuffer with the colour space.
+const (
+	SRGBColorSpace   = 0x00037001
+	LinearColorSpace = 0x00037002
+	ScRGBColorSpace  = 0x00037003
+	HDR10ColorSpace  = 0x00037004
 )
 
 // Naming related hints. (Use with glfw.WindowHintString)
@@ -114,6 +133,83 @@ const (
 	DontCare = -1
 )
 
+// Error codes passed to the error callback.
+const (
+	NotInitialized       = 0x00010001 // GLFW has not been initialized.
+	NoCurrentContext     = 0x00010002 // No context is current for this thread.
+	InvalidEnum          = 0x00010003 // One of the arguments to the function was an invalid enum value.
+	InvalidValue         = 0x00010004 // One of the arguments to the function was an invalid value.
+	OutOfMemory          = 0x00010005 // A memory allocation failed.
+	APIUnavailable       = 0x00010006 // GLFW could not find support for the requested API on the system.
+	VersionUnavailable   = 0x00010007 // The requested OpenGL or OpenGL ES version is not available.
+	PlatformError        = 0x00010008 // A platform-specific error occurred that does not match any of the more specific categories.
+	FormatUnavailable    = 0x00010009 // The requested format is not supported or available.
+	NoWindowContext      = 0x0001000A // The specified window does not have an OpenGL or OpenGL ES context.
+	CursorUnavailable    = 0x0001000B // The specified cursor shape is not available.
+	FeatureUnavailable   = 0x0001000C // The requested feature is not provided by the platform.
+	FeatureUnimplemented = 0x0001000D // The requested feature is not implemented for the platform.
+	PlatformUnavailable  = 0x0001000E // Platform unavailable or no matching platform was found.
+)
+
+// Init hints. (Use with glfw.InitHint)
+const (
+	JoystickHatButtons  Hint = 0x00050001 // Specifies whether to also expose joystick hats as buttons.
+	AnglePlatformType   Hint = 0x00050002 // Specifies the platform type (rendering backend) to request when using OpenGL ES and EGL via ANGLE.
+	Platform            Hint = 0x00050003 // Specifies the platform to use for windowing and input.
+	GPUPreference       Hint = 0x00050004 // Specifies which GPU should render OpenGL contexts on machines with several. Needs Win32GPUPreferenceRegistry.
+	CocoaChdirResources Hint = 0x00051001 // Specifies whether to set the current directory to the application to the Contents/Resources subdirectory of the application's bundle, if present.
+	CocoaMenubar        Hint = 0x00051002 // Specifies whether to create a basic menu bar.
+	X11XCBVulkanSurface Hint = 0x00052001 // Specifies whether to prefer the VK_KHR_xcb_surface extension for creating Vulkan surfaces.
+	WaylandLibdecor     Hint = 0x00053001 // Specifies whether to use libdecor for window decorations where available.
+
+	// Win32GPUPreferenceRegistry allows GPUPreference to write the preference of the
+	// executable to HKCU\Software\Microsoft\DirectX\UserGpuPreferences, the value
+	// also shown in the Windows graphics settings, until Terminate restores it.
+	// A preference already set by the user is left unchanged. False by default.
+	Win32GPUPreferenceRegistry Hint = 0x00054001
+)
+
+// Values for the Platform init hint.
+const (
+	AnyPlatform     = 0x00060000
+	PlatformWin32   = 0x00060001
+	PlatformCocoa   = 0x00060002
+	PlatformWayland = 0x00060003
+	PlatformX11     = 0x00060004
+	PlatformNull    = 0x00060005
+)
+
+// Values for the GPUPreference init hint.
+const (
+	AnyGPU             = 0x00039000 // Let the system and driver decide
+	HighPerformanceGPU = 0x00039001 // Prefer the discrete GPU
+	LowPowerGPU        = 0x00039002 // Prefer the integrated GPU
+)
+
+// Values for the AnglePlatformType init hint.
+const (
+	AnglePlatformTypeNone     = 0x00037001
+	AnglePlatformTypeOpenGL   = 0x00037002
+	AnglePlatformTypeOpenGLES = 0x00037003
+	AnglePlatformTypeD3D9     = 0x00037004
+	AnglePlatformTypeD3D11    = 0x00037005
+	AnglePlatformTypeVulkan   = 0x00037007
+	AnglePlatformTypeMetal    = 0x00037008
+)
+
+// Values for the WaylandLibdecor init hint.
+const (
+	WaylandPreferLibdecor  = 0x00038001
+	WaylandDisableLibdecor = 0x00038002
+)
+
+// Version of the GLFW API implemented by this package.
+const (
+	VersionMajor    = 3
+	VersionMinor    = 4
+	VersionRevision = 0
+)
+
 // InputMode corresponds to an input mode.
 type InputMode int
 
@@ -202,6 +298,7 @@ func WindowHint(hint Hint, v int) {
 	case DepthBits:
 		_glfw.hints.framebuffer.depthBits = value
 	case StencilBits:
+		_glfw.hints.framebuffer.stencilBits = value
 	case AccumRedBits:
 		_glfw.hints.framebuffer.accumRedBits = value
 	case AccumGreenBits:
@@ -222,6 +319,10 @@ func WindowHint(hint Hint, v int) {
 		_glfw.hints.framebuffer.samples = value
 	case SRGBCapable:
 		_glfw.hints.framebuffer.sRGB = value != 0
+	case ComponentType:
+		_glfw.hints.framebuffer.floatBuffer = value == FloatComponents
+	case ColorSpace:
+		_glfw.hints.framebuffer.colorSpace = value
 	case Resizable:
 		_glfw.hints.window.resizable = value != 0
 	case Decorated:
@@ -236,6 +337,8 @@ func WindowHint(hint Hint, v int) {
 		_glfw.hints.window.maximized = value != 0
 	case Visible:
 		_glfw.hints.window.visible = value != 0
+	case Offscreen:
+		_glfw.hints.window.offscreen = value != 0
 	case PositionX:
 		_glfw.hints.window.xpos = value
 	case PositionY:
@@ -298,6 +401,7 @@ func CreateCursor(image image.Image, xhot int, yhot int) *Cursor {
 	var cursor Cursor
 	cursor.next = _glfw.cursorListHead
 	_glfw.cursorListHead = &cursor
+	trackResource("cursor", 1)
 	im := imageToGLFW(image)
 	cursor.handle = createIcon(&im, int32(xhot), int32(yhot), false)
 	return &cursor
@@ -309,6 +413,7 @@ func CreateStandardCursor(shape int) *Cursor {
 	var cursor = Cursor{}
 	cursor.next = _glfw.cursorListHead
 	_glfw.cursorListHead = &cursor
+	trackResource("cursor", 1)
 	var id uint16
 	switch shape {
 	case ArrowCursor:
@@ -341,6 +446,9 @@ func CreateStandardCursor(shape int) *Cursor {
 	return &cursor
 }
 
+// CreateWindow creates a window and its OpenGL or OpenGL ES context as
+// requested by the current window hints. When no pixel format satisfies the
+// framebuffer hints, the error wraps a *FormatUnavailableError.
 func CreateWindow(width, height int, title string, monitor *Monitor, share *Window) (*Window, error) {
 	wnd, err := glfwCreateWindow(int32(width), int32(height), title, monitor, share)
 	if err != nil {
@@ -421,6 +529,9 @@ func (w *Window) SetSize(width, height int) {
 		}
 	} else {
 		glfwSetSize(w, width, height)
+		if w.offscreen.fbo != 0 {
+			resizeOffscreenFramebuffer(w, int32(width), int32(height))
+		}
 	}
 }
 
@@ -590,36 +701,117 @@ func GetCurrentContext() *Window {
 	return glfwGetCurrentContext()
 }
 
-// Terminate destroys all remaining Windows, frees any allocated resources and
-// sets the library to an uninitialized state.
+// Terminate destroys all remaining Windows and cursors, restores the video mode
+// of any monitor used by a full screen window, frees any allocated resources and
+// sets the library to an uninitialized state. Init may be called again afterwards.
+// The error callback is kept.
 func Terminate() {
 	glfwTerminate()
 }
 
-var startTime atomic.Int64
-
+// GetTime returns the current GLFW time, in seconds. Unless the time has been
+// set using SetTime, it measures time elapsed since GLFW was initialized.
+// The time is taken from a monotonic high-resolution timer, so it is not
+// affected by changes to the system clock.
 func GetTime() float64 {
-	t := time.Now().UnixNano()
-	t = t - startTime.Load()
-	return float64(t/1e6) / 1e3
+	return float64(glfwPlatformGetTimerValue()-_glfw.timer.offset.Load()) / float64(glfwPlatformGetTimerFrequency())
 }
 
+// SetTime sets the current GLFW time, in seconds. The value must be a
+// positive finite number less than or equal to 18446744073.0, which is
+// approximately 584.5 years.
 func SetTime(newTime float64) {
-	tNow := time.Now().UnixNano()
-	startTime.Store(tNow - int64(newTime*1e9))
+	if newTime != newTime || newTime < 0.0 || newTime > 18446744073.0 {
+		glfwInputError(InvalidValue, fmt.Sprintf("Invalid time %f", newTime))
+		return
+	}
+	_glfw.timer.offset.Store(glfwPlatformGetTimerValue() - uint64(newTime*float64(glfwPlatformGetTimerFrequency())))
 }
 
-// Init is glfwInit(void)
+// GetTimerValue returns the current value of the raw timer, measured in
+// 1 / frequency seconds. The frequency can be queried with GetTimerFrequency.
+func GetTimerValue() uint64 {
+	return glfwPlatformGetTimerValue()
+}
+
+// GetTimerFrequency returns the frequency, in Hz, of the raw timer.
+func GetTimerFrequency() uint64 {
+	return glfwPlatformGetTimerFrequency()
+}
+
+// InitHint sets hints for the next initialization of GLFW. The values you set
+// are not affected by initialization or termination, but they are only read
+// during initialization. Once GLFW has been initialized, any values you set
+// will be ignored until the library is terminated and initialized again.
+func InitHint(hint Hint, value int) {
+	switch hint {
+	case JoystickHatButtons:
+		_glfwInitHints.hatButtons = value != 0
+	case AnglePlatformType:
+		_glfwInitHints.angleType = int32(value)
+	case Platform:
+		_glfwInitHints.platformID = int32(value)
+	case GPUPreference:
+		_glfwInitHints.gpuPreference = int32(value)
+	case Win32GPUPreferenceRegistry:
+		_glfwInitHints.win32.gpuPreferenceRegistry = value != 0
+	case CocoaMenubar:
+		_glfwInitHints.ns.menubar = value != 0
+	case CocoaChdirResources:
+		_glfwInitHints.ns.chdir = value != 0
+	case X11XCBVulkanSurface:
+		_glfwInitHints.x11.xcbVulkanSurface = value != 0
+	case WaylandLibdecor:
+		_glfwInitHints.wl.libdecorMode = value
+	default:
+		glfwInputError(InvalidEnum, fmt.Sprintf("Invalid init hint 0x%08X", hint))
+	}
+}
+
+// GetVersion retrieves the major, minor and revision numbers of the GLFW API
+// implemented by this package.
+func GetVersion() (major, minor, revision int) {
+	return VersionMajor, VersionMinor, VersionRevision
+}
+
+// GetVersionString returns a string describing the compile-time configuration,
+// starting with the version number.
+func GetVersionString() string {
+	return fmt.Sprintf("%d.%d.%d Win32 WGL purego", VersionMajor, VersionMinor, VersionRevision)
+}
+
+// GetPlatform returns the platform that was selected during initialization,
+// or zero if GLFW is not initialized.
+func GetPlatform() int {
+	if !_glfw.initialized {
+		glfwInputError(NotInitialized, "GetPlatform: GLFW is not initialized")
+		return 0
+	}
+	return PlatformWin32
+}
+
+// PlatformSupported reports whether the library includes support for the
+// specified platform. Only Win32 is supported.
+func PlatformSupported(platform int) bool {
+	return platform == PlatformWin32
+}
+
+// Init initializes the GLFW library. Before most GLFW functions can be used,
+// GLFW must be initialized, and before an application terminates GLFW should
+// be terminated in order to free any resources allocated during or after
+// initialization.
 func Init() error {
-	majv, minv, build := GetWindowsVersion()
-	fmt.Printf("Windows version %d.%d.%d\n", majv, minv, build)
 	// Repeated calls do nothing
 	SetTime(0)
 	if _glfw.initialized {
 		return nil
 	}
+	_glfw.hints.init = _glfwInitHints
+	if p := _glfw.hints.init.platformID; p != AnyPlatform && !PlatformSupported(int(p)) {
+		glfwInputError(PlatformUnavailable, fmt.Sprintf("Platform 0x%08X is not supported", p))
+		return fmt.Errorf("platform 0x%08X is not supported", p)
+	}
 	_glfw.initialized = true
-	_glfw.hints.init = _GLFWinitconfig{}
 	if err := glfwPlatformInit(); err != nil {
 		return err
 	}
@@ -695,7 +887,7 @@ func (w *Window) SetInputMode(mode int, value int) {
 			value != CursorHidden &&
 			value != CursorDisabled &&
 			value != CursorCaptured {
-			fmt.Printf("Invalid cursor mode 0x%08X", value)
+			glfwInputError(InvalidEnum, fmt.Sprintf("Invalid cursor mode 0x%08X", value))
 		}
 		if w.cursorMode == value {
 			return
@@ -766,6 +958,8 @@ func RawMouseMotionSupported() bool {
 	return true
 }
 
+// DestroyCursor destroys a cursor created with CreateCursor or CreateStandardCursor.
+// Windows using the cursor revert to the default cursor.
 func DestroyCursor(cursor *Cursor) {
 	if cursor == nil {
 		return
@@ -775,14 +969,18 @@ func DestroyCursor(cursor *Cursor) {
 		if window.cursor == cursor {
 			window.SetCursor(nil)
 		}
-		destroyCursor(cursor)
-		// Unlink cursor from global linked list
-		prev := &_glfw.cursorListHead
-		for *prev != cursor {
-			prev = &((*prev).next)
-			*prev = cursor.next
-		}
 	}
+	// Unlink cursor from global linked list
+	prev := &_glfw.cursorListHead
+	for *prev != nil && *prev != cursor {
+		prev = &((*prev).next)
+	}
+	if *prev == nil {
+		// Already destroyed
+		return
+	}
+	*prev = cursor.next
+	destroyCursor(cursor)
 }
 
 func DefaultWindowHints() {
@@ -790,6 +988,7 @@ func DefaultWindowHints() {
 	_glfw.hints.context.source = NativeContextAPI
 	_glfw.hints.context.major = 1
 	_glfw.hints.context.minor = 0
+	_glfw.hints.context.versions = nil
 	// The default is a focused, visible, resizable window with decorations
 	_glfw.hints.window.resizable = true
 	_glfw.hints.window.visible = true
@@ -801,6 +1000,7 @@ func DefaultWindowHints() {
 	_glfw.hints.window.xpos = AnyPosition
 	_glfw.hints.window.ypos = AnyPosition
 	_glfw.hints.window.scaleFramebuffer = true
+	_glfw.hints.window.offscreen = false
 	// The default is 24 bits of color, 24 bits of depth and 8 bits of stencil, double buffered
 	_glfw.hints.framebuffer.redBits = 8
 	_glfw.hints.framebuffer.greenBits = 8
@@ -809,12 +1009,20 @@ func DefaultWindowHints() {
 	_glfw.hints.framebuffer.depthBits = 24
 	_glfw.hints.framebuffer.stencilBits = 8
 	_glfw.hints.framebuffer.doublebuffer = true
+	_glfw.hints.framebuffer.floatBuffer = false
+	_glfw.hints.framebuffer.colorSpace = DontCare
 	// The default is to select the highest available refresh rate
 	_glfw.hints.refreshRate = DontCare
 	// The default is to use full Retina resolution framebuffers
 	_glfw.hints.window.ns.retina = true
 }
 
+// SwapInterval sets the swap interval for the current context, i.e. the number
+// of screen updates to wait from the time SwapBuffers was called before swapping
+// the buffers and returning. A negative interval enables adaptive vsync, where
+// late swaps happen immediately instead of waiting for the next retrace. This
+// is only available when SwapIntervalTearSupported returns true, otherwise the
+// absolute value of the interval is used.
 func SwapInterval(interval int) {
 	window := glfwGetCurrentContext()
 	if window == nil {
@@ -823,6 +1031,24 @@ func SwapInterval(interval int) {
 	window.context.swapInterval(interval)
 }
 
+// GetSwapInterval returns the swap interval of the current context, as set
+// by the last call to SwapInterval.
+func GetSwapInterval() int {
+	window := glfwGetCurrentContext()
+	if window == nil {
+		glfwInputError(NoCurrentContext, "Cannot get the swap interval without a current OpenGL or OpenGL ES context")
+		return 0
+	}
+	return window.context.wgl.interval
+}
+
+// SwapIntervalTearSupported reports whether negative swap intervals (adaptive
+// vsync, WGL_EXT_swap_control_tear) are supported. The result is only valid
+// after a window with a context has been created.
+func SwapIntervalTearSupported() bool {
+	return _glfw.wgl.EXT_swap_control_tear
+}
+
 func PostEmptyEvent() {
 	glfwPostEmptyEvent()
 }
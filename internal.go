@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"unicode"
 	"unicode/utf16"
@@ -44,12 +45,15 @@ type _GLFWcontext struct {
 	GetStringi              uintptr
 	GetIntegerv             uintptr
 	GetString               uintptr
+	GetGraphicsResetStatus  uintptr
+	lost                    bool
 	makeCurrent             _GLFWmakecontextcurrentfun
 	swapBuffers             _GLFWswapbuffersfun
 	swapInterval            _GLFWswapintervalfun
 	extensionSupported      _GLFWextensionsupportedfun
 	getProcAddress          _GLFWgetprocaddressfun
 	destroy                 _GLFWdestroycontextfun
+	procs                   map[string]uintptr // Entry points loaded by call
 	wgl                     struct {
 		dc       HDC
 		handle   HANDLE
@@ -68,7 +72,6 @@ type _GLFWwindow struct {
 	focusOnShow             bool
 	shouldClose             bool
 	mousePassthrough        bool
-	cursorTracked           bool
 	iconified               bool
 	maximized               bool
 	userPointer             unsafe.Pointer
@@ -97,6 +100,12 @@ type _GLFWwindow struct {
 	lastCursorPosX    float64 // The last received cursor position, regardless of source
 	lastCursorPosY    float64 // The last received cursor position, regardless of source
 
+	framebuffer FramebufferConfig
+	offscreen   _GLFWoffscreen
+	ctxconfig   _GLFWctxconfig
+	fbconfig    _GLFWfbconfig
+	shared      []*SharedContext
+
 	attribs     [40]int32
 	values      [40]int32
 	attribCount int
@@ -109,6 +118,7 @@ type _GLFWwindow struct {
 	scrollCallback          ScrollCallback
 	refreshCallback         RefreshCallback
 	sizeCallback            SizeCallback
+	posCallback             PosCallback
 	dropCallback            DropCallback
 	iconifyCallback         IconifyCallback
 	framebufferSizeCallback SizeCallback
@@ -116,6 +126,7 @@ type _GLFWwindow struct {
 	cursorEnterCallback     CursorEnterCallback
 	maximizeCallback        MaximizeCallback
 	windowCloseCallback     func(w *_GLFWwindow)
+	contextLostCallback     ContextLostCallback
 	fFramebufferSizeHolder  func(w *_GLFWwindow, width int, height int)
 	fCloseHolder            func(w *_GLFWwindow)
 	fMaximizeHolder         func(w *_GLFWwindow, maximized bool)
@@ -138,18 +149,46 @@ type _GLFWwindowWin32 = struct {
 	width          int    // Cached size used to filter out duplicate events
 	height         int    // Cached size used to filter out duplicate events
 	highSurrogate  uint16 // The last recevied high surrogate when decoding pairs of UTF-16 messages
+	present        struct {
+		pix           []byte // The last presented image, as 32-bit BGRX
+		width, height int32
+	}
 }
 
 type _GLFWinitconfig = struct {
-	hatButtons bool
-	ns         struct {
+	hatButtons    bool
+	angleType     int32
+	platformID    int32
+	gpuPreference int32
+	ns            struct {
 		menubar bool
 		chdir   bool
 	}
+	win32 struct {
+		gpuPreferenceRegistry bool
+	}
+	x11 struct {
+		xcbVulkanSurface bool
+	}
 	wl struct {
 		libdecorMode int
 	}
 }
+
+// _glfwInitHints holds the init hints set by InitHint. They are copied
+// into _glfw.hints.init by Init, and are not reset by Terminate.
+var _glfwInitHints = func() (c _GLFWinitconfig) {
+	c.hatButtons = true
+	c.angleType = AnglePlatformTypeNone
+	c.platformID = AnyPlatform
+	c.gpuPreference = AnyGPU
+	c.ns.menubar = true
+	c.ns.chdir = true
+	c.x11.xcbVulkanSurface = true
+	c.wl.libdecorMode = WaylandPreferLibdecor
+	return c
+}()
+
 type _GLFWwndconfig = struct {
 	xpos             int32
 	ypos             int32
@@ -169,6 +208,7 @@ type _GLFWwndconfig = struct {
 	mousePassthrough bool
 	scaleToMonitor   bool
 	scaleFramebuffer bool
+	offscreen        bool
 	win32            struct {
 		keymenu     bool
 		showDefault bool
@@ -191,6 +231,7 @@ type _GLFWctxconfig = struct {
 	robustness int32
 	release    int32
 	share      *_GLFWwindow
+	versions   []ContextVersion // Tried in order instead of client, major, minor and profile
 	nsgl       struct {
 		offline bool
 	}
@@ -222,6 +263,8 @@ type _GLFWfbconfig = struct {
 	sRGB           bool
 	doublebuffer   bool
 	transparent    bool
+	floatBuffer    bool
+	colorSpace     int32
 	handle         uintptr
 }
 
@@ -247,13 +290,17 @@ var _glfw struct {
 	cursorListHead  *Cursor
 	windowListHead  *_GLFWwindow
 	monitors        []*Monitor
-	monitorCallback func(w *Monitor, action int)
+	monitorCallback MonitorCallback
 	errorCallback   ErrorCallbackFunc
 	monitorCount    int
 	errorSlot       _GLFWtls
 	contextSlot     _GLFWtls
 	errorLock       sync.Mutex
-	win32           struct {
+	timer           struct {
+		offset    atomic.Uint64 // Timer value corresponding to time zero
+		frequency atomic.Uint64 // Timer counts per second, cached on first use
+	}
+	win32 struct {
 		deviceNotificationHandle syscall.Handle
 		helperWindowHandle       syscall.Handle
 		helperWindowClass        uint16
@@ -268,38 +315,44 @@ var _glfw struct {
 		restoreCursorPosY        float64
 		disabledCursorWindow     *Window
 		capturedCursorWindow     *Window
-	}
-	wgl struct {
-		dc       HDC
-		handle   syscall.Handle
-		interval int
-		// _GLFWlibraryWGL
-		instance                       *windows.LazyDLL
-		wglCreateContext               *windows.LazyProc
-		wglDeleteContext               *windows.LazyProc
-		wglGetProcAddress              *windows.LazyProc
-		wglGetCurrentDC                *windows.LazyProc
-		wglGetCurrentContext           *windows.LazyProc
-		wglMakeCurrent                 *windows.LazyProc
-		wglShareLists                  *windows.LazyProc
-		SwapIntervalEXT                uintptr
-		GetPixelFormatAttribivARB      uintptr
-		GetExtensionsStringEXT         uintptr
-		GetExtensionsStringARB         uintptr
-		wglCreateContextAttribsARB     uintptr
-		EXT_swap_control               bool
-		EXT_colorspace                 bool
-		ARB_multisample                bool
-		ARB_framebuffer_sRGB           bool
-		EXT_framebuffer_sRGB           bool
-		ARB_pixel_format               bool
-		ARB_create_context             bool
-		ARB_create_context_profile     bool
-		EXT_create_context_es2_profile bool
-		ARB_create_context_robustness  bool
-		ARB_create_context_no_error    bool
-		ARB_context_flush_control      bool
-	}
+		gpuPreference            *gpuPreferenceValue // The registry value to restore on Terminate
+		windows8OrGreater        bool                // Checked once by Init, as it is needed on every swap
+	}
+	wgl _GLFWlibraryWGL
+}
+
+// _GLFWlibraryWGL is the WGL specific global state
+type _GLFWlibraryWGL struct {
+	dc                             HDC
+	handle                         syscall.Handle
+	interval                       int
+	instance                       *windows.LazyDLL
+	wglCreateContext               *windows.LazyProc
+	wglDeleteContext               *windows.LazyProc
+	wglGetProcAddress              *windows.LazyProc
+	wglGetCurrentDC                *windows.LazyProc
+	wglGetCurrentContext           *windows.LazyProc
+	wglMakeCurrent                 *windows.LazyProc
+	wglShareLists                  *windows.LazyProc
+	SwapIntervalEXT                uintptr
+	GetPixelFormatAttribivARB      uintptr
+	GetExtensionsStringEXT         uintptr
+	GetExtensionsStringARB         uintptr
+	wglCreateContextAttribsARB     uintptr
+	EXT_swap_control               bool
+	EXT_swap_control_tear          bool
+	EXT_colorspace                 bool
+	ARB_multisample                bool
+	ARB_framebuffer_sRGB           bool
+	EXT_framebuffer_sRGB           bool
+	ARB_pixel_format               bool
+	ARB_pixel_format_float         bool
+	ARB_create_context             bool
+	ARB_create_context_profile     bool
+	EXT_create_context_es2_profile bool
+	ARB_create_context_robustness  bool
+	ARB_create_context_no_error    bool
+	ARB_context_flush_control      bool
 }
 
 type MINMAXINFO struct {
@@ -441,6 +494,16 @@ func glfwInputWindowDamage(window *_GLFWwindow) {
 	}
 }
 
+// glfwInputError reports a non-fatal error to the error callback, if any
+func glfwInputError(code int, description string) {
+	_glfw.errorLock.Lock()
+	cb := _glfw.errorCallback
+	_glfw.errorLock.Unlock()
+	if cb != nil {
+		cb(code, description)
+	}
+}
+
 func glfwInputWindowCloseRequest(window *_GLFWwindow) {
 	// TODO
 }
@@ -544,6 +607,11 @@ func windowProc(hwnd syscall.Handle, msg uint32, wParam, lParam uintptr) uintptr
 	case _WM_DPICHANGED:
 		// Let Windows know we're prepared for runtime DPI changes.
 		return True
+	case _WM_DWMCOMPOSITIONCHANGED, _WM_DWMCOLORIZATIONCOLORCHANGED:
+		if window.Win32.transparent {
+			updateFramebufferTransparency(window)
+		}
+		return 0
 	case _WM_ERASEBKGND:
 		// Avoid flickering between GPU content and background color.
 		return True
@@ -668,7 +736,7 @@ func windowProc(hwnd syscall.Handle, msg uint32, wParam, lParam uintptr) uintptr
 			tme.dwFlags = _TME_LEAVE
 			tme.hwndTrack = window.Win32.Handle
 			TrackMouseEvent(&tme)
-			window.cursorTracked = true
+			window.Win32.cursorTracked = true
 			if window.cursorEnterCallback != nil {
 				window.cursorEnterCallback(window, true)
 			}
@@ -705,6 +773,14 @@ func windowProc(hwnd syscall.Handle, msg uint32, wParam, lParam uintptr) uintptr
 
 	case _WM_PAINT:
 		glfwInputWindowDamage(window)
+		if window.Win32.present.pix != nil {
+			// Repaint the last presented image
+			var ps PAINTSTRUCT
+			dc := BeginPaint(hwnd, &ps)
+			blitPresented(window, dc)
+			EndPaint(hwnd, &ps)
+			return 0
+		}
 
 	case _WM_SIZE:
 		width := int(lParam & 0xFFFF)
@@ -733,7 +809,7 @@ func windowProc(hwnd syscall.Handle, msg uint32, wParam, lParam uintptr) uintptr
 			if window.sizeCallback != nil {
 				window.sizeCallback(window, width, height)
 			}
-			if window.framebufferSizeCallback != nil {
+			if window.framebufferSizeCallback != nil && window.offscreen.fbo == 0 {
 				window.framebufferSizeCallback(window, width, height)
 			}
 		}
@@ -749,6 +825,18 @@ func windowProc(hwnd syscall.Handle, msg uint32, wParam, lParam uintptr) uintptr
 		window.maximized = maximized
 		return 0
 
+	case _WM_MOVE:
+		if _glfw.win32.capturedCursorWindow == window {
+			captureCursor(window)
+		}
+		// The coordinates are signed on multi-monitor setups
+		x := int(int16(lParam & 0xFFFF))
+		y := int(int16((lParam >> 16) & 0xFFFF))
+		if window.posCallback != nil {
+			window.posCallback(window, x, y)
+		}
+		return 0
+
 	case _WM_GETMINMAXINFO:
 		if window.monitor != nil {
 			break
@@ -928,10 +1016,16 @@ func createMonitor(adapter *DISPLAY_DEVICEW, display *DISPLAY_DEVICEW) *Monitor
 	rect.Right = dm.dmPosition.X + dm.dmPelsWidth
 	rect.Bottom = dm.dmPosition.Y + dm.dmPelsHeight
 	CurrentMonitor = monitor
-	_ = EnumDisplayMonitors(0, &rect, NewEnumDisplayMonitorsCallback(enumMonitorCallback), uintptr(unsafe.Pointer(monitor)))
+	if enumMonitorCallbackPtr == 0 {
+		// Callbacks can never be released, so the same one is reused for all monitors
+		enumMonitorCallbackPtr = NewEnumDisplayMonitorsCallback(enumMonitorCallback)
+	}
+	_ = EnumDisplayMonitors(0, &rect, enumMonitorCallbackPtr, uintptr(unsafe.Pointer(monitor)))
 	return monitor
 }
 
+var enumMonitorCallbackPtr uintptr
+
 func enumMonitorCallback(hmon HMONITOR, hdc HDC, bounds RECT, lParam uintptr) bool {
 	if uintptr(unsafe.Pointer(CurrentMonitor)) == lParam {
 		CurrentMonitor.Win32.hMonitor = hmon
@@ -984,7 +1078,7 @@ func glfwInputMonitor(monitor *Monitor, action int, placement int) {
 	}
 
 	if _glfw.monitorCallback != nil {
-		_glfw.monitorCallback(monitor, action)
+		_glfw.monitorCallback(monitor, PeripheralEvent(action))
 	}
 
 }
@@ -1064,7 +1158,7 @@ func glfwSetVideoMode(monitor *Monitor, desired *GLFWvidmode) error {
 	current := monitor.GetVideoMode()
 	best := glfwChooseVideoMode(monitor, desired)
 	if glfwCompareVideoModes(&current, best) == 0 {
-		fmt.Printf("glfwCompareVideoModes returned 0, could not set video mode\n")
+		// The desired mode is already current
 		return nil
 	}
 	var dm DEVMODEW
@@ -1311,7 +1405,9 @@ func SetRawMouseMotion(window *Window, enabled bool) {
 func destroyCursor(cursor *Cursor) {
 	if cursor.handle != 0 {
 		DestroyIcon(cursor.handle)
+		cursor.handle = 0
 	}
+	trackResource("cursor", -1)
 }
 
 func glfwSetWindowAspectRatio(window *Window, numer, denom int32) {
@@ -1403,6 +1499,29 @@ func glfwGetWindowAttrib(window *Window, attrib Hint) int32 {
 		return int32(toInt(window.autoIconify))
 	case DoubleBuffer:
 		return int32(toInt(window.doublebuffer))
+	case RedBits:
+		return int32(window.framebuffer.RedBits)
+	case GreenBits:
+		return int32(window.framebuffer.GreenBits)
+	case BlueBits:
+		return int32(window.framebuffer.BlueBits)
+	case AlphaBits:
+		return int32(window.framebuffer.AlphaBits)
+	case DepthBits:
+		return int32(window.framebuffer.DepthBits)
+	case StencilBits:
+		return int32(window.framebuffer.StencilBits)
+	case Samples:
+		return int32(window.framebuffer.Samples)
+	case SRGBCapable:
+		return int32(toInt(window.framebuffer.SRGB))
+	case ComponentType:
+		if window.framebuffer.Float {
+			return FloatComponents
+		}
+		return FixedComponents
+	case ColorSpace:
+		return int32(window.framebuffer.ColorSpace)
 	case ClientAPI:
 		return window.context.client
 	case ContextCreationAPI:
@@ -1524,6 +1643,9 @@ func glfwGetPos(w *Window) (x, y int32) {
 }
 
 func glfwGetFramebufferSize(w *Window) (width int, height int) {
+	if w.offscreen.fbo != 0 {
+		return int(w.offscreen.width), int(w.offscreen.height)
+	}
 	var area RECT
 	_, _, err := _GetClientRect.Call(uintptr(w.Win32.Handle), uintptr(unsafe.Pointer(&area)))
 	if !errors.Is(err, syscall.Errno(0)) {
@@ -1678,6 +1800,9 @@ func createNativeWindow(window *_GLFWwindow, wndconfig *_GLFWwndconfig, fbconfig
 		0, // No menu
 		_glfw.win32.instance,
 		uintptr(unsafe.Pointer(wndconfig)))
+	if window.Win32.Handle != 0 {
+		trackResource("window", 1)
+	}
 
 	SetProp(window.Win32.Handle, "GLFW", uintptr(unsafe.Pointer(window)))
 	ChangeWindowMessageFilterEx(window.Win32.Handle, _WM_DROPFILES, _MSGFLT_ALLOW, 0)
@@ -1728,14 +1853,48 @@ func createNativeWindow(window *_GLFWwindow, wndconfig *_GLFWwndconfig, fbconfig
 		}
 
 	}
+	if fbconfig.transparent {
+		updateFramebufferTransparency(window)
+		window.Win32.transparent = true
+	}
 	return err
 }
 
+// updateFramebufferTransparency lets the compositor blend the window with
+// the desktop using the alpha channel of the framebuffer
+func updateFramebufferTransparency(window *_GLFWwindow) {
+	if !IsWindowsVistaOrGreater() || !DwmIsCompositionEnabled() {
+		return
+	}
+	_, opaque, ok := DwmGetColorizationColor()
+	if IsWindows8OrGreater() || (ok && !opaque) {
+		region := CreateRectRgn(0, 0, -1, -1)
+		bb := DWM_BLURBEHIND{dwFlags: _DWM_BB_ENABLE | _DWM_BB_BLURREGION, fEnable: 1, hRgnBlur: region}
+		DwmEnableBlurBehindWindow(window.Win32.Handle, &bb)
+		DeleteObject(region)
+	} else {
+		// HACK: Disable framebuffer transparency on Windows 7 when the
+		//       colorization color is opaque, because otherwise the window
+		//       contents is blended additively with the previous frame instead
+		//       of replacing it
+		bb := DWM_BLURBEHIND{dwFlags: _DWM_BB_ENABLE}
+		DwmEnableBlurBehindWindow(window.Win32.Handle, &bb)
+	}
+}
+
 // Destroy destroys the specified window and its context. On calling this
 // function, no further callbacks will be called for that window.
 //
 // This function may only be called from the main thread.
 func glfwDestroyWindow(w *Window) {
+	// Ignore windows that are already destroyed, possibly by Terminate
+	prev := &_glfw.windowListHead
+	for *prev != nil && *prev != w {
+		prev = &((*prev).next)
+	}
+	if *prev == nil {
+		return
+	}
 	w.windowCloseCallback = nil
 	w.refreshCallback = nil
 	w.charCallback = nil
@@ -1745,13 +1904,43 @@ func glfwDestroyWindow(w *Window) {
 	w.sizeCallback = nil
 	w.dropCallback = nil
 	w.contentScaleCallback = nil
-	RemoveProp(w.Win32.Handle, "GLFW")
+	w.contextLostCallback = nil
+	deleteOffscreenFramebuffer(w)
 	if w == getCurrentWindow() {
 		_ = glfwMakeContextCurrent(nil)
 	}
-	DestroyWindow(w.Win32.Handle)
+	if w.monitor != nil {
+		releaseMonitor(w)
+	}
+	for _, sc := range w.shared {
+		glfwDestroyWindow(sc.window)
+	}
+	w.shared = nil
+	if w.context.destroy != nil {
+		w.context.destroy(w)
+		trackResource("context", -1)
+	}
+	if _glfw.win32.disabledCursorWindow == w {
+		enableCursor(w)
+	}
+	if _glfw.win32.capturedCursorWindow == w {
+		releaseCursor()
+	}
+	if w.Win32.Handle != 0 {
+		RemoveProp(w.Win32.Handle, "GLFW")
+		DestroyWindow(w.Win32.Handle)
+		trackResource("window", -1)
+	}
+	if w.Win32.bigIcon != 0 {
+		DestroyIcon(w.Win32.bigIcon)
+		w.Win32.bigIcon = 0
+	}
+	if w.Win32.smallIcon != 0 {
+		DestroyIcon(w.Win32.smallIcon)
+		w.Win32.smallIcon = 0
+	}
 	// Unlink window from global linked list
-	prev := &_glfw.windowListHead
+	prev = &_glfw.windowListHead
 	for *prev != w {
 		prev = &((*prev).next)
 	}
@@ -1759,30 +1948,70 @@ func glfwDestroyWindow(w *Window) {
 	w.Win32.Handle = 0
 }
 
+// glfwTerminate destroys all remaining windows and cursors, restores any
+// modified video modes and frees the platform resources, so that the
+// library can be initialized again.
 func glfwTerminate() {
 	if !_glfw.initialized {
 		return
 	}
-	_glfw.initialized = false
+	_glfw.monitorCallback = nil
+	// The resources still alive now were not released by the application
+	reportLeaks()
+	for _glfw.windowListHead != nil {
+		glfwDestroyWindow(_glfw.windowListHead)
+	}
+	for _glfw.cursorListHead != nil {
+		DestroyCursor(_glfw.cursorListHead)
+	}
+	for _, monitor := range _glfw.monitors {
+		glfwRestoreVideoMode(monitor)
+		monitor.window = nil
+		monitor.modes = nil
+	}
+	_glfw.monitors = nil
+	_glfw.monitorCount = 0
+	if _glfw.win32.acquiredMonitorCount > 0 {
+		SetThreadExecutionState(_ES_CONTINUOUS)
+		_glfw.win32.acquiredMonitorCount = 0
+	}
+
 	if _glfw.win32.deviceNotificationHandle != 0 {
 		UnregisterDeviceNotification(_glfw.win32.deviceNotificationHandle)
+		_glfw.win32.deviceNotificationHandle = 0
 	}
-
 	if _glfw.win32.mainWindowClass != 0 {
-		UnregisterClass(_glfw.win32.mainWindowClass, _glfw.win32.instance)
+		UnregisterClass(_glfw.win32.mainWindowClass, _glfw.instance)
 		_glfw.win32.mainWindowClass = 0
+		_glfw.class = 0
+	}
+	if _glfw.win32.helperWindowHandle != 0 {
+		DestroyWindow(_glfw.win32.helperWindowHandle)
+		_glfw.win32.helperWindowHandle = 0
 	}
-	DestroyWindow(_glfw.win32.helperWindowHandle)
 	if _glfw.win32.helperWindowClass != 0 {
-		UnregisterClass(_glfw.win32.helperWindowClass, _glfw.win32.instance)
+		UnregisterClass(_glfw.win32.helperWindowClass, _glfw.instance)
+		_glfw.win32.helperWindowClass = 0
 	}
-	_glfw.win32.helperWindowHandle = 0
+	_glfw.win32.disabledCursorWindow = nil
+	_glfw.win32.capturedCursorWindow = nil
+	restoreGPUPreference()
+	// The WGL extension state is queried again when the next context is created.
+	// opengl32.dll itself stays loaded, as lazy DLLs cannot be unloaded.
+	_glfw.wgl = _GLFWlibraryWGL{}
+
+	glfwPlatformDestroyTls(&_glfw.errorSlot)
+	glfwPlatformDestroyTls(&_glfw.contextSlot)
+	_glfw.initialized = false
 }
 
 func glfwPlatformInit() error {
+	glfwPlatformInitTimer()
 	createKeyTables()
+	_glfw.win32.windows8OrGreater = IsWindows8OrGreater()
 	SetProcessDpiAwareness()
 	_glfw.instance = GetModuleHandle()
+	applyGPUPreference()
 	err := createHelperWindow()
 	if err != nil {
 		return err
@@ -1794,6 +2023,9 @@ func glfwPlatformInit() error {
 }
 
 func glfwPlatformCreateWindow(window *_GLFWwindow, wndconfig *_GLFWwndconfig, ctxconfig *_GLFWctxconfig, fbconfig *_GLFWfbconfig) error {
+	if wndconfig.offscreen && (ctxconfig.client == NoAPI || window.monitor != nil) {
+		return fmt.Errorf("offscreen windows need a context and cannot be full screen")
+	}
 	err := createNativeWindow(window, wndconfig, fbconfig)
 	if err != nil {
 		return err
@@ -1802,11 +2034,23 @@ func glfwPlatformCreateWindow(window *_GLFWwindow, wndconfig *_GLFWwndconfig, ct
 		if err = _glfwInitWGL(); err != nil {
 			return fmt.Errorf("could not create window, %v", err.Error())
 		}
-		if err = glfwCreateContextWGL(window, ctxconfig, fbconfig); err != nil {
-			return fmt.Errorf("could not create graphical context, %v", err.Error())
+		if len(ctxconfig.versions) > 0 {
+			if err = createContextVersions(window, ctxconfig, fbconfig); err != nil {
+				return err
+			}
+		} else {
+			if err = glfwCreateContextWGL(window, ctxconfig, fbconfig); err != nil {
+				return fmt.Errorf("could not create graphical context, %w", err)
+			}
+			if err = glfwRefreshContextAttribs(window, ctxconfig); err != nil {
+				return err
+			}
 		}
-		if err = glfwRefreshContextAttribs(window, ctxconfig); err != nil {
-			return err
+		window.framebuffer.Transparent = window.Win32.transparent
+		if wndconfig.offscreen {
+			if err = createOffscreenFramebuffer(window, wndconfig.width, wndconfig.height, fbconfig); err != nil {
+				return err
+			}
 		}
 	}
 	if window.monitor != nil {
@@ -1820,7 +2064,7 @@ func glfwPlatformCreateWindow(window *_GLFWwindow, wndconfig *_GLFWwndconfig, ct
 			x, y := window.GetPos()
 			window.SetCursorPos(float64(x/2), float64(y/2))
 		}
-	} else if wndconfig.visible {
+	} else if wndconfig.visible && !wndconfig.offscreen {
 		glfwShowWindow(window)
 		if wndconfig.focused {
 			glfwFocusWindow(window)
@@ -1843,17 +2087,28 @@ func glfwCreateWindow(width, height int32, title string, monitor *Monitor, share
 
 	wndconfig.title = title
 	ctxconfig.share = share
-	if glfwIsValidContextConfig(&ctxconfig) != nil {
+	if len(ctxconfig.versions) > 0 {
+		for _, v := range ctxconfig.versions {
+			if err := glfwIsValidContextConfig(versionConfig(&ctxconfig, v)); err != nil {
+				return nil, fmt.Errorf("context version %v is invalid: %w", v, err)
+			}
+		}
+	} else if glfwIsValidContextConfig(&ctxconfig) != nil {
 		return nil, fmt.Errorf("glfw context config is invalid: %v", ctxconfig)
 	}
+	return glfwCreateWindowConfig(monitor, &wndconfig, &ctxconfig, &fbconfig)
+}
 
+// glfwCreateWindowConfig creates a window from the given configuration
+// instead of the current hints
+func glfwCreateWindowConfig(monitor *Monitor, wndconfig *_GLFWwndconfig, ctxconfig *_GLFWctxconfig, fbconfig *_GLFWfbconfig) (*_GLFWwindow, error) {
 	window := &_GLFWwindow{}
 	window.context = &_GLFWcontext{}
 	window.next = _glfw.windowListHead
 	_glfw.windowListHead = window
 
-	window.videoMode.Width = width
-	window.videoMode.Height = height
+	window.videoMode.Width = wndconfig.width
+	window.videoMode.Height = wndconfig.height
 	window.videoMode.RedBits = fbconfig.redBits
 	window.videoMode.GreenBits = fbconfig.greenBits
 	window.videoMode.BlueBits = fbconfig.blueBits
@@ -1874,9 +2129,14 @@ func glfwCreateWindow(width, height int32, title string, monitor *Monitor, share
 	window.numer = DontCare
 	window.denom = DontCare
 
-	if err := glfwPlatformCreateWindow(window, &wndconfig, &ctxconfig, &fbconfig); err != nil {
+	if err := glfwPlatformCreateWindow(window, wndconfig, ctxconfig, fbconfig); err != nil {
+		glfwDestroyWindow(window)
 		return nil, err
 	}
+	// Kept for creating shared contexts
+	window.ctxconfig = *ctxconfig
+	window.ctxconfig.share = nil
+	window.fbconfig = *fbconfig
 	return window, nil
 }
 
@@ -2424,6 +2684,8 @@ func glfwPlatformDestroyTls(tls *_GLFWtls) {
 	if tls.allocated {
 		TlsFree(tls.index)
 	}
+	tls.allocated = false
+	tls.index = 0
 }
 
 func glfwPlatformCreateTls(tls *_GLFWtls) error {
@@ -2437,3 +2699,30 @@ func glfwPlatformCreateTls(tls *_GLFWtls) error {
 	tls.allocated = true
 	return nil
 }
+
+// glfwPlatformInitTimer caches the timer frequency, which is fixed at system boot
+func glfwPlatformInitTimer() {
+	_glfw.timer.frequency.Store(QueryPerformanceFrequency())
+}
+
+func glfwPlatformGetTimerValue() uint64 {
+	return QueryPerformanceCounter()
+}
+
+func glfwPlatformGetTimerFrequency() uint64 {
+	f := _glfw.timer.frequency.Load()
+	if f == 0 {
+		// The timer may be used before Init has been called
+		glfwPlatformInitTimer()
+		f = _glfw.timer.frequency.Load()
+	}
+	return f
+}
+
+// glfwPlatformGetCompositionTiming returns the DWM composition timing, or false if composition is disabled
+func glfwPlatformGetCompositionTiming() (dwmTimingInfo, bool) {
+	if !DwmIsCompositionEnabled() {
+		return dwmTimingInfo{}, false
+	}
+	return DwmGetCompositionTimingInfo(0)
+}
@@ -0,0 +1,14 @@
+//go:build !glfwdebug
+
+package glfw
+
+func trackResource(kind string, delta int) {}
+
+// LeakReport returns a description of the windows, contexts and cursors
+// that were created but not destroyed. It always returns an empty string
+// unless building with the glfwdebug tag.
+func LeakReport() string {
+	return ""
+}
+
+func reportLeaks() {}
@@ -0,0 +1,43 @@
+//go:build glfwdebug
+
+package glfw
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Resource counting is only done when building with the glfwdebug tag.
+var liveResources = map[string]int{}
+
+// trackResource adds delta to the number of live resources of the given kind
+func trackResource(kind string, delta int) {
+	liveResources[kind] += delta
+}
+
+// LeakReport returns a description of the windows, contexts and cursors
+// that were created but not destroyed, or an empty string if there are none.
+// The report is only available when building with the glfwdebug tag.
+func LeakReport() string {
+	var kinds []string
+	for kind, n := range liveResources {
+		if n != 0 {
+			kinds = append(kinds, kind)
+		}
+	}
+	sort.Strings(kinds)
+	var s []string
+	for _, kind := range kinds {
+		s = append(s, fmt.Sprintf("%d %s(s)", liveResources[kind], kind))
+	}
+	return strings.Join(s, ", ")
+}
+
+// reportLeaks reports the resources that are still alive when Terminate is
+// called, before it destroys them, through the error callback
+func reportLeaks() {
+	if r := LeakReport(); r != "" {
+		glfwInputError(PlatformError, "Terminate: resources not released: "+r)
+	}
+}
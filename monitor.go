@@ -87,6 +87,28 @@ func (m *Monitor) GetVideoMode() GLFWvidmode {
 	return glfwGetVideoMode(m)
 }
 
+// HDRInfo describes the colour capabilities of a monitor.
+// The primaries and white point are CIE xy chromaticities and
+// the luminance values are in nits.
+type HDRInfo struct {
+	Enabled               bool // The monitor is in HDR (advanced colour) mode
+	BitsPerColor          int  // Bits per colour channel of the display signal
+	RedPrimary            [2]float32
+	GreenPrimary          [2]float32
+	BluePrimary           [2]float32
+	WhitePoint            [2]float32
+	MinLuminance          float32
+	MaxLuminance          float32 // Peak luminance of a small area
+	MaxFullFrameLuminance float32
+}
+
+// GetHDRInfo returns the HDR capabilities of the monitor. The primaries and
+// luminance values come from the monitor description, so they are also
+// reported when HDR is not enabled. It needs Windows 10 version 1703 or later.
+func (m *Monitor) GetHDRInfo() (HDRInfo, error) {
+	return glfwPlatformGetHDRInfo(m)
+}
+
 // GetVideoModes returns a slice with all the monitor's video modes
 func (m *Monitor) GetVideoModes() []GLFWvidmode {
 	if !refreshVideoModes(m) {
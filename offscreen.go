@@ -0,0 +1,272 @@
+package glfw
+
+import (
+	"fmt"
+	"image"
+	"unsafe"
+)
+
+// Off-screen windows render to a framebuffer object in the context of a
+// hidden window. ReadPixels copies the rendered image back to Go memory.
+
+const (
+	_GL_FRAMEBUFFER               = 0x8D40
+	_GL_READ_FRAMEBUFFER          = 0x8CA8
+	_GL_READ_FRAMEBUFFER_BINDING  = 0x8CAA
+	_GL_RENDERBUFFER              = 0x8D41
+	_GL_COLOR_ATTACHMENT0         = 0x8CE0
+	_GL_DEPTH_STENCIL_ATTACHMENT  = 0x821A
+	_GL_FRAMEBUFFER_COMPLETE      = 0x8CD5
+	_GL_RGBA8                     = 0x8058
+	_GL_SRGB8_ALPHA8              = 0x8C43
+	_GL_RGBA16F                   = 0x881A
+	_GL_DEPTH24_STENCIL8          = 0x88F0
+	_GL_FRAMEBUFFER_SRGB          = 0x8DB9
+	_GL_FRONT                     = 0x0404
+	_GL_BACK                      = 0x0405
+	_GL_READ_BUFFER               = 0x0C02
+	_GL_PACK_ALIGNMENT            = 0x0D05
+	_GL_PIXEL_PACK_BUFFER         = 0x88EB
+	_GL_PIXEL_PACK_BUFFER_BINDING = 0x88ED
+	_GL_RGBA                      = 0x1908
+	_GL_UNSIGNED_BYTE             = 0x1401
+	_GL_NO_ERROR                  = 0
+)
+
+type _GLFWoffscreen struct {
+	fbo           uint32
+	color         uint32
+	depthStencil  uint32
+	colorFormat   uint32
+	width, height int32
+}
+
+// ColorBuffer selects the buffer read by ReadPixels.
+type ColorBuffer int
+
+const (
+	BackBuffer  ColorBuffer = iota // The buffer being rendered to
+	FrontBuffer                    // The buffer shown by the last SwapBuffers
+)
+
+// OffscreenFramebuffer returns the name of the framebuffer object used by an
+// off-screen window, or 0 for other windows. It is bound when the window is
+// created, and must be bound instead of framebuffer 0 when rendering to the window.
+func (w *Window) OffscreenFramebuffer() uint32 {
+	return w.offscreen.fbo
+}
+
+// ReadPixels returns the content of a colour buffer of the window, with the
+// top row first. The context of the window is made current during the call.
+// The values are returned as stored, which for an sRGB capable framebuffer
+// means sRGB encoded. Floating point values are clamped to [0,1].
+// The alpha channel is set to opaque unless the framebuffer is transparent.
+// Off-screen windows always return their framebuffer object.
+func (w *Window) ReadPixels(buffer ColorBuffer) (*image.RGBA, error) {
+	if w.context.client == NoAPI {
+		return nil, fmt.Errorf("ReadPixels: the window has no OpenGL context")
+	}
+	previous := getCurrentWindow()
+	if previous != w {
+		if err := glfwMakeContextCurrent(w); err != nil {
+			return nil, err
+		}
+		defer func() { _ = glfwMakeContextCurrent(previous) }()
+	}
+	width, height := glfwGetFramebufferSize(w)
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	if width <= 0 || height <= 0 {
+		return img, nil
+	}
+	c := w.context
+	getInteger := func(name uint32) int32 {
+		var v int32
+		c.call("glGetIntegerv", uintptr(name), uintptr(unsafe.Pointer(&v)))
+		return v
+	}
+	fboSupported := c.major >= 3 || ExtensionSupported("GL_ARB_framebuffer_object")
+	// OpenGL ES has no GL_FRAMEBUFFER_SRGB without GL_EXT_sRGB_write_control
+	var srgbSupported bool
+	if c.client == OpenGLAPI {
+		srgbSupported = c.major >= 3 || ExtensionSupported("GL_ARB_framebuffer_sRGB") || ExtensionSupported("GL_EXT_framebuffer_sRGB")
+	} else {
+		srgbSupported = ExtensionSupported("GL_EXT_sRGB_write_control")
+	}
+	pboSupported := c.major > 2 || c.major == 2 && c.minor >= 1
+
+	// Clear the errors left by the application, so only those of the read
+	// are reported. The loop is bounded as a lost context may keep returning
+	// an error.
+	for i := 0; i < 16 && uint32(c.call("glGetError")) != _GL_NO_ERROR; i++ {
+	}
+
+	// Select the buffer, saving the state that is changed
+	var readFramebuffer, packBuffer int32
+	if fboSupported {
+		readFramebuffer = getInteger(_GL_READ_FRAMEBUFFER_BINDING)
+		c.call("glBindFramebuffer", _GL_READ_FRAMEBUFFER, uintptr(w.offscreen.fbo))
+	}
+	// OpenGL ES 2.0 has no glReadBuffer, and always reads the back buffer
+	readBufferSupported := c.lookup("glReadBuffer") != 0
+	var readBuffer int32
+	if readBufferSupported {
+		readBuffer = getInteger(_GL_READ_BUFFER)
+		switch {
+		case w.offscreen.fbo != 0:
+			c.call("glReadBuffer", _GL_COLOR_ATTACHMENT0)
+		case buffer == FrontBuffer && w.doublebuffer:
+			c.call("glReadBuffer", _GL_FRONT)
+		default:
+			c.call("glReadBuffer", _GL_BACK)
+		}
+	}
+	if pboSupported {
+		packBuffer = getInteger(_GL_PIXEL_PACK_BUFFER_BINDING)
+		c.call("glBindBuffer", _GL_PIXEL_PACK_BUFFER, 0)
+	}
+	alignment := getInteger(_GL_PACK_ALIGNMENT)
+	c.call("glPixelStorei", _GL_PACK_ALIGNMENT, 4)
+	// Disable sRGB conversion so the stored values are returned unchanged
+	srgb := srgbSupported && c.call("glIsEnabled", _GL_FRAMEBUFFER_SRGB)&0xFF != 0
+	if srgb {
+		c.call("glDisable", _GL_FRAMEBUFFER_SRGB)
+	}
+
+	c.call("glReadPixels", 0, 0, uintptr(width), uintptr(height), _GL_RGBA, _GL_UNSIGNED_BYTE, uintptr(unsafe.Pointer(&img.Pix[0])))
+	glErr := uint32(c.call("glGetError"))
+
+	// Restore the state
+	if srgb {
+		c.call("glEnable", _GL_FRAMEBUFFER_SRGB)
+	}
+	c.call("glPixelStorei", _GL_PACK_ALIGNMENT, uintptr(alignment))
+	if pboSupported {
+		c.call("glBindBuffer", _GL_PIXEL_PACK_BUFFER, uintptr(packBuffer))
+	}
+	if readBufferSupported {
+		c.call("glReadBuffer", uintptr(readBuffer))
+	}
+	if fboSupported {
+		c.call("glBindFramebuffer", _GL_READ_FRAMEBUFFER, uintptr(readFramebuffer))
+	}
+	if glErr != _GL_NO_ERROR {
+		return nil, fmt.Errorf("ReadPixels: OpenGL error 0x%04X", glErr)
+	}
+
+	// OpenGL has the origin in the lower left corner
+	stride := img.Stride
+	row := make([]byte, stride)
+	for y := 0; y < height/2; y++ {
+		top := img.Pix[y*stride : (y+1)*stride]
+		bottom := img.Pix[(height-1-y)*stride : (height-y)*stride]
+		copy(row, top)
+		copy(top, bottom)
+		copy(bottom, row)
+	}
+	if !w.Win32.transparent {
+		for i := 3; i < len(img.Pix); i += 4 {
+			img.Pix[i] = 0xFF
+		}
+	}
+	return img, nil
+}
+
+// Screenshot returns the image last presented by SwapBuffers, or the
+// rendered image for single buffered and off-screen windows.
+func (w *Window) Screenshot() (*image.RGBA, error) {
+	return w.ReadPixels(FrontBuffer)
+}
+
+// createOffscreenFramebuffer creates the framebuffer object of an off-screen
+// window and binds it in the context of the window
+func createOffscreenFramebuffer(w *_GLFWwindow, width, height int32, fbconfig *_GLFWfbconfig) error {
+	previous := getCurrentWindow()
+	if err := glfwMakeContextCurrent(w); err != nil {
+		return err
+	}
+	defer func() { _ = glfwMakeContextCurrent(previous) }()
+	c := w.context
+	if c.major < 3 && !ExtensionSupported("GL_ARB_framebuffer_object") {
+		return fmt.Errorf("offscreen windows need OpenGL 3.0 or GL_ARB_framebuffer_object")
+	}
+	switch {
+	case fbconfig.floatBuffer:
+		w.offscreen.colorFormat = _GL_RGBA16F
+	case fbconfig.sRGB:
+		w.offscreen.colorFormat = _GL_SRGB8_ALPHA8
+	default:
+		w.offscreen.colorFormat = _GL_RGBA8
+	}
+	o := &w.offscreen
+	c.call("glGenFramebuffers", 1, uintptr(unsafe.Pointer(&o.fbo)))
+	c.call("glGenRenderbuffers", 1, uintptr(unsafe.Pointer(&o.color)))
+	if fbconfig.depthBits > 0 || fbconfig.stencilBits > 0 {
+		c.call("glGenRenderbuffers", 1, uintptr(unsafe.Pointer(&o.depthStencil)))
+	}
+	allocateOffscreenFramebuffer(w, width, height)
+	c.call("glBindFramebuffer", _GL_FRAMEBUFFER, uintptr(o.fbo))
+	c.call("glFramebufferRenderbuffer", _GL_FRAMEBUFFER, _GL_COLOR_ATTACHMENT0, _GL_RENDERBUFFER, uintptr(o.color))
+	if o.depthStencil != 0 {
+		c.call("glFramebufferRenderbuffer", _GL_FRAMEBUFFER, _GL_DEPTH_STENCIL_ATTACHMENT, _GL_RENDERBUFFER, uintptr(o.depthStencil))
+	}
+	if status := uint32(c.call("glCheckFramebufferStatus", _GL_FRAMEBUFFER)); status != _GL_FRAMEBUFFER_COMPLETE {
+		return fmt.Errorf("offscreen framebuffer is incomplete, status 0x%04X", status)
+	}
+	c.call("glViewport", 0, 0, uintptr(width), uintptr(height))
+	return nil
+}
+
+// deleteOffscreenFramebuffer deletes the framebuffer object and the
+// renderbuffers of an off-screen window
+func deleteOffscreenFramebuffer(w *_GLFWwindow) {
+	o := &w.offscreen
+	if o.fbo == 0 {
+		return
+	}
+	previous := getCurrentWindow()
+	if previous != w {
+		if glfwMakeContextCurrent(w) != nil {
+			return
+		}
+		defer func() { _ = glfwMakeContextCurrent(previous) }()
+	}
+	c := w.context
+	c.call("glBindFramebuffer", _GL_FRAMEBUFFER, 0)
+	c.call("glDeleteFramebuffers", 1, uintptr(unsafe.Pointer(&o.fbo)))
+	c.call("glDeleteRenderbuffers", 1, uintptr(unsafe.Pointer(&o.color)))
+	if o.depthStencil != 0 {
+		c.call("glDeleteRenderbuffers", 1, uintptr(unsafe.Pointer(&o.depthStencil)))
+	}
+	*o = _GLFWoffscreen{}
+}
+
+// resizeOffscreenFramebuffer changes the size of the framebuffer object.
+// The framebuffer size callback is called here instead of from the window size messages.
+func resizeOffscreenFramebuffer(w *_GLFWwindow, width, height int32) {
+	previous := getCurrentWindow()
+	if previous != w {
+		if glfwMakeContextCurrent(w) != nil {
+			return
+		}
+		defer func() { _ = glfwMakeContextCurrent(previous) }()
+	}
+	allocateOffscreenFramebuffer(w, width, height)
+	if w.framebufferSizeCallback != nil {
+		w.framebufferSizeCallback(w, int(width), int(height))
+	}
+}
+
+// allocateOffscreenFramebuffer sets the storage of the renderbuffers.
+// The context of the window must be current.
+func allocateOffscreenFramebuffer(w *_GLFWwindow, width, height int32) {
+	c := w.context
+	o := &w.offscreen
+	o.width, o.height = max(width, 1), max(height, 1)
+	c.call("glBindRenderbuffer", _GL_RENDERBUFFER, uintptr(o.color))
+	c.call("glRenderbufferStorage", _GL_RENDERBUFFER, uintptr(o.colorFormat), uintptr(o.width), uintptr(o.height))
+	if o.depthStencil != 0 {
+		c.call("glBindRenderbuffer", _GL_RENDERBUFFER, uintptr(o.depthStencil))
+		c.call("glRenderbufferStorage", _GL_RENDERBUFFER, _GL_DEPTH24_STENCIL8, uintptr(o.width), uintptr(o.height))
+	}
+	c.call("glBindRenderbuffer", _GL_RENDERBUFFER, 0)
+}
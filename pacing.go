@@ -0,0 +1,217 @@
+package glfw
+
+// pacing.go contains the frame pacing helper. The frame statistics only
+// depend on the clock given to the pacer, so they can be driven by a fake clock.
+// The DWM composition statistics are sampled through the platform functions.
+
+import (
+	"math"
+	"slices"
+	"time"
+)
+
+// FrameStats summarizes the frames recorded by a FramePacer.
+// All times are in seconds.
+type FrameStats struct {
+	Frames         int     // Number of frame times in the history
+	FPS            float64 // Average frames per second over the history
+	Mean           float64 // Mean frame time
+	Min            float64 // Shortest frame time
+	Max            float64 // Longest frame time
+	P50            float64 // Median frame time
+	P95            float64 // 95th percentile frame time
+	P99            float64 // 99th percentile frame time
+	MissedFrames   uint64  // Refreshes where the compositor had no new frame, since the pacer was created or reset
+	DroppedFrames  uint64  // Frames discarded by the compositor, since the pacer was created or reset
+	RefreshPeriod  float64 // Display refresh period reported by the compositor, 0 if unknown
+	PresentLatency float64 // Time from SwapBuffers until the frame was displayed, 0 if unknown
+}
+
+// FramePacer keeps a history of frame times for a window and can limit the
+// frame rate when vsync is off. Call its SwapBuffers instead of Window.SwapBuffers.
+type FramePacer struct {
+	window    *Window
+	now       func() float64
+	sleep     func(time.Duration)
+	timing    func() (dwmTimingInfo, bool)
+	history   []float64
+	next      int
+	count     int
+	last      float64
+	started   bool
+	targetFPS float64
+	flush     bool
+	dwm       struct {
+		valid         bool
+		baseMissed    uint64
+		baseDropped   uint64
+		missed        uint64
+		dropped       uint64
+		refreshPeriod float64
+		latency       float64
+		swaps         [8]uint64 // Timer values of the most recent swaps
+		swapIndex     int
+	}
+}
+
+// NewFramePacer returns a frame pacer for the window, keeping the given
+// number of frame times. The window may be nil, in which case only Tick
+// can be used to record frames.
+func NewFramePacer(window *Window, historySize int) *FramePacer {
+	if historySize <= 0 {
+		panic("NewFramePacer: history size must be positive")
+	}
+	return &FramePacer{
+		window:  window,
+		now:     GetTime,
+		sleep:   time.Sleep,
+		timing:  glfwPlatformGetCompositionTiming,
+		history: make([]float64, historySize),
+	}
+}
+
+// SetClock replaces the clock and sleep functions used by the pacer.
+// The clock returns the time in seconds. This is mainly used for testing.
+func (p *FramePacer) SetClock(now func() float64, sleep func(time.Duration)) {
+	p.now = now
+	p.sleep = sleep
+}
+
+// SetTargetFPS sets the frame rate limit used when the swap interval is zero.
+// A value of zero or less disables the limiter.
+func (p *FramePacer) SetTargetFPS(fps float64) {
+	p.targetFPS = max(0, fps)
+}
+
+// SetCompositionSync makes SwapBuffers wait for the next compositor pass
+// after swapping, using DwmFlush. It has no effect when composition is disabled.
+func (p *FramePacer) SetCompositionSync(enabled bool) {
+	p.flush = enabled
+}
+
+// SwapBuffers waits if needed to keep the target frame rate, swaps the
+// buffers of the window and records the frame.
+func (p *FramePacer) SwapBuffers() {
+	p.limit()
+	if p.window != nil {
+		p.window.SwapBuffers()
+		p.dwm.swaps[p.dwm.swapIndex] = glfwPlatformGetTimerValue()
+		p.dwm.swapIndex = (p.dwm.swapIndex + 1) % len(p.dwm.swaps)
+		if p.flush && DwmIsCompositionEnabled() {
+			DwmFlush()
+		}
+		p.sampleComposition()
+	}
+	p.Tick()
+}
+
+// Tick records the end of a frame at the current clock time.
+// The first call only sets the starting point.
+func (p *FramePacer) Tick() {
+	t := p.now()
+	if p.started {
+		p.history[p.next] = t - p.last
+		p.next = (p.next + 1) % len(p.history)
+		p.count = min(p.count+1, len(p.history))
+	}
+	p.last = t
+	p.started = true
+}
+
+// Reset clears the frame history and the compositor counters.
+func (p *FramePacer) Reset() {
+	p.next = 0
+	p.count = 0
+	p.started = false
+	p.dwm.valid = false
+	p.dwm.latency = 0
+}
+
+// History returns the recorded frame times in seconds, oldest first.
+func (p *FramePacer) History() []float64 {
+	h := make([]float64, 0, p.count)
+	start := (p.next - p.count + len(p.history)) % len(p.history)
+	for i := 0; i < p.count; i++ {
+		h = append(h, p.history[(start+i)%len(p.history)])
+	}
+	return h
+}
+
+// Stats returns statistics for the recorded frames.
+func (p *FramePacer) Stats() FrameStats {
+	var s FrameStats
+	if p.dwm.valid {
+		s.MissedFrames = p.dwm.missed - p.dwm.baseMissed
+		s.DroppedFrames = p.dwm.dropped - p.dwm.baseDropped
+		s.RefreshPeriod = p.dwm.refreshPeriod
+		s.PresentLatency = p.dwm.latency
+	}
+	h := p.History()
+	s.Frames = len(h)
+	if len(h) == 0 {
+		return s
+	}
+	s.Min = math.Inf(1)
+	var sum float64
+	for _, d := range h {
+		sum += d
+		s.Min = min(s.Min, d)
+		s.Max = max(s.Max, d)
+	}
+	s.Mean = sum / float64(len(h))
+	if s.Mean > 0 {
+		s.FPS = 1 / s.Mean
+	}
+	slices.Sort(h)
+	s.P50 = percentile(h, 50)
+	s.P95 = percentile(h, 95)
+	s.P99 = percentile(h, 99)
+	return s
+}
+
+// percentile returns the nearest-rank percentile of the sorted values
+func percentile(sorted []float64, pct float64) float64 {
+	rank := int(math.Ceil(pct / 100 * float64(len(sorted))))
+	return sorted[min(max(rank-1, 0), len(sorted)-1)]
+}
+
+// limit sleeps until the next frame is due when the limiter is enabled and vsync is off
+func (p *FramePacer) limit() {
+	if p.targetFPS <= 0 || !p.started {
+		return
+	}
+	if p.window != nil && p.window.context.wgl.interval != 0 {
+		return
+	}
+	wait := p.last + 1/p.targetFPS - p.now()
+	if wait > 0 {
+		p.sleep(time.Duration(wait * 1e9))
+	}
+}
+
+// sampleComposition updates the compositor counters and the present latency
+func (p *FramePacer) sampleComposition() {
+	info, ok := p.timing()
+	if !ok {
+		return
+	}
+	freq := float64(glfwPlatformGetTimerFrequency())
+	if !p.dwm.valid {
+		p.dwm.baseMissed = info.cFramesMissed
+		p.dwm.baseDropped = info.cFramesDropped
+		p.dwm.valid = true
+	}
+	p.dwm.missed = info.cFramesMissed
+	p.dwm.dropped = info.cFramesDropped
+	p.dwm.refreshPeriod = float64(info.qpcRefreshPeriod) / freq
+	// The latency is measured from the latest swap before the displayed frame
+	var swap uint64
+	for _, t := range p.dwm.swaps {
+		if t != 0 && t <= info.qpcFrameDisplayed && t > swap {
+			swap = t
+		}
+	}
+	if swap != 0 {
+		p.dwm.latency = float64(info.qpcFrameDisplayed-swap) / freq
+	}
+}
@@ -0,0 +1,144 @@
+package glfw
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+// fakeClock is a clock for FramePacer that only advances when told to
+type fakeClock struct {
+	t     float64
+	slept []time.Duration
+}
+
+func (c *fakeClock) now() float64 { return c.t }
+
+func (c *fakeClock) sleep(d time.Duration) {
+	c.slept = append(c.slept, d)
+	c.t += d.Seconds()
+}
+
+func newTestPacer(historySize int) (*FramePacer, *fakeClock) {
+	c := &fakeClock{t: 10}
+	p := NewFramePacer(nil, historySize)
+	p.SetClock(c.now, c.sleep)
+	return p, c
+}
+
+func TestFramePacerHistory(t *testing.T) {
+	p, c := newTestPacer(3)
+	if h := p.History(); len(h) != 0 {
+		t.Fatalf("History before the first frame = %v, want empty", h)
+	}
+	// The first tick only sets the starting point
+	p.Tick()
+	for _, d := range []float64{0.25, 0.5, 0.125, 1} {
+		c.t += d
+		p.Tick()
+	}
+	if h, want := p.History(), []float64{0.5, 0.125, 1}; !slices.Equal(h, want) {
+		t.Errorf("History = %v, want %v", h, want)
+	}
+	p.Reset()
+	if h := p.History(); len(h) != 0 {
+		t.Errorf("History after Reset = %v, want empty", h)
+	}
+	p.Tick()
+	c.t += 0.5
+	p.Tick()
+	if h, want := p.History(), []float64{0.5}; !slices.Equal(h, want) {
+		t.Errorf("History after Reset and two ticks = %v, want %v", h, want)
+	}
+}
+
+func TestFramePacerStats(t *testing.T) {
+	p, c := newTestPacer(100)
+	if s := p.Stats(); s != (FrameStats{}) {
+		t.Errorf("Stats without frames = %+v, want zero", s)
+	}
+	p.Tick()
+	// 98 frames of 1/64 s and two slow frames
+	for i := range 100 {
+		d := 1.0 / 64
+		if i == 40 || i == 70 {
+			d = 0.25
+		}
+		c.t += d
+		p.Tick()
+	}
+	s := p.Stats()
+	if s.Frames != 100 {
+		t.Errorf("Frames = %d, want 100", s.Frames)
+	}
+	mean := (98.0/64 + 0.5) / 100
+	if diff := s.Mean - mean; diff > 1e-12 || diff < -1e-12 {
+		t.Errorf("Mean = %v, want %v", s.Mean, mean)
+	}
+	if diff := s.FPS - 1/mean; diff > 1e-9 || diff < -1e-9 {
+		t.Errorf("FPS = %v, want %v", s.FPS, 1/mean)
+	}
+	if s.Min != 1.0/64 || s.Max != 0.25 {
+		t.Errorf("Min, Max = %v, %v, want %v, %v", s.Min, s.Max, 1.0/64, 0.25)
+	}
+	if s.P50 != 1.0/64 || s.P95 != 1.0/64 || s.P99 != 0.25 {
+		t.Errorf("P50, P95, P99 = %v, %v, %v, want %v, %v, %v", s.P50, s.P95, s.P99, 1.0/64, 1.0/64, 0.25)
+	}
+	if s.MissedFrames != 0 || s.DroppedFrames != 0 || s.RefreshPeriod != 0 || s.PresentLatency != 0 {
+		t.Errorf("compositor statistics without a window = %+v, want zero", s)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	sorted := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	tests := []struct {
+		pct  float64
+		want float64
+	}{
+		{0, 1},
+		{10, 1},
+		{11, 2},
+		{50, 5},
+		{95, 10},
+		{99, 10},
+		{100, 10},
+	}
+	for _, tt := range tests {
+		if got := percentile(sorted, tt.pct); got != tt.want {
+			t.Errorf("percentile(1..10, %v) = %v, want %v", tt.pct, got, tt.want)
+		}
+	}
+	if got := percentile([]float64{3}, 99); got != 3 {
+		t.Errorf("percentile([3], 99) = %v, want 3", got)
+	}
+}
+
+func TestFramePacerLimit(t *testing.T) {
+	p, c := newTestPacer(10)
+	p.SetTargetFPS(4)
+	// Nothing to wait for before the first frame
+	p.SwapBuffers()
+	if len(c.slept) != 0 {
+		t.Fatalf("slept %v before the first frame", c.slept)
+	}
+	c.t += 0.125
+	p.SwapBuffers()
+	if want := []time.Duration{125 * time.Millisecond}; !slices.Equal(c.slept, want) {
+		t.Errorf("slept %v, want %v", c.slept, want)
+	}
+	if h, want := p.History(), []float64{0.25}; !slices.Equal(h, want) {
+		t.Errorf("History = %v, want %v", h, want)
+	}
+	// A late frame does not wait
+	c.slept = nil
+	c.t += 0.5
+	p.SwapBuffers()
+	if len(c.slept) != 0 {
+		t.Errorf("slept %v after a late frame", c.slept)
+	}
+	p.SetTargetFPS(0)
+	p.SwapBuffers()
+	if len(c.slept) != 0 {
+		t.Errorf("slept %v with the limiter disabled", c.slept)
+	}
+}
@@ -0,0 +1,66 @@
+package glfw
+
+import (
+	"fmt"
+	"image"
+	"unsafe"
+)
+
+// Present shows a CPU rendered image in a window created with the NoAPI client
+// API. The image is scaled to fill the content area, so for a one to one
+// mapping it should have the size returned by GetFramebufferSize, which is in
+// physical pixels and follows DPI changes. The alpha channel is ignored.
+// The image is copied, and is repainted by the window when needed until
+// the next call. No OpenGL is used.
+func (w *Window) Present(img *image.RGBA) error {
+	if w.context.client != NoAPI {
+		return fmt.Errorf("Present: the window has an OpenGL context, use SwapBuffers")
+	}
+	width, height := img.Rect.Dx(), img.Rect.Dy()
+	if width <= 0 || height <= 0 {
+		return fmt.Errorf("Present: the image is empty")
+	}
+	p := &w.Win32.present
+	if len(p.pix) != width*height*4 {
+		p.pix = make([]byte, width*height*4)
+	}
+	p.width, p.height = int32(width), int32(height)
+	// Convert RGBA to the BGRX layout of 32-bit DIBs
+	for y := 0; y < height; y++ {
+		src := img.Pix[img.PixOffset(img.Rect.Min.X, img.Rect.Min.Y+y):]
+		dst := p.pix[y*width*4:]
+		for x := 0; x < width*4; x += 4 {
+			dst[x+0] = src[x+2]
+			dst[x+1] = src[x+1]
+			dst[x+2] = src[x+0]
+			dst[x+3] = 0
+		}
+	}
+	dc := getDC(w.Win32.Handle)
+	defer releaseDC(w.Win32.Handle, dc)
+	if !blitPresented(w, dc) {
+		return fmt.Errorf("Present: StretchDIBits failed")
+	}
+	return nil
+}
+
+// blitPresented draws the last presented image scaled to the content area
+func blitPresented(w *_GLFWwindow, dc HDC) bool {
+	p := &w.Win32.present
+	area := GetClientRect(w.Win32.Handle)
+	if area.Right <= 0 || area.Bottom <= 0 {
+		// Iconified
+		return true
+	}
+	bi := BITMAPV5HEADER{
+		bV5Width:       p.width,
+		bV5Height:      -p.height, // Top-down
+		bV5Planes:      1,
+		bV5BitCount:    32,
+		bV5Compression: BI_RGB,
+	}
+	bi.bV5Size = uint32(unsafe.Sizeof(bi))
+	SetStretchBltMode(dc, COLORONCOLOR)
+	r := StretchDIBits(dc, 0, 0, area.Right, area.Bottom, 0, 0, p.width, p.height, &p.pix[0], &bi, DIB_RGB_COLORS, SRCCOPY)
+	return r != 0
+}
@@ -0,0 +1,145 @@
+package glfw
+
+import (
+	"fmt"
+	"syscall"
+)
+
+// ResetStatus is the cause of a graphics reset, as returned by glGetGraphicsResetStatus.
+type ResetStatus uint32
+
+const (
+	GuiltyContextReset   ResetStatus = 0x8253 // The reset was caused by this context
+	InnocentContextReset ResetStatus = 0x8254 // The reset was caused by another context
+	UnknownContextReset  ResetStatus = 0x8255 // The cause of the reset is unknown
+)
+
+func (s ResetStatus) String() string {
+	switch s {
+	case GuiltyContextReset:
+		return "guilty"
+	case InnocentContextReset:
+		return "innocent"
+	case UnknownContextReset:
+		return "unknown"
+	}
+	return fmt.Sprintf("ResetStatus(0x%04X)", uint32(s))
+}
+
+// getGraphicsResetStatusProc returns the reset status query of the current
+// context, from OpenGL 4.5, OpenGL ES 3.2 or one of the robustness extensions.
+func getGraphicsResetStatusProc(window *_GLFWwindow) uintptr {
+	names := []string{"glGetGraphicsResetStatus"}
+	if ExtensionSupported("GL_ARB_robustness") {
+		names = append(names, "glGetGraphicsResetStatusARB")
+	}
+	if ExtensionSupported("GL_EXT_robustness") {
+		names = append(names, "glGetGraphicsResetStatusEXT")
+	}
+	if ExtensionSupported("GL_KHR_robustness") {
+		names = append(names, "glGetGraphicsResetStatusKHR")
+	}
+	for _, name := range names {
+		if proc := window.context.getProcAddress(name); proc != 0 {
+			return proc
+		}
+	}
+	return 0
+}
+
+// checkGraphicsReset calls the context lost callback the first time a reset
+// is reported. The status can only be queried while the context is current.
+func checkGraphicsReset(window *_GLFWwindow) {
+	c := window.context
+	if c.lost || c.GetGraphicsResetStatus == 0 || getCurrentWindow() != window {
+		return
+	}
+	r, _, _ := syscall.SyscallN(c.GetGraphicsResetStatus)
+	if status := ResetStatus(uint32(r)); status != 0 {
+		c.lost = true
+		if window.contextLostCallback != nil {
+			window.contextLostCallback(window, status)
+		}
+	}
+}
+
+// IsContextLost returns true when a graphics reset has been detected for the
+// context of the window, and it has not yet been recreated.
+func (w *Window) IsContextLost() bool {
+	return w.context.lost
+}
+
+// RecreateContext replaces the context of the window with a new one, created
+// with the same hints as the original, keeping the window itself. All OpenGL
+// objects are lost and must be created again, including the entry points
+// loaded by the gl package, as these may be specific to a context. A context
+// that was current on the calling thread is made current again, with the same
+// swap interval. Shared contexts created with CreateSharedContext no longer
+// share objects with the window, so they should be destroyed and created again.
+// If the new context cannot be created, the window keeps the old one.
+// This function may only be called from the main thread.
+func (w *Window) RecreateContext() error {
+	if w.context.client == NoAPI {
+		return fmt.Errorf("RecreateContext: the window has no OpenGL context")
+	}
+	current := getCurrentWindow() == w
+	interval := w.context.wgl.interval
+	if current {
+		_ = glfwMakeContextCurrent(nil)
+	}
+	// Create the new context beside the old one, which is kept if this fails
+	old, oldOffscreen := *w.context, w.offscreen
+	offscreen := w.offscreen.fbo != 0
+	*w.context = _GLFWcontext{}
+	w.offscreen = _GLFWoffscreen{}
+	if err := recreateContext(w, offscreen, oldOffscreen.width, oldOffscreen.height); err != nil {
+		if getCurrentWindow() == w {
+			_ = glfwMakeContextCurrent(nil)
+		}
+		destroyContext(w)
+		*w.context, w.offscreen = old, oldOffscreen
+		if current {
+			_ = glfwMakeContextCurrent(w)
+		}
+		return fmt.Errorf("RecreateContext: %w", err)
+	}
+	if getCurrentWindow() == w {
+		_ = glfwMakeContextCurrent(nil)
+	}
+	created := *w.context
+	*w.context = old
+	destroyContext(w)
+	*w.context = created
+
+	if current {
+		if err := glfwMakeContextCurrent(w); err != nil {
+			return err
+		}
+		w.context.swapInterval(interval)
+	}
+	return nil
+}
+
+// recreateContext creates a context for the window with its original hints,
+// and the framebuffer object of an off-screen window
+func recreateContext(w *_GLFWwindow, offscreen bool, width, height int32) error {
+	ctxconfig := w.ctxconfig
+	if err := glfwCreateContextWGL(w, &ctxconfig, &w.fbconfig); err != nil {
+		return err
+	}
+	if err := glfwRefreshContextAttribs(w, &ctxconfig); err != nil {
+		return err
+	}
+	if offscreen {
+		return createOffscreenFramebuffer(w, width, height, &w.fbconfig)
+	}
+	return nil
+}
+
+// destroyContext destroys the context of the window, if it has one
+func destroyContext(w *_GLFWwindow) {
+	if w.context.destroy != nil {
+		w.context.destroy(w)
+		trackResource("context", -1)
+	}
+}
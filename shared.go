@@ -0,0 +1,71 @@
+package glfw
+
+import (
+	"fmt"
+	"slices"
+)
+
+// SharedContext is an OpenGL context without a visible surface, sharing
+// objects such as textures and buffers with a window. It is typically made
+// current on a loader goroutine to upload resources in the background.
+type SharedContext struct {
+	window *_GLFWwindow
+	parent *_GLFWwindow
+}
+
+// CreateSharedContext creates a headless context with the same version and
+// pixel format as the window, sharing objects with it. The context is backed
+// by a hidden window, so it must be created and destroyed on the main thread.
+// It is destroyed together with the window, and by Terminate.
+func (w *Window) CreateSharedContext() (*SharedContext, error) {
+	if w.context.client == NoAPI {
+		return nil, fmt.Errorf("CreateSharedContext: the window has no OpenGL context")
+	}
+	wndconfig := _glfw.hints.window
+	wndconfig.width, wndconfig.height = 1, 1
+	wndconfig.title = ""
+	wndconfig.visible = false
+	wndconfig.focused = false
+	wndconfig.maximized = false
+	wndconfig.offscreen = false
+	ctxconfig := w.ctxconfig
+	ctxconfig.share = w
+	fbconfig := w.fbconfig
+	fbconfig.transparent = false
+	window, err := glfwCreateWindowConfig(nil, &wndconfig, &ctxconfig, &fbconfig)
+	if err != nil {
+		return nil, err
+	}
+	sc := &SharedContext{window: window, parent: w}
+	w.shared = append(w.shared, sc)
+	return sc, nil
+}
+
+// MakeCurrent makes the context current on the calling thread. Lock the
+// goroutine to its thread with runtime.LockOSThread before calling it.
+// A context can only be current on one thread at a time.
+func (sc *SharedContext) MakeCurrent() error {
+	if sc.window == nil || sc.window.Win32.Handle == 0 {
+		return fmt.Errorf("MakeCurrent: the shared context is destroyed")
+	}
+	return glfwMakeContextCurrent(sc.window)
+}
+
+// Release detaches the context from the calling thread, if it is current there.
+func (sc *SharedContext) Release() {
+	if sc.window != nil && getCurrentWindow() == sc.window {
+		_ = glfwMakeContextCurrent(nil)
+	}
+}
+
+// Destroy destroys the context. It must not be current on any other thread,
+// so call Release on the loader thread first. This function may only be
+// called from the main thread.
+func (sc *SharedContext) Destroy() {
+	if sc.window == nil {
+		return
+	}
+	glfwDestroyWindow(sc.window)
+	sc.parent.shared = slices.DeleteFunc(sc.parent.shared, func(s *SharedContext) bool { return s == sc })
+	sc.window = nil
+}
@@ -1,6 +1,7 @@
 package glfw
 
 import (
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"strconv"
@@ -17,6 +18,10 @@ import (
 var (
 	dwmapi                   = windows.NewLazySystemDLL("dwmapi.dll")
 	_DwmIsCompositionEnabled = dwmapi.NewProc("DwmIsCompositionEnabled")
+	_DwmGetCompositionTiming = dwmapi.NewProc("DwmGetCompositionTimingInfo")
+	_DwmFlush                = dwmapi.NewProc("DwmFlush")
+	_DwmEnableBlurBehind     = dwmapi.NewProc("DwmEnableBlurBehindWindow")
+	_DwmGetColorizationColor = dwmapi.NewProc("DwmGetColorizationColor")
 )
 
 var (
@@ -26,11 +31,15 @@ var (
 	_DeleteDC            = gdi32.NewProc("DeleteDC")
 	_SwapBuffers         = gdi32.NewProc("SwapBuffers")
 	_SetPixelFormat      = gdi32.NewProc("SetPixelFormat")
+	_GetPixelFormat      = gdi32.NewProc("GetPixelFormat")
 	_ChoosePixelFormat   = gdi32.NewProc("ChoosePixelFormat")
 	_DescribePixelFormat = gdi32.NewProc("DescribePixelFormat")
 	_CreateDIBSection    = gdi32.NewProc("CreateDIBSection")
 	_CreateBitmap        = gdi32.NewProc("CreateBitmap")
 	_DeleteObject        = gdi32.NewProc("DeleteObject")
+	_CreateRectRgn       = gdi32.NewProc("CreateRectRgn")
+	_StretchDIBits       = gdi32.NewProc("StretchDIBits")
+	_SetStretchBltMode   = gdi32.NewProc("SetStretchBltMode")
 )
 
 var (
@@ -53,6 +62,8 @@ var (
 	_GlobalLock              = kernel32.NewProc("GlobalLock")
 	_GlobalUnlock            = kernel32.NewProc("GlobalUnlock")
 	_RtlMoveMemory           = kernel32.NewProc("RtlMoveMemory")
+	_QueryPerformanceCounter = kernel32.NewProc("QueryPerformanceCounter")
+	_QueryPerformanceFreq    = kernel32.NewProc("QueryPerformanceFrequency")
 )
 
 var (
@@ -78,6 +89,8 @@ var (
 	_WaitMessage                   = user32.NewProc("WaitMessage")
 	_RegisterClassExW              = user32.NewProc("RegisterClassExW")
 	_ReleaseDC                     = user32.NewProc("ReleaseDC")
+	_BeginPaint                    = user32.NewProc("BeginPaint")
+	_EndPaint                      = user32.NewProc("EndPaint")
 	_ScreenToClient                = user32.NewProc("ScreenToClient")
 	_ShowWindow                    = user32.NewProc("ShowWindow")
 	_SetCursor                     = user32.NewProc("SetCursor")
@@ -321,6 +334,22 @@ func IsWindows10Version1703OrGreater() bool {
 	return r == 0
 }
 
+// IsWindows8OrGreater is true for version 8.0 or newer
+// It will panic on errors.
+func IsWindows8OrGreater() bool {
+	var osvi _OSVERSIONINFOEXW
+	osvi.dwOSVersionInfoSize = uint32(unsafe.Sizeof(osvi))
+	osvi.dwMajorVersion = uint32(WIN32_WINNT_WIN8 >> 8)
+	osvi.dwMinorVersion = uint32(WIN32_WINNT_WIN8 & 0xFF)
+	osvi.wServicePackMajor = 0
+	var mask uint32 = VER_MAJORVERSION | VER_MINORVERSION | VER_SERVICEPACKMAJOR
+	r, _, err := _RtlVerifyVersionInfo.Call(uintptr(unsafe.Pointer(&osvi)), uintptr(mask), uintptr(0x800000000001801b))
+	if !errors.Is(err, syscall.Errno(0)) {
+		panic("IsWindows8OrGreater failed, " + err.Error())
+	}
+	return r == 0
+}
+
 // IsWindows8Point1OrGreater is true for version 8.10 or newer
 // It will panic on errors.
 func IsWindows8Point1OrGreater() bool {
@@ -344,19 +373,18 @@ func SetProcessDpiAwareness() {
 		_, _, err = _SetProcessDpiAwarenessContext.Call(uintptr(DPI_AWARENESS_CONTEXT_PER_MONITOR_AWARE_V2))
 		// We will get error 5=Access denied if the awareness is already set. So ignore this error.
 		if !errors.Is(err, syscall.Errno(0)) && !errors.Is(err, syscall.Errno(5)) {
-			fmt.Println("Win10.0 v17030 or greater: SetProcessDpiAwarenessContext failed, " + err.Error() + "\n")
+			glfwInputError(PlatformError, "Win32: SetProcessDpiAwarenessContext failed, "+err.Error())
 		}
 
 	} else if IsWindows8Point1OrGreater() {
 		_, _, err = _SetProcessDpiAwareness.Call(uintptr(PROCESS_PER_MONITOR_DPI_AWARE))
 		if !errors.Is(err, syscall.Errno(0)) && !errors.Is(err, syscall.Errno(5)) {
-			fmt.Printf("Your computer has a Window version older than Win10.0v17030. You should upgrade Windows immediately.\n")
-			fmt.Println("SetProcessDpiAwareness(" + strconv.Itoa(PROCESS_PER_MONITOR_DPI_AWARE) + ") failed, " + err.Error() + "\n")
+			glfwInputError(PlatformError, "Win32: SetProcessDpiAwareness("+strconv.Itoa(PROCESS_PER_MONITOR_DPI_AWARE)+") failed, "+err.Error())
 		}
 	} else {
 		_, _, err = _SetProcessDPIAware.Call()
 		if !errors.Is(err, syscall.Errno(0)) && !errors.Is(err, syscall.Errno(5)) {
-			fmt.Println("Before Win8.10: SetProcessDPIAware() failed, " + err.Error() + "\n")
+			glfwInputError(PlatformError, "Win32: SetProcessDPIAware failed, "+err.Error())
 		}
 	}
 }
@@ -393,7 +421,7 @@ func SetWindowLongW(hWnd syscall.Handle, index int, newValue uint32) {
 func EnumDisplayDevices(device uintptr, no int, adapter *DISPLAY_DEVICEW, flags uint32) error {
 	ret, _, err := _EnumDisplayDevicesW.Call(device, uintptr(no), uintptr(unsafe.Pointer(adapter)), uintptr(flags))
 	if ret == 0 || !errors.Is(err, syscall.Errno(0)) {
-		return fmt.Errorf("EnumDisplayDevices failed, " + err.Error())
+		return fmt.Errorf("EnumDisplayDevices failed, %v", err)
 	}
 	return nil
 }
@@ -592,7 +620,7 @@ func GetClassLongPtrW(hWnd syscall.Handle, nIndex int32) syscall.Handle {
 func SendMessage(hWnd syscall.Handle, Msg uint32, wParam uint16, Lparam uint32) uintptr {
 	r, _, err := _SendMessage.Call(uintptr(hWnd), uintptr(Msg), uintptr(wParam), uintptr(Lparam))
 	if !errors.Is(err, syscall.Errno(0)) {
-		fmt.Println("SendMessage failed, " + err.Error())
+		glfwInputError(PlatformError, "Win32: SendMessage failed, "+err.Error())
 	}
 	return r
 }
@@ -723,13 +751,95 @@ func ChangeDisplaySettingsEx(name *uint16, mode *DEVMODEW, hWnd syscall.Handle,
 	return int32(r)
 }
 
+// DwmEnableBlurBehindWindow enables or disables the blur behind the window.
+// Errors are ignored, as the window is then just left opaque.
+func DwmEnableBlurBehindWindow(hwnd syscall.Handle, bb *DWM_BLURBEHIND) {
+	_, _, _ = _DwmEnableBlurBehind.Call(uintptr(hwnd), uintptr(unsafe.Pointer(bb)))
+}
+
+// DwmGetColorizationColor returns the colour used for glass composition
+// and whether it is opaque
+func DwmGetColorizationColor() (color uint32, opaque bool, ok bool) {
+	var o int32
+	r, _, _ := _DwmGetColorizationColor.Call(uintptr(unsafe.Pointer(&color)), uintptr(unsafe.Pointer(&o)))
+	return color, o != 0, r == 0
+}
+
+func CreateRectRgn(left, top, right, bottom int32) syscall.Handle {
+	r, _, err := _CreateRectRgn.Call(uintptr(left), uintptr(top), uintptr(right), uintptr(bottom))
+	if r == 0 {
+		panic("CreateRectRgn failed, " + err.Error())
+	}
+	return syscall.Handle(r)
+}
+
+// StretchDIBits copies a device independent bitmap to a rectangle of the device context
+func StretchDIBits(dc HDC, xDest, yDest, wDest, hDest, xSrc, ySrc, wSrc, hSrc int32, bits *uint8, bmi *BITMAPV5HEADER, usage, rop uint32) int32 {
+	r, _, _ := _StretchDIBits.Call(uintptr(dc), uintptr(xDest), uintptr(yDest), uintptr(wDest), uintptr(hDest),
+		uintptr(xSrc), uintptr(ySrc), uintptr(wSrc), uintptr(hSrc),
+		uintptr(unsafe.Pointer(bits)), uintptr(unsafe.Pointer(bmi)), uintptr(usage), uintptr(rop))
+	return int32(r)
+}
+
+func SetStretchBltMode(dc HDC, mode int32) {
+	_, _, _ = _SetStretchBltMode.Call(uintptr(dc), uintptr(mode))
+}
+
+func BeginPaint(hwnd syscall.Handle, ps *PAINTSTRUCT) HDC {
+	r, _, _ := _BeginPaint.Call(uintptr(hwnd), uintptr(unsafe.Pointer(ps)))
+	return HDC(r)
+}
+
+func EndPaint(hwnd syscall.Handle, ps *PAINTSTRUCT) {
+	_, _, _ = _EndPaint.Call(uintptr(hwnd), uintptr(unsafe.Pointer(ps)))
+}
+
+// DwmIsCompositionEnabled returns true if DWM composition is enabled.
+// It is always enabled on Windows 8 and later.
 func DwmIsCompositionEnabled() bool {
 	var flag uint32
-	r, _, err := _DwmIsCompositionEnabled.Call(uintptr(unsafe.Pointer(&flag)))
-	if err != nil && !errors.Is(err, syscall.Errno(0)) {
-		return false
+	r, _, _ := _DwmIsCompositionEnabled.Call(uintptr(unsafe.Pointer(&flag)))
+	// The return value is an HRESULT, the result is returned in flag
+	return r == 0 && flag != 0
+}
+
+// DWM_TIMING_INFO is declared with 1-byte packing in dwmapi.h, so it is
+// read into a raw buffer and the fields we need are picked out by offset.
+const (
+	_DWM_TIMING_INFO_SIZE       = 292
+	_DWM_TI_QPC_REFRESH_PERIOD  = 12
+	_DWM_TI_QPC_VBLANK          = 28
+	_DWM_TI_C_REFRESH           = 36
+	_DWM_TI_C_FRAME_DISPLAYED   = 124
+	_DWM_TI_QPC_FRAME_DISPLAYED = 132
+	_DWM_TI_C_FRAMES_DROPPED    = 212
+	_DWM_TI_C_FRAMES_MISSED     = 220
+)
+
+// DwmGetCompositionTimingInfo retrieves the current composition timing information.
+// The window handle must be 0 on Windows 8.1 and later. Returns false if
+// composition is disabled or the call fails.
+func DwmGetCompositionTimingInfo(hWnd syscall.Handle) (dwmTimingInfo, bool) {
+	var buf [_DWM_TIMING_INFO_SIZE]byte
+	var info dwmTimingInfo
+	binary.LittleEndian.PutUint32(buf[0:], _DWM_TIMING_INFO_SIZE)
+	r, _, _ := _DwmGetCompositionTiming.Call(uintptr(hWnd), uintptr(unsafe.Pointer(&buf[0])))
+	if r != 0 {
+		return info, false
 	}
-	return r != 0
+	info.qpcRefreshPeriod = binary.LittleEndian.Uint64(buf[_DWM_TI_QPC_REFRESH_PERIOD:])
+	info.qpcVBlank = binary.LittleEndian.Uint64(buf[_DWM_TI_QPC_VBLANK:])
+	info.cRefresh = binary.LittleEndian.Uint64(buf[_DWM_TI_C_REFRESH:])
+	info.cFrameDisplayed = binary.LittleEndian.Uint64(buf[_DWM_TI_C_FRAME_DISPLAYED:])
+	info.qpcFrameDisplayed = binary.LittleEndian.Uint64(buf[_DWM_TI_QPC_FRAME_DISPLAYED:])
+	info.cFramesDropped = binary.LittleEndian.Uint64(buf[_DWM_TI_C_FRAMES_DROPPED:])
+	info.cFramesMissed = binary.LittleEndian.Uint64(buf[_DWM_TI_C_FRAMES_MISSED:])
+	return info, true
+}
+
+// DwmFlush blocks until the next DWM composition pass. Errors are ignored.
+func DwmFlush() {
+	_, _, _ = _DwmFlush.Call()
 }
 
 func ChangeWindowMessageFilterEx(hWnd syscall.Handle, msg uint32, action uint32, filter uintptr) bool {
@@ -819,3 +929,19 @@ func SetThreadExecutionState(state int) {
 		panic("SetThreadExecutionState failed, " + err.Error())
 	}
 }
+
+// QueryPerformanceCounter returns the current value of the high-resolution performance counter.
+// It never fails on Windows XP or later.
+func QueryPerformanceCounter() uint64 {
+	var count uint64
+	_, _, _ = _QueryPerformanceCounter.Call(uintptr(unsafe.Pointer(&count)))
+	return count
+}
+
+// QueryPerformanceFrequency returns the frequency of the performance counter in counts per second.
+// The frequency is fixed at system boot and is consistent across all processors.
+func QueryPerformanceFrequency() uint64 {
+	var freq uint64
+	_, _, _ = _QueryPerformanceFreq.Call(uintptr(unsafe.Pointer(&freq)))
+	return freq
+}
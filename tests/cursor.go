@@ -266,7 +266,7 @@ func CursorMain() {
 	}
 
 	window.MakeContextCurrent()
-	err = gl.Init()
+	err = gl.InitWithProcAddrFunc(glfw.GetProcAddress)
 	if err != nil {
 		glfw.Terminate()
 		fmt.Printf("gl Init error, " + err.Error())
@@ -0,0 +1,285 @@
+// Event logger test
+// This test registers every callback on one or more windows and prints a
+// numbered, timestamped line for each event. With -machine the lines are
+// tab separated fields, to compare event sequences with diff:
+//
+//	counter, window, event and name=value pairs
+//
+// The time is left out so that the logs of two runs can be compared, unless
+// -time is also given, which adds it after the counter. The other messages
+// are then printed to stderr.
+//
+// Run it with go run ./tests -t events [-n 2] [-machine [-time]]
+package main
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+
+	glfw "github.com/jkvatne/purego-glfw"
+	gl "github.com/jkvatne/purego-glfw/gl"
+)
+
+var (
+	eventWindowCount int
+	eventsMachine    bool
+	eventsTime       bool
+)
+
+var eventCounter int
+
+// eventWindows holds the number of each window, from 1
+var eventWindows = map[*glfw.Window]int{}
+
+type eventField struct {
+	name  string
+	value any
+}
+
+// logEvent prints an event. The window is 0 for monitor and error events.
+func logEvent(window *glfw.Window, event string, fields ...eventField) {
+	eventCounter++
+	t := glfw.GetTime()
+	if eventsMachine {
+		s := []string{fmt.Sprint(eventCounter)}
+		if eventsTime {
+			s = append(s, fmt.Sprintf("%.3f", t))
+		}
+		s = append(s, fmt.Sprint(eventWindows[window]), event)
+		for _, f := range fields {
+			s = append(s, fmt.Sprintf("%s=%v", f.name, f.value))
+		}
+		fmt.Println(strings.Join(s, "\t"))
+		return
+	}
+	s := fmt.Sprintf("%08d at %0.3f: ", eventCounter, t)
+	if window != nil {
+		s += fmt.Sprintf("Window %d: ", eventWindows[window])
+	}
+	s += event
+	for i, f := range fields {
+		if i == 0 {
+			s += " "
+		} else {
+			s += ", "
+		}
+		s += fmt.Sprintf("%s %v", f.name, f.value)
+	}
+	fmt.Println(s)
+}
+
+var keyNames = map[glfw.Key]string{
+	glfw.KeyA: "A", glfw.KeyB: "B", glfw.KeyC: "C", glfw.KeyD: "D", glfw.KeyE: "E",
+	glfw.KeyF: "F", glfw.KeyG: "G", glfw.KeyH: "H", glfw.KeyI: "I", glfw.KeyJ: "J",
+	glfw.KeyK: "K", glfw.KeyL: "L", glfw.KeyM: "M", glfw.KeyN: "N", glfw.KeyO: "O",
+	glfw.KeyP: "P", glfw.KeyQ: "Q", glfw.KeyR: "R", glfw.KeyS: "S", glfw.KeyT: "T",
+	glfw.KeyU: "U", glfw.KeyV: "V", glfw.KeyW: "W", glfw.KeyX: "X", glfw.KeyY: "Y",
+	glfw.KeyZ: "Z",
+	glfw.Key0: "0", glfw.Key1: "1", glfw.Key2: "2", glfw.Key3: "3", glfw.Key4: "4",
+	glfw.Key5: "5", glfw.Key6: "6", glfw.Key7: "7", glfw.Key8: "8", glfw.Key9: "9",
+	glfw.KeySpace: "SPACE", glfw.KeyApostrophe: "APOSTROPHE", glfw.KeyComma: "COMMA",
+	glfw.KeyMinus: "MINUS", glfw.KeyPeriode: "PERIOD", glfw.KeySlash: "SLASH",
+	glfw.KeySemicolon: "SEMICOLON", glfw.KeyEqual: "EQUAL", glfw.KeyLeftBracket: "LEFT BRACKET",
+	glfw.KeyBackslash: "BACKSLASH", glfw.KeyRightBracket: "RIGHT BRACKET",
+	glfw.KeyGraveAccent: "GRAVE ACCENT", glfw.KeyWorld1: "WORLD 1", glfw.KeyWorld2: "WORLD 2",
+	glfw.KeyEscape: "ESCAPE", glfw.KeyEnter: "ENTER", glfw.KeyTab: "TAB",
+	glfw.KeyBackspace: "BACKSPACE", glfw.KeyInsert: "INSERT", glfw.KeyDelete: "DELETE",
+	glfw.KeyRight: "RIGHT", glfw.KeyLeft: "LEFT", glfw.KeyDown: "DOWN", glfw.KeyUp: "UP",
+	glfw.KeyPageUp: "PAGE UP", glfw.KeyPageDown: "PAGE DOWN", glfw.KeyHome: "HOME",
+	glfw.KeyEnd: "END", glfw.KeyCapsLock: "CAPS LOCK", glfw.KeyScrollLock: "SCROLL LOCK",
+	glfw.KeyNumLock: "NUM LOCK", glfw.KeyPrintScreen: "PRINT SCREEN", glfw.KeyPause: "PAUSE",
+	glfw.KeyF1: "F1", glfw.KeyF2: "F2", glfw.KeyF3: "F3", glfw.KeyF4: "F4",
+	glfw.KeyF5: "F5", glfw.KeyF6: "F6", glfw.KeyF7: "F7", glfw.KeyF8: "F8",
+	glfw.KeyF9: "F9", glfw.KeyF10: "F10", glfw.KeyF11: "F11", glfw.KeyF12: "F12",
+	glfw.KeyKP_0: "KEYPAD 0", glfw.KeyKP_1: "KEYPAD 1", glfw.KeyKP_2: "KEYPAD 2",
+	glfw.KeyKP_3: "KEYPAD 3", glfw.KeyKP_4: "KEYPAD 4", glfw.KeyKP_5: "KEYPAD 5",
+	glfw.KeyKP_6: "KEYPAD 6", glfw.KeyKP_7: "KEYPAD 7", glfw.KeyKP_8: "KEYPAD 8",
+	glfw.KeyKP_9: "KEYPAD 9", glfw.KeyKPDecimal: "KEYPAD DECIMAL", glfw.KeyKPDivide: "KEYPAD DIVIDE",
+	glfw.KeyKPMultiply: "KEYPAD MULTIPLY", glfw.KeyKPSubtract: "KEYPAD SUBTRACT",
+	glfw.KeyKPAdd: "KEYPAD ADD", glfw.KeyKPEnter: "KEYPAD ENTER", glfw.KeyKPEqual: "KEYPAD EQUAL",
+	glfw.KeyLeftShift: "LEFT SHIFT", glfw.KeyLeftControl: "LEFT CONTROL", glfw.KeyLeftAlt: "LEFT ALT",
+	glfw.KeyLeftSuper: "LEFT SUPER", glfw.KeyRightShift: "RIGHT SHIFT", glfw.KeyRightControl: "RIGHT CONTROL",
+	glfw.KeyRightAlt: "RIGHT ALT", glfw.KeyRightSuper: "RIGHT SUPER", glfw.KeyMenu: "MENU",
+}
+
+func keyName(key glfw.Key) string {
+	if name, ok := keyNames[key]; ok {
+		return name
+	}
+	return "UNKNOWN"
+}
+
+func actionName(action glfw.Action) string {
+	switch action {
+	case glfw.Press:
+		return "pressed"
+	case glfw.Release:
+		return "released"
+	case glfw.Repeat:
+		return "repeated"
+	}
+	return "caused unknown action"
+}
+
+func buttonName(button glfw.MouseButton) string {
+	switch button {
+	case glfw.MouseButtonLeft:
+		return "left"
+	case glfw.MouseButtonRight:
+		return "right"
+	case glfw.MouseButtonMiddle:
+		return "middle"
+	}
+	return fmt.Sprint(int(button))
+}
+
+func modsName(mods glfw.ModifierKey) string {
+	names := []struct {
+		mod  glfw.ModifierKey
+		name string
+	}{
+		{glfw.ModShift, "shift"},
+		{glfw.ModControl, "control"},
+		{glfw.ModAlt, "alt"},
+		{glfw.ModSuper, "super"},
+		{glfw.ModCapsLock, "capslock"},
+		{glfw.ModNumLock, "numlock"},
+	}
+	var s []string
+	for _, n := range names {
+		if mods&n.mod != 0 {
+			s = append(s, n.name)
+		}
+	}
+	if len(s) == 0 {
+		return "none"
+	}
+	return strings.Join(s, "+")
+}
+
+func registerEventCallbacks(w *glfw.Window) {
+	w.SetPosCallback(func(w *glfw.Window, x, y int) {
+		logEvent(w, "Window position", eventField{"x", x}, eventField{"y", y})
+	})
+	w.SetSizeCallback(func(w *glfw.Window, width, height int) {
+		logEvent(w, "Window size", eventField{"width", width}, eventField{"height", height})
+	})
+	w.SetFramebufferSizeCallback(func(w *glfw.Window, width, height int) {
+		logEvent(w, "Framebuffer size", eventField{"width", width}, eventField{"height", height})
+	})
+	w.SetContentScaleCallback(func(w *glfw.Window, x, y float32) {
+		logEvent(w, "Window content scale", eventField{"x", fmt.Sprintf("%0.3f", x)}, eventField{"y", fmt.Sprintf("%0.3f", y)})
+	})
+	w.SetCloseCallback(func(w *glfw.Window) {
+		logEvent(w, "Window close")
+	})
+	w.SetRefreshCallback(func(w *glfw.Window) {
+		logEvent(w, "Window refresh")
+		w.MakeContextCurrent()
+		gl.Clear(gl.COLOR_BUFFER_BIT)
+		w.SwapBuffers()
+	})
+	w.SetFocusCallback(func(w *glfw.Window, focused bool) {
+		logEvent(w, "Window focus", eventField{"focused", focused})
+	})
+	w.SetIconifyCallback(func(w *glfw.Window, iconified bool) {
+		logEvent(w, "Window iconify", eventField{"iconified", iconified})
+	})
+	w.SetMaximizeCallback(func(w *glfw.Window, maximized bool) {
+		logEvent(w, "Window maximize", eventField{"maximized", maximized})
+	})
+	w.SetMouseButtonCallback(func(w *glfw.Window, button glfw.MouseButton, action glfw.Action, mods glfw.ModifierKey) {
+		logEvent(w, "Mouse button", eventField{"button", buttonName(button)},
+			eventField{"action", actionName(action)}, eventField{"mods", modsName(mods)})
+	})
+	w.SetCursorPosCallback(func(w *glfw.Window, x, y float64) {
+		logEvent(w, "Cursor position", eventField{"x", fmt.Sprintf("%0.3f", x)}, eventField{"y", fmt.Sprintf("%0.3f", y)})
+	})
+	w.SetCursorEnterCallback(func(w *glfw.Window, entered bool) {
+		logEvent(w, "Cursor enter", eventField{"entered", entered})
+	})
+	w.SetScrollCallback(func(w *glfw.Window, x, y float64) {
+		logEvent(w, "Scroll", eventField{"x", fmt.Sprintf("%0.3f", x)}, eventField{"y", fmt.Sprintf("%0.3f", y)})
+	})
+	w.SetKeyCallback(func(w *glfw.Window, key glfw.Key, scancode int, action glfw.Action, mods glfw.ModifierKey) {
+		logEvent(w, "Key", eventField{"key", fmt.Sprintf("0x%04x", int(key))}, eventField{"name", keyName(key)},
+			eventField{"scancode", fmt.Sprintf("0x%04x", scancode)}, eventField{"action", actionName(action)},
+			eventField{"mods", modsName(mods)})
+	})
+	w.SetCharCallback(func(w *glfw.Window, char rune) {
+		logEvent(w, "Character", eventField{"codepoint", fmt.Sprintf("U+%04X", char)}, eventField{"input", fmt.Sprintf("%q", char)})
+	})
+	w.SetDropCallback(func(w *glfw.Window, names []string) {
+		fields := []eventField{{"count", len(names)}}
+		for i, name := range names {
+			fields = append(fields, eventField{fmt.Sprintf("path%d", i+1), fmt.Sprintf("%q", name)})
+		}
+		logEvent(w, "Drop", fields...)
+	})
+}
+
+// eventStatus prints a message that is not an event, to stderr in machine mode
+func eventStatus(format string, args ...any) {
+	out := os.Stdout
+	if eventsMachine {
+		out = os.Stderr
+	}
+	fmt.Fprintf(out, format, args...)
+}
+
+func EventsMain() {
+	eventStatus("Event logger, close all windows to exit\n")
+	runtime.LockOSThread()
+	err := glfw.Init()
+	if err != nil {
+		panic(err.Error())
+	}
+	defer glfw.Terminate()
+	glfw.SetErrorCallback(func(code int, description string) {
+		logEvent(nil, "Error", eventField{"code", fmt.Sprintf("0x%08x", code)}, eventField{"description", fmt.Sprintf("%q", description)})
+	})
+	glfw.SetMonitorCallback(func(monitor *glfw.Monitor, event glfw.PeripheralEvent) {
+		if event == glfw.Connected {
+			x, y := monitor.GetPos()
+			mode := monitor.GetVideoMode()
+			logEvent(nil, "Monitor connected", eventField{"name", fmt.Sprintf("%q", monitor.GetMonitorName())},
+				eventField{"mode", fmt.Sprintf("%dx%d", mode.Width, mode.Height)}, eventField{"x", x}, eventField{"y", y})
+		} else {
+			logEvent(nil, "Monitor disconnected", eventField{"name", fmt.Sprintf("%q", monitor.GetMonitorName())})
+		}
+	})
+
+	windows := make([]*glfw.Window, max(eventWindowCount, 1))
+	for i := range windows {
+		title := fmt.Sprintf("Event Linter (Window %d)", i+1)
+		windows[i], err = glfw.CreateWindow(640, 480, title, nil, nil)
+		if err != nil {
+			eventStatus("Could not create window: %v\n", err)
+			os.Exit(1)
+		}
+		eventWindows[windows[i]] = i + 1
+		registerEventCallbacks(windows[i])
+		windows[i].MakeContextCurrent()
+		if i == 0 {
+			if err = gl.InitWithProcAddrFunc(glfw.GetProcAddress); err != nil {
+				eventStatus("Could not init gl: %v\n", err)
+				os.Exit(2)
+			}
+		}
+		glfw.SwapInterval(1)
+	}
+
+	for len(windows) > 0 {
+		glfw.WaitEventsTimeout(1.0)
+		for i := 0; i < len(windows); i++ {
+			if windows[i].ShouldClose() {
+				windows[i].Destroy()
+				windows = append(windows[:i], windows[i+1:]...)
+				i--
+			}
+		}
+	}
+	eventStatus("Event logger finished\n")
+}
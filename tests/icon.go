@@ -96,7 +96,7 @@ func IconMain() {
 		panic("Failed to create window, " + err.Error())
 	}
 	window.MakeContextCurrent()
-	err = gl.Init()
+	err = gl.InitWithProcAddrFunc(glfw.GetProcAddress)
 	if err != nil {
 		panic("gl Init error, " + err.Error())
 	}
@@ -11,7 +11,10 @@ import (
 var testName string
 
 func main() {
-	flag.StringVar(&testName, "t", "", "string can tearing, window, cursor, threads, msaa, reopen, timeout, monitor or opacity")
+	flag.StringVar(&testName, "t", "", "string can tearing, window, cursor, threads, msaa, reopen, timeout, monitor, opacity or events")
+	flag.IntVar(&eventWindowCount, "n", 1, "number of windows for the events test")
+	flag.BoolVar(&eventsMachine, "machine", false, "print the events test log as tab separated fields")
+	flag.BoolVar(&eventsTime, "time", false, "include the time in the machine readable events test log")
 	flag.Parse()
 	switch testName {
 	case "title":
@@ -36,6 +39,8 @@ func main() {
 		OpacityMain()
 	case "tearing":
 		TearingMain()
+	case "events":
+		EventsMain()
 	case "all":
 		TitleMain()
 		ThreadsMain()
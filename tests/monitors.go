@@ -137,7 +137,7 @@ func test_mode(monitor *glfw.Monitor, i int, timeShownSec float64) {
 	window.SetFramebufferSizeCallback(framebuffer_size_callback)
 	window.SetKeyCallback(keyCallback4)
 	window.MakeContextCurrent()
-	err = gl.Init()
+	err = gl.InitWithProcAddrFunc(glfw.GetProcAddress)
 	if err != nil {
 		panic(fmt.Sprintf("Failed to enter mode %d: %s\n", i, formatMode(&mode)))
 	}
@@ -98,7 +98,7 @@ func MsaaMain() {
 	}
 	_ = window.SetKeyCallback(key_callback2)
 	window.MakeContextCurrent()
-	err = gl.Init()
+	err = gl.InitWithProcAddrFunc(glfw.GetProcAddress)
 	if err != nil {
 		glfw.Terminate()
 		fmt.Printf("gl Init error, " + err.Error())
@@ -57,7 +57,7 @@ func OpacityMain() {
 
 	// Initialize Open-gl on current window
 	window.MakeContextCurrent()
-	err = gl.Init()
+	err = gl.InitWithProcAddrFunc(glfw.GetProcAddress)
 	if err != nil {
 		glfw.Terminate()
 		fmt.Printf("Could not init gl: %v\n", err)
@@ -124,7 +124,7 @@ func ReopenMain() {
 			fmt.Printf("Opening regular window took %0.3f seconds\n", glfw.GetTime()-base)
 		}
 		window.MakeContextCurrent()
-		err = gl.Init()
+		err = gl.InitWithProcAddrFunc(glfw.GetProcAddress)
 		if err != nil {
 			panic(err.Error())
 		}
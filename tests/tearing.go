@@ -31,8 +31,8 @@ func update_window_title(window *glfw.Window) {
 }
 
 func set_swap_interval(window *glfw.Window, interval int) {
-	swap_interval = interval
-	glfw.SwapInterval(swap_interval)
+	glfw.SwapInterval(interval)
+	swap_interval = glfw.GetSwapInterval()
 	update_window_title(window)
 }
 
@@ -99,13 +99,13 @@ func TearingMain() {
 	}
 	window.MakeContextCurrent()
 	window.SetKeyCallback(key_callback10)
-	err = gl.Init()
+	err = gl.InitWithProcAddrFunc(glfw.GetProcAddress)
 	if err != nil {
 		glfw.Terminate()
 		fmt.Printf("Failed to initialize gl: %v", err)
 	}
 	set_swap_interval(window, 0)
-	swap_tear = glfw.ExtensionSupported("WGL_EXT_swap_control_tear") || glfw.ExtensionSupported("GLX_EXT_swap_control_tear")
+	swap_tear = glfw.SwapIntervalTearSupported()
 	fmt.Printf("Extension for controling tear is %v\n", swap_tear)
 	gl.GenBuffers(1, &vertex_buffer2)
 	gl.BindBuffer(gl.ARRAY_BUFFER, vertex_buffer2)
@@ -199,7 +199,7 @@ func ThreadsMain() {
 	// Initialize the gl library. This has to be done with a valid context (i.e. a window).
 	// We use window 0 here. It is detached after initialization
 	threadDefs[0].window.MakeContextCurrent()
-	err = gl.Init()
+	err = gl.InitWithProcAddrFunc(glfw.GetProcAddress)
 	if err != nil {
 		fmt.Printf("gl Init error, " + err.Error())
 	}
@@ -40,7 +40,7 @@ func TimeoutMain() {
 		fmt.Printf("Could not create window: %v\n", err)
 	}
 	window.MakeContextCurrent()
-	err = gl.Init()
+	err = gl.InitWithProcAddrFunc(glfw.GetProcAddress)
 	if err != nil {
 		glfw.Terminate()
 		fmt.Printf("Could not init gl: %v\n", err)
@@ -28,7 +28,7 @@ func TitleMain() {
 		os.Exit(1)
 	}
 	window.MakeContextCurrent()
-	err = gl.Init()
+	err = gl.InitWithProcAddrFunc(glfw.GetProcAddress)
 	if err != nil {
 		glfw.Terminate()
 		fmt.Printf("Could not init gl: %v\n", err)
@@ -74,7 +74,7 @@ func WindowInfoMain() {
 	}
 	window.SetKeyCallback(key_callback_window)
 	window.MakeContextCurrent()
-	err = gl.Init()
+	err = gl.InitWithProcAddrFunc(glfw.GetProcAddress)
 	if err != nil {
 		glfw.Terminate()
 		fmt.Printf("Could not init gl: %v\n", err)
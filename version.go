@@ -0,0 +1,94 @@
+package glfw
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ContextVersion is an OpenGL or OpenGL ES version with its profile.
+type ContextVersion struct {
+	Client  int // OpenGLAPI or OpenGLESAPI
+	Major   int
+	Minor   int
+	Profile int // OpenGLCoreProfile, OpenGLCompatProfile or 0 for any profile
+}
+
+func (v ContextVersion) String() string {
+	s := fmt.Sprintf("OpenGL %d.%d", v.Major, v.Minor)
+	if v.Client == OpenGLESAPI {
+		return fmt.Sprintf("OpenGL ES %d.%d", v.Major, v.Minor)
+	}
+	switch v.Profile {
+	case OpenGLCoreProfile:
+		s += " core"
+	case OpenGLCompatProfile:
+		s += " compat"
+	}
+	return s
+}
+
+// ContextVersionsHint sets an ordered list of acceptable context versions,
+// for example 4.6 core, 4.1 core, 3.3 core and then OpenGL ES 3.0. The next
+// CreateWindow tries them in turn on the same native window, and uses the
+// first one the driver provides. The list replaces the ClientAPI,
+// ContextVersionMajor, ContextVersionMinor and OpenGLProfile hints, while the
+// other context hints apply to every version. Calling it without versions
+// restores the use of those hints, and so does DefaultWindowHints.
+// Use Window.GetContextVersion to find the version that was obtained.
+func ContextVersionsHint(versions ...ContextVersion) {
+	_glfw.hints.context.versions = append([]ContextVersion(nil), versions...)
+}
+
+// GetContextVersion returns the client API, version and profile of the
+// context of the window, as reported by the driver. The version can be
+// newer than the one requested.
+func (w *Window) GetContextVersion() ContextVersion {
+	return ContextVersion{
+		Client:  int(w.context.client),
+		Major:   int(w.context.major),
+		Minor:   int(w.context.minor),
+		Profile: int(w.context.profile),
+	}
+}
+
+// versionConfig returns a copy of the context configuration for the version
+func versionConfig(ctxconfig *_GLFWctxconfig, v ContextVersion) *_GLFWctxconfig {
+	c := *ctxconfig
+	c.client = int32(v.Client)
+	c.major = int32(v.Major)
+	c.minor = int32(v.Minor)
+	c.profile = int32(v.Profile)
+	c.versions = nil
+	return &c
+}
+
+// createContextVersions creates a context with the first version of the list
+// that succeeds. The pixel format of the window is set by the first attempt
+// and kept for the others. On success the configuration is updated to the
+// version used, so that shared and recreated contexts get the same.
+func createContextVersions(window *_GLFWwindow, ctxconfig *_GLFWctxconfig, fbconfig *_GLFWfbconfig) error {
+	previous := getCurrentWindow()
+	var failures []error
+	for _, v := range ctxconfig.versions {
+		c := versionConfig(ctxconfig, v)
+		err := glfwCreateContextWGL(window, c, fbconfig)
+		if err == nil {
+			err = glfwRefreshContextAttribs(window, c)
+		}
+		if err == nil {
+			*ctxconfig = *c
+			return nil
+		}
+		failures = append(failures, fmt.Errorf("%v: %w", v, err))
+		// Discard the failed context, keeping the window
+		if getCurrentWindow() == window {
+			_ = glfwMakeContextCurrent(previous)
+		}
+		if window.context.destroy != nil {
+			window.context.destroy(window)
+			trackResource("context", -1)
+		}
+		*window.context = _GLFWcontext{}
+	}
+	return fmt.Errorf("could not create graphical context with any of the requested versions:\n%w", errors.Join(failures...))
+}
@@ -2,10 +2,7 @@ package glfw
 
 import (
 	"errors"
-	"flag"
 	"fmt"
-	"log/slog"
-	"os"
 	"strconv"
 	"strings"
 	"syscall"
@@ -15,8 +12,7 @@ import (
 )
 
 var (
-	opengl32         = windows.NewLazySystemDLL("opengl32.dll")
-	outputVideoModes = flag.Bool("output-video-modes", false, "Show videomodes and mke a video-modes.txt file")
+	opengl32 = windows.NewLazySystemDLL("opengl32.dll")
 )
 
 const (
@@ -44,11 +40,14 @@ const (
 	wgl_STENCIL_BITS_ARB                        = 0x2023
 	wgl_AUX_BUFFERS_ARB                         = 0x2024
 	wgl_NO_ACCELERATION_ARB                     = 0x2025
+	wgl_GENERIC_ACCELERATION_ARB                = 0x2026
 	wgl_TYPE_RGBA_ARB                           = 0x202B
+	wgl_TYPE_RGBA_FLOAT_ARB                     = 0x21A0
 	wgl_SAMPLES_ARB                             = 0x2042
 	wgl_FRAMEBUFFER_SRGB_CAPABLE_ARB            = 0x20a9
 	wgl_COLORSPACE_EXT                          = 0x309d
 	wgl_COLORSPACE_SRGB_EXT                     = 0x3089
+	wgl_COLORSPACE_LINEAR_EXT                   = 0x308A
 	wgl_CONTEXT_DEBUG_BIT_ARB                   = 0x00000001
 	wgl_CONTEXT_FORWARD_COMPATIBLE_BIT_ARB      = 0x00000002
 	wgl_CONTEXT_PROFILE_MASK_ARB                = 0x9126
@@ -95,6 +94,12 @@ func wglGetProcAddress(name string) uintptr {
 }
 
 func swapBuffersWGL(window *_GLFWwindow) {
+	if window.monitor == nil && !_glfw.win32.windows8OrGreater && DwmIsCompositionEnabled() {
+		// HACK: Use DwmFlush when desktop composition is enabled on Windows Vista and 7
+		for count := abs(int32(window.context.wgl.interval)); count > 0; count-- {
+			DwmFlush()
+		}
+	}
 	_, _, _ = _SwapBuffers.Call(uintptr(window.context.wgl.dc))
 	// Ignore errors becausee it sometimes fails without reason
 }
@@ -118,7 +123,18 @@ func swapIntervalWGL(interval int) {
 	if window == nil {
 		panic("swapIntervalWGL failed, window is nil\n")
 	}
+	if interval < 0 && !_glfw.wgl.EXT_swap_control_tear {
+		// Negative intervals are only valid with WGL_EXT_swap_control_tear
+		glfwInputError(InvalidValue, fmt.Sprintf("WGL: Swap interval %d requires WGL_EXT_swap_control_tear", interval))
+		interval = -interval
+	}
 	window.context.wgl.interval = interval
+	if window.monitor == nil && !_glfw.win32.windows8OrGreater && DwmIsCompositionEnabled() {
+		// HACK: Disable WGL swap interval when desktop composition is enabled on
+		//       Windows Vista and 7 to avoid interfering with DWM vsync
+		//       The interval is instead applied by DwmFlush in swapBuffersWGL
+		interval = 0
+	}
 	if _glfw.wgl.EXT_swap_control {
 		_, _, _ = syscall.SyscallN(_glfw.wgl.SwapIntervalEXT, uintptr(interval))
 	}
@@ -158,7 +174,7 @@ func getCurrentContext() HANDLE {
 func makeCurrent(dc HDC, handle HANDLE) bool {
 	r1, _, err := _glfw.wgl.wglMakeCurrent.Call(uintptr(dc), uintptr(handle))
 	if !errors.Is(err, syscall.Errno(0)) {
-		slog.Error("wgl makeCurrent failed", "err", err, "dc", dc, "handle", handle)
+		glfwInputError(PlatformError, fmt.Sprintf("WGL: wglMakeCurrent failed, %v (dc=%v, handle=%v)", err, dc, handle))
 	}
 	return r1 != 0
 }
@@ -171,10 +187,15 @@ func setPixelFormat(dc HDC, iPixelFormat int32, pfd *PIXELFORMATDESCRIPTOR) int
 	return int(ret)
 }
 
+func getPixelFormat(dc HDC) int32 {
+	ret, _, _ := _GetPixelFormat.Call(uintptr(dc))
+	return int32(ret)
+}
+
 func choosePixelFormat(dc HDC, pfd *PIXELFORMATDESCRIPTOR) (int32, error) {
 	ret, _, err := _ChoosePixelFormat.Call(uintptr(dc), uintptr(unsafe.Pointer(pfd)))
 	if !errors.Is(err, syscall.Errno(0)) {
-		return 0, fmt.Errorf("choosePixelFormat failed, " + err.Error())
+		return 0, fmt.Errorf("choosePixelFormat failed, %v", err)
 	}
 	return int32(ret), nil
 }
@@ -196,7 +217,8 @@ func shareLists(share syscall.Handle, handle HANDLE) bool {
 	return ret == 0
 }
 
-func extensionSupportedWGL(extension string) bool {
+// extensionsStringWGL returns the WGL extensions of the current device context
+func extensionsStringWGL() string {
 	var extensions string
 	if _glfw.wgl.GetExtensionsStringARB != 0 {
 		r, _, err := syscall.SyscallN(_glfw.wgl.GetExtensionsStringARB, uintptr(getCurrentDC()))
@@ -211,6 +233,11 @@ func extensionSupportedWGL(extension string) bool {
 		}
 		extensions = GoStr((*uint8)(unsafe.Pointer(r)))
 	}
+	return extensions
+}
+
+func extensionSupportedWGL(extension string) bool {
+	extensions := extensionsStringWGL()
 	if extensions == "" {
 		return false
 	}
@@ -222,27 +249,11 @@ func getProcAddressWGL(procName string) uintptr {
 	if proc != 0 {
 		return proc
 	}
-	return opengl32.NewProc(procName).Addr()
-}
-
-func OutputPixelFormats(dc HDC) {
-	var pfd PIXELFORMATDESCRIPTOR
-	f, err := os.Create("video-modes.txt")
-	if err != nil {
-		slog.Error("Failed to open file video-modes.txt", "err", err)
-	}
-	defer func(f *os.File) {
-		_ = f.Close()
-	}(f)
-	e := int32(500)
-	for pf := int32(1); pf <= e; pf++ {
-		e = describePixelFormat(dc, pf, int(unsafe.Sizeof(pfd)), &pfd)
-		fmt.Printf("No %d: Col=%d, RGBA=%v, Flag=%4X, Accum=%d, Depth=%d, Stencil=%d, Aux=%d\n",
-			pf, pfd.cColorBits, pfd.iPixelType == 0, pfd.dwFlags, pfd.cAccumBits, pfd.cDepthBits, pfd.cStencilBits, pfd.cAuxBuffers)
-		s := fmt.Sprintf("No %d: Col=%d, RGBA=%v, Flag=%4X, Accum=%d, Depth=%d, Stencil=%d, Aux=%d\n",
-			pf, pfd.cColorBits, pfd.iPixelType == 0, pfd.dwFlags, pfd.cAccumBits, pfd.cDepthBits, pfd.cStencilBits, pfd.cAuxBuffers)
-		_, _ = f.WriteString(s)
+	p := opengl32.NewProc(procName)
+	if p.Find() != nil {
+		return 0
 	}
+	return p.Addr()
 }
 
 func _glfwInitWGL() error {
@@ -252,13 +263,13 @@ func _glfwInitWGL() error {
 		return fmt.Errorf("your graphic card does not support OpenGl, 'opengl32.dll' not available: %w", err)
 	}
 	if err := gdi32.Load(); err != nil {
-		return fmt.Errorf("could not load gdi32.dll: " + err.Error())
+		return fmt.Errorf("could not load gdi32.dll: %v", err)
 	}
 	if err := _ChoosePixelFormat.Find(); err != nil {
-		return fmt.Errorf("could not find ChoosePixelFormat() in gdi32.dll: " + err.Error())
+		return fmt.Errorf("could not find ChoosePixelFormat() in gdi32.dll: %v", err)
 	}
 	if err := _SetPixelFormat.Find(); err != nil {
-		return fmt.Errorf("could not find SetPixelFormat() in gdi32.dll: " + err.Error())
+		return fmt.Errorf("could not find SetPixelFormat() in gdi32.dll: %v", err)
 	}
 
 	if _glfw.wgl.instance != nil {
@@ -280,9 +291,6 @@ func _glfwInitWGL() error {
 	// NOTE: A dummy context has to be created for opengl32.dll to load the
 	// OpenGL Installable Client Driver, from which we can then query WGL extensions
 	dc := getDC(_glfw.win32.helperWindowHandle)
-	if *outputVideoModes {
-		OutputPixelFormats(dc)
-	}
 
 	// Select wanted functionality
 	pfd.nSize = uint16(unsafe.Sizeof(pfd))
@@ -296,14 +304,13 @@ func _glfwInitWGL() error {
 		pfd.dwFlags = PFD_DRAW_TO_WINDOW | PFD_SUPPORT_OPENGL
 		pf, err = choosePixelFormat(dc, &pfd)
 		if err != nil {
-			fmt.Println("call to gdi32.dll.choosePixelFormat() failed: " + err.Error())
-			fmt.Println("Default to pixel format 1")
+			glfwInputError(PlatformError, "WGL: ChoosePixelFormat failed, defaulting to pixel format 1: "+err.Error())
 			pf = 1
 		}
 	}
 	if setPixelFormat(dc, pf, &pfd) == 0 {
 		err := syscall.GetLastError()
-		fmt.Println("setPixelFormat(" + strconv.Itoa(int(pf)) + ") failed: " + err.Error())
+		glfwInputError(PlatformError, "WGL: SetPixelFormat("+strconv.Itoa(int(pf))+") failed: "+err.Error())
 	}
 	rc := createContext(dc)
 	if rc == 0 {
@@ -312,7 +319,6 @@ func _glfwInitWGL() error {
 	pdc := getCurrentDC()
 	prc := getCurrentContext()
 	if !makeCurrent(dc, rc) {
-		slog.Error("WGL: Failed to make dummy context current")
 		_, _, _ = _glfw.wgl.wglMakeCurrent.Call(uintptr(pdc), uintptr(prc))
 		_, _, _ = _glfw.wgl.wglDeleteContext.Call(uintptr(rc))
 		return fmt.Errorf("WGL: Failed to make dummy context current")
@@ -335,8 +341,10 @@ func _glfwInitWGL() error {
 	_glfw.wgl.ARB_create_context_robustness = extensionSupportedWGL("WGL_ARB_create_context_robustness")
 	_glfw.wgl.ARB_create_context_no_error = extensionSupportedWGL("WGL_ARB_create_context_no_error")
 	_glfw.wgl.EXT_swap_control = extensionSupportedWGL("WGL_EXT_swap_control")
+	_glfw.wgl.EXT_swap_control_tear = extensionSupportedWGL("WGL_EXT_swap_control_tear")
 	_glfw.wgl.EXT_colorspace = extensionSupportedWGL("WGL_EXT_colorspace")
 	_glfw.wgl.ARB_pixel_format = extensionSupportedWGL("WGL_ARB_pixel_format")
+	_glfw.wgl.ARB_pixel_format_float = extensionSupportedWGL("WGL_ARB_pixel_format_float")
 	_glfw.wgl.ARB_context_flush_control = extensionSupportedWGL("WGL_ARB_context_flush_control")
 	makeCurrent(pdc, prc)
 	deleteContext(rc)
@@ -361,7 +369,7 @@ func releaseDC(w syscall.Handle, dc HDC) {
 func describePixelFormat(dc HDC, iPixelFormat int32, nBytes int, ppfd *PIXELFORMATDESCRIPTOR) int32 {
 	r1, _, err := _DescribePixelFormat.Call(uintptr(dc), uintptr(iPixelFormat), uintptr(nBytes), uintptr(unsafe.Pointer(ppfd)))
 	if r1 == 0 || !errors.Is(err, syscall.Errno(0)) {
-		slog.Error("describePixelFormat failed, " + err.Error())
+		glfwInputError(PlatformError, "WGL: DescribePixelFormat failed, "+err.Error())
 		r1 = 0
 	}
 	return int32(r1)
@@ -372,22 +380,28 @@ func glfwCreateContextWGL(window *_GLFWwindow, ctxConfig *_GLFWctxconfig, fbConf
 	var pfd PIXELFORMATDESCRIPTOR
 	hShare := syscall.Handle(0)
 	if ctxConfig.share != nil {
-		hShare = ctxConfig.share.Win32.Handle
+		hShare = syscall.Handle(ctxConfig.share.context.wgl.handle)
 	}
 	share := ctxConfig.share
 	window.context.wgl.dc = getDC(window.Win32.Handle)
 	if window.context.wgl.dc == 0 {
 		return fmt.Errorf("WGL: Failed to retrieve DC for window")
 	}
-	pixelFormat := choosePixelFormatWGL(window, ctxConfig, fbConfig)
-	if pixelFormat == 0 {
-		return fmt.Errorf("WGL: Failed to retrieve PixelFormat for window")
-	}
-	if describePixelFormat(window.context.wgl.dc, pixelFormat, int(unsafe.Sizeof(pfd)), &pfd) == 0 {
-		return fmt.Errorf("WGL: Failed to retrieve PFD for selected pixel format")
-	}
-	if setPixelFormat(window.context.wgl.dc, pixelFormat, &pfd) == 0 {
-		return fmt.Errorf("WGL: Failed to set selected pixel format")
+	// The pixel format of a window can only be set once, so a recreated context keeps it
+	if getPixelFormat(window.context.wgl.dc) == 0 {
+		pixelFormat, err := choosePixelFormatWGL(window, ctxConfig, fbConfig)
+		if err != nil {
+			return err
+		}
+		if pixelFormat == 0 {
+			return fmt.Errorf("WGL: Failed to retrieve PixelFormat for window")
+		}
+		if describePixelFormat(window.context.wgl.dc, pixelFormat, int(unsafe.Sizeof(pfd)), &pfd) == 0 {
+			return fmt.Errorf("WGL: Failed to retrieve PFD for selected pixel format")
+		}
+		if setPixelFormat(window.context.wgl.dc, pixelFormat, &pfd) == 0 {
+			return fmt.Errorf("WGL: Failed to set selected pixel format")
+		}
 	}
 	if ctxConfig.client == OpenGLAPI {
 		if ctxConfig.forward && !_glfw.wgl.ARB_create_context {
@@ -423,11 +437,11 @@ func glfwCreateContextWGL(window *_GLFWwindow, ctxConfig *_GLFWctxconfig, fbConf
 			if _glfw.wgl.ARB_create_context_robustness {
 				if ctxConfig.robustness == NoResetNotification {
 					attribList = append(attribList, wgl_CONTEXT_RESET_NOTIFICATION_STRATEGY_ARB, wgl_NO_RESET_NOTIFICATION_ARB)
+				} else if ctxConfig.robustness == LoseContextOnReset {
+					attribList = append(attribList, wgl_CONTEXT_RESET_NOTIFICATION_STRATEGY_ARB, wgl_LOSE_CONTEXT_ON_RESET_ARB)
 				}
-			} else if ctxConfig.robustness == LoseContextOnReset {
-				attribList = append(attribList, wgl_CONTEXT_RESET_NOTIFICATION_STRATEGY_ARB, wgl_LOSE_CONTEXT_ON_RESET_ARB)
+				flags |= wgl_CONTEXT_ROBUST_ACCESS_BIT_ARB
 			}
-			flags |= wgl_CONTEXT_ROBUST_ACCESS_BIT_ARB
 		}
 		if ctxConfig.release != 0 {
 			if _glfw.wgl.ARB_context_flush_control {
@@ -466,7 +480,7 @@ func glfwCreateContextWGL(window *_GLFWwindow, ctxConfig *_GLFWctxconfig, fbConf
 			return fmt.Errorf("WGL: Failed to create OpenGL context")
 		}
 		if share != nil {
-			if shareLists(share.Win32.Handle, window.context.wgl.handle) {
+			if shareLists(syscall.Handle(share.context.wgl.handle), window.context.wgl.handle) {
 				return fmt.Errorf("WGL: Failed to enable sharing with specified OpenGL context")
 			}
 		}
@@ -478,6 +492,7 @@ func glfwCreateContextWGL(window *_GLFWwindow, ctxConfig *_GLFWctxconfig, fbConf
 	window.context.extensionSupported = extensionSupportedWGL
 	window.context.getProcAddress = getProcAddressWGL
 	window.context.destroy = destroyContextWGL
+	trackResource("context", 1)
 	return nil
 }
 
@@ -489,13 +504,12 @@ func wglGetPixelFormatAttribivARB(dc HDC, pixelFormat int32, layerPlane int, nAt
 	}
 }
 
-func choosePixelFormatWGL(w *_GLFWwindow, ctxConfig *_GLFWctxconfig, fbConfig *_GLFWfbconfig) int32 {
-	var (
-		closest                               *_GLFWfbconfig
-		pixelFormat, nativeCount, usableCount int32
-		pfd                                   PIXELFORMATDESCRIPTOR
-	)
-	nativeCount = describePixelFormat(w.context.wgl.dc, 1, int(unsafe.Sizeof(pfd)), nil)
+// getPixelFormatsWGL returns the RGBA pixel formats of the device context
+// that support OpenGL rendering to a window. The window attribute buffers are
+// used for the queries.
+func getPixelFormatsWGL(w *_GLFWwindow, dc HDC, client int32) []FramebufferConfig {
+	var pfd PIXELFORMATDESCRIPTOR
+	nativeCount := describePixelFormat(dc, 1, int(unsafe.Sizeof(pfd)), nil)
 	w.attribCount = 0
 	if _glfw.wgl.ARB_pixel_format {
 		addAttrib(w, wgl_SUPPORT_OPENGL_ARB)
@@ -524,117 +538,136 @@ func choosePixelFormatWGL(w *_GLFWwindow, ctxConfig *_GLFWctxconfig, fbConfig *_
 		if _glfw.wgl.ARB_multisample {
 			addAttrib(w, wgl_SAMPLES_ARB)
 		}
-		if ctxConfig.client == OpenGLAPI {
-			if _glfw.wgl.ARB_framebuffer_sRGB || _glfw.wgl.EXT_framebuffer_sRGB {
-				addAttrib(w, wgl_FRAMEBUFFER_SRGB_CAPABLE_ARB)
-			}
-		} else {
-			if _glfw.wgl.EXT_colorspace {
-				addAttrib(w, wgl_COLORSPACE_EXT)
-			}
+		if client == OpenGLAPI && (_glfw.wgl.ARB_framebuffer_sRGB || _glfw.wgl.EXT_framebuffer_sRGB) {
+			addAttrib(w, wgl_FRAMEBUFFER_SRGB_CAPABLE_ARB)
+		}
+		if _glfw.wgl.EXT_colorspace {
+			addAttrib(w, wgl_COLORSPACE_EXT)
 		}
 		attrib := int32(wgl_NUMBER_PIXEL_FORMATS_ARB)
 		var extensionCount int32
-		wglGetPixelFormatAttribivARB(w.context.wgl.dc, 1, 0, 1, &attrib, &extensionCount)
+		wglGetPixelFormatAttribivARB(dc, 1, 0, 1, &attrib, &extensionCount)
 		nativeCount = min(nativeCount, extensionCount)
 	}
-	usableConfigs := make([]_GLFWfbconfig, nativeCount)
-	for i := 0; i < int(nativeCount); i++ {
-		u := &usableConfigs[usableCount]
-		pixelFormat = int32(i) + 1
+	configs := make([]FramebufferConfig, 0, nativeCount)
+	for pixelFormat := int32(1); pixelFormat <= nativeCount; pixelFormat++ {
+		u := FramebufferConfig{PixelFormat: int(pixelFormat)}
 		if _glfw.wgl.ARB_pixel_format {
 			// Get pixel format attributes through "modern" extension
-			w.values[0] = 0
-			wglGetPixelFormatAttribivARB(w.context.wgl.dc, pixelFormat, 0, 1 /*attribCount*/, &w.attribs[2], &w.values[2])
 			for j := 0; j < w.attribCount; j++ {
-				wglGetPixelFormatAttribivARB(w.context.wgl.dc, pixelFormat, 0, 1 /*attribCount*/, &w.attribs[j], &w.values[j])
+				wglGetPixelFormatAttribivARB(dc, pixelFormat, 0, 1, &w.attribs[j], &w.values[j])
 			}
 			if findAttrib(w, wgl_SUPPORT_OPENGL_ARB) == 0 || findAttrib(w, wgl_DRAW_TO_WINDOW_ARB) == 0 {
 				continue
 			}
-			if findAttrib(w, wgl_PIXEL_TYPE_ARB) != wgl_TYPE_RGBA_ARB {
-				continue
-			}
-			if findAttrib(w, wgl_ACCELERATION_ARB) == wgl_NO_ACCELERATION_ARB {
-				continue
-			}
-			if (findAttrib(w, wgl_DOUBLE_BUFFER_ARB) != 0) != fbConfig.doublebuffer {
+			switch findAttrib(w, wgl_PIXEL_TYPE_ARB) {
+			case wgl_TYPE_RGBA_ARB:
+			case wgl_TYPE_RGBA_FLOAT_ARB:
+				if !_glfw.wgl.ARB_pixel_format_float {
+					continue
+				}
+				u.Float = true
+			default:
 				continue
 			}
-			u.redBits = findAttrib(w, wgl_RED_BITS_ARB)
-			u.greenBits = findAttrib(w, wgl_GREEN_BITS_ARB)
-			u.blueBits = findAttrib(w, wgl_BLUE_BITS_ARB)
-			u.alphaBits = findAttrib(w, wgl_ALPHA_BITS_ARB)
-			u.depthBits = findAttrib(w, wgl_DEPTH_BITS_ARB)
-			u.stencilBits = findAttrib(w, wgl_STENCIL_BITS_ARB)
-			u.accumRedBits = findAttrib(w, wgl_ACCUM_RED_BITS_ARB)
-			u.accumGreenBits = findAttrib(w, wgl_ACCUM_GREEN_BITS_ARB)
-			u.accumBlueBits = findAttrib(w, wgl_ACCUM_BLUE_BITS_ARB)
-			u.accumAlphaBits = findAttrib(w, wgl_ACCUM_ALPHA_BITS_ARB)
-			u.auxBuffers = findAttrib(w, wgl_AUX_BUFFERS_ARB)
-			if findAttrib(w, wgl_STEREO_ARB) != 0 {
-				u.stereo = true
+			switch findAttrib(w, wgl_ACCELERATION_ARB) {
+			case wgl_NO_ACCELERATION_ARB:
+				u.Acceleration = NoAcceleration
+			case wgl_GENERIC_ACCELERATION_ARB:
+				u.Acceleration = GenericAcceleration
+			default:
+				u.Acceleration = FullAcceleration
 			}
+			u.Doublebuffer = findAttrib(w, wgl_DOUBLE_BUFFER_ARB) != 0
+			u.RedBits = int(findAttrib(w, wgl_RED_BITS_ARB))
+			u.GreenBits = int(findAttrib(w, wgl_GREEN_BITS_ARB))
+			u.BlueBits = int(findAttrib(w, wgl_BLUE_BITS_ARB))
+			u.AlphaBits = int(findAttrib(w, wgl_ALPHA_BITS_ARB))
+			u.DepthBits = int(findAttrib(w, wgl_DEPTH_BITS_ARB))
+			u.StencilBits = int(findAttrib(w, wgl_STENCIL_BITS_ARB))
+			u.AccumRedBits = int(findAttrib(w, wgl_ACCUM_RED_BITS_ARB))
+			u.AccumGreenBits = int(findAttrib(w, wgl_ACCUM_GREEN_BITS_ARB))
+			u.AccumBlueBits = int(findAttrib(w, wgl_ACCUM_BLUE_BITS_ARB))
+			u.AccumAlphaBits = int(findAttrib(w, wgl_ACCUM_ALPHA_BITS_ARB))
+			u.AuxBuffers = int(findAttrib(w, wgl_AUX_BUFFERS_ARB))
+			u.Stereo = findAttrib(w, wgl_STEREO_ARB) != 0
 			if _glfw.wgl.ARB_multisample {
-				u.samples = findAttrib(w, wgl_SAMPLES_ARB)
+				u.Samples = int(findAttrib(w, wgl_SAMPLES_ARB))
+			}
+			if _glfw.wgl.EXT_colorspace {
+				switch findAttrib(w, wgl_COLORSPACE_EXT) {
+				case wgl_COLORSPACE_SRGB_EXT:
+					u.ColorSpace = SRGBColorSpace
+				case wgl_COLORSPACE_LINEAR_EXT:
+					u.ColorSpace = LinearColorSpace
+				}
 			}
-			if ctxConfig.client == OpenGLAPI {
+			if client == OpenGLAPI {
 				if _glfw.wgl.ARB_framebuffer_sRGB || _glfw.wgl.EXT_framebuffer_sRGB {
-					if findAttrib(w, wgl_FRAMEBUFFER_SRGB_CAPABLE_ARB) != 0 {
-						u.sRGB = true
-					}
+					u.SRGB = findAttrib(w, wgl_FRAMEBUFFER_SRGB_CAPABLE_ARB) != 0
 				}
 			} else {
-				if _glfw.wgl.EXT_colorspace {
-					if findAttrib(w, wgl_COLORSPACE_EXT) == wgl_COLORSPACE_SRGB_EXT {
-						u.sRGB = true
-					}
-				}
+				u.SRGB = u.ColorSpace == SRGBColorSpace
 			}
 		} else {
 			// Get pixel format attributes through legacy PFDs
-			if describePixelFormat(w.context.wgl.dc, pixelFormat, int(unsafe.Sizeof(pfd)), &pfd) == 0 {
-				panic("WGL: Failed to describe pixel format")
-			}
-			if (pfd.dwFlags&PFD_DRAW_TO_WINDOW) == 0 || (pfd.dwFlags&PFD_SUPPORT_OPENGL) == 0 {
+			if describePixelFormat(dc, pixelFormat, int(unsafe.Sizeof(pfd)), &pfd) == 0 {
 				continue
 			}
-			if pfd.dwFlags&PFD_GENERIC_ACCELERATED == 0 && pfd.dwFlags&PFD_GENERIC_FORMAT == 0 {
+			if (pfd.dwFlags&PFD_DRAW_TO_WINDOW) == 0 || (pfd.dwFlags&PFD_SUPPORT_OPENGL) == 0 {
 				continue
 			}
 			if pfd.iPixelType != PFD_TYPE_RGBA {
 				continue
 			}
-			if ((pfd.dwFlags & PFD_DOUBLEBUFFER) != 0) != fbConfig.doublebuffer {
-				continue
-			}
-			u.redBits = int32(pfd.cRedBits)
-			u.greenBits = int32(pfd.cGreenBits)
-			u.blueBits = int32(pfd.cBlueBits)
-			u.alphaBits = int32(pfd.cAlphaBits)
-			u.depthBits = int32(pfd.cDepthBits)
-			u.stencilBits = int32(pfd.cStencilBits)
-			u.accumRedBits = int32(pfd.cAccumRedBits)
-			u.accumGreenBits = int32(pfd.cAccumGreenBits)
-			u.accumBlueBits = int32(pfd.cAccumBlueBits)
-			u.accumAlphaBits = int32(pfd.cAccumAlphaBits)
-			u.auxBuffers = int32(pfd.cAuxBuffers)
-			if pfd.dwFlags&PFD_STEREO != 0 {
-				u.stereo = true
+			switch {
+			case pfd.dwFlags&PFD_GENERIC_FORMAT == 0:
+				u.Acceleration = FullAcceleration
+			case pfd.dwFlags&PFD_GENERIC_ACCELERATED != 0:
+				u.Acceleration = GenericAcceleration
+			default:
+				u.Acceleration = NoAcceleration
 			}
+			u.Doublebuffer = pfd.dwFlags&PFD_DOUBLEBUFFER != 0
+			u.RedBits = int(pfd.cRedBits)
+			u.GreenBits = int(pfd.cGreenBits)
+			u.BlueBits = int(pfd.cBlueBits)
+			u.AlphaBits = int(pfd.cAlphaBits)
+			u.DepthBits = int(pfd.cDepthBits)
+			u.StencilBits = int(pfd.cStencilBits)
+			u.AccumRedBits = int(pfd.cAccumRedBits)
+			u.AccumGreenBits = int(pfd.cAccumGreenBits)
+			u.AccumBlueBits = int(pfd.cAccumBlueBits)
+			u.AccumAlphaBits = int(pfd.cAccumAlphaBits)
+			u.AuxBuffers = int(pfd.cAuxBuffers)
+			u.Stereo = pfd.dwFlags&PFD_STEREO != 0
 		}
-		u.handle = uintptr(pixelFormat)
-		usableCount++
+		configs = append(configs, u)
+	}
+	return configs
+}
+
+func glfwPlatformGetFramebufferConfigs(client int32) ([]FramebufferConfig, error) {
+	if err := _glfwInitWGL(); err != nil {
+		return nil, err
 	}
-	if usableCount == 0 {
-		panic("WGL: The driver does not appear to support OpenGL")
+	dc := getDC(_glfw.win32.helperWindowHandle)
+	defer releaseDC(_glfw.win32.helperWindowHandle, dc)
+	return getPixelFormatsWGL(&_GLFWwindow{}, dc, client), nil
+}
+
+func choosePixelFormatWGL(w *_GLFWwindow, ctxConfig *_GLFWctxconfig, fbConfig *_GLFWfbconfig) (int32, error) {
+	configs := getPixelFormatsWGL(w, w.context.wgl.dc, ctxConfig.client)
+	if len(configs) == 0 {
+		glfwInputError(APIUnavailable, "WGL: The driver does not appear to support OpenGL")
+		return 0, fmt.Errorf("WGL: The driver does not appear to support OpenGL")
 	}
-	closest = glfwChooseFBConfig(fbConfig, usableConfigs, usableCount)
-	if closest == nil {
-		panic("WGL: Failed to find a suitable pixel format")
+	closest, err := chooseFramebuffer(w, fbConfig, configs)
+	if err != nil {
+		glfwInputError(FormatUnavailable, "WGL: Failed to find a suitable pixel format: "+err.Error())
+		return 0, err
 	}
-	pixelFormat = int32(closest.handle)
-	return pixelFormat
+	return int32(closest.PixelFormat), nil
 }
 
 func makeContextCurrentWGL(window *_GLFWwindow) error {